@@ -45,8 +45,24 @@ type Cache struct {
 
 // Token Token配置
 type Token struct {
-	Secret string `yaml:"secret"`
-	Expire int    `yaml:"expire"` // 建议明确单位，如 ExpireHour
+	Secret        string `yaml:"secret"`
+	Expire        int    `yaml:"expire"`        // 建议明确单位，如 ExpireHour
+	RefreshExpire int    `yaml:"refreshExpire"` // 刷新令牌闲置有效期(小时)，默认与 MaxRefresh 一致
+	MaxRefresh    int    `yaml:"maxRefresh"`    // 自登录起最长可刷新时间(小时)，默认24
+	RenewWindow   int    `yaml:"renewWindow"`   // 访问令牌剩余有效期低于该值(分钟)时自动续期，0表示关闭
+	SingleSession bool   `yaml:"singleSession"` // 单会话模式，登录时注销该用户的其他会话
+	Algorithm     string `yaml:"algorithm"`     // 签名算法：HS512(默认)、RS256、EdDSA
+	KeyDir        string `yaml:"keyDir"`        // 非对称密钥目录，默认 ./keys
+	ActiveKid     string `yaml:"activeKid"`     // 指定当前签名密钥ID，为空时使用目录中记录的当前密钥
+	KeyReload     int    `yaml:"keyReload"`     // 密钥目录重新扫描间隔(秒)，0表示不扫描
+	// 使用RS256/EdDSA时，在该时间(格式 2006-01-02 15:04:05)之前仍接受未携带kid的HS512旧令牌，为空表示不接受
+	LegacySecretUntil string `yaml:"legacySecretUntil"`
+
+	LoginMaxUserFailures  int `yaml:"loginMaxUserFailures"`  // 同一用户名连续登录失败次数上限，默认5
+	LoginMaxIpFailures    int `yaml:"loginMaxIpFailures"`    // 同一IP连续登录失败次数上限，默认20
+	LoginFailureWindow    int `yaml:"loginFailureWindow"`    // 登录失败次数统计窗口(分钟)，默认15
+	LoginLockDuration     int `yaml:"loginLockDuration"`     // 登录锁定时长(分钟)，默认15
+	LoginCaptchaThreshold int `yaml:"loginCaptchaThreshold"` // 登录失败多少次后需要验证码，默认3
 }
 
 // Email 邮箱配置
@@ -68,6 +84,21 @@ type AliYun struct {
 	AccessKeySecret string `yaml:"accessKeySecret"`
 }
 
+// Storage 文件存储配置
+type Storage struct {
+	Driver        string `yaml:"driver"`        // 存储驱动：local(默认)、s3
+	LocalRoot     string `yaml:"localRoot"`     // 本地存储根目录，默认 ./uploads/storage
+	LocalURL      string `yaml:"localUrl"`      // 本地存储预签名下载接口地址，默认 /dev-api/v1/storage/object
+	Secret        string `yaml:"secret"`        // 本地存储预签名密钥，默认使用 Token.Secret
+	Endpoint      string `yaml:"endpoint"`      // S3 兼容服务地址，如 http://127.0.0.1:9000
+	Region        string `yaml:"region"`        // S3 区域，默认 us-east-1
+	Bucket        string `yaml:"bucket"`        // S3 存储桶
+	AccessKey     string `yaml:"accessKey"`     // S3 访问密钥ID
+	SecretKey     string `yaml:"secretKey"`     // S3 访问密钥
+	PathStyle     bool   `yaml:"pathStyle"`     // S3 使用路径风格地址(MinIO 需开启)
+	PresignExpire int    `yaml:"presignExpire"` // 预签名下载地址有效期(秒)，默认900
+}
+
 // Config 总配置结构体
 type Config struct {
 	Server      Server      `yaml:"server"`
@@ -0,0 +1,33 @@
+/**
+ * Description：
+ * FileName：live.go
+ * Author：CJiaの用心
+ * Create：2025/11/16 10:12:27
+ * Remark：
+ */
+
+package config
+
+import "sync/atomic"
+
+// Live 可热更新的配置，变更时整体替换，使用方每次通过 Load 读取最新值
+type Live[T any] struct {
+	value atomic.Pointer[T]
+}
+
+// NewLive 创建可热更新的配置
+func NewLive[T any](value T) *Live[T] {
+	live := &Live[T]{}
+	live.Store(value)
+	return live
+}
+
+// Load 读取当前配置
+func (l *Live[T]) Load() T {
+	return *l.value.Load()
+}
+
+// Store 替换当前配置
+func (l *Live[T]) Store(value T) {
+	l.value.Store(&value)
+}
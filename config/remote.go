@@ -0,0 +1,20 @@
+/**
+ * Description：
+ * FileName：remote.go
+ * Author：CJiaの用心
+ * Create：2025/11/16 10:21:44
+ * Remark：
+ */
+
+package config
+
+const (
+	DefaultRemoteFile = "./remote.yaml"
+	DefaultEnvPrefix  = "CAREFUL"
+)
+
+// Remote 远程配置来源，优先级：本地文件 < Nacos < 环境变量
+type Remote struct {
+	File      string `yaml:"file"`      // 本地兜底配置文件，Nacos 不可用时使用，默认 ./remote.yaml
+	EnvPrefix string `yaml:"envPrefix"` // 环境变量前缀，默认 CAREFUL，如 CAREFUL_TOKEN_EXPIRE 覆盖 token.expire
+}
@@ -10,6 +10,7 @@ package config
 
 // Server 服务
 type Server struct {
-	Host string `yaml:"host"`
-	Port int    `yaml:"port"`
+	Host           string   `yaml:"host"`
+	Port           int      `yaml:"port"`
+	TrustedProxies []string `yaml:"trustedProxies"` // 可信代理的IP或网段，仅来自可信代理的请求读取 X-Forwarded-For，为空时使用连接地址
 }
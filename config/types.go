@@ -9,27 +9,39 @@
 package config
 
 import (
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/jwt/keymanager"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/logsink"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/storage"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/workerpool"
 	ut "github.com/go-playground/universal-translator"
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
+	"time"
 )
 
 type LocalConfig struct {
 	Server      Server      `yaml:"server"`
 	Application Application `yaml:"application"`
 	NaCos       NaCos       `yaml:"nacos"`
+	Remote      Remote      `yaml:"remote"`
 }
 
 type RemoteConfig struct {
 	DatabaseConfig map[string]DatabaseDetail `yaml:"database" json:"database"`
 	CacheConfig    Cache                     `yaml:"cache" json:"cache"`
 	TokenConfig    Token                     `yaml:"token" json:"token"`
+	StorageConfig  Storage                   `yaml:"storage" json:"storage"`
 }
 
 type RelyConfig struct {
-	Logger *zap.Logger
-	Db     Database
-	Redis  redis.Cmdable
-	Trans  ut.Translator
-	Token  Token
+	Logger  *zap.Logger
+	Db      Database
+	Redis   redis.Cmdable
+	Trans   ut.Translator
+	Token   *Live[Token]          // 令牌配置，配置中心变更后即时生效
+	LogSink *logsink.LogSink      // 审计日志异步写入器
+	Keys    keymanager.KeyManager // 令牌签名密钥
+	Jobs    *workerpool.Pool      // 后台任务协程池
+	Storage storage.Driver        // 文件存储驱动
+	Presign time.Duration         // 预签名下载地址有效期
 }
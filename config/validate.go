@@ -0,0 +1,123 @@
+/**
+ * Description：
+ * FileName：validate.go
+ * Author：CJiaの用心
+ * Create：2025/11/16 10:36:51
+ * Remark：
+ */
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/jwt/keymanager"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/storage"
+	"net"
+	"sort"
+	"time"
+)
+
+// Validate 校验远程配置，返回全部不合法的配置项，错误信息以配置路径开头，如 database.careful.host
+func (c *RemoteConfig) Validate() error {
+	var errs []error
+	invalid := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	if _, ok := c.DatabaseConfig["careful"]; !ok {
+		invalid("database.careful: 未配置业务数据库")
+	}
+	names := make([]string, 0, len(c.DatabaseConfig))
+	for name := range c.DatabaseConfig {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		database := c.DatabaseConfig[name]
+		path := "database." + name
+		if database.Type != "mysql" {
+			invalid("%s.type: 不支持的数据库类型 %q", path, database.Type)
+		}
+		if database.Host == "" {
+			invalid("%s.host: 不能为空", path)
+		}
+		if database.Port <= 0 || database.Port > 65535 {
+			invalid("%s.port: 端口 %d 不合法", path, database.Port)
+		}
+		if database.Username == "" {
+			invalid("%s.username: 不能为空", path)
+		}
+		if database.DBName == "" {
+			invalid("%s.dbname: 不能为空", path)
+		}
+		if database.MaxIdleConn < 0 || database.MaxOpenConn < 0 {
+			invalid("%s: 连接池大小不能为负数", path)
+		}
+		if database.MaxOpenConn > 0 && database.MaxIdleConn > database.MaxOpenConn {
+			invalid("%s.maxIdleConn: 空闲连接数 %d 不能大于最大连接数 %d", path, database.MaxIdleConn, database.MaxOpenConn)
+		}
+		if database.ConnMaxLifetime != nil && *database.ConnMaxLifetime < 0 {
+			invalid("%s.connMaxLifetime: 不能为负数", path)
+		}
+	}
+
+	if c.CacheConfig.Host == "" {
+		invalid("cache.host: 不能为空")
+	}
+	if c.CacheConfig.Port <= 0 || c.CacheConfig.Port > 65535 {
+		invalid("cache.port: 端口 %d 不合法", c.CacheConfig.Port)
+	}
+
+	token := c.TokenConfig
+	switch token.Algorithm {
+	case "", keymanager.AlgorithmHS512:
+		if token.Secret == "" {
+			invalid("token.secret: HS512 签名密钥不能为空")
+		}
+	case keymanager.AlgorithmRS256, keymanager.AlgorithmEdDSA:
+	default:
+		invalid("token.algorithm: 不支持的签名算法 %q", token.Algorithm)
+	}
+	if token.Expire <= 0 {
+		invalid("token.expire: 令牌有效期必须大于0")
+	}
+	if token.LegacySecretUntil != "" {
+		if _, err := time.ParseInLocation(time.DateTime, token.LegacySecretUntil, time.Local); err != nil {
+			invalid("token.legacySecretUntil: 时间格式应为 %s", time.DateTime)
+		}
+	}
+
+	switch c.StorageConfig.Driver {
+	case "", storage.DriverLocal:
+	case storage.DriverS3:
+		if c.StorageConfig.Endpoint == "" || c.StorageConfig.Bucket == "" {
+			invalid("storage: S3 存储需配置 endpoint 与 bucket")
+		}
+	default:
+		invalid("storage.driver: 不支持的文件存储驱动 %q", c.StorageConfig.Driver)
+	}
+
+	return errors.Join(errs...)
+}
+
+// Validate 校验本地配置
+func (c *LocalConfig) Validate() error {
+	var errs []error
+	if c.Server.Port <= 0 || c.Server.Port > 65535 {
+		errs = append(errs, fmt.Errorf("server.port: 端口 %d 不合法", c.Server.Port))
+	}
+	for _, proxy := range c.Server.TrustedProxies {
+		if net.ParseIP(proxy) != nil {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(proxy); err != nil {
+			errs = append(errs, fmt.Errorf("server.trustedProxies: 代理地址 %q 不合法", proxy))
+		}
+	}
+	// 未配置 Nacos 地址时仅使用本地文件与环境变量
+	if c.NaCos.Host != "" && (c.NaCos.DataId == "" || c.NaCos.Group == "") {
+		errs = append(errs, errors.New("nacos: 需同时配置 dataId 与 group"))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,82 @@
+/**
+ * Description：
+ * FileName：validate_test.go
+ * Author：CJiaの用心
+ * Create：2025/11/16 15:58:23
+ * Remark：
+ */
+
+package config
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRemoteConfig_Validate(t *testing.T) {
+	valid := func() RemoteConfig {
+		return RemoteConfig{
+			DatabaseConfig: map[string]DatabaseDetail{"careful": {
+				Type: "mysql", Host: "127.0.0.1", Port: 3306, Username: "root", DBName: "careful_db",
+				MaxIdleConn: 10, MaxOpenConn: 100,
+			}},
+			CacheConfig: Cache{Host: "127.0.0.1", Port: 6379},
+			TokenConfig: Token{Secret: "secret", Expire: 2},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		modify   func(cfg *RemoteConfig)
+		wantErrs []string
+	}{
+		{
+			name:   "合法配置",
+			modify: func(cfg *RemoteConfig) {},
+		},
+		{
+			name:     "未配置业务数据库",
+			modify:   func(cfg *RemoteConfig) { cfg.DatabaseConfig = nil },
+			wantErrs: []string{"database.careful: 未配置业务数据库"},
+		},
+		{
+			name: "返回全部不合法的配置项",
+			modify: func(cfg *RemoteConfig) {
+				detail := cfg.DatabaseConfig["careful"]
+				detail.Port, detail.MaxIdleConn = 0, 200
+				cfg.DatabaseConfig["careful"] = detail
+				cfg.TokenConfig.Secret = ""
+				cfg.StorageConfig.Driver = "ftp"
+			},
+			wantErrs: []string{
+				"database.careful.port: 端口 0 不合法",
+				"database.careful.maxIdleConn: 空闲连接数 200 不能大于最大连接数 100",
+				"token.secret: HS512 签名密钥不能为空",
+				`storage.driver: 不支持的文件存储驱动 "ftp"`,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := valid()
+			tc.modify(&cfg)
+			err := cfg.Validate()
+			if len(tc.wantErrs) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			for _, want := range tc.wantErrs {
+				assert.ErrorContains(t, err, want)
+			}
+		})
+	}
+}
+
+func TestLocalConfig_Validate(t *testing.T) {
+	cfg := LocalConfig{Server: Server{Port: 8080, TrustedProxies: []string{"127.0.0.1", "10.0.0.0/8"}}}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Server.TrustedProxies = append(cfg.Server.TrustedProxies, "nginx")
+	assert.ErrorContains(t, cfg.Validate(), `server.trustedProxies: 代理地址 "nginx" 不合法`)
+}
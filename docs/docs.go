@@ -24,9 +24,38 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/v1/auth/captcha": {
+            "get": {
+                "description": "获取图片验证码，多次登录失败后登录需携带验证码",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "认证管理"
+                ],
+                "summary": "获取登录验证码",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/auth.CaptchaResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
         "/v1/auth/login": {
             "post": {
-                "description": "账号密码登录",
+                "description": "账号密码登录，连续失败后需携带验证码，超过上限将临时锁定",
                 "consumes": [
                     "application/json"
                 ],
@@ -101,6 +130,40 @@ const docTemplate = `{
                 }
             }
         },
+        "/v1/auth/menus": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取当前登录用户可见的菜单树及按钮权限标识，超级管理员返回全部启用菜单",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "认证管理"
+                ],
+                "summary": "获取当前登录用户菜单",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/system.UserMenu"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
         "/v1/auth/profile": {
             "get": {
                 "security": [
@@ -140,7 +203,7 @@ const docTemplate = `{
         },
         "/v1/auth/refresh-token": {
             "post": {
-                "description": "使用旧的JWT令牌获取新的令牌",
+                "description": "使用刷新令牌换取新的访问令牌与刷新令牌，刷新令牌仅可使用一次，重复使用将注销整个会话",
                 "consumes": [
                     "application/json"
                 ],
@@ -184,14 +247,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/tools/dict/create": {
-            "post": {
+        "/v1/auth/sessions": {
+            "get": {
                 "security": [
                     {
                         "LoginToken": []
                     }
                 ],
-                "description": "创建字典",
+                "description": "获取当前用户全部有效的登录会话(设备、IP、浏览器、登录时间)",
                 "consumes": [
                     "application/json"
                 ],
@@ -199,19 +262,54 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "系统工具/字典管理"
+                    "认证管理"
                 ],
-                "summary": "创建字典",
-                "parameters": [
-                    {
-                        "description": "请求",
-                        "name": "CreateDictRequest",
-                        "in": "body",
-                        "required": true,
+                "summary": "获取当前用户的登录会话",
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/tools.CreateDictRequest"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/auth.SessionResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
+                }
+            }
+        },
+        "/v1/auth/sessions/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "注销当前用户的指定登录会话，对应设备需重新登录",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "认证管理"
+                ],
+                "summary": "注销当前用户的指定会话",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "会话ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
                 "responses": {
                     "200": {
@@ -229,14 +327,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/tools/dict/delete/batchDelete": {
-            "post": {
+        "/v1/jobs/listPage": {
+            "get": {
                 "security": [
                     {
                         "LoginToken": []
                     }
                 ],
-                "description": "批量删除字典",
+                "description": "获取本人提交的后台任务分页列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -244,28 +342,52 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "系统工具/字典管理"
+                    "系统工具/后台任务"
                 ],
-                "summary": "批量删除字典",
+                "summary": "获取后台任务分页列表",
                 "parameters": [
                     {
-                        "description": "id数组",
-                        "name": "ids",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
-                        }
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页数量",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "任务名称",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "任务类型(1-导入 2-导出)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "任务状态(1-排队中 2-执行中 3-已完成 4-失败 5-已过期)",
+                        "name": "status",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "$ref": "#/definitions/tools.JobListPageResponse"
                         }
                     },
                     "400": {
@@ -277,14 +399,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/tools/dict/delete/{id}": {
-            "delete": {
+        "/v1/jobs/{id}": {
+            "get": {
                 "security": [
                     {
                         "LoginToken": []
                     }
                 ],
-                "description": "删除指定id字典",
+                "description": "获取本人提交的后台任务状态与执行进度",
                 "consumes": [
                     "application/json"
                 ],
@@ -292,9 +414,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "系统工具/字典管理"
+                    "系统工具/后台任务"
                 ],
-                "summary": "删除字典",
+                "summary": "获取后台任务",
                 "parameters": [
                     {
                         "type": "string",
@@ -308,7 +430,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_tools.Job"
                         }
                     },
                     "400": {
@@ -320,82 +442,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/tools/dict/export": {
+        "/v1/jobs/{id}/download": {
             "get": {
                 "security": [
                     {
                         "LoginToken": []
                     }
                 ],
-                "description": "导出字典数据到Excel文件",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "下载已完成任务的结果文件，结果文件过期清理后不可下载",
                 "produces": [
-                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                    "application/octet-stream"
                 ],
                 "tags": [
-                    "系统工具/字典管理"
+                    "系统工具/后台任务"
                 ],
-                "summary": "导出字典数据",
+                "summary": "下载后台任务结果",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "创建人",
-                        "name": "creator",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "修改人",
-                        "name": "modifier",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "default": true,
-                        "description": "状态",
-                        "name": "status",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "字典名称",
-                        "name": "name",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "字典编码",
-                        "name": "code",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "字典分类",
-                        "name": "type",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "字典值类型",
-                        "name": "valueType",
-                        "in": "query",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Excel文件",
+                        "description": "OK",
                         "schema": {
                             "type": "file"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -403,24 +482,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/tools/dict/getById/{id}": {
+        "/v1/jobs/{id}/events": {
             "get": {
                 "security": [
                     {
                         "LoginToken": []
                     }
                 ],
-                "description": "获取指定id字典信息",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "以SSE推送任务状态(event: progress)，任务结束后推送最终状态并关闭连接",
                 "produces": [
-                    "application/json"
+                    "text/event-stream"
                 ],
                 "tags": [
-                    "系统工具/字典管理"
+                    "系统工具/后台任务"
                 ],
-                "summary": "获取字典",
+                "summary": "订阅后台任务进度",
                 "parameters": [
                     {
                         "type": "string",
@@ -434,7 +510,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_tools.Dict"
+                            "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_tools.Job"
                         }
                     },
                     "400": {
@@ -446,31 +522,36 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/tools/dict/import": {
+        "/v1/logger/cacheLog/delete/batchDelete": {
             "post": {
                 "security": [
                     {
                         "LoginToken": []
                     }
                 ],
-                "description": "导入字典",
+                "description": "批量删除缓存日志",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "系统工具/字典管理"
+                    "日志管理/缓存日志"
                 ],
-                "summary": "导入字典",
+                "summary": "批量删除缓存日志",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "文件(支持xlsx/csv格式)",
-                        "name": "file",
-                        "in": "formData",
-                        "required": true
+                        "description": "id数组",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
                     }
                 ],
                 "responses": {
@@ -489,14 +570,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/tools/dict/listAll": {
-            "get": {
+        "/v1/logger/cacheLog/delete/clear": {
+            "delete": {
                 "security": [
                     {
                         "LoginToken": []
                     }
                 ],
-                "description": "获取所有字典列表",
+                "description": "清空全部缓存日志",
                 "consumes": [
                     "application/json"
                 ],
@@ -504,55 +585,150 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "系统工具/字典管理"
+                    "日志管理/缓存日志"
                 ],
-                "summary": "获取所有字典",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "创建人",
-                        "name": "creator",
+                "summary": "清空缓存日志",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/logger/cacheLog/export": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "按查询条件导出缓存日志为Excel、CSV或JSON文件",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+                    "text/csv",
+                    "application/json",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "日志管理/缓存日志"
+                ],
+                "summary": "导出缓存日志",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "xlsx",
+                        "description": "导出格式(xlsx/csv/json/ndjson)",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "CSV是否写入UTF-8 BOM，中文版Excel直接打开时需开启",
+                        "name": "bom",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "修改人",
-                        "name": "modifier",
+                        "description": "缓存用户名",
+                        "name": "username",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "缓存请求地址",
+                        "name": "path",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "缓存请求方式",
+                        "name": "method",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "缓存者IP",
+                        "name": "ip",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "缓存key键",
+                        "name": "key",
                         "in": "query"
                     },
                     {
                         "type": "boolean",
-                        "default": true,
-                        "description": "状态",
+                        "description": "状态，不传则不限制",
                         "name": "status",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "字典名称",
-                        "name": "name",
+                        "description": "开始时间，yyyy-MM-dd 或 yyyy-MM-dd HH:mm:ss",
+                        "name": "startTime",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "字典编码",
-                        "name": "code",
+                        "description": "结束时间，yyyy-MM-dd 或 yyyy-MM-dd HH:mm:ss",
+                        "name": "endTime",
                         "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "导出文件",
+                        "schema": {
+                            "type": "cache_log"
+                        }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/logger/cacheLog/getById/{id}": {
+            "get": {
+                "security": [
                     {
-                        "type": "integer",
-                        "default": 0,
-                        "description": "字典分类",
-                        "name": "type",
-                        "in": "query",
-                        "required": true
-                    },
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取指定id缓存日志详情",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "日志管理/缓存日志"
+                ],
+                "summary": "获取缓存日志详情",
+                "parameters": [
                     {
-                        "type": "integer",
-                        "default": 0,
-                        "description": "字典值类型",
-                        "name": "valueType",
-                        "in": "query",
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -560,13 +736,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "array",
-                                "items": {
-                                    "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_tools.Dict"
-                                }
-                            }
+                            "$ref": "#/definitions/logger.CacheLog"
                         }
                     },
                     "400": {
@@ -578,14 +748,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/tools/dict/listPage": {
+        "/v1/logger/cacheLog/listPage": {
             "get": {
                 "security": [
                     {
                         "LoginToken": []
                     }
                 ],
-                "description": "获取字典分页列表",
+                "description": "获取缓存日志分页列表，按创建时间倒序",
                 "consumes": [
                     "application/json"
                 ],
@@ -593,9 +763,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "系统工具/字典管理"
+                    "日志管理/缓存日志"
                 ],
-                "summary": "获取字典分页列表",
+                "summary": "获取缓存日志分页列表",
                 "parameters": [
                     {
                         "type": "integer",
@@ -615,57 +785,58 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "创建人",
-                        "name": "creator",
+                        "description": "缓存用户名",
+                        "name": "username",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "修改人",
-                        "name": "modifier",
+                        "description": "缓存请求地址",
+                        "name": "path",
                         "in": "query"
                     },
                     {
-                        "type": "boolean",
-                        "default": true,
-                        "description": "状态",
-                        "name": "status",
+                        "type": "string",
+                        "description": "缓存请求方式",
+                        "name": "method",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "字典名称",
-                        "name": "name",
+                        "description": "缓存者IP",
+                        "name": "ip",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "字典编码",
-                        "name": "code",
+                        "description": "缓存key键",
+                        "name": "key",
                         "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "default": 0,
-                        "description": "字典分类",
-                        "name": "type",
-                        "in": "query",
-                        "required": true
+                        "type": "boolean",
+                        "description": "状态，不传则不限制",
+                        "name": "status",
+                        "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "default": 0,
-                        "description": "字典值类型",
-                        "name": "valueType",
-                        "in": "query",
-                        "required": true
+                        "type": "string",
+                        "description": "开始时间，yyyy-MM-dd 或 yyyy-MM-dd HH:mm:ss",
+                        "name": "startTime",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "结束时间，yyyy-MM-dd 或 yyyy-MM-dd HH:mm:ss",
+                        "name": "endTime",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/tools.DictListPageResponse"
+                            "$ref": "#/definitions/logger.CacheLogListPageResponse"
                         }
                     },
                     "400": {
@@ -677,14 +848,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/tools/dict/update": {
-            "put": {
+        "/v1/logger/dataLog/listPage": {
+            "get": {
                 "security": [
                     {
                         "LoginToken": []
                     }
                 ],
-                "description": "更新字典信息",
+                "description": "获取数据变更日志分页列表，按变更时间倒序",
                 "consumes": [
                     "application/json"
                 ],
@@ -692,25 +863,74 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "系统工具/字典管理"
+                    "日志管理/数据变更日志"
                 ],
-                "summary": "更新字典",
+                "summary": "获取数据变更日志分页列表",
                 "parameters": [
                     {
-                        "description": "请求",
-                        "name": "UpdateDictRequest",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/tools.UpdateDictRequest"
-                        }
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页数量",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "数据表",
+                        "name": "recordTable",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "数据主键ID",
+                        "name": "recordId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "变更类型【1-新增 2-修改 3-删除】",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "操作人",
+                        "name": "operator",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "请求ID",
+                        "name": "requestId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "开始时间，yyyy-MM-dd 或 yyyy-MM-dd HH:mm:ss",
+                        "name": "startTime",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "结束时间，yyyy-MM-dd 或 yyyy-MM-dd HH:mm:ss",
+                        "name": "endTime",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "$ref": "#/definitions/logger.DataLogListPageResponse"
                         }
                     },
                     "400": {
@@ -722,14 +942,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/tools/dictType/batchDelete": {
+        "/v1/logger/loginLog/delete/batchDelete": {
             "post": {
                 "security": [
                     {
                         "LoginToken": []
                     }
                 ],
-                "description": "批量删除字典信息",
+                "description": "批量删除登录日志",
                 "consumes": [
                     "application/json"
                 ],
@@ -737,9 +957,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "系统工具/字典信息管理"
+                    "日志管理/登录日志"
                 ],
-                "summary": "批量删除字典信息",
+                "summary": "批量删除登录日志",
                 "parameters": [
                     {
                         "description": "id数组",
@@ -770,14 +990,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/tools/dictType/create": {
-            "post": {
+        "/v1/logger/loginLog/delete/clear": {
+            "delete": {
                 "security": [
                     {
                         "LoginToken": []
                     }
                 ],
-                "description": "创建字典信息",
+                "description": "清空全部登录日志",
                 "consumes": [
                     "application/json"
                 ],
@@ -785,20 +1005,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "系统工具/字典信息管理"
-                ],
-                "summary": "创建字典信息",
-                "parameters": [
-                    {
-                        "description": "请求",
-                        "name": "CreateDictTypeRequest",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/tools.CreateDictTypeRequest"
-                        }
-                    }
+                    "日志管理/登录日志"
                 ],
+                "summary": "清空登录日志",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -815,130 +1024,207 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/tools/dictType/delete/{id}": {
-            "delete": {
+        "/v1/logger/loginLog/export": {
+            "get": {
                 "security": [
                     {
                         "LoginToken": []
                     }
                 ],
-                "description": "删除指定id信息",
+                "description": "按查询条件导出登录日志为Excel、CSV或JSON文件",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+                    "text/csv",
+                    "application/json",
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "系统工具/字典信息管理"
+                    "日志管理/登录日志"
                 ],
-                "summary": "删除字典信息",
+                "summary": "导出登录日志",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/response.Response"
-                        }
+                        "default": "xlsx",
+                        "description": "导出格式(xlsx/csv/json/ndjson)",
+                        "name": "format",
+                        "in": "query"
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/response.Response"
-                        }
-                    }
-                }
-            }
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "CSV是否写入UTF-8 BOM，中文版Excel直接打开时需开启",
+                        "name": "bom",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "登录用户名",
+                        "name": "username",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "登录ip",
+                        "name": "ip",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "登录结果【true-成功 false-失败】，不传则不限制",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "开始时间，yyyy-MM-dd 或 yyyy-MM-dd HH:mm:ss",
+                        "name": "startTime",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "结束时间，yyyy-MM-dd 或 yyyy-MM-dd HH:mm:ss",
+                        "name": "endTime",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "导出文件",
+                        "schema": {
+                            "type": "login_log"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
         },
-        "/v1/tools/dictType/export": {
+        "/v1/logger/loginLog/getById/{id}": {
             "get": {
                 "security": [
                     {
                         "LoginToken": []
                     }
                 ],
-                "description": "导出字典信息到Excel文件",
+                "description": "获取指定id登录日志详情",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                    "application/json"
                 ],
                 "tags": [
-                    "系统工具/字典信息管理"
+                    "日志管理/登录日志"
                 ],
-                "summary": "导出字典信息",
+                "summary": "获取登录日志详情",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "创建人",
-                        "name": "creator",
-                        "in": "query"
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/logger.LoginLog"
+                        }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/logger/loginLog/listPage": {
+            "get": {
+                "security": [
                     {
-                        "type": "string",
-                        "description": "修改人",
-                        "name": "modifier",
-                        "in": "query"
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取登录日志分页列表，按创建时间倒序",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "日志管理/登录日志"
+                ],
+                "summary": "获取登录日志分页列表",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
                     },
                     {
-                        "type": "boolean",
-                        "default": true,
-                        "description": "状态",
-                        "name": "status",
-                        "in": "query"
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页数量",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "字典信息名称",
-                        "name": "name",
+                        "description": "登录用户名",
+                        "name": "username",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "default": "primary",
-                        "description": "标签类型",
-                        "name": "dictTag",
+                        "description": "登录ip",
+                        "name": "ip",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "数据字典名称",
-                        "name": "dictName",
+                        "type": "boolean",
+                        "description": "登录结果【true-成功 false-失败】，不传则不限制",
+                        "name": "status",
                         "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "数据类型",
-                        "name": "valueType",
-                        "in": "query",
-                        "required": true
+                        "type": "string",
+                        "description": "开始时间，yyyy-MM-dd 或 yyyy-MM-dd HH:mm:ss",
+                        "name": "startTime",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "数据字典id",
-                        "name": "dict_id",
+                        "description": "结束时间，yyyy-MM-dd 或 yyyy-MM-dd HH:mm:ss",
+                        "name": "endTime",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Excel文件",
+                        "description": "OK",
                         "schema": {
-                            "type": "file"
+                            "$ref": "#/definitions/logger.LoginLogListPageResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -946,14 +1232,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/tools/dictType/getById/{id}": {
-            "get": {
+        "/v1/logger/operateLog/delete/batchDelete": {
+            "post": {
                 "security": [
                     {
                         "LoginToken": []
                     }
                 ],
-                "description": "获取指定id字典信息",
+                "description": "批量删除操作日志",
                 "consumes": [
                     "application/json"
                 ],
@@ -961,23 +1247,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "系统工具/字典信息管理"
+                    "日志管理/操作日志"
                 ],
-                "summary": "获取字典信息",
+                "summary": "批量删除操作日志",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "id数组",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_tools.DictType"
+                            "$ref": "#/definitions/response.Response"
                         }
                     },
                     "400": {
@@ -989,33 +1280,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/tools/dictType/import": {
-            "post": {
+        "/v1/logger/operateLog/delete/clear": {
+            "delete": {
                 "security": [
                     {
                         "LoginToken": []
                     }
                 ],
-                "description": "导入字典信息",
+                "description": "清空全部操作日志",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "系统工具/字典信息管理"
-                ],
-                "summary": "导入字典信息",
-                "parameters": [
-                    {
-                        "type": "file",
-                        "description": "文件(支持xlsx/csv格式)",
-                        "name": "file",
-                        "in": "formData",
-                        "required": true
-                    }
+                    "日志管理/操作日志"
                 ],
+                "summary": "清空操作日志",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1032,93 +1314,94 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/tools/dictType/listAll": {
+        "/v1/logger/operateLog/export": {
             "get": {
                 "security": [
                     {
                         "LoginToken": []
                     }
                 ],
-                "description": "获取所有字典信息列表",
+                "description": "按查询条件导出操作日志为Excel、CSV或JSON文件",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+                    "text/csv",
+                    "application/json",
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "系统工具/字典信息管理"
+                    "日志管理/操作日志"
                 ],
-                "summary": "获取所有字典信息",
+                "summary": "导出操作日志",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "创建人",
-                        "name": "creator",
+                        "default": "xlsx",
+                        "description": "导出格式(xlsx/csv/json/ndjson)",
+                        "name": "format",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "修改人",
-                        "name": "modifier",
+                        "type": "boolean",
+                        "default": false,
+                        "description": "CSV是否写入UTF-8 BOM，中文版Excel直接打开时需开启",
+                        "name": "bom",
                         "in": "query"
                     },
                     {
-                        "type": "boolean",
-                        "default": true,
-                        "description": "状态",
-                        "name": "status",
+                        "type": "string",
+                        "description": "请求用户名",
+                        "name": "username",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "字典信息名称",
-                        "name": "name",
+                        "description": "请求地址",
+                        "name": "path",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "default": "primary",
-                        "description": "标签类型",
-                        "name": "dictTag",
+                        "description": "请求方式",
+                        "name": "method",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "响应状态码",
+                        "name": "status",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "数据字典名称",
-                        "name": "dictName",
+                        "description": "请求IP地址",
+                        "name": "ip",
                         "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "数据类型",
-                        "name": "valueType",
-                        "in": "query",
-                        "required": true
+                        "type": "string",
+                        "description": "开始时间，yyyy-MM-dd 或 yyyy-MM-dd HH:mm:ss",
+                        "name": "startTime",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "数据字典id",
-                        "name": "dict_id",
+                        "description": "结束时间，yyyy-MM-dd 或 yyyy-MM-dd HH:mm:ss",
+                        "name": "endTime",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "导出文件",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "array",
-                                "items": {
-                                    "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_tools.DictType"
-                                }
-                            }
+                            "type": "operate_log"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -1126,14 +1409,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/tools/dictType/listByDictNames": {
-            "post": {
+        "/v1/logger/operateLog/getById/{id}": {
+            "get": {
                 "security": [
                     {
                         "LoginToken": []
                     }
                 ],
-                "description": "返回分层结构的字典项映射",
+                "description": "获取指定id操作日志详情",
                 "consumes": [
                     "application/json"
                 ],
@@ -1141,34 +1424,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "系统工具/字典信息管理"
+                    "日志管理/操作日志"
                 ],
-                "summary": "根据字典名称批量查询字典项",
+                "summary": "获取操作日志详情",
                 "parameters": [
                     {
-                        "description": "字典名称数组",
-                        "name": "dictNames",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
-                        }
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "array",
-                                "items": {
-                                    "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_tools.DictType"
-                                }
-                            }
+                            "$ref": "#/definitions/logger.OperateLog"
                         }
                     },
                     "400": {
@@ -1180,14 +1452,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/tools/dictType/listPage": {
+        "/v1/logger/operateLog/listPage": {
             "get": {
                 "security": [
                     {
                         "LoginToken": []
                     }
                 ],
-                "description": "获取字典信息分页列表",
+                "description": "获取操作日志分页列表，按创建时间倒序",
                 "consumes": [
                     "application/json"
                 ],
@@ -1195,9 +1467,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "系统工具/字典信息管理"
+                    "日志管理/操作日志"
                 ],
-                "summary": "获取字典信息分页列表",
+                "summary": "获取操作日志分页列表",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1217,62 +1489,156 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "创建人",
-                        "name": "creator",
+                        "description": "请求用户名",
+                        "name": "username",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "修改人",
-                        "name": "modifier",
+                        "description": "请求地址",
+                        "name": "path",
                         "in": "query"
                     },
                     {
-                        "type": "boolean",
-                        "default": true,
-                        "description": "状态",
-                        "name": "status",
+                        "type": "string",
+                        "description": "请求方式",
+                        "name": "method",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "响应状态码",
+                        "name": "status",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "字典信息名称",
-                        "name": "name",
+                        "description": "请求IP地址",
+                        "name": "ip",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "default": "primary",
-                        "description": "标签类型",
-                        "name": "dictTag",
+                        "description": "开始时间，yyyy-MM-dd 或 yyyy-MM-dd HH:mm:ss",
+                        "name": "startTime",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "数据字典名称",
-                        "name": "dictName",
+                        "description": "结束时间，yyyy-MM-dd 或 yyyy-MM-dd HH:mm:ss",
+                        "name": "endTime",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/logger.OperateLogListPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/storage/object": {
+            "get": {
+                "description": "凭预签名参数下载本地存储的文件，地址由获取文件下载地址接口生成",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "系统工具/文件存储"
+                ],
+                "summary": "预签名下载",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "存储对象键",
+                        "name": "key",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "下载文件名",
+                        "name": "name",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "default": 1,
-                        "description": "数据类型",
-                        "name": "valueType",
+                        "description": "过期时间戳(秒)",
+                        "name": "expires",
                         "in": "query",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "数据字典id",
-                        "name": "dict_id",
-                        "in": "query"
+                        "description": "签名",
+                        "name": "signature",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/tools.DictTypeListPageResponse"
+                            "type": "file"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/dept/ancestors/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取指定id部门的全部祖先部门，按层级由根到近排列",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/部门管理"
+                ],
+                "summary": "获取上级部门链",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_system.Dept"
+                                }
+                            }
                         }
                     },
                     "400": {
@@ -1284,14 +1650,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/tools/dictType/update": {
-            "put": {
+        "/v1/system/dept/create": {
+            "post": {
                 "security": [
                     {
                         "LoginToken": []
                     }
                 ],
-                "description": "更新字典信息",
+                "description": "创建部门",
                 "consumes": [
                     "application/json"
                 ],
@@ -1299,17 +1665,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "系统工具/字典信息管理"
+                    "系统管理/部门管理"
                 ],
-                "summary": "更新字典信息",
+                "summary": "创建部门",
                 "parameters": [
                     {
                         "description": "请求",
-                        "name": "UpdateDictTypeRequest",
+                        "name": "CreateDeptRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/tools.UpdateDictTypeRequest"
+                            "$ref": "#/definitions/system.CreateDeptRequest"
                         }
                     }
                 ],
@@ -1328,559 +1694,9433 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "auth.LoginRequest": {
-            "type": "object",
-            "required": [
-                "password",
-                "username"
-            ],
-            "properties": {
-                "password": {
-                    "description": "密码",
-                    "type": "string",
-                    "maxLength": 50,
-                    "minLength": 6
-                },
-                "username": {
-                    "description": "用户名",
-                    "type": "string",
-                    "maxLength": 50,
-                    "minLength": 3
-                }
-            }
         },
-        "auth.LoginResponse": {
-            "type": "object",
-            "properties": {
-                "expire": {
-                    "description": "过期时间(秒)",
-                    "type": "integer"
-                },
-                "token": {
-                    "description": "JWT令牌",
-                    "type": "string"
-                },
-                "user": {
-                    "description": "用户信息",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_system.User"
+        "/v1/system/dept/delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "删除指定id部门，存在子部门或用户时不允许删除",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/部门管理"
+                ],
+                "summary": "删除部门",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
                         }
-                    ]
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
                 }
             }
         },
-        "auth.RefreshTokenRequest": {
-            "type": "object",
-            "required": [
-                "token"
-            ],
-            "properties": {
-                "token": {
-                    "description": "旧的JWT令牌",
-                    "type": "string",
-                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6Ikp..."
+        "/v1/system/dept/getById/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取指定id部门信息",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/部门管理"
+                ],
+                "summary": "获取部门",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_system.Dept"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
                 }
             }
         },
-        "dict.TypeConst": {
-            "type": "integer",
-            "enum": [
-                1,
-                2,
-                3
-            ],
-            "x-enum-comments": {
-                "TypeConstEnum": "枚举字典",
-                "TypeConstOrdinary": "普通字典",
-                "TypeConstSystem": "系统字典"
-            },
-            "x-enum-varnames": [
-                "TypeConstOrdinary",
-                "TypeConstSystem",
-                "TypeConstEnum"
-            ]
-        },
-        "dict.ValueTypeConst": {
-            "type": "integer",
-            "enum": [
-                1,
-                2,
-                3
-            ],
-            "x-enum-comments": {
-                "ValueTypeConstBool": "布尔",
-                "ValueTypeConstInt": "整型",
-                "ValueTypeConstStr": "字符串"
-            },
-            "x-enum-varnames": [
-                "ValueTypeConstStr",
-                "ValueTypeConstInt",
-                "ValueTypeConstBool"
-            ]
+        "/v1/system/dept/history/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取指定id数据的字段变更历史，按变更时间倒序，敏感字段已脱敏",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "日志管理/数据变更日志"
+                ],
+                "summary": "获取数据变更历史",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页数量",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/logger.DataLogListPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/dept/move": {
+            "put": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "将部门移动到新的上级部门下，子孙部门随之移动",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/部门管理"
+                ],
+                "summary": "移动部门",
+                "parameters": [
+                    {
+                        "description": "请求",
+                        "name": "MoveDeptRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/system.MoveDeptRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/dept/recycle/listPage": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取已删除的部门分页列表，按删除时间倒序",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/部门管理"
+                ],
+                "summary": "获取部门回收站分页列表",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页数量",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "名称",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "删除人",
+                        "name": "deletedBy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/system.DeptListPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/dept/recycle/purge": {
+            "post": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "彻底删除回收站中的部门，删除后不可恢复",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/部门管理"
+                ],
+                "summary": "彻底删除部门",
+                "parameters": [
+                    {
+                        "description": "id数组",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/dept/recycle/restore/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "从回收站恢复指定id部门，上级部门需未被删除",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/部门管理"
+                ],
+                "summary": "恢复部门",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/dept/subtree/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取指定id部门及其全部子孙部门组成的树",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/部门管理"
+                ],
+                "summary": "获取部门子树",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "default": true,
+                        "description": "状态",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "部门名称",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "部门编码",
+                        "name": "code",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_system.Dept"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/dept/tree": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取完整部门树",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/部门管理"
+                ],
+                "summary": "获取部门树",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "default": true,
+                        "description": "状态",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "部门名称",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "部门编码",
+                        "name": "code",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_system.Dept"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/dept/update": {
+            "put": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "更新部门信息，调整上级请使用移动接口",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/部门管理"
+                ],
+                "summary": "更新部门",
+                "parameters": [
+                    {
+                        "description": "请求",
+                        "name": "UpdateDeptRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/system.UpdateDeptRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/menu/create": {
+            "post": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "创建目录、菜单或按钮，按钮必须填写唯一的权限标识",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/菜单管理"
+                ],
+                "summary": "创建菜单",
+                "parameters": [
+                    {
+                        "description": "请求",
+                        "name": "CreateMenuRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/system.CreateMenuRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/menu/delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "删除指定id菜单，存在子菜单时不允许删除，角色关联在彻底删除时解除",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/菜单管理"
+                ],
+                "summary": "删除菜单",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/menu/getById/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取指定id菜单详情",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/菜单管理"
+                ],
+                "summary": "获取菜单详情",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_system.Menu"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/menu/history/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取指定id数据的字段变更历史，按变更时间倒序，敏感字段已脱敏",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "日志管理/数据变更日志"
+                ],
+                "summary": "获取数据变更历史",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页数量",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/logger.DataLogListPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/menu/recycle/listPage": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取已删除的菜单分页列表，按删除时间倒序",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/菜单管理"
+                ],
+                "summary": "获取菜单回收站分页列表",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页数量",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "菜单标题",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "删除人",
+                        "name": "deletedBy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/system.MenuListPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/menu/recycle/purge": {
+            "post": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "彻底删除回收站中的菜单，删除后不可恢复",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/菜单管理"
+                ],
+                "summary": "彻底删除菜单",
+                "parameters": [
+                    {
+                        "description": "id数组",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/menu/recycle/restore/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "从回收站恢复指定id菜单，上级菜单需未被删除",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/菜单管理"
+                ],
+                "summary": "恢复菜单",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/menu/tree": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取完整菜单树，包含按钮",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/菜单管理"
+                ],
+                "summary": "获取菜单树",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "default": true,
+                        "description": "状态",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "菜单标题",
+                        "name": "title",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "菜单类型【1-目录 2-菜单 3-按钮】",
+                        "name": "type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_system.Menu"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/menu/update": {
+            "put": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "更新菜单信息，可调整上级菜单",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/菜单管理"
+                ],
+                "summary": "更新菜单",
+                "parameters": [
+                    {
+                        "description": "请求",
+                        "name": "UpdateMenuRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/system.UpdateMenuRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/online/delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "强制指定会话下线，对应设备需重新登录",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/在线用户"
+                ],
+                "summary": "强制会话下线",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "会话ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/online/deleteUser/{userId}": {
+            "delete": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "强制指定用户的全部会话下线",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/在线用户"
+                ],
+                "summary": "强制用户下线",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/online/listPage": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取在线会话分页列表，按登录时间倒序",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/在线用户"
+                ],
+                "summary": "获取在线用户分页列表",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页数量",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "用户名",
+                        "name": "username",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/system.OnlineListPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/permission/create": {
+            "post": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "创建接口权限，路径需与路由定义一致（不含接口前缀）",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/接口权限管理"
+                ],
+                "summary": "创建接口权限",
+                "parameters": [
+                    {
+                        "description": "请求",
+                        "name": "CreatePermissionRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/system.CreatePermissionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/permission/delete/batchDelete": {
+            "post": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "批量删除接口权限",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/接口权限管理"
+                ],
+                "summary": "批量删除接口权限",
+                "parameters": [
+                    {
+                        "description": "id数组",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/permission/delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "删除指定id接口权限，移入回收站，角色关联在彻底删除时解除",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/接口权限管理"
+                ],
+                "summary": "删除接口权限",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/permission/getById/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取指定id接口权限信息",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/接口权限管理"
+                ],
+                "summary": "获取接口权限",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_system.Permission"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/permission/history/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取指定id数据的字段变更历史，按变更时间倒序，敏感字段已脱敏",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "日志管理/数据变更日志"
+                ],
+                "summary": "获取数据变更历史",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页数量",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/logger.DataLogListPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/permission/listAll": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取所有接口权限列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/接口权限管理"
+                ],
+                "summary": "获取所有接口权限",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "创建人",
+                        "name": "creator",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "修改人",
+                        "name": "modifier",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": true,
+                        "description": "状态",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "权限名称",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "权限编码",
+                        "name": "code",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "请求方式",
+                        "name": "method",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "接口路径",
+                        "name": "path",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_system.Permission"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/permission/listPage": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取接口权限分页列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/接口权限管理"
+                ],
+                "summary": "获取接口权限分页列表",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页数量",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "创建人",
+                        "name": "creator",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "修改人",
+                        "name": "modifier",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": true,
+                        "description": "状态",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "权限名称",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "权限编码",
+                        "name": "code",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "请求方式",
+                        "name": "method",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "接口路径",
+                        "name": "path",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/system.PermissionListPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/permission/recycle/listPage": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取已删除的接口权限分页列表，按删除时间倒序",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/接口权限管理"
+                ],
+                "summary": "获取接口权限回收站分页列表",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页数量",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "名称",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "删除人",
+                        "name": "deletedBy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/system.PermissionListPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/permission/recycle/purge": {
+            "post": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "彻底删除回收站中的接口权限，删除后不可恢复",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/接口权限管理"
+                ],
+                "summary": "彻底删除接口权限",
+                "parameters": [
+                    {
+                        "description": "id数组",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/permission/recycle/restore/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "从回收站恢复指定id接口权限，恢复前重新校验唯一性",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/接口权限管理"
+                ],
+                "summary": "恢复接口权限",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/permission/update": {
+            "put": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "更新接口权限信息",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/接口权限管理"
+                ],
+                "summary": "更新接口权限",
+                "parameters": [
+                    {
+                        "description": "请求",
+                        "name": "UpdatePermissionRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/system.UpdatePermissionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/role/assignDepts": {
+            "put": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "覆盖设置角色自定义数据范围的部门，仅数据范围为自定义时生效",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/角色管理"
+                ],
+                "summary": "分配角色数据范围部门",
+                "parameters": [
+                    {
+                        "description": "请求",
+                        "name": "AssignDeptsRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/system.AssignDeptsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/role/assignMenus": {
+            "put": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "覆盖设置角色可见的目录、菜单与按钮，需包含授权节点本身，上级目录会自动补全",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/角色管理"
+                ],
+                "summary": "分配角色菜单",
+                "parameters": [
+                    {
+                        "description": "请求",
+                        "name": "AssignMenusRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/system.AssignMenusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/role/assignPermissions": {
+            "put": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "覆盖设置角色的接口权限，拥有该角色的用户权限缓存随之失效",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/角色管理"
+                ],
+                "summary": "分配角色接口权限",
+                "parameters": [
+                    {
+                        "description": "请求",
+                        "name": "AssignPermissionsRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/system.AssignPermissionsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/role/assignUserRoles": {
+            "put": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "覆盖设置用户的角色，用户权限缓存随之失效",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/角色管理"
+                ],
+                "summary": "分配用户角色",
+                "parameters": [
+                    {
+                        "description": "请求",
+                        "name": "AssignUserRolesRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/system.AssignUserRolesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/role/create": {
+            "post": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "创建角色",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/角色管理"
+                ],
+                "summary": "创建角色",
+                "parameters": [
+                    {
+                        "description": "请求",
+                        "name": "CreateRoleRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/system.CreateRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/role/delete/batchDelete": {
+            "post": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "批量删除角色",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/角色管理"
+                ],
+                "summary": "批量删除角色",
+                "parameters": [
+                    {
+                        "description": "id数组",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/role/delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "删除指定id角色，移入回收站，用户及权限关联在彻底删除时解除",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/角色管理"
+                ],
+                "summary": "删除角色",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/role/getById/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取指定id角色信息",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/角色管理"
+                ],
+                "summary": "获取角色",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_system.Role"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/role/getByUserId/{userId}": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取指定用户已分配的角色",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/角色管理"
+                ],
+                "summary": "获取用户角色",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_system.Role"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/role/getDeptIds/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取指定id角色自定义数据范围的部门ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/角色管理"
+                ],
+                "summary": "获取角色数据范围部门",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/role/getMenuIds/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取指定id角色已分配的菜单ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/角色管理"
+                ],
+                "summary": "获取角色菜单",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/role/getPermissionIds/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取指定id角色已分配的接口权限ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/角色管理"
+                ],
+                "summary": "获取角色接口权限",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/role/history/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取指定id数据的字段变更历史，按变更时间倒序，敏感字段已脱敏",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "日志管理/数据变更日志"
+                ],
+                "summary": "获取数据变更历史",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页数量",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/logger.DataLogListPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/role/listAll": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取所有角色列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/角色管理"
+                ],
+                "summary": "获取所有角色",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "创建人",
+                        "name": "creator",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "修改人",
+                        "name": "modifier",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": true,
+                        "description": "状态",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "角色名称",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "角色编码",
+                        "name": "code",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_system.Role"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/role/listPage": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取角色分页列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/角色管理"
+                ],
+                "summary": "获取角色分页列表",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页数量",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "创建人",
+                        "name": "creator",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "修改人",
+                        "name": "modifier",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": true,
+                        "description": "状态",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "角色名称",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "角色编码",
+                        "name": "code",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/system.RoleListPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/role/recycle/listPage": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取已删除的角色分页列表，按删除时间倒序",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/角色管理"
+                ],
+                "summary": "获取角色回收站分页列表",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页数量",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "名称",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "删除人",
+                        "name": "deletedBy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/system.RoleListPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/role/recycle/purge": {
+            "post": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "彻底删除回收站中的角色，删除后不可恢复",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/角色管理"
+                ],
+                "summary": "彻底删除角色",
+                "parameters": [
+                    {
+                        "description": "id数组",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/role/recycle/restore/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "从回收站恢复指定id角色及其权限、菜单与数据范围的分配，恢复前重新校验唯一性",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/角色管理"
+                ],
+                "summary": "恢复角色",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/role/update": {
+            "put": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "更新角色信息",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/角色管理"
+                ],
+                "summary": "更新角色",
+                "parameters": [
+                    {
+                        "description": "请求",
+                        "name": "UpdateRoleRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/system.UpdateRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/signingKey/info": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取当前签名算法、签名密钥ID及已加载的验签密钥ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/签名密钥"
+                ],
+                "summary": "获取令牌签名密钥信息",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/system.SigningKeyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/signingKey/rotate": {
+            "post": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "生成新密钥并设为当前签名密钥，旧密钥保留用于验签直至移除，仅支持RS256/EdDSA",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/签名密钥"
+                ],
+                "summary": "轮换令牌签名密钥",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/system.SigningKeyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/user/create": {
+            "post": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "创建用户",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/用户管理"
+                ],
+                "summary": "创建用户",
+                "parameters": [
+                    {
+                        "description": "请求",
+                        "name": "CreateUserRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/system.CreateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/user/delete/batchDelete": {
+            "post": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "批量删除用户",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/用户管理"
+                ],
+                "summary": "批量删除用户",
+                "parameters": [
+                    {
+                        "description": "id数组",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/user/delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "删除指定id用户",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/用户管理"
+                ],
+                "summary": "删除用户",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/user/getById/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取指定id用户信息",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/用户管理"
+                ],
+                "summary": "获取用户",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_system.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/user/history/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取指定id数据的字段变更历史，按变更时间倒序，敏感字段已脱敏",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "日志管理/数据变更日志"
+                ],
+                "summary": "获取数据变更历史",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页数量",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/logger.DataLogListPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/user/listAll": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取所有用户列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/用户管理"
+                ],
+                "summary": "获取所有用户",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "创建人",
+                        "name": "creator",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "修改人",
+                        "name": "modifier",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": true,
+                        "description": "状态",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "用户名",
+                        "name": "username",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "姓名",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "性别",
+                        "name": "gender",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "邮箱",
+                        "name": "email",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "电话",
+                        "name": "mobile",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "部门ID",
+                        "name": "dept_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_system.User"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/user/listPage": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取用户分页列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/用户管理"
+                ],
+                "summary": "获取用户分页列表",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页数量",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "创建人",
+                        "name": "creator",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "修改人",
+                        "name": "modifier",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": true,
+                        "description": "状态",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "用户名",
+                        "name": "username",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "姓名",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "性别",
+                        "name": "gender",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "邮箱",
+                        "name": "email",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "电话",
+                        "name": "mobile",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "部门ID",
+                        "name": "dept_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/system.UserListPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/user/recycle/listPage": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取已删除的用户分页列表，按删除时间倒序",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/用户管理"
+                ],
+                "summary": "获取用户回收站分页列表",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页数量",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "用户名或姓名",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "删除人",
+                        "name": "deletedBy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/system.UserListPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/user/recycle/purge": {
+            "post": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "彻底删除回收站中的用户，删除后不可恢复",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/用户管理"
+                ],
+                "summary": "彻底删除用户",
+                "parameters": [
+                    {
+                        "description": "id数组",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/user/recycle/restore/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "从回收站恢复指定id用户，用户名不能与现有用户重复，原部门已删除时不再关联部门",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/用户管理"
+                ],
+                "summary": "恢复用户",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/user/resetPassword": {
+            "put": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "管理员重置指定用户密码",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/用户管理"
+                ],
+                "summary": "重置用户密码",
+                "parameters": [
+                    {
+                        "description": "请求",
+                        "name": "ResetUserPasswordRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/system.ResetUserPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/user/update": {
+            "put": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "更新用户信息",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/用户管理"
+                ],
+                "summary": "更新用户",
+                "parameters": [
+                    {
+                        "description": "请求",
+                        "name": "UpdateUserRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/system.UpdateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/system/user/updateStatus": {
+            "put": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "启用或停用指定用户",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统管理/用户管理"
+                ],
+                "summary": "启用/停用用户",
+                "parameters": [
+                    {
+                        "description": "请求",
+                        "name": "UpdateUserStatusRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/system.UpdateUserStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/bucket/create": {
+            "post": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "创建存储桶，编码作为文件存储路径前缀",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/文件存储"
+                ],
+                "summary": "创建存储桶",
+                "parameters": [
+                    {
+                        "description": "请求",
+                        "name": "CreateBucketRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tools.CreateBucketRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/bucket/delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "删除指定id存储桶，桶内仍有文件时不允许删除",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/文件存储"
+                ],
+                "summary": "删除存储桶",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/bucket/getById/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取指定id存储桶信息",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/文件存储"
+                ],
+                "summary": "获取存储桶",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_tools.Bucket"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/bucket/history/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取指定id数据的字段变更历史，按变更时间倒序，敏感字段已脱敏",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "日志管理/数据变更日志"
+                ],
+                "summary": "获取数据变更历史",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页数量",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/logger.DataLogListPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/bucket/listAll": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取所有存储桶列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/文件存储"
+                ],
+                "summary": "获取所有存储桶",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "状态",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "存储桶名称",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "存储桶编码",
+                        "name": "code",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_tools.Bucket"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/bucket/listPage": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取存储桶分页列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/文件存储"
+                ],
+                "summary": "获取存储桶分页列表",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页数量",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "状态",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "存储桶名称",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "存储桶编码",
+                        "name": "code",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tools.BucketListPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/bucket/recycle/listPage": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取已删除的存储桶分页列表，按删除时间倒序",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/文件存储"
+                ],
+                "summary": "获取存储桶回收站分页列表",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页数量",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "名称",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "删除人",
+                        "name": "deletedBy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tools.BucketListPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/bucket/recycle/purge": {
+            "post": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "彻底删除回收站中的存储桶，回收站中仍有其文件时不允许删除",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/文件存储"
+                ],
+                "summary": "彻底删除存储桶",
+                "parameters": [
+                    {
+                        "description": "id数组",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/bucket/recycle/restore/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "从回收站恢复指定id存储桶，恢复前重新校验唯一性",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/文件存储"
+                ],
+                "summary": "恢复存储桶",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/bucket/update": {
+            "put": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "更新存储桶名称、大小与类型限制，编码不可修改",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/文件存储"
+                ],
+                "summary": "更新存储桶",
+                "parameters": [
+                    {
+                        "description": "请求",
+                        "name": "UpdateBucketRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tools.UpdateBucketRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/dict/create": {
+            "post": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "创建字典",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/字典管理"
+                ],
+                "summary": "创建字典",
+                "parameters": [
+                    {
+                        "description": "请求",
+                        "name": "CreateDictRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tools.CreateDictRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/dict/delete/batchDelete": {
+            "post": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "批量删除字典",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/字典管理"
+                ],
+                "summary": "批量删除字典",
+                "parameters": [
+                    {
+                        "description": "id数组",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/dict/delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "删除指定id字典",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/字典管理"
+                ],
+                "summary": "删除字典",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/dict/export": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "导出字典数据为Excel、CSV或JSON文件",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+                    "text/csv",
+                    "application/json",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "系统工具/字典管理"
+                ],
+                "summary": "导出字典数据",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "xlsx",
+                        "description": "导出格式(xlsx/csv/json/ndjson)",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "CSV是否写入UTF-8 BOM，中文版Excel直接打开时需开启",
+                        "name": "bom",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "创建人",
+                        "name": "creator",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "修改人",
+                        "name": "modifier",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": true,
+                        "description": "状态",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "字典名称",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "字典编码",
+                        "name": "code",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "字典分类",
+                        "name": "type",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "字典值类型",
+                        "name": "valueType",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "是否提交为后台任务，完成后通过 /v1/jobs/{id}/download 下载",
+                        "name": "async",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "导出文件",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/dict/getById/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取指定id字典信息",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/字典管理"
+                ],
+                "summary": "获取字典",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_tools.Dict"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/dict/history/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取指定id数据的字段变更历史，按变更时间倒序，敏感字段已脱敏",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "日志管理/数据变更日志"
+                ],
+                "summary": "获取数据变更历史",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页数量",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/logger.DataLogListPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/dict/import": {
+            "post": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "导入字典",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/字典管理"
+                ],
+                "summary": "导入字典",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "文件(支持xlsx/csv格式)，与 uploadId 二选一",
+                        "name": "file",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "分片上传会话ID，大文件先通过 /v1/tools/upload 分片上传",
+                        "name": "uploadId",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "导入模式(partial/all/dryRun)，默认 partial",
+                        "name": "mode",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "是否提交为后台任务，提交后通过 /v1/jobs/{id} 查询进度",
+                        "name": "async",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/dict/listAll": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取所有字典列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/字典管理"
+                ],
+                "summary": "获取所有字典",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "创建人",
+                        "name": "creator",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "修改人",
+                        "name": "modifier",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": true,
+                        "description": "状态",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "字典名称",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "字典编码",
+                        "name": "code",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "字典分类",
+                        "name": "type",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "字典值类型",
+                        "name": "valueType",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_tools.Dict"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/dict/listPage": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取字典分页列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/字典管理"
+                ],
+                "summary": "获取字典分页列表",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页数量",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "创建人",
+                        "name": "creator",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "修改人",
+                        "name": "modifier",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": true,
+                        "description": "状态",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "字典名称",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "字典编码",
+                        "name": "code",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "字典分类",
+                        "name": "type",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "字典值类型",
+                        "name": "valueType",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tools.DictListPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/dict/recycle/listPage": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取已删除的字典分页列表，按删除时间倒序",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/字典管理"
+                ],
+                "summary": "获取字典回收站分页列表",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页数量",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "名称",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "删除人",
+                        "name": "deletedBy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tools.DictListPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/dict/recycle/purge": {
+            "post": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "彻底删除回收站中的字典，删除后不可恢复",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/字典管理"
+                ],
+                "summary": "彻底删除字典",
+                "parameters": [
+                    {
+                        "description": "id数组",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/dict/recycle/restore/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "从回收站恢复指定id字典，恢复前重新校验唯一性",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/字典管理"
+                ],
+                "summary": "恢复字典",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/dict/update": {
+            "put": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "更新字典信息",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/字典管理"
+                ],
+                "summary": "更新字典",
+                "parameters": [
+                    {
+                        "description": "请求",
+                        "name": "UpdateDictRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tools.UpdateDictRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/dictType/batchDelete": {
+            "post": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "批量删除字典信息",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/字典信息管理"
+                ],
+                "summary": "批量删除字典信息",
+                "parameters": [
+                    {
+                        "description": "id数组",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/dictType/create": {
+            "post": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "创建字典信息",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/字典信息管理"
+                ],
+                "summary": "创建字典信息",
+                "parameters": [
+                    {
+                        "description": "请求",
+                        "name": "CreateDictTypeRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tools.CreateDictTypeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/dictType/delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "删除指定id信息",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/字典信息管理"
+                ],
+                "summary": "删除字典信息",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/dictType/export": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "导出字典信息为Excel、CSV或JSON文件",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+                    "text/csv",
+                    "application/json",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "系统工具/字典信息管理"
+                ],
+                "summary": "导出字典信息",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "xlsx",
+                        "description": "导出格式(xlsx/csv/json/ndjson)",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "CSV是否写入UTF-8 BOM，中文版Excel直接打开时需开启",
+                        "name": "bom",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "创建人",
+                        "name": "creator",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "修改人",
+                        "name": "modifier",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": true,
+                        "description": "状态",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "字典信息名称",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "primary",
+                        "description": "标签类型",
+                        "name": "dictTag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "数据字典名称",
+                        "name": "dictName",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "数据类型",
+                        "name": "valueType",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "数据字典id",
+                        "name": "dict_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "导出文件",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/dictType/getById/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取指定id字典信息",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/字典信息管理"
+                ],
+                "summary": "获取字典信息",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_tools.DictType"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/dictType/history/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取指定id数据的字段变更历史，按变更时间倒序，敏感字段已脱敏",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "日志管理/数据变更日志"
+                ],
+                "summary": "获取数据变更历史",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页数量",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/logger.DataLogListPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/dictType/import": {
+            "post": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "导入字典信息，模板：/static/templates/import/字典信息导入模板.xlsx",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/字典信息管理"
+                ],
+                "summary": "导入字典信息",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "文件(支持xlsx/csv格式)，与 uploadId 二选一",
+                        "name": "file",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "分片上传会话ID，大文件先通过 /v1/tools/upload 分片上传",
+                        "name": "uploadId",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "导入模式(partial/all/dryRun)，默认 partial",
+                        "name": "mode",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/dictType/listAll": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取所有字典信息列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/字典信息管理"
+                ],
+                "summary": "获取所有字典信息",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "创建人",
+                        "name": "creator",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "修改人",
+                        "name": "modifier",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": true,
+                        "description": "状态",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "字典信息名称",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "primary",
+                        "description": "标签类型",
+                        "name": "dictTag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "数据字典名称",
+                        "name": "dictName",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "数据类型",
+                        "name": "valueType",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "数据字典id",
+                        "name": "dict_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_tools.DictType"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/dictType/listByDictNames": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "按字典名称返回字典选项映射，值的类型由字典的数据类型决定",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/字典信息管理"
+                ],
+                "summary": "根据字典名称批量查询字典项",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "字典名称数组",
+                        "name": "dictNames",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/tools.DictOption"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/dictType/listPage": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取字典信息分页列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/字典信息管理"
+                ],
+                "summary": "获取字典信息分页列表",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页数量",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "创建人",
+                        "name": "creator",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "修改人",
+                        "name": "modifier",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": true,
+                        "description": "状态",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "字典信息名称",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "primary",
+                        "description": "标签类型",
+                        "name": "dictTag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "数据字典名称",
+                        "name": "dictName",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "数据类型",
+                        "name": "valueType",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "数据字典id",
+                        "name": "dict_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tools.DictTypeListPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/dictType/recycle/listPage": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取已删除的字典信息分页列表，按删除时间倒序",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/字典信息管理"
+                ],
+                "summary": "获取字典信息回收站分页列表",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页数量",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "名称",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "删除人",
+                        "name": "deletedBy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tools.DictTypeListPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/dictType/recycle/purge": {
+            "post": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "彻底删除回收站中的字典信息，删除后不可恢复",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/字典信息管理"
+                ],
+                "summary": "彻底删除字典信息",
+                "parameters": [
+                    {
+                        "description": "id数组",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/dictType/recycle/restore/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "从回收站恢复指定id字典信息，恢复前重新校验唯一性",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/字典信息管理"
+                ],
+                "summary": "恢复字典信息",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/dictType/update": {
+            "put": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "更新字典信息",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/字典信息管理"
+                ],
+                "summary": "更新字典信息",
+                "parameters": [
+                    {
+                        "description": "请求",
+                        "name": "UpdateDictTypeRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tools.UpdateDictTypeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/file/delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "删除指定id文件，移入回收站，存储内容在彻底删除后释放",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/文件存储"
+                ],
+                "summary": "删除文件",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/file/download/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "下载指定id文件",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "系统工具/文件存储"
+                ],
+                "summary": "下载文件",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/file/getById/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取指定id文件信息",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/文件存储"
+                ],
+                "summary": "获取文件",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_tools.File"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/file/history/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取指定id数据的字段变更历史，按变更时间倒序，敏感字段已脱敏",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "日志管理/数据变更日志"
+                ],
+                "summary": "获取数据变更历史",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页数量",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/logger.DataLogListPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/file/listPage": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取文件分页列表，按数据权限过滤",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/文件存储"
+                ],
+                "summary": "获取文件分页列表",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页数量",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "存储桶ID",
+                        "name": "bucketId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "文件名称",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "文件类型(前缀匹配，如 image/)",
+                        "name": "mimeType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "内容哈希",
+                        "name": "hash",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "开始时间(2006-01-02 15:04:05)",
+                        "name": "startTime",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "结束时间(2006-01-02 15:04:05)",
+                        "name": "endTime",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tools.FileListPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/file/presign/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "生成限时有效的文件下载地址，无需登录即可下载",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/文件存储"
+                ],
+                "summary": "获取文件下载地址",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tools.PresignFileResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/file/recycle/listPage": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取已删除的文件分页列表，按删除时间倒序",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/文件存储"
+                ],
+                "summary": "获取文件回收站分页列表",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页数量",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "文件名",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "删除人",
+                        "name": "deletedBy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tools.FileListPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/file/recycle/purge": {
+            "post": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "彻底删除回收站中的文件，存储对象不再被引用时一并删除，删除后不可恢复",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/文件存储"
+                ],
+                "summary": "彻底删除文件",
+                "parameters": [
+                    {
+                        "description": "id数组",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/file/recycle/restore/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "从回收站恢复指定id文件，所属存储桶需未被删除",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/文件存储"
+                ],
+                "summary": "恢复文件",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/file/upload": {
+            "post": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "上传文件到指定存储桶，文件类型以内容识别为准，同一存储桶内内容相同的文件共用存储",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/文件存储"
+                ],
+                "summary": "上传文件",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "文件",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "存储桶编码",
+                        "name": "bucket",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "备注",
+                        "name": "remark",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_tools.File"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/import/errorFile/{token}": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "下载导入结果中的错误数据工作簿，令牌取自导入结果的 ErrorFile，过期后不可下载",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "系统工具/导入"
+                ],
+                "summary": "下载导入错误数据",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "下载令牌",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/upload/init": {
+            "post": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "创建分片上传会话并返回会话ID与分片大小，同一用户上传同一文件时返回未完成的会话及已上传分片用于断点续传",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/分片上传"
+                ],
+                "summary": "创建分片上传会话",
+                "parameters": [
+                    {
+                        "description": "参数",
+                        "name": "InitUploadRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tools.InitUploadRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tools.UploadSession"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/upload/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "获取本人的分片上传会话及已上传的分片序号",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/分片上传"
+                ],
+                "summary": "获取分片上传会话",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "上传会话ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tools.UploadSession"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "取消分片上传会话并删除已上传分片",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/分片上传"
+                ],
+                "summary": "取消分片上传",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "上传会话ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/upload/{id}/chunks/{index}": {
+            "put": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "请求体为分片原始内容，除最后一个分片外长度须等于分片大小，重复上传同一分片会覆盖",
+                "consumes": [
+                    "application/octet-stream"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/分片上传"
+                ],
+                "summary": "上传分片",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "上传会话ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "分片序号(从0开始)",
+                        "name": "index",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "分片内容的SHA-256十六进制串",
+                        "name": "X-Chunk-Checksum",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/upload/{id}/complete": {
+            "post": {
+                "security": [
+                    {
+                        "LoginToken": []
+                    }
+                ],
+                "description": "合并全部分片并校验文件哈希，通过后写入会话指定的存储桶",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "系统工具/分片上传"
+                ],
+                "summary": "完成分片上传",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "上传会话ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_tools.File"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "auth.CaptchaResponse": {
+            "type": "object",
+            "properties": {
+                "captchaId": {
+                    "description": "验证码id",
+                    "type": "string"
+                },
+                "image": {
+                    "description": "base64图片",
+                    "type": "string"
+                }
+            }
+        },
+        "auth.LoginRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "username"
+            ],
+            "properties": {
+                "captchaCode": {
+                    "description": "验证码，多次失败后必填",
+                    "type": "string"
+                },
+                "captchaId": {
+                    "description": "验证码id，多次失败后必填",
+                    "type": "string"
+                },
+                "password": {
+                    "description": "密码",
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 6
+                },
+                "username": {
+                    "description": "用户名",
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 3
+                }
+            }
+        },
+        "auth.LoginResponse": {
+            "type": "object",
+            "properties": {
+                "expire": {
+                    "description": "过期时间(秒)",
+                    "type": "integer"
+                },
+                "refreshExpire": {
+                    "description": "刷新令牌过期时间(秒)",
+                    "type": "integer"
+                },
+                "refreshToken": {
+                    "description": "刷新令牌",
+                    "type": "string"
+                },
+                "token": {
+                    "description": "JWT令牌",
+                    "type": "string"
+                },
+                "user": {
+                    "description": "用户信息",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_system.User"
+                        }
+                    ]
+                }
+            }
+        },
+        "auth.RefreshTokenRequest": {
+            "type": "object",
+            "required": [
+                "refreshToken"
+            ],
+            "properties": {
+                "refreshToken": {
+                    "description": "刷新令牌",
+                    "type": "string",
+                    "example": "Q2FyZWZ1bEFkbWluUmVmcmVzaFRva2Vu..."
+                }
+            }
+        },
+        "auth.SessionResponse": {
+            "type": "object",
+            "properties": {
+                "browser": {
+                    "description": "浏览器",
+                    "type": "string"
+                },
+                "current": {
+                    "description": "是否当前会话",
+                    "type": "boolean"
+                },
+                "expireTime": {
+                    "description": "过期时间",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "会话ID",
+                    "type": "string"
+                },
+                "ip": {
+                    "description": "登录IP",
+                    "type": "string"
+                },
+                "loginTime": {
+                    "description": "登录时间",
+                    "type": "string"
+                },
+                "os": {
+                    "description": "操作系统",
+                    "type": "string"
+                },
+                "userAgent": {
+                    "description": "设备信息",
+                    "type": "string"
+                }
+            }
+        },
+        "data_log.ActionConst": {
+            "type": "integer",
+            "enum": [
+                1,
+                2,
+                3
+            ],
+            "x-enum-comments": {
+                "ActionConstCreate": "新增",
+                "ActionConstDelete": "删除",
+                "ActionConstUpdate": "修改"
+            },
+            "x-enum-descriptions": [
+                "新增",
+                "修改",
+                "删除"
+            ],
+            "x-enum-varnames": [
+                "ActionConstCreate",
+                "ActionConstUpdate",
+                "ActionConstDelete"
+            ]
+        },
+        "dict.TypeConst": {
+            "type": "integer",
+            "enum": [
+                1,
+                2,
+                3
+            ],
+            "x-enum-comments": {
+                "TypeConstEnum": "枚举字典",
+                "TypeConstOrdinary": "普通字典",
+                "TypeConstSystem": "系统字典"
+            },
+            "x-enum-descriptions": [
+                "普通字典",
+                "系统字典",
+                "枚举字典"
+            ],
+            "x-enum-varnames": [
+                "TypeConstOrdinary",
+                "TypeConstSystem",
+                "TypeConstEnum"
+            ]
+        },
+        "dict.ValueTypeConst": {
+            "type": "integer",
+            "enum": [
+                1,
+                2,
+                3
+            ],
+            "x-enum-comments": {
+                "ValueTypeConstBool": "布尔",
+                "ValueTypeConstInt": "整型",
+                "ValueTypeConstStr": "字符串"
+            },
+            "x-enum-descriptions": [
+                "字符串",
+                "整型",
+                "布尔"
+            ],
+            "x-enum-varnames": [
+                "ValueTypeConstStr",
+                "ValueTypeConstInt",
+                "ValueTypeConstBool"
+            ]
+        },
+        "dict_type.DictTagConst": {
+            "type": "string",
+            "enum": [
+                "primary",
+                "success",
+                "warning",
+                "danger",
+                "info"
+            ],
+            "x-enum-comments": {
+                "DictTagConstDanger": "danger",
+                "DictTagConstInfo": "info",
+                "DictTagConstPrimary": "primary",
+                "DictTagConstSuccess": "success",
+                "DictTagConstWarning": "warning"
+            },
+            "x-enum-descriptions": [
+                "primary",
+                "success",
+                "warning",
+                "danger",
+                "info"
+            ],
+            "x-enum-varnames": [
+                "DictTagConstPrimary",
+                "DictTagConstSuccess",
+                "DictTagConstWarning",
+                "DictTagConstDanger",
+                "DictTagConstInfo"
+            ]
+        },
+        "github_com_carefuly_careful-admin-go-gin_internal_domain_careful_system.Dept": {
+            "type": "object",
+            "properties": {
+                "belongDept": {
+                    "description": "数据归属部门",
+                    "type": "string"
+                },
+                "child_count": {
+                    "description": "子部门数量",
+                    "type": "integer"
+                },
+                "children": {
+                    "description": "子部门列表",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_system.Dept"
+                    }
+                },
+                "code": {
+                    "description": "部门编码",
+                    "type": "string"
+                },
+                "createTime": {
+                    "description": "创建时间",
+                    "type": "string"
+                },
+                "creator": {
+                    "description": "创建人",
+                    "type": "string"
+                },
+                "deletedAt": {
+                    "description": "删除时间",
+                    "type": "integer"
+                },
+                "deletedBy": {
+                    "description": "删除人",
+                    "type": "string"
+                },
+                "email": {
+                    "description": "邮箱",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "主键ID(自增)",
+                    "type": "string"
+                },
+                "level": {
+                    "description": "层级深度，根节点为0",
+                    "type": "integer"
+                },
+                "modifier": {
+                    "description": "修改人",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "部门名称",
+                    "type": "string"
+                },
+                "owner": {
+                    "description": "负责人",
+                    "type": "string"
+                },
+                "parent": {
+                    "description": "父部门信息",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_model_careful_system.Dept"
+                        }
+                    ]
+                },
+                "parent_id": {
+                    "description": "上级部门ID",
+                    "type": "string"
+                },
+                "path": {
+                    "description": "节点路径，格式：/1/2/3/\"",
+                    "type": "string"
+                },
+                "phone": {
+                    "description": "联系电话",
+                    "type": "string"
+                },
+                "remark": {
+                    "description": "备注",
+                    "type": "string"
+                },
+                "sort": {
+                    "description": "显示排序",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "状态",
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "description": "版本号(时间戳)",
+                    "type": "integer"
+                },
+                "updateTime": {
+                    "description": "更新时间",
+                    "type": "string"
+                },
+                "user_count": {
+                    "description": "用户数量",
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_carefuly_careful-admin-go-gin_internal_domain_careful_system.Menu": {
+            "type": "object",
+            "properties": {
+                "belongDept": {
+                    "description": "数据归属部门",
+                    "type": "string"
+                },
+                "children": {
+                    "description": "子菜单列表",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_system.Menu"
+                    }
+                },
+                "code": {
+                    "description": "权限标识",
+                    "type": "string"
+                },
+                "component": {
+                    "description": "组件路径",
+                    "type": "string"
+                },
+                "createTime": {
+                    "description": "创建时间",
+                    "type": "string"
+                },
+                "creator": {
+                    "description": "创建人",
+                    "type": "string"
+                },
+                "deletedAt": {
+                    "description": "删除时间",
+                    "type": "integer"
+                },
+                "deletedBy": {
+                    "description": "删除人",
+                    "type": "string"
+                },
+                "icon": {
+                    "description": "菜单图标",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "主键ID(自增)",
+                    "type": "string"
+                },
+                "is_link": {
+                    "description": "是否外链",
+                    "type": "boolean"
+                },
+                "keep_alive": {
+                    "description": "是否缓存页面",
+                    "type": "boolean"
+                },
+                "modifier": {
+                    "description": "修改人",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "路由名称",
+                    "type": "string"
+                },
+                "parent_id": {
+                    "description": "上级菜单ID",
+                    "type": "string"
+                },
+                "path": {
+                    "description": "路由地址",
+                    "type": "string"
+                },
+                "redirect": {
+                    "description": "重定向地址",
+                    "type": "string"
+                },
+                "remark": {
+                    "description": "备注",
+                    "type": "string"
+                },
+                "sort": {
+                    "description": "显示排序",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "状态",
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "description": "版本号(时间戳)",
+                    "type": "integer"
+                },
+                "title": {
+                    "description": "菜单标题",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "菜单类型",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/menu.TypeConst"
+                        }
+                    ]
+                },
+                "updateTime": {
+                    "description": "更新时间",
+                    "type": "string"
+                },
+                "visible": {
+                    "description": "是否显示",
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_carefuly_careful-admin-go-gin_internal_domain_careful_system.Permission": {
+            "type": "object",
+            "properties": {
+                "belongDept": {
+                    "description": "数据归属部门",
+                    "type": "string"
+                },
+                "code": {
+                    "description": "权限编码",
+                    "type": "string"
+                },
+                "createTime": {
+                    "description": "创建时间",
+                    "type": "string"
+                },
+                "creator": {
+                    "description": "创建人",
+                    "type": "string"
+                },
+                "deletedAt": {
+                    "description": "删除时间",
+                    "type": "integer"
+                },
+                "deletedBy": {
+                    "description": "删除人",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "主键ID(自增)",
+                    "type": "string"
+                },
+                "method": {
+                    "description": "请求方式",
+                    "type": "string"
+                },
+                "modifier": {
+                    "description": "修改人",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "权限名称",
+                    "type": "string"
+                },
+                "path": {
+                    "description": "接口路径",
+                    "type": "string"
+                },
+                "remark": {
+                    "description": "备注",
+                    "type": "string"
+                },
+                "sort": {
+                    "description": "显示排序",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "状态",
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "description": "版本号(时间戳)",
+                    "type": "integer"
+                },
+                "updateTime": {
+                    "description": "更新时间",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_carefuly_careful-admin-go-gin_internal_domain_careful_system.Role": {
+            "type": "object",
+            "properties": {
+                "belongDept": {
+                    "description": "数据归属部门",
+                    "type": "string"
+                },
+                "code": {
+                    "description": "角色编码",
+                    "type": "string"
+                },
+                "createTime": {
+                    "description": "创建时间",
+                    "type": "string"
+                },
+                "creator": {
+                    "description": "创建人",
+                    "type": "string"
+                },
+                "data_scope": {
+                    "description": "数据范围",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/role.DataScopeConst"
+                        }
+                    ]
+                },
+                "deletedAt": {
+                    "description": "删除时间",
+                    "type": "integer"
+                },
+                "deletedBy": {
+                    "description": "删除人",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "主键ID(自增)",
+                    "type": "string"
+                },
+                "modifier": {
+                    "description": "修改人",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "角色名称",
+                    "type": "string"
+                },
+                "remark": {
+                    "description": "备注",
+                    "type": "string"
+                },
+                "sort": {
+                    "description": "显示排序",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "状态",
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "description": "版本号(时间戳)",
+                    "type": "integer"
+                },
+                "updateTime": {
+                    "description": "更新时间",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_carefuly_careful-admin-go-gin_internal_domain_careful_system.User": {
+            "type": "object",
+            "properties": {
+                "avatar": {
+                    "description": "头像",
+                    "type": "string"
+                },
+                "belongDept": {
+                    "description": "数据归属部门",
+                    "type": "string"
+                },
+                "createTime": {
+                    "description": "创建时间",
+                    "type": "string"
+                },
+                "creator": {
+                    "description": "创建人",
+                    "type": "string"
+                },
+                "deletedAt": {
+                    "description": "删除时间",
+                    "type": "integer"
+                },
+                "deletedBy": {
+                    "description": "删除人",
+                    "type": "string"
+                },
+                "dept": {
+                    "description": "部门",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_model_careful_system.Dept"
+                        }
+                    ]
+                },
+                "dept_id": {
+                    "description": "部门ID",
+                    "type": "string"
+                },
+                "email": {
+                    "description": "邮箱",
+                    "type": "string"
+                },
+                "gender": {
+                    "description": "性别",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/user.GenderConst"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "主键ID(自增)",
+                    "type": "string"
+                },
+                "is_superuser": {
+                    "description": "是否超级管理员",
+                    "type": "boolean"
+                },
+                "mobile": {
+                    "description": "电话",
+                    "type": "string"
+                },
+                "modifier": {
+                    "description": "修改人",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "姓名",
+                    "type": "string"
+                },
+                "remark": {
+                    "description": "备注",
+                    "type": "string"
+                },
+                "sort": {
+                    "description": "显示排序",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "状态",
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "description": "版本号(时间戳)",
+                    "type": "integer"
+                },
+                "updateTime": {
+                    "description": "更新时间",
+                    "type": "string"
+                },
+                "username": {
+                    "description": "用户名",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_carefuly_careful-admin-go-gin_internal_domain_careful_tools.Bucket": {
+            "type": "object",
+            "properties": {
+                "allowedMimes": {
+                    "description": "允许的文件类型",
+                    "type": "string"
+                },
+                "belongDept": {
+                    "description": "数据归属部门",
+                    "type": "string"
+                },
+                "code": {
+                    "description": "存储桶编码",
+                    "type": "string"
+                },
+                "createTime": {
+                    "description": "创建时间",
+                    "type": "string"
+                },
+                "creator": {
+                    "description": "创建人",
+                    "type": "string"
+                },
+                "deletedAt": {
+                    "description": "删除时间",
+                    "type": "integer"
+                },
+                "deletedBy": {
+                    "description": "删除人",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "主键ID(自增)",
+                    "type": "string"
+                },
+                "maxSize": {
+                    "description": "单个文件大小上限",
+                    "type": "integer"
+                },
+                "modifier": {
+                    "description": "修改人",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "存储桶名称",
+                    "type": "string"
+                },
+                "remark": {
+                    "description": "备注",
+                    "type": "string"
+                },
+                "sort": {
+                    "description": "显示排序",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "状态",
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "description": "版本号(时间戳)",
+                    "type": "integer"
+                },
+                "updateTime": {
+                    "description": "更新时间",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_carefuly_careful-admin-go-gin_internal_domain_careful_tools.Dict": {
+            "type": "object",
+            "properties": {
+                "belongDept": {
+                    "description": "数据归属部门",
+                    "type": "string"
+                },
+                "code": {
+                    "description": "字典编码",
+                    "type": "string"
+                },
+                "createTime": {
+                    "description": "创建时间",
+                    "type": "string"
+                },
+                "creator": {
+                    "description": "创建人",
+                    "type": "string"
+                },
+                "deletedAt": {
+                    "description": "删除时间",
+                    "type": "integer"
+                },
+                "deletedBy": {
+                    "description": "删除人",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "主键ID(自增)",
+                    "type": "string"
+                },
+                "modifier": {
+                    "description": "修改人",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "字典名称",
+                    "type": "string"
+                },
+                "remark": {
+                    "description": "备注",
+                    "type": "string"
+                },
+                "sort": {
+                    "description": "显示排序",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "状态",
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "description": "版本号(时间戳)",
+                    "type": "integer"
+                },
+                "type": {
+                    "description": "字典类型",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dict.TypeConst"
+                        }
+                    ]
+                },
+                "updateTime": {
+                    "description": "更新时间",
+                    "type": "string"
+                },
+                "valueType": {
+                    "description": "数据类型",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dict.ValueTypeConst"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_carefuly_careful-admin-go-gin_internal_domain_careful_tools.DictType": {
+            "type": "object",
+            "properties": {
+                "belongDept": {
+                    "description": "数据归属部门",
+                    "type": "string"
+                },
+                "boolValue": {
+                    "description": "布尔-字典信息值",
+                    "type": "boolean"
+                },
+                "createTime": {
+                    "description": "创建时间",
+                    "type": "string"
+                },
+                "creator": {
+                    "description": "创建人",
+                    "type": "string"
+                },
+                "deletedAt": {
+                    "description": "删除时间",
+                    "type": "integer"
+                },
+                "deletedBy": {
+                    "description": "删除人",
+                    "type": "string"
+                },
+                "dict": {
+                    "description": "数据字典",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_model_careful_tools.Dict"
+                        }
+                    ]
+                },
+                "dictColor": {
+                    "description": "标签颜色",
+                    "type": "string"
+                },
+                "dictName": {
+                    "description": "字典名称",
+                    "type": "string"
+                },
+                "dictTag": {
+                    "description": "标签类型",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dict_type.DictTagConst"
+                        }
+                    ]
+                },
+                "dict_id": {
+                    "description": "所属字典ID",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "主键ID(自增)",
+                    "type": "string"
+                },
+                "intValue": {
+                    "description": "整型-字典信息值",
+                    "type": "integer"
+                },
+                "label": {
+                    "description": "名称",
+                    "type": "string"
+                },
+                "modifier": {
+                    "description": "修改人",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "字典项名称",
+                    "type": "string"
+                },
+                "remark": {
+                    "description": "备注",
+                    "type": "string"
+                },
+                "sort": {
+                    "description": "显示排序",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "状态",
+                    "type": "boolean"
+                },
+                "strValue": {
+                    "description": "字符串-字典信息值",
+                    "type": "string"
+                },
+                "timestamp": {
+                    "description": "版本号(时间戳)",
+                    "type": "integer"
+                },
+                "updateTime": {
+                    "description": "更新时间",
+                    "type": "string"
+                },
+                "value": {
+                    "description": "值"
+                },
+                "valueType": {
+                    "description": "数据类型",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dict.ValueTypeConst"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_carefuly_careful-admin-go-gin_internal_domain_careful_tools.File": {
+            "type": "object",
+            "properties": {
+                "belongDept": {
+                    "description": "数据归属部门",
+                    "type": "string"
+                },
+                "bucketCode": {
+                    "description": "存储桶编码",
+                    "type": "string"
+                },
+                "bucketId": {
+                    "description": "存储桶ID",
+                    "type": "string"
+                },
+                "createTime": {
+                    "description": "创建时间",
+                    "type": "string"
+                },
+                "creator": {
+                    "description": "创建人",
+                    "type": "string"
+                },
+                "deletedAt": {
+                    "description": "删除时间",
+                    "type": "integer"
+                },
+                "deletedBy": {
+                    "description": "删除人",
+                    "type": "string"
+                },
+                "driver": {
+                    "description": "存储驱动",
+                    "type": "string"
+                },
+                "ext": {
+                    "description": "扩展名",
+                    "type": "string"
+                },
+                "hash": {
+                    "description": "内容哈希",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "主键ID(自增)",
+                    "type": "string"
+                },
+                "mimeType": {
+                    "description": "文件类型",
+                    "type": "string"
+                },
+                "modifier": {
+                    "description": "修改人",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "文件名称",
+                    "type": "string"
+                },
+                "remark": {
+                    "description": "备注",
+                    "type": "string"
+                },
+                "size": {
+                    "description": "文件大小",
+                    "type": "integer"
+                },
+                "sort": {
+                    "description": "显示排序",
+                    "type": "integer"
+                },
+                "timestamp": {
+                    "description": "版本号(时间戳)",
+                    "type": "integer"
+                },
+                "updateTime": {
+                    "description": "更新时间",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_carefuly_careful-admin-go-gin_internal_domain_careful_tools.Job": {
+            "type": "object",
+            "properties": {
+                "artifactName": {
+                    "description": "结果文件名",
+                    "type": "string"
+                },
+                "belongDept": {
+                    "description": "数据归属部门",
+                    "type": "string"
+                },
+                "createTime": {
+                    "description": "创建时间",
+                    "type": "string"
+                },
+                "creator": {
+                    "description": "创建人",
+                    "type": "string"
+                },
+                "downloadUrl": {
+                    "description": "结果文件下载地址",
+                    "type": "string"
+                },
+                "expireTime": {
+                    "description": "结果文件过期时间",
+                    "type": "string"
+                },
+                "failCount": {
+                    "description": "失败条数",
+                    "type": "integer"
+                },
+                "finishTime": {
+                    "description": "结束时间",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "主键ID(自增)",
+                    "type": "string"
+                },
+                "message": {
+                    "description": "结果信息",
+                    "type": "string"
+                },
+                "modifier": {
+                    "description": "修改人",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "任务名称",
+                    "type": "string"
+                },
+                "processed": {
+                    "description": "已处理条数",
+                    "type": "integer"
+                },
+                "progress": {
+                    "description": "执行进度(0-100)",
+                    "type": "number"
+                },
+                "remark": {
+                    "description": "备注",
+                    "type": "string"
+                },
+                "result": {
+                    "description": "结果详情",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "sort": {
+                    "description": "显示排序",
+                    "type": "integer"
+                },
+                "startTime": {
+                    "description": "开始时间",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "任务状态",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/job.StatusConst"
+                        }
+                    ]
+                },
+                "successCount": {
+                    "description": "成功条数",
+                    "type": "integer"
+                },
+                "timestamp": {
+                    "description": "版本号(时间戳)",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "总条数",
+                    "type": "integer"
+                },
+                "type": {
+                    "description": "任务类型",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/job.TypeConst"
+                        }
+                    ]
+                },
+                "updateTime": {
+                    "description": "更新时间",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_carefuly_careful-admin-go-gin_internal_model_careful_system.Dept": {
+            "type": "object",
+            "properties": {
+                "belongDept": {
+                    "description": "数据归属部门",
+                    "type": "string"
+                },
+                "child_count": {
+                    "description": "子部门数量",
+                    "type": "integer"
+                },
+                "children": {
+                    "description": "关联查询字段（不存储到数据库）",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_model_careful_system.Dept"
+                    }
+                },
+                "code": {
+                    "description": "部门编码",
+                    "type": "string"
+                },
+                "creator": {
+                    "description": "创建人",
+                    "type": "string"
+                },
+                "deletedAt": {
+                    "description": "删除时间",
+                    "type": "integer"
+                },
+                "deletedBy": {
+                    "description": "删除人",
+                    "type": "string"
+                },
+                "email": {
+                    "description": "邮箱",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "主键ID(自增)",
+                    "type": "string"
+                },
+                "level": {
+                    "description": "层级深度，根节点为0",
+                    "type": "integer"
+                },
+                "modifier": {
+                    "description": "修改人",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "部门名称",
+                    "type": "string"
+                },
+                "owner": {
+                    "description": "负责人",
+                    "type": "string"
+                },
+                "parent": {
+                    "description": "父部门信息",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_model_careful_system.Dept"
+                        }
+                    ]
+                },
+                "parent_id": {
+                    "description": "上级部门ID",
+                    "type": "string"
+                },
+                "path": {
+                    "description": "节点路径，格式：/1/2/3/\"",
+                    "type": "string"
+                },
+                "phone": {
+                    "description": "联系电话",
+                    "type": "string"
+                },
+                "remark": {
+                    "description": "备注",
+                    "type": "string"
+                },
+                "sort": {
+                    "description": "显示排序",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "状态",
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "description": "版本号(时间戳)",
+                    "type": "integer"
+                },
+                "user_count": {
+                    "description": "用户数量",
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_carefuly_careful-admin-go-gin_internal_model_careful_tools.Dict": {
+            "type": "object",
+            "properties": {
+                "belongDept": {
+                    "description": "数据归属部门",
+                    "type": "string"
+                },
+                "code": {
+                    "description": "字典编码",
+                    "type": "string"
+                },
+                "creator": {
+                    "description": "创建人",
+                    "type": "string"
+                },
+                "deletedAt": {
+                    "description": "删除时间",
+                    "type": "integer"
+                },
+                "deletedBy": {
+                    "description": "删除人",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "主键ID(自增)",
+                    "type": "string"
+                },
+                "modifier": {
+                    "description": "修改人",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "字典名称",
+                    "type": "string"
+                },
+                "remark": {
+                    "description": "备注",
+                    "type": "string"
+                },
+                "sort": {
+                    "description": "显示排序",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "状态",
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "description": "版本号(时间戳)",
+                    "type": "integer"
+                },
+                "type": {
+                    "description": "字典类型",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dict.TypeConst"
+                        }
+                    ]
+                },
+                "valueType": {
+                    "description": "数据类型",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dict.ValueTypeConst"
+                        }
+                    ]
+                }
+            }
+        },
+        "job.StatusConst": {
+            "type": "integer",
+            "enum": [
+                1,
+                2,
+                3,
+                4,
+                5
+            ],
+            "x-enum-comments": {
+                "StatusConstDone": "已完成",
+                "StatusConstExpired": "已过期(结果文件已清理)",
+                "StatusConstFailed": "失败",
+                "StatusConstQueued": "排队中",
+                "StatusConstRunning": "执行中"
+            },
+            "x-enum-descriptions": [
+                "排队中",
+                "执行中",
+                "已完成",
+                "失败",
+                "已过期(结果文件已清理)"
+            ],
+            "x-enum-varnames": [
+                "StatusConstQueued",
+                "StatusConstRunning",
+                "StatusConstDone",
+                "StatusConstFailed",
+                "StatusConstExpired"
+            ]
+        },
+        "job.TypeConst": {
+            "type": "integer",
+            "enum": [
+                1,
+                2
+            ],
+            "x-enum-comments": {
+                "TypeConstExport": "导出",
+                "TypeConstImport": "导入"
+            },
+            "x-enum-descriptions": [
+                "导入",
+                "导出"
+            ],
+            "x-enum-varnames": [
+                "TypeConstImport",
+                "TypeConstExport"
+            ]
+        },
+        "logger.CacheLog": {
+            "type": "object",
+            "properties": {
+                "belongDept": {
+                    "description": "数据归属部门",
+                    "type": "string"
+                },
+                "cacheError": {
+                    "description": "缓存Error错误",
+                    "type": "string"
+                },
+                "cacheHost": {
+                    "description": "当前主机地址",
+                    "type": "string"
+                },
+                "cacheIp": {
+                    "description": "缓存者IP",
+                    "type": "string"
+                },
+                "cacheKey": {
+                    "description": "缓存请求地址",
+                    "type": "string"
+                },
+                "cacheMethod": {
+                    "description": "缓存请求方式",
+                    "type": "string"
+                },
+                "cachePath": {
+                    "description": "缓存请求地址",
+                    "type": "string"
+                },
+                "cacheTime": {
+                    "description": "缓存记录时间",
+                    "type": "string"
+                },
+                "cacheUsername": {
+                    "description": "缓存用户名",
+                    "type": "string"
+                },
+                "cacheValue": {
+                    "description": "缓存value值",
+                    "type": "string"
+                },
+                "createTime": {
+                    "description": "创建时间",
+                    "type": "string"
+                },
+                "creator": {
+                    "description": "创建人",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "主键ID(自增)",
+                    "type": "string"
+                },
+                "modifier": {
+                    "description": "修改人",
+                    "type": "string"
+                },
+                "remark": {
+                    "description": "备注",
+                    "type": "string"
+                },
+                "sort": {
+                    "description": "显示排序",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "状态",
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "description": "版本号(时间戳)",
+                    "type": "integer"
+                },
+                "updateTime": {
+                    "description": "更新时间",
+                    "type": "string"
+                }
+            }
+        },
+        "logger.CacheLogListPageResponse": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "description": "列表",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/logger.CacheLog"
+                    }
+                },
+                "page": {
+                    "description": "页码",
+                    "type": "integer"
+                },
+                "pageSize": {
+                    "description": "每页数量",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "总数",
+                    "type": "integer"
+                }
+            }
+        },
+        "logger.DataLog": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "变更类型",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/data_log.ActionConst"
+                        }
+                    ]
+                },
+                "belongDept": {
+                    "description": "数据归属部门",
+                    "type": "string"
+                },
+                "changes": {
+                    "description": "字段变更",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/logger.FieldChange"
+                    }
+                },
+                "createTime": {
+                    "description": "变更时间",
+                    "type": "string"
+                },
+                "creator": {
+                    "description": "创建人",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "主键ID(自增)",
+                    "type": "string"
+                },
+                "modifier": {
+                    "description": "修改人",
+                    "type": "string"
+                },
+                "operator": {
+                    "description": "操作人",
+                    "type": "string"
+                },
+                "recordId": {
+                    "description": "数据主键ID",
+                    "type": "string"
+                },
+                "recordTable": {
+                    "description": "数据表",
+                    "type": "string"
+                },
+                "remark": {
+                    "description": "备注",
+                    "type": "string"
+                },
+                "requestId": {
+                    "description": "请求ID",
+                    "type": "string"
+                },
+                "sort": {
+                    "description": "显示排序",
+                    "type": "integer"
+                },
+                "timestamp": {
+                    "description": "版本号(时间戳)",
+                    "type": "integer"
+                },
+                "version": {
+                    "description": "数据版本号",
+                    "type": "integer"
+                }
+            }
+        },
+        "logger.DataLogListPageResponse": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "description": "列表",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/logger.DataLog"
+                    }
+                },
+                "page": {
+                    "description": "页码",
+                    "type": "integer"
+                },
+                "pageSize": {
+                    "description": "每页数量",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "总数",
+                    "type": "integer"
+                }
+            }
+        },
+        "logger.FieldChange": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "description": "字段(列名)",
+                    "type": "string"
+                },
+                "new": {
+                    "description": "变更后"
+                },
+                "old": {
+                    "description": "变更前"
+                }
+            }
+        },
+        "logger.LoginLog": {
+            "type": "object",
+            "properties": {
+                "agent": {
+                    "description": "agent信息",
+                    "type": "string"
+                },
+                "area_code": {
+                    "description": "区域代码",
+                    "type": "string"
+                },
+                "belongDept": {
+                    "description": "数据归属部门",
+                    "type": "string"
+                },
+                "browser": {
+                    "description": "浏览器名",
+                    "type": "string"
+                },
+                "city": {
+                    "description": "城市",
+                    "type": "string"
+                },
+                "continent": {
+                    "description": "州",
+                    "type": "string"
+                },
+                "country": {
+                    "description": "国家",
+                    "type": "string"
+                },
+                "country_code": {
+                    "description": "简称",
+                    "type": "string"
+                },
+                "country_english": {
+                    "description": "英文全称",
+                    "type": "string"
+                },
+                "createTime": {
+                    "description": "创建时间",
+                    "type": "string"
+                },
+                "creator": {
+                    "description": "创建人",
+                    "type": "string"
+                },
+                "district": {
+                    "description": "县区",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "主键ID(自增)",
+                    "type": "string"
+                },
+                "ip": {
+                    "description": "登录ip",
+                    "type": "string"
+                },
+                "isp": {
+                    "description": "运营商",
+                    "type": "string"
+                },
+                "latitude": {
+                    "description": "纬度",
+                    "type": "string"
+                },
+                "loginUsername": {
+                    "description": "登录用户名",
+                    "type": "string"
+                },
+                "longitude": {
+                    "description": "经度",
+                    "type": "string"
+                },
+                "modifier": {
+                    "description": "修改人",
+                    "type": "string"
+                },
+                "os": {
+                    "description": "操作系统",
+                    "type": "string"
+                },
+                "province": {
+                    "description": "省份",
+                    "type": "string"
+                },
+                "remark": {
+                    "description": "备注",
+                    "type": "string"
+                },
+                "sort": {
+                    "description": "显示排序",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "状态",
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "description": "版本号(时间戳)",
+                    "type": "integer"
+                },
+                "updateTime": {
+                    "description": "更新时间",
+                    "type": "string"
+                }
+            }
+        },
+        "logger.LoginLogListPageResponse": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "description": "列表",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/logger.LoginLog"
+                    }
+                },
+                "page": {
+                    "description": "页码",
+                    "type": "integer"
+                },
+                "pageSize": {
+                    "description": "每页数量",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "总数",
+                    "type": "integer"
+                }
+            }
+        },
+        "logger.OperateLog": {
+            "type": "object",
+            "properties": {
+                "belongDept": {
+                    "description": "数据归属部门",
+                    "type": "string"
+                },
+                "createTime": {
+                    "description": "创建时间",
+                    "type": "string"
+                },
+                "creator": {
+                    "description": "创建人",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "主键ID(自增)",
+                    "type": "string"
+                },
+                "modifier": {
+                    "description": "修改人",
+                    "type": "string"
+                },
+                "remark": {
+                    "description": "备注",
+                    "type": "string"
+                },
+                "requestBody": {
+                    "description": "请求体(大文本)"
+                },
+                "requestBrowser": {
+                    "description": "操作浏览器",
+                    "type": "string"
+                },
+                "requestCode": {
+                    "description": "自定义响应状态码",
+                    "type": "integer"
+                },
+                "requestInternal": {
+                    "description": "系统错误",
+                    "type": "string"
+                },
+                "requestIp": {
+                    "description": "请求IP地址",
+                    "type": "string"
+                },
+                "requestMethod": {
+                    "description": "请求方式",
+                    "type": "string"
+                },
+                "requestOs": {
+                    "description": "操作系统",
+                    "type": "string"
+                },
+                "requestPath": {
+                    "description": "请求地址",
+                    "type": "string"
+                },
+                "requestQuery": {
+                    "description": "请求查询参数",
+                    "type": "string"
+                },
+                "requestResult": {
+                    "description": "响应信息",
+                    "type": "string"
+                },
+                "requestStatus": {
+                    "description": "响应状态码",
+                    "type": "integer"
+                },
+                "requestTime": {
+                    "description": "请求耗时",
+                    "type": "string"
+                },
+                "requestUsername": {
+                    "description": "请求用户名",
+                    "type": "string"
+                },
+                "sort": {
+                    "description": "显示排序",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "状态",
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "description": "版本号(时间戳)",
+                    "type": "integer"
+                },
+                "updateTime": {
+                    "description": "更新时间",
+                    "type": "string"
+                },
+                "userAgent": {
+                    "description": "用户代理",
+                    "type": "string"
+                }
+            }
+        },
+        "logger.OperateLogListPageResponse": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "description": "列表",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/logger.OperateLog"
+                    }
+                },
+                "page": {
+                    "description": "页码",
+                    "type": "integer"
+                },
+                "pageSize": {
+                    "description": "每页数量",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "总数",
+                    "type": "integer"
+                }
+            }
+        },
+        "menu.TypeConst": {
+            "type": "integer",
+            "enum": [
+                1,
+                2,
+                3
+            ],
+            "x-enum-comments": {
+                "TypeConstButton": "按钮",
+                "TypeConstDirectory": "目录",
+                "TypeConstPage": "菜单页面"
+            },
+            "x-enum-descriptions": [
+                "目录",
+                "菜单页面",
+                "按钮"
+            ],
+            "x-enum-varnames": [
+                "TypeConstDirectory",
+                "TypeConstPage",
+                "TypeConstButton"
+            ]
+        },
+        "response.Response": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "HTTP状态码",
+                    "type": "integer"
+                },
+                "data": {
+                    "description": "数据"
+                },
+                "msg": {
+                    "description": "提示信息"
+                },
+                "request_id": {
+                    "description": "请求ID",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "响应状态: success|error",
+                    "type": "string"
+                },
+                "timestamp": {
+                    "description": "时间戳",
+                    "type": "string"
+                }
+            }
+        },
+        "role.DataScopeConst": {
+            "type": "integer",
+            "enum": [
+                1,
+                2,
+                3,
+                4,
+                5
+            ],
+            "x-enum-comments": {
+                "DataScopeConstAll": "全部数据",
+                "DataScopeConstCustom": "自定义部门数据",
+                "DataScopeConstDept": "本部门数据",
+                "DataScopeConstDeptAndChild": "本部门及以下数据",
+                "DataScopeConstSelf": "仅本人数据"
+            },
+            "x-enum-descriptions": [
+                "全部数据",
+                "本部门数据",
+                "本部门及以下数据",
+                "自定义部门数据",
+                "仅本人数据"
+            ],
+            "x-enum-varnames": [
+                "DataScopeConstAll",
+                "DataScopeConstDept",
+                "DataScopeConstDeptAndChild",
+                "DataScopeConstCustom",
+                "DataScopeConstSelf"
+            ]
+        },
+        "system.AssignDeptsRequest": {
+            "type": "object",
+            "required": [
+                "role_id"
+            ],
+            "properties": {
+                "dept_ids": {
+                    "description": "部门ID，为空则清空",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "role_id": {
+                    "description": "角色ID",
+                    "type": "string"
+                }
+            }
+        },
+        "system.AssignMenusRequest": {
+            "type": "object",
+            "required": [
+                "role_id"
+            ],
+            "properties": {
+                "menu_ids": {
+                    "description": "菜单ID，为空则清空",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "role_id": {
+                    "description": "角色ID",
+                    "type": "string"
+                }
+            }
+        },
+        "system.AssignPermissionsRequest": {
+            "type": "object",
+            "required": [
+                "role_id"
+            ],
+            "properties": {
+                "permission_ids": {
+                    "description": "接口权限ID，为空则清空",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "role_id": {
+                    "description": "角色ID",
+                    "type": "string"
+                }
+            }
+        },
+        "system.AssignUserRolesRequest": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "role_ids": {
+                    "description": "角色ID，为空则清空",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "user_id": {
+                    "description": "用户ID",
+                    "type": "string"
+                }
+            }
+        },
+        "system.CreateDeptRequest": {
+            "type": "object",
+            "required": [
+                "code",
+                "name"
+            ],
+            "properties": {
+                "code": {
+                    "description": "部门编码",
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "email": {
+                    "description": "邮箱",
+                    "type": "string",
+                    "maxLength": 32
+                },
+                "name": {
+                    "description": "部门名称",
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "owner": {
+                    "description": "负责人",
+                    "type": "string",
+                    "maxLength": 32
+                },
+                "parent_id": {
+                    "description": "上级部门ID",
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "phone": {
+                    "description": "联系电话",
+                    "type": "string",
+                    "maxLength": 32
+                },
+                "remark": {
+                    "description": "备注",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "sort": {
+                    "description": "排序",
+                    "type": "integer",
+                    "default": 1
+                },
+                "status": {
+                    "description": "状态【true-启用 false-停用】",
+                    "type": "boolean",
+                    "default": true
+                }
+            }
+        },
+        "system.CreateMenuRequest": {
+            "type": "object",
+            "required": [
+                "title",
+                "type"
+            ],
+            "properties": {
+                "code": {
+                    "description": "权限标识，按钮必填",
+                    "type": "string",
+                    "maxLength": 128
+                },
+                "component": {
+                    "description": "组件路径",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "icon": {
+                    "description": "图标",
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "is_link": {
+                    "description": "是否外链",
+                    "type": "boolean"
+                },
+                "keep_alive": {
+                    "description": "是否缓存",
+                    "type": "boolean"
+                },
+                "name": {
+                    "description": "路由名称",
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "parent_id": {
+                    "description": "上级菜单ID",
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "path": {
+                    "description": "路由地址",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "redirect": {
+                    "description": "重定向地址",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "remark": {
+                    "description": "备注",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "sort": {
+                    "description": "排序",
+                    "type": "integer",
+                    "default": 1
+                },
+                "status": {
+                    "description": "状态【true-启用 false-停用】",
+                    "type": "boolean",
+                    "default": true
+                },
+                "title": {
+                    "description": "菜单标题",
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "type": {
+                    "description": "菜单类型【1-目录 2-菜单 3-按钮】",
+                    "default": 1,
+                    "enum": [
+                        1,
+                        2,
+                        3
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/menu.TypeConst"
+                        }
+                    ]
+                },
+                "visible": {
+                    "description": "是否显示",
+                    "type": "boolean",
+                    "default": true
+                }
+            }
+        },
+        "system.CreatePermissionRequest": {
+            "type": "object",
+            "required": [
+                "code",
+                "method",
+                "name",
+                "path"
+            ],
+            "properties": {
+                "code": {
+                    "description": "权限编码",
+                    "type": "string",
+                    "maxLength": 128
+                },
+                "method": {
+                    "description": "请求方式，*表示全部",
+                    "type": "string",
+                    "maxLength": 10
+                },
+                "name": {
+                    "description": "权限名称",
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "path": {
+                    "description": "接口路径，如/v1/system/user/getById/:id",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "remark": {
+                    "description": "备注",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "sort": {
+                    "description": "排序",
+                    "type": "integer",
+                    "default": 1
+                },
+                "status": {
+                    "description": "状态【true-启用 false-停用】",
+                    "type": "boolean",
+                    "default": true
+                }
+            }
         },
-        "dict_type.DictTagConst": {
-            "type": "string",
-            "enum": [
-                "primary",
-                "success",
-                "warning",
-                "danger",
-                "info"
+        "system.CreateRoleRequest": {
+            "type": "object",
+            "required": [
+                "code",
+                "name"
             ],
-            "x-enum-comments": {
-                "DictTagConstDanger": "danger",
-                "DictTagConstInfo": "info",
-                "DictTagConstPrimary": "primary",
-                "DictTagConstSuccess": "success",
-                "DictTagConstWarning": "warning"
-            },
-            "x-enum-varnames": [
-                "DictTagConstPrimary",
-                "DictTagConstSuccess",
-                "DictTagConstWarning",
-                "DictTagConstDanger",
-                "DictTagConstInfo"
-            ]
+            "properties": {
+                "code": {
+                    "description": "角色编码",
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "data_scope": {
+                    "description": "数据范围【1-全部 2-本部门 3-本部门及以下 4-自定义 5-仅本人】",
+                    "default": 1,
+                    "enum": [
+                        1,
+                        2,
+                        3,
+                        4,
+                        5
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/role.DataScopeConst"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "角色名称",
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "remark": {
+                    "description": "备注",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "sort": {
+                    "description": "排序",
+                    "type": "integer",
+                    "default": 1
+                },
+                "status": {
+                    "description": "状态【true-启用 false-停用】",
+                    "type": "boolean",
+                    "default": true
+                }
+            }
+        },
+        "system.CreateUserRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "username"
+            ],
+            "properties": {
+                "avatar": {
+                    "description": "头像",
+                    "type": "string"
+                },
+                "dept_id": {
+                    "description": "部门ID",
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "email": {
+                    "description": "邮箱",
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "gender": {
+                    "description": "性别",
+                    "enum": [
+                        1,
+                        2,
+                        3
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/user.GenderConst"
+                        }
+                    ]
+                },
+                "mobile": {
+                    "description": "电话",
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "name": {
+                    "description": "姓名",
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "password": {
+                    "description": "密码",
+                    "type": "string",
+                    "maxLength": 64,
+                    "minLength": 6
+                },
+                "remark": {
+                    "description": "备注",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "sort": {
+                    "description": "排序",
+                    "type": "integer",
+                    "default": 1
+                },
+                "status": {
+                    "description": "状态【true-启用 false-停用】",
+                    "type": "boolean",
+                    "default": true
+                },
+                "username": {
+                    "description": "用户名",
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 4
+                }
+            }
+        },
+        "system.DeptListPageResponse": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "description": "列表",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_system.Dept"
+                    }
+                },
+                "page": {
+                    "description": "页码",
+                    "type": "integer"
+                },
+                "pageSize": {
+                    "description": "每页数量",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "总数",
+                    "type": "integer"
+                }
+            }
+        },
+        "system.MenuListPageResponse": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "description": "列表",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_system.Menu"
+                    }
+                },
+                "page": {
+                    "description": "页码",
+                    "type": "integer"
+                },
+                "pageSize": {
+                    "description": "每页数量",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "总数",
+                    "type": "integer"
+                }
+            }
+        },
+        "system.MoveDeptRequest": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "description": "主键ID",
+                    "type": "string"
+                },
+                "parent_id": {
+                    "description": "新上级部门ID，为空则移动为根节点",
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "timestamp": {
+                    "description": "版本",
+                    "type": "integer"
+                }
+            }
+        },
+        "system.OnlineListPageResponse": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "description": "列表",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/system.OnlineSession"
+                    }
+                },
+                "page": {
+                    "description": "页码",
+                    "type": "integer"
+                },
+                "pageSize": {
+                    "description": "每页数量",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "总数",
+                    "type": "integer"
+                }
+            }
+        },
+        "system.OnlineSession": {
+            "type": "object",
+            "properties": {
+                "browser": {
+                    "description": "浏览器",
+                    "type": "string"
+                },
+                "expireTime": {
+                    "description": "过期时间",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "会话ID",
+                    "type": "string"
+                },
+                "ip": {
+                    "description": "登录IP",
+                    "type": "string"
+                },
+                "loginTime": {
+                    "description": "登录时间",
+                    "type": "string"
+                },
+                "os": {
+                    "description": "操作系统",
+                    "type": "string"
+                },
+                "userAgent": {
+                    "description": "设备信息",
+                    "type": "string"
+                },
+                "userId": {
+                    "description": "用户ID",
+                    "type": "string"
+                },
+                "username": {
+                    "description": "用户名",
+                    "type": "string"
+                }
+            }
+        },
+        "system.PermissionListPageResponse": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "description": "列表",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_system.Permission"
+                    }
+                },
+                "page": {
+                    "description": "页码",
+                    "type": "integer"
+                },
+                "pageSize": {
+                    "description": "每页数量",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "总数",
+                    "type": "integer"
+                }
+            }
         },
-        "github_com_carefuly_careful-admin-go-gin_internal_domain_careful_system.User": {
+        "system.ResetUserPasswordRequest": {
             "type": "object",
+            "required": [
+                "id",
+                "password"
+            ],
             "properties": {
-                "avatar": {
-                    "description": "头像",
+                "id": {
+                    "description": "主键ID",
                     "type": "string"
                 },
-                "belongDept": {
-                    "description": "数据归属部门",
-                    "type": "string"
+                "password": {
+                    "description": "新密码",
+                    "type": "string",
+                    "maxLength": 64,
+                    "minLength": 6
+                }
+            }
+        },
+        "system.RoleListPageResponse": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "description": "列表",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_system.Role"
+                    }
                 },
-                "createTime": {
-                    "description": "创建时间",
-                    "type": "string"
+                "page": {
+                    "description": "页码",
+                    "type": "integer"
                 },
-                "creator": {
-                    "description": "创建人",
-                    "type": "string"
+                "pageSize": {
+                    "description": "每页数量",
+                    "type": "integer"
                 },
-                "dept": {
-                    "description": "部门",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/system.Dept"
-                        }
-                    ]
+                "total": {
+                    "description": "总数",
+                    "type": "integer"
+                }
+            }
+        },
+        "system.SigningKeyResponse": {
+            "type": "object",
+            "properties": {
+                "activeKid": {
+                    "description": "当前签名密钥ID",
+                    "type": "string"
                 },
-                "dept_id": {
-                    "description": "部门ID",
+                "algorithm": {
+                    "description": "签名算法",
                     "type": "string"
                 },
+                "kids": {
+                    "description": "已加载的密钥ID",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "system.UpdateDeptRequest": {
+            "type": "object",
+            "required": [
+                "code",
+                "id",
+                "name"
+            ],
+            "properties": {
+                "code": {
+                    "description": "部门编码",
+                    "type": "string",
+                    "maxLength": 50
+                },
                 "email": {
                     "description": "邮箱",
+                    "type": "string",
+                    "maxLength": 32
+                },
+                "id": {
+                    "description": "主键ID",
                     "type": "string"
                 },
-                "gender": {
-                    "description": "性别",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/user.GenderConst"
-                        }
-                    ]
+                "name": {
+                    "description": "部门名称",
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "owner": {
+                    "description": "负责人",
+                    "type": "string",
+                    "maxLength": 32
+                },
+                "phone": {
+                    "description": "联系电话",
+                    "type": "string",
+                    "maxLength": 32
+                },
+                "remark": {
+                    "description": "备注",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "sort": {
+                    "description": "排序",
+                    "type": "integer",
+                    "default": 1
+                },
+                "status": {
+                    "description": "状态【true-启用 false-停用】",
+                    "type": "boolean",
+                    "default": true
+                },
+                "timestamp": {
+                    "description": "版本",
+                    "type": "integer"
+                }
+            }
+        },
+        "system.UpdateMenuRequest": {
+            "type": "object",
+            "required": [
+                "id",
+                "title",
+                "type"
+            ],
+            "properties": {
+                "code": {
+                    "description": "权限标识，按钮必填",
+                    "type": "string",
+                    "maxLength": 128
+                },
+                "component": {
+                    "description": "组件路径",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "icon": {
+                    "description": "图标",
+                    "type": "string",
+                    "maxLength": 64
                 },
                 "id": {
-                    "description": "主键ID(自增)",
+                    "description": "主键ID",
                     "type": "string"
                 },
-                "mobile": {
-                    "description": "电话",
-                    "type": "string"
+                "is_link": {
+                    "description": "是否外链",
+                    "type": "boolean"
                 },
-                "modifier": {
-                    "description": "修改人",
-                    "type": "string"
+                "keep_alive": {
+                    "description": "是否缓存",
+                    "type": "boolean"
                 },
                 "name": {
-                    "description": "姓名",
-                    "type": "string"
+                    "description": "路由名称",
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "parent_id": {
+                    "description": "上级菜单ID",
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "path": {
+                    "description": "路由地址",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "redirect": {
+                    "description": "重定向地址",
+                    "type": "string",
+                    "maxLength": 255
                 },
                 "remark": {
                     "description": "备注",
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 255
                 },
                 "sort": {
-                    "description": "显示排序",
-                    "type": "integer"
+                    "description": "排序",
+                    "type": "integer",
+                    "default": 1
                 },
                 "status": {
-                    "description": "状态",
-                    "type": "boolean"
+                    "description": "状态【true-启用 false-停用】",
+                    "type": "boolean",
+                    "default": true
                 },
                 "timestamp": {
-                    "description": "版本号(时间戳)",
+                    "description": "版本",
                     "type": "integer"
                 },
-                "updateTime": {
-                    "description": "更新时间",
-                    "type": "string"
+                "title": {
+                    "description": "菜单标题",
+                    "type": "string",
+                    "maxLength": 64
                 },
-                "username": {
-                    "description": "用户名",
-                    "type": "string"
+                "type": {
+                    "description": "菜单类型【1-目录 2-菜单 3-按钮】",
+                    "default": 1,
+                    "enum": [
+                        1,
+                        2,
+                        3
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/menu.TypeConst"
+                        }
+                    ]
+                },
+                "visible": {
+                    "description": "是否显示",
+                    "type": "boolean",
+                    "default": true
                 }
             }
         },
-        "github_com_carefuly_careful-admin-go-gin_internal_domain_careful_tools.Dict": {
+        "system.UpdatePermissionRequest": {
             "type": "object",
+            "required": [
+                "code",
+                "id",
+                "method",
+                "name",
+                "path"
+            ],
             "properties": {
-                "belongDept": {
-                    "description": "数据归属部门",
-                    "type": "string"
-                },
                 "code": {
-                    "description": "字典编码",
-                    "type": "string"
+                    "description": "权限编码",
+                    "type": "string",
+                    "maxLength": 128
                 },
-                "createTime": {
-                    "description": "创建时间",
+                "id": {
+                    "description": "主键ID",
                     "type": "string"
                 },
-                "creator": {
-                    "description": "创建人",
-                    "type": "string"
+                "method": {
+                    "description": "请求方式，*表示全部",
+                    "type": "string",
+                    "maxLength": 10
+                },
+                "name": {
+                    "description": "权限名称",
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "path": {
+                    "description": "接口路径，如/v1/system/user/getById/:id",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "remark": {
+                    "description": "备注",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "sort": {
+                    "description": "排序",
+                    "type": "integer",
+                    "default": 1
+                },
+                "status": {
+                    "description": "状态【true-启用 false-停用】",
+                    "type": "boolean",
+                    "default": true
+                },
+                "timestamp": {
+                    "description": "版本",
+                    "type": "integer"
+                }
+            }
+        },
+        "system.UpdateRoleRequest": {
+            "type": "object",
+            "required": [
+                "code",
+                "id",
+                "name"
+            ],
+            "properties": {
+                "code": {
+                    "description": "角色编码",
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "data_scope": {
+                    "description": "数据范围【1-全部 2-本部门 3-本部门及以下 4-自定义 5-仅本人】",
+                    "default": 1,
+                    "enum": [
+                        1,
+                        2,
+                        3,
+                        4,
+                        5
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/role.DataScopeConst"
+                        }
+                    ]
                 },
                 "id": {
-                    "description": "主键ID(自增)",
-                    "type": "string"
-                },
-                "modifier": {
-                    "description": "修改人",
+                    "description": "主键ID",
                     "type": "string"
                 },
                 "name": {
-                    "description": "字典名称",
-                    "type": "string"
+                    "description": "角色名称",
+                    "type": "string",
+                    "maxLength": 64
                 },
                 "remark": {
                     "description": "备注",
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 255
                 },
                 "sort": {
-                    "description": "显示排序",
-                    "type": "integer"
+                    "description": "排序",
+                    "type": "integer",
+                    "default": 1
                 },
                 "status": {
-                    "description": "状态",
-                    "type": "boolean"
+                    "description": "状态【true-启用 false-停用】",
+                    "type": "boolean",
+                    "default": true
                 },
                 "timestamp": {
-                    "description": "版本号(时间戳)",
+                    "description": "版本",
                     "type": "integer"
-                },
-                "type": {
-                    "description": "字典类型",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/dict.TypeConst"
-                        }
-                    ]
-                },
-                "updateTime": {
-                    "description": "更新时间",
-                    "type": "string"
-                },
-                "valueType": {
-                    "description": "数据类型",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/dict.ValueTypeConst"
-                        }
-                    ]
                 }
             }
         },
-        "github_com_carefuly_careful-admin-go-gin_internal_domain_careful_tools.DictType": {
+        "system.UpdateUserRequest": {
             "type": "object",
+            "required": [
+                "id"
+            ],
             "properties": {
-                "belongDept": {
-                    "description": "数据归属部门",
-                    "type": "string"
-                },
-                "boolValue": {
-                    "description": "布尔-字典信息值",
-                    "type": "boolean"
-                },
-                "createTime": {
-                    "description": "创建时间",
-                    "type": "string"
-                },
-                "creator": {
-                    "description": "创建人",
+                "avatar": {
+                    "description": "头像",
                     "type": "string"
                 },
-                "dict": {
-                    "description": "数据字典",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_model_careful_tools.Dict"
-                        }
-                    ]
-                },
-                "dictColor": {
-                    "description": "标签颜色",
-                    "type": "string"
+                "dept_id": {
+                    "description": "部门ID",
+                    "type": "string",
+                    "maxLength": 100
                 },
-                "dictName": {
-                    "description": "字典名称",
-                    "type": "string"
+                "email": {
+                    "description": "邮箱",
+                    "type": "string",
+                    "maxLength": 50
                 },
-                "dictTag": {
-                    "description": "标签类型",
+                "gender": {
+                    "description": "性别",
+                    "enum": [
+                        1,
+                        2,
+                        3
+                    ],
                     "allOf": [
                         {
-                            "$ref": "#/definitions/dict_type.DictTagConst"
+                            "$ref": "#/definitions/user.GenderConst"
                         }
                     ]
                 },
-                "dict_id": {
-                    "description": "所属字典ID",
-                    "type": "string"
-                },
                 "id": {
-                    "description": "主键ID(自增)",
-                    "type": "string"
-                },
-                "intValue": {
-                    "description": "整型-字典信息值",
-                    "type": "integer"
-                },
-                "label": {
-                    "description": "名称",
+                    "description": "主键ID",
                     "type": "string"
                 },
-                "modifier": {
-                    "description": "修改人",
-                    "type": "string"
+                "mobile": {
+                    "description": "电话",
+                    "type": "string",
+                    "maxLength": 20
                 },
                 "name": {
-                    "description": "字典项名称",
-                    "type": "string"
+                    "description": "姓名",
+                    "type": "string",
+                    "maxLength": 50
                 },
                 "remark": {
                     "description": "备注",
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 255
                 },
                 "sort": {
-                    "description": "显示排序",
-                    "type": "integer"
+                    "description": "排序",
+                    "type": "integer",
+                    "default": 1
                 },
                 "status": {
-                    "description": "状态",
-                    "type": "boolean"
-                },
-                "strValue": {
-                    "description": "字符串-字典信息值",
-                    "type": "string"
+                    "description": "状态【true-启用 false-停用】",
+                    "type": "boolean",
+                    "default": true
                 },
                 "timestamp": {
-                    "description": "版本号(时间戳)",
+                    "description": "版本",
                     "type": "integer"
-                },
-                "updateTime": {
-                    "description": "更新时间",
-                    "type": "string"
-                },
-                "value": {
-                    "description": "值"
-                },
-                "valueType": {
-                    "description": "数据类型",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/dict.ValueTypeConst"
-                        }
-                    ]
                 }
             }
         },
-        "github_com_carefuly_careful-admin-go-gin_internal_model_careful_tools.Dict": {
+        "system.UpdateUserStatusRequest": {
             "type": "object",
+            "required": [
+                "id"
+            ],
             "properties": {
-                "belongDept": {
-                    "description": "数据归属部门",
-                    "type": "string"
-                },
-                "code": {
-                    "description": "字典编码",
-                    "type": "string"
-                },
-                "creator": {
-                    "description": "创建人",
-                    "type": "string"
-                },
                 "id": {
-                    "description": "主键ID(自增)",
-                    "type": "string"
-                },
-                "modifier": {
-                    "description": "修改人",
-                    "type": "string"
-                },
-                "name": {
-                    "description": "字典名称",
-                    "type": "string"
-                },
-                "remark": {
-                    "description": "备注",
+                    "description": "主键ID",
                     "type": "string"
                 },
-                "sort": {
-                    "description": "显示排序",
-                    "type": "integer"
-                },
                 "status": {
-                    "description": "状态",
+                    "description": "状态【true-启用 false-停用】",
                     "type": "boolean"
+                }
+            }
+        },
+        "system.UserListPageResponse": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "description": "列表",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_system.User"
+                    }
                 },
-                "timestamp": {
-                    "description": "版本号(时间戳)",
+                "page": {
+                    "description": "页码",
                     "type": "integer"
                 },
-                "type": {
-                    "description": "字典类型",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/dict.TypeConst"
-                        }
-                    ]
+                "pageSize": {
+                    "description": "每页数量",
+                    "type": "integer"
                 },
-                "valueType": {
-                    "description": "数据类型",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/dict.ValueTypeConst"
-                        }
-                    ]
+                "total": {
+                    "description": "总数",
+                    "type": "integer"
                 }
             }
         },
-        "response.Response": {
+        "system.UserMenu": {
             "type": "object",
             "properties": {
-                "code": {
-                    "description": "HTTP状态码",
-                    "type": "integer"
-                },
-                "data": {
-                    "description": "数据"
-                },
-                "msg": {
-                    "description": "提示信息"
-                },
-                "request_id": {
-                    "description": "请求ID",
-                    "type": "string"
-                },
-                "status": {
-                    "description": "响应状态: success|error",
-                    "type": "string"
+                "menus": {
+                    "description": "目录与菜单组成的树",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_system.Menu"
+                    }
                 },
-                "timestamp": {
-                    "description": "时间戳",
-                    "type": "string"
+                "permissions": {
+                    "description": "按钮权限标识",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "system.Dept": {
+        "tools.BucketListPageResponse": {
             "type": "object",
             "properties": {
-                "belongDept": {
-                    "description": "数据归属部门",
-                    "type": "string"
-                },
-                "child_count": {
-                    "description": "子部门数量",
-                    "type": "integer"
-                },
-                "children": {
-                    "description": "关联查询字段（不存储到数据库）",
+                "list": {
+                    "description": "列表",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/system.Dept"
+                        "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_tools.Bucket"
                     }
                 },
-                "code": {
-                    "description": "部门编码",
-                    "type": "string"
-                },
-                "creator": {
-                    "description": "创建人",
-                    "type": "string"
-                },
-                "email": {
-                    "description": "邮箱",
-                    "type": "string"
-                },
-                "id": {
-                    "description": "主键ID(自增)",
-                    "type": "string"
-                },
-                "level": {
-                    "description": "层级深度，根节点为0",
+                "page": {
+                    "description": "页码",
                     "type": "integer"
                 },
-                "modifier": {
-                    "description": "修改人",
-                    "type": "string"
-                },
-                "name": {
-                    "description": "部门名称",
-                    "type": "string"
-                },
-                "owner": {
-                    "description": "负责人",
-                    "type": "string"
+                "pageSize": {
+                    "description": "每页数量",
+                    "type": "integer"
                 },
-                "parent": {
-                    "description": "父部门信息",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/system.Dept"
-                        }
-                    ]
+                "total": {
+                    "description": "总数",
+                    "type": "integer"
+                }
+            }
+        },
+        "tools.CreateBucketRequest": {
+            "type": "object",
+            "required": [
+                "code",
+                "name"
+            ],
+            "properties": {
+                "allowedMimes": {
+                    "description": "允许的文件类型，逗号分隔，支持 image/* 通配",
+                    "type": "string",
+                    "maxLength": 512
                 },
-                "parent_id": {
-                    "description": "上级部门ID",
-                    "type": "string"
+                "code": {
+                    "description": "存储桶编码(文件存储路径前缀，创建后不可修改)",
+                    "type": "string",
+                    "maxLength": 64
                 },
-                "path": {
-                    "description": "节点路径，格式：/1/2/3/\"",
-                    "type": "string"
+                "maxSize": {
+                    "description": "单个文件大小上限(字节)，0表示不限制",
+                    "type": "integer",
+                    "minimum": 0
                 },
-                "phone": {
-                    "description": "联系电话",
-                    "type": "string"
+                "name": {
+                    "description": "存储桶名称",
+                    "type": "string",
+                    "maxLength": 100
                 },
                 "remark": {
                     "description": "备注",
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 255
                 },
                 "sort": {
-                    "description": "显示排序",
-                    "type": "integer"
+                    "description": "排序",
+                    "type": "integer",
+                    "default": 1
                 },
                 "status": {
-                    "description": "状态",
-                    "type": "boolean"
-                },
-                "timestamp": {
-                    "description": "版本号(时间戳)",
-                    "type": "integer"
-                },
-                "user_count": {
-                    "description": "用户数量",
-                    "type": "integer"
+                    "description": "状态【true-启用 false-停用】",
+                    "type": "boolean",
+                    "default": true
                 }
             }
         },
@@ -2022,6 +11262,30 @@ const docTemplate = `{
                 }
             }
         },
+        "tools.DictOption": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "description": "标签颜色",
+                    "type": "string"
+                },
+                "label": {
+                    "description": "名称",
+                    "type": "string"
+                },
+                "tag": {
+                    "description": "标签类型",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dict_type.DictTagConst"
+                        }
+                    ]
+                },
+                "value": {
+                    "description": "值，类型由所属字典的数据类型决定"
+                }
+            }
+        },
         "tools.DictTypeListPageResponse": {
             "type": "object",
             "properties": {
@@ -2046,6 +11310,143 @@ const docTemplate = `{
                 }
             }
         },
+        "tools.FileListPageResponse": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "description": "列表",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_tools.File"
+                    }
+                },
+                "page": {
+                    "description": "页码",
+                    "type": "integer"
+                },
+                "pageSize": {
+                    "description": "每页数量",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "总数",
+                    "type": "integer"
+                }
+            }
+        },
+        "tools.InitUploadRequest": {
+            "type": "object",
+            "required": [
+                "hash",
+                "name",
+                "size"
+            ],
+            "properties": {
+                "bucket": {
+                    "description": "存储桶编码，为空时仅可用于导入",
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "hash": {
+                    "description": "文件内容哈希(SHA-256)",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "文件名称",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "size": {
+                    "description": "文件大小(字节)",
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "tools.JobListPageResponse": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "description": "列表",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_carefuly_careful-admin-go-gin_internal_domain_careful_tools.Job"
+                    }
+                },
+                "page": {
+                    "description": "页码",
+                    "type": "integer"
+                },
+                "pageSize": {
+                    "description": "每页数量",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "总数",
+                    "type": "integer"
+                }
+            }
+        },
+        "tools.PresignFileResponse": {
+            "type": "object",
+            "properties": {
+                "expiresIn": {
+                    "description": "有效期(秒)",
+                    "type": "integer"
+                },
+                "url": {
+                    "description": "下载地址",
+                    "type": "string"
+                }
+            }
+        },
+        "tools.UpdateBucketRequest": {
+            "type": "object",
+            "required": [
+                "id",
+                "name"
+            ],
+            "properties": {
+                "allowedMimes": {
+                    "description": "允许的文件类型，逗号分隔，支持 image/* 通配",
+                    "type": "string",
+                    "maxLength": 512
+                },
+                "id": {
+                    "description": "主键ID",
+                    "type": "string"
+                },
+                "maxSize": {
+                    "description": "单个文件大小上限(字节)，0表示不限制",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "name": {
+                    "description": "存储桶名称",
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "remark": {
+                    "description": "备注",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "sort": {
+                    "description": "排序",
+                    "type": "integer",
+                    "default": 1
+                },
+                "status": {
+                    "description": "状态【true-启用 false-停用】",
+                    "type": "boolean",
+                    "default": true
+                },
+                "timestamp": {
+                    "description": "版本",
+                    "type": "integer"
+                }
+            }
+        },
         "tools.UpdateDictRequest": {
             "type": "object",
             "required": [
@@ -2141,6 +11542,54 @@ const docTemplate = `{
                 }
             }
         },
+        "tools.UploadSession": {
+            "type": "object",
+            "properties": {
+                "bucket": {
+                    "description": "存储桶编码，仅用于导入时可为空",
+                    "type": "string"
+                },
+                "chunkSize": {
+                    "description": "分片大小(字节)，最后一个分片可小于该值",
+                    "type": "integer"
+                },
+                "deptId": {
+                    "description": "上传人所属部门",
+                    "type": "string"
+                },
+                "hash": {
+                    "description": "文件内容哈希(SHA-256)",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "文件名称",
+                    "type": "string"
+                },
+                "size": {
+                    "description": "文件大小(字节)",
+                    "type": "integer"
+                },
+                "totalChunks": {
+                    "description": "分片总数",
+                    "type": "integer"
+                },
+                "uploadId": {
+                    "description": "上传会话ID",
+                    "type": "string"
+                },
+                "uploaded": {
+                    "description": "已上传的分片序号(从0开始)",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "userId": {
+                    "description": "上传人",
+                    "type": "string"
+                }
+            }
+        },
         "user.GenderConst": {
             "type": "integer",
             "enum": [
@@ -2153,6 +11602,11 @@ const docTemplate = `{
                 "GenderConstMale": "男",
                 "GenderConstSecret": "保密"
             },
+            "x-enum-descriptions": [
+                "男",
+                "女",
+                "保密"
+            ],
             "x-enum-varnames": [
                 "GenderConstMale",
                 "GenderConstFemale",
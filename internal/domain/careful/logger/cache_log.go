@@ -0,0 +1,60 @@
+/**
+ * Description：
+ * FileName：cache_log.go
+ * Author：CJiaの用心
+ * Create：2025/10/30 09:21:47
+ * Remark：
+ */
+
+package logger
+
+import (
+	"context"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/logger"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"gorm.io/gorm"
+)
+
+type CacheLog struct {
+	logger.CacheLogger
+	CreateTime string `json:"createTime"` // 创建时间
+	UpdateTime string `json:"updateTime"` // 更新时间
+}
+
+type CacheLogFilter struct {
+	filters.Pagination
+	filters.Filters
+	filters.TimeRange
+	Username string `json:"username"` // 缓存用户名
+	Path     string `json:"path"`     // 缓存请求地址
+	Method   string `json:"method"`   // 缓存请求方式
+	Ip       string `json:"ip"`       // 缓存者IP
+	Key      string `json:"key"`      // 缓存key键
+	Status   *bool  `json:"status"`   // 状态，为空则不限制
+}
+
+func (f *CacheLogFilter) QueryFilter(ctx context.Context, query *gorm.DB) *gorm.DB {
+	query = f.TimeRange.QueryFilter(ctx, f.Filters.QueryFilter(ctx, query)).
+		Order("create_time DESC")
+
+	if f.Username != "" {
+		query = query.Where("cacheUsername LIKE ?", "%"+f.Username+"%")
+	}
+	if f.Path != "" {
+		query = query.Where("cachePath LIKE ?", "%"+f.Path+"%")
+	}
+	if f.Method != "" {
+		query = query.Where("cacheMethod = ?", f.Method)
+	}
+	if f.Ip != "" {
+		query = query.Where("cacheIp LIKE ?", "%"+f.Ip+"%")
+	}
+	if f.Key != "" {
+		query = query.Where("cacheKey LIKE ?", "%"+f.Key+"%")
+	}
+	if f.Status != nil {
+		query = query.Where("status = ?", *f.Status)
+	}
+
+	return query
+}
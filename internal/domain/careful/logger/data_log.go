@@ -0,0 +1,57 @@
+/**
+ * Description：
+ * FileName：data_log.go
+ * Author：CJiaの用心
+ * Create：2025/11/14 14:20:33
+ * Remark：
+ */
+
+package logger
+
+import (
+	"context"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/logger"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/logger/data_log"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"gorm.io/gorm"
+)
+
+type DataLog struct {
+	logger.DataLogger
+	Changes    []logger.FieldChange `json:"changes"`    // 字段变更
+	CreateTime string               `json:"createTime"` // 变更时间
+}
+
+type DataLogFilter struct {
+	filters.Pagination
+	filters.Filters
+	filters.TimeRange
+	RecordTable string               `json:"recordTable"` // 数据表
+	RecordId    string               `json:"recordId"`    // 数据主键ID
+	Action      data_log.ActionConst `json:"action"`      // 变更类型
+	Operator    string               `json:"operator"`    // 操作人
+	RequestId   string               `json:"requestId"`   // 请求ID
+}
+
+func (f *DataLogFilter) QueryFilter(ctx context.Context, query *gorm.DB) *gorm.DB {
+	query = f.TimeRange.QueryFilter(ctx, f.Filters.QueryFilter(ctx, query)).
+		Order("create_time DESC")
+
+	if f.RecordTable != "" {
+		query = query.Where("recordTable = ?", f.RecordTable)
+	}
+	if f.RecordId != "" {
+		query = query.Where("recordId = ?", f.RecordId)
+	}
+	if f.Action > 0 {
+		query = query.Where("action = ?", f.Action)
+	}
+	if f.Operator != "" {
+		query = query.Where("operator = ?", f.Operator)
+	}
+	if f.RequestId != "" {
+		query = query.Where("requestId = ?", f.RequestId)
+	}
+
+	return query
+}
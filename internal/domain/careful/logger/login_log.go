@@ -0,0 +1,48 @@
+/**
+ * Description：
+ * FileName：login_log.go
+ * Author：CJiaの用心
+ * Create：2025/10/30 09:18:02
+ * Remark：
+ */
+
+package logger
+
+import (
+	"context"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/logger"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"gorm.io/gorm"
+)
+
+type LoginLog struct {
+	logger.LoginLogger
+	CreateTime string `json:"createTime"` // 创建时间
+	UpdateTime string `json:"updateTime"` // 更新时间
+}
+
+type LoginLogFilter struct {
+	filters.Pagination
+	filters.Filters
+	filters.TimeRange
+	Username string `json:"username"` // 登录用户名
+	Ip       string `json:"ip"`       // 登录ip
+	Status   *bool  `json:"status"`   // 登录结果，为空则不限制
+}
+
+func (f *LoginLogFilter) QueryFilter(ctx context.Context, query *gorm.DB) *gorm.DB {
+	query = f.TimeRange.QueryFilter(ctx, f.Filters.QueryFilter(ctx, query)).
+		Order("create_time DESC")
+
+	if f.Username != "" {
+		query = query.Where("loginUsername LIKE ?", "%"+f.Username+"%")
+	}
+	if f.Ip != "" {
+		query = query.Where("ip LIKE ?", "%"+f.Ip+"%")
+	}
+	if f.Status != nil {
+		query = query.Where("status = ?", *f.Status)
+	}
+
+	return query
+}
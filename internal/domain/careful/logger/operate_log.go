@@ -0,0 +1,56 @@
+/**
+ * Description：
+ * FileName：operate_log.go
+ * Author：CJiaの用心
+ * Create：2025/10/30 09:12:26
+ * Remark：
+ */
+
+package logger
+
+import (
+	"context"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/logger"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"gorm.io/gorm"
+)
+
+type OperateLog struct {
+	logger.OperateLogger
+	CreateTime string `json:"createTime"` // 创建时间
+	UpdateTime string `json:"updateTime"` // 更新时间
+}
+
+type OperateLogFilter struct {
+	filters.Pagination
+	filters.Filters
+	filters.TimeRange
+	Username string `json:"username"` // 请求用户名
+	Path     string `json:"path"`     // 请求地址
+	Method   string `json:"method"`   // 请求方式
+	Status   int    `json:"status"`   // 响应状态码
+	Ip       string `json:"ip"`       // 请求IP地址
+}
+
+func (f *OperateLogFilter) QueryFilter(ctx context.Context, query *gorm.DB) *gorm.DB {
+	query = f.TimeRange.QueryFilter(ctx, f.Filters.QueryFilter(ctx, query)).
+		Order("create_time DESC")
+
+	if f.Username != "" {
+		query = query.Where("requestUsername LIKE ?", "%"+f.Username+"%")
+	}
+	if f.Path != "" {
+		query = query.Where("requestPath LIKE ?", "%"+f.Path+"%")
+	}
+	if f.Method != "" {
+		query = query.Where("requestMethod = ?", f.Method)
+	}
+	if f.Status > 0 {
+		query = query.Where("requestStatus = ?", f.Status)
+	}
+	if f.Ip != "" {
+		query = query.Where("requestIp LIKE ?", "%"+f.Ip+"%")
+	}
+
+	return query
+}
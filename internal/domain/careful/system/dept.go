@@ -0,0 +1,69 @@
+/**
+ * Description：
+ * FileName：dept.go
+ * Author：CJiaの用心
+ * Create：2025/10/26 09:35:12
+ * Remark：
+ */
+
+package system
+
+import (
+	"context"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"gorm.io/gorm"
+)
+
+type Dept struct {
+	system.Dept
+	ParentId   string  `json:"parent_id"`          // 上级部门ID
+	CreateTime string  `json:"createTime"`         // 创建时间
+	UpdateTime string  `json:"updateTime"`         // 更新时间
+	Children   []*Dept `json:"children,omitempty"` // 子部门列表
+}
+
+type DeptFilter struct {
+	filters.Pagination
+	filters.Filters
+	Status bool   `json:"status"` // 状态
+	Name   string `json:"name"`   // 部门名称
+	Code   string `json:"code"`   // 部门编码
+}
+
+func (f *DeptFilter) QueryFilter(ctx context.Context, query *gorm.DB) *gorm.DB {
+	query = f.Filters.QueryFilter(ctx, query).
+		Where("status = ?", f.Status).
+		Order("level ASC, sort ASC, update_time DESC")
+
+	if f.Name != "" {
+		query = query.Where("name LIKE ?", "%"+f.Name+"%")
+	}
+	if f.Code != "" {
+		query = query.Where("code LIKE ?", "%"+f.Code+"%")
+	}
+
+	return query
+}
+
+// BuildDeptTree 将扁平列表组装为树，上级不在列表中的节点视为根节点
+func BuildDeptTree(list []Dept) []*Dept {
+	nodes := make(map[string]*Dept, len(list))
+	for i := range list {
+		node := list[i]
+		node.Children = []*Dept{}
+		nodes[node.Id] = &node
+	}
+
+	tree := make([]*Dept, 0)
+	for i := range list {
+		node := nodes[list[i].Id]
+		if parent, ok := nodes[node.ParentId]; ok && node.ParentId != "" {
+			parent.Children = append(parent.Children, node)
+			continue
+		}
+		tree = append(tree, node)
+	}
+
+	return tree
+}
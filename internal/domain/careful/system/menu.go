@@ -0,0 +1,142 @@
+/**
+ * Description：
+ * FileName：menu.go
+ * Author：CJiaの用心
+ * Create：2025/10/28 09:41:06
+ * Remark：
+ */
+
+package system
+
+import (
+	"context"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/system/menu"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"gorm.io/gorm"
+)
+
+type Menu struct {
+	system.Menu
+	ParentId   string  `json:"parent_id"`          // 上级菜单ID
+	CreateTime string  `json:"createTime"`         // 创建时间
+	UpdateTime string  `json:"updateTime"`         // 更新时间
+	Children   []*Menu `json:"children,omitempty"` // 子菜单列表
+}
+
+type MenuFilter struct {
+	filters.Pagination
+	filters.Filters
+	Status bool           `json:"status"` // 状态
+	Title  string         `json:"title"`  // 菜单标题
+	Type   menu.TypeConst `json:"type"`   // 菜单类型
+}
+
+func (f *MenuFilter) QueryFilter(ctx context.Context, query *gorm.DB) *gorm.DB {
+	query = f.Filters.QueryFilter(ctx, query).
+		Where("status = ?", f.Status).
+		Order("sort ASC, create_time ASC")
+
+	if f.Title != "" {
+		query = query.Where("title LIKE ?", "%"+f.Title+"%")
+	}
+	if f.Type > 0 {
+		query = query.Where("type = ?", f.Type)
+	}
+
+	return query
+}
+
+// UserMenu 当前用户可见菜单
+type UserMenu struct {
+	Menus       []*Menu  `json:"menus"`       // 目录与菜单组成的树
+	Permissions []string `json:"permissions"` // 按钮权限标识
+}
+
+// BuildMenuTree 将扁平列表组装为树，上级不在列表中的节点视为根节点
+func BuildMenuTree(list []Menu) []*Menu {
+	nodes := make(map[string]*Menu, len(list))
+	for i := range list {
+		node := list[i]
+		node.Children = []*Menu{}
+		nodes[node.Id] = &node
+	}
+
+	tree := make([]*Menu, 0)
+	for i := range list {
+		node := nodes[list[i].Id]
+		if parent, ok := nodes[node.ParentId]; ok && node.ParentId != "" {
+			parent.Children = append(parent.Children, node)
+			continue
+		}
+		tree = append(tree, node)
+	}
+
+	return tree
+}
+
+// BuildUserMenu 从全部启用菜单中筛选授权菜单，补全祖先节点后组装为树，按钮仅输出权限标识
+// 祖先链中存在停用（不在 all 中）的节点时，整条分支不可见
+func BuildUserMenu(all []Menu, allowedIds []string, isSuperuser bool) UserMenu {
+	index := make(map[string]Menu, len(all))
+	for _, v := range all {
+		index[v.Id] = v
+	}
+
+	// 校验祖先链完整，结果缓存避免重复遍历
+	reachable := make(map[string]bool, len(all))
+	var isReachable func(id string) bool
+	isReachable = func(id string) bool {
+		if ok, seen := reachable[id]; seen {
+			return ok
+		}
+		node, ok := index[id]
+		if !ok {
+			return false
+		}
+		reachable[id] = false // 防止脏数据成环
+		ok = node.ParentId == "" || isReachable(node.ParentId)
+		reachable[id] = ok
+		return ok
+	}
+
+	// 授权节点及其祖先节点均可见
+	visible := make(map[string]struct{}, len(all))
+	if isSuperuser {
+		for _, v := range all {
+			allowedIds = append(allowedIds, v.Id)
+		}
+	}
+	for _, id := range allowedIds {
+		if !isReachable(id) {
+			continue
+		}
+		for current, ok := index[id]; ok; current, ok = index[current.ParentId] {
+			if _, seen := visible[current.Id]; seen {
+				break
+			}
+			visible[current.Id] = struct{}{}
+		}
+	}
+
+	result := UserMenu{
+		Menus:       []*Menu{},
+		Permissions: []string{},
+	}
+	menus := make([]Menu, 0, len(visible))
+	for _, v := range all {
+		if _, ok := visible[v.Id]; !ok {
+			continue
+		}
+		if v.Type == menu.TypeConstButton {
+			if v.Code != "" {
+				result.Permissions = append(result.Permissions, v.Code)
+			}
+			continue
+		}
+		menus = append(menus, v)
+	}
+	result.Menus = BuildMenuTree(menus)
+
+	return result
+}
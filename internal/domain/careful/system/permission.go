@@ -0,0 +1,80 @@
+/**
+ * Description：
+ * FileName：permission.go
+ * Author：CJiaの用心
+ * Create：2025/10/27 09:52:06
+ * Remark：
+ */
+
+package system
+
+import (
+	"context"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"gorm.io/gorm"
+	"strings"
+)
+
+type Permission struct {
+	system.Permission
+	CreateTime string `json:"createTime"` // 创建时间
+	UpdateTime string `json:"updateTime"` // 更新时间
+}
+
+type PermissionFilter struct {
+	filters.Pagination
+	filters.Filters
+	Status bool   `json:"status"` // 状态
+	Name   string `json:"name"`   // 权限名称
+	Code   string `json:"code"`   // 权限编码
+	Method string `json:"method"` // 请求方式
+	Path   string `json:"path"`   // 接口路径
+}
+
+func (f *PermissionFilter) QueryFilter(ctx context.Context, query *gorm.DB) *gorm.DB {
+	query = f.Filters.QueryFilter(ctx, query).
+		Where("status = ?", f.Status).
+		Order("sort ASC, update_time DESC")
+
+	if f.Name != "" {
+		query = query.Where("name LIKE ?", "%"+f.Name+"%")
+	}
+	if f.Code != "" {
+		query = query.Where("code LIKE ?", "%"+f.Code+"%")
+	}
+	if f.Method != "" {
+		query = query.Where("method = ?", strings.ToUpper(f.Method))
+	}
+	if f.Path != "" {
+		query = query.Where("path LIKE ?", "%"+f.Path+"%")
+	}
+
+	return query
+}
+
+// UserPermission 用户接口权限（缓存结构）
+type UserPermission struct {
+	IsSuperuser bool     `json:"isSuperuser"` // 是否超级管理员
+	Apis        []string `json:"apis"`        // 接口权限，格式：METHOD PATH
+}
+
+// PermissionApiKey 生成接口权限标识
+func PermissionApiKey(method, path string) string {
+	return strings.ToUpper(method) + " " + path
+}
+
+// Allow 判断是否拥有接口权限，请求方式为*时匹配全部
+func (p *UserPermission) Allow(method, path string) bool {
+	if p.IsSuperuser {
+		return true
+	}
+	target := PermissionApiKey(method, path)
+	wildcard := PermissionApiKey("*", path)
+	for _, api := range p.Apis {
+		if api == target || api == wildcard {
+			return true
+		}
+	}
+	return false
+}
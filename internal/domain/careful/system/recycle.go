@@ -0,0 +1,46 @@
+/**
+ * Description：
+ * FileName：recycle.go
+ * Author：CJiaの用心
+ * Create：2025/11/18 10:12:26
+ * Remark：
+ */
+
+package system
+
+import (
+	"context"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"gorm.io/gorm"
+)
+
+// RecycleFilter 回收站查询条件，调用方需以 Unscoped 查询
+type RecycleFilter struct {
+	filters.Pagination
+	filters.Filters
+	Name      string `json:"name"`      // 名称
+	DeletedBy string `json:"deletedBy"` // 删除人
+}
+
+// QueryFilter 构建回收站查询条件，columns 为名称匹配的列，默认 name
+func (f *RecycleFilter) QueryFilter(ctx context.Context, query *gorm.DB, columns ...string) *gorm.DB {
+	query = f.Filters.QueryFilter(ctx, query).
+		Where("deleted_at <> ?", 0).
+		Order("deleted_at DESC")
+
+	if f.Name != "" {
+		if len(columns) == 0 {
+			columns = []string{"name"}
+		}
+		conditions := query.Session(&gorm.Session{NewDB: true}).Where(columns[0]+" LIKE ?", "%"+f.Name+"%")
+		for _, column := range columns[1:] {
+			conditions = conditions.Or(column+" LIKE ?", "%"+f.Name+"%")
+		}
+		query = query.Where(conditions)
+	}
+	if f.DeletedBy != "" {
+		query = query.Where("deleted_by = ?", f.DeletedBy)
+	}
+
+	return query
+}
@@ -0,0 +1,45 @@
+/**
+ * Description：
+ * FileName：role.go
+ * Author：CJiaの用心
+ * Create：2025/10/27 09:48:21
+ * Remark：
+ */
+
+package system
+
+import (
+	"context"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"gorm.io/gorm"
+)
+
+type Role struct {
+	system.Role
+	CreateTime string `json:"createTime"` // 创建时间
+	UpdateTime string `json:"updateTime"` // 更新时间
+}
+
+type RoleFilter struct {
+	filters.Pagination
+	filters.Filters
+	Status bool   `json:"status"` // 状态
+	Name   string `json:"name"`   // 角色名称
+	Code   string `json:"code"`   // 角色编码
+}
+
+func (f *RoleFilter) QueryFilter(ctx context.Context, query *gorm.DB) *gorm.DB {
+	query = f.Filters.QueryFilter(ctx, query).
+		Where("status = ?", f.Status).
+		Order("sort ASC, update_time DESC")
+
+	if f.Name != "" {
+		query = query.Where("name LIKE ?", "%"+f.Name+"%")
+	}
+	if f.Code != "" {
+		query = query.Where("code LIKE ?", "%"+f.Code+"%")
+	}
+
+	return query
+}
@@ -8,7 +8,13 @@
 
 package system
 
-import "github.com/carefuly/careful-admin-go-gin/internal/model/careful/system"
+import (
+	"context"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/system/user"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"gorm.io/gorm"
+)
 
 type User struct {
 	system.User
@@ -16,3 +22,42 @@ type User struct {
 	CreateTime string `json:"createTime"` // 创建时间
 	UpdateTime string `json:"updateTime"` // 更新时间
 }
+
+type UserFilter struct {
+	filters.Pagination
+	filters.Filters
+	Status   bool             `json:"status"`   // 状态
+	Username string           `json:"username"` // 用户名
+	Name     string           `json:"name"`     // 姓名
+	Gender   user.GenderConst `json:"gender"`   // 性别
+	Email    string           `json:"email"`    // 邮箱
+	Mobile   string           `json:"mobile"`   // 电话
+	DeptId   string           `json:"dept_id"`  // 部门ID
+}
+
+func (f *UserFilter) QueryFilter(ctx context.Context, query *gorm.DB) *gorm.DB {
+	query = f.Filters.QueryFilter(ctx, query).
+		Where("status = ?", f.Status).
+		Order("sort ASC, update_time DESC")
+
+	if f.Username != "" {
+		query = query.Where("username LIKE ?", "%"+f.Username+"%")
+	}
+	if f.Name != "" {
+		query = query.Where("name LIKE ?", "%"+f.Name+"%")
+	}
+	if f.Gender > 0 {
+		query = query.Where("gender = ?", f.Gender)
+	}
+	if f.Email != "" {
+		query = query.Where("email LIKE ?", "%"+f.Email+"%")
+	}
+	if f.Mobile != "" {
+		query = query.Where("mobile LIKE ?", "%"+f.Mobile+"%")
+	}
+	if f.DeptId != "" {
+		query = query.Where("dept_id = ?", f.DeptId)
+	}
+
+	return query
+}
@@ -0,0 +1,75 @@
+/**
+ * Description：
+ * FileName：bucket.go
+ * Author：CJiaの用心
+ * Create：2025/11/11 15:52:17
+ * Remark：
+ */
+
+package tools
+
+import (
+	"context"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/tools"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"gorm.io/gorm"
+	"path"
+	"strings"
+)
+
+type Bucket struct {
+	tools.Bucket
+	CreateTime string `json:"createTime"` // 创建时间
+	UpdateTime string `json:"updateTime"` // 更新时间
+}
+
+// Mimes 允许的文件类型列表，为空表示不限制
+func (b *Bucket) Mimes() []string {
+	mimes := make([]string, 0)
+	for _, mime := range strings.Split(b.AllowedMimes, ",") {
+		if mime = strings.ToLower(strings.TrimSpace(mime)); mime != "" {
+			mimes = append(mimes, mime)
+		}
+	}
+	return mimes
+}
+
+// AllowMime 判断文件类型是否在白名单内，支持 image/* 形式的通配
+func (b *Bucket) AllowMime(mimeType string) bool {
+	mimes := b.Mimes()
+	if len(mimes) == 0 {
+		return true
+	}
+	mimeType = strings.ToLower(mimeType)
+	for _, pattern := range mimes {
+		if ok, _ := path.Match(pattern, mimeType); ok {
+			return true
+		}
+	}
+	return false
+}
+
+type BucketFilter struct {
+	filters.Pagination
+	filters.Filters
+	Status *bool  `json:"status"` // 状态
+	Name   string `json:"name"`   // 存储桶名称
+	Code   string `json:"code"`   // 存储桶编码
+}
+
+func (f *BucketFilter) QueryFilter(ctx context.Context, query *gorm.DB) *gorm.DB {
+	// 存储桶为全局配置，不按数据权限过滤
+	query = query.Order("sort ASC, create_time DESC")
+
+	if f.Status != nil {
+		query = query.Where("status = ?", *f.Status)
+	}
+	if f.Name != "" {
+		query = query.Where("name LIKE ?", "%"+f.Name+"%")
+	}
+	if f.Code != "" {
+		query = query.Where("code LIKE ?", "%"+f.Code+"%")
+	}
+
+	return query
+}
@@ -18,8 +18,8 @@ import (
 
 type Dict struct {
 	tools.Dict
-	CreateTime string `json:"createTime"` // 创建时间
-	UpdateTime string `json:"updateTime"` // 更新时间
+	CreateTime string `json:"createTime" excel:"title=创建时间;width=22;readonly;order=120"` // 创建时间
+	UpdateTime string `json:"updateTime" excel:"title=更新时间;width=22;readonly;order=121"` // 更新时间
 }
 
 type DictFilter struct {
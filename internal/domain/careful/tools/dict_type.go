@@ -11,19 +11,47 @@ package tools
 import (
 	"context"
 	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/tools"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/tools/dict"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/tools/dict_type"
 	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
 	"gorm.io/gorm"
 )
 
 type DictType struct {
 	tools.DictType
-	Label      string `json:"label"`      // 名称
-	Value      any    `json:"value"`      // 值
-	StrValue   string `json:"strValue"`   // 字符串-字典信息值
-	IntValue   int64  `json:"intValue"`   // 整型-字典信息值
-	BoolValue  bool   `json:"boolValue"`  // 布尔-字典信息值
-	CreateTime string `json:"createTime"` // 创建时间
-	UpdateTime string `json:"updateTime"` // 更新时间
+	Label      string `json:"label"`                                                     // 名称
+	Value      any    `json:"value"`                                                     // 值
+	StrValue   string `json:"strValue"`                                                  // 字符串-字典信息值
+	IntValue   int64  `json:"intValue"`                                                  // 整型-字典信息值
+	BoolValue  bool   `json:"boolValue"`                                                 // 布尔-字典信息值
+	CreateTime string `json:"createTime" excel:"title=创建时间;width=22;readonly;order=120"` // 创建时间
+	UpdateTime string `json:"updateTime" excel:"title=更新时间;width=22;readonly;order=121"` // 更新时间
+}
+
+// DictOption 字典选项，供前端下拉、标签等组件使用
+type DictOption struct {
+	Label string                 `json:"label"` // 名称
+	Value any                    `json:"value"` // 值，类型由所属字典的数据类型决定
+	Tag   dict_type.DictTagConst `json:"tag"`   // 标签类型
+	Color string                 `json:"color"` // 标签颜色
+}
+
+// Option 转换为字典选项，按数据类型取对应的字典值
+func (d DictType) Option() DictOption {
+	option := DictOption{
+		Label: d.Name,
+		Tag:   d.DictTag,
+		Color: d.DictColor,
+	}
+	switch d.ValueType {
+	case dict.ValueTypeConstStr:
+		option.Value = d.StrValue
+	case dict.ValueTypeConstInt:
+		option.Value = d.IntValue
+	case dict.ValueTypeConstBool:
+		option.Value = d.BoolValue
+	}
+	return option
 }
 
 type DictTypeFilter struct {
@@ -0,0 +1,53 @@
+/**
+ * Description：
+ * FileName：file.go
+ * Author：CJiaの用心
+ * Create：2025/11/11 16:03:45
+ * Remark：
+ */
+
+package tools
+
+import (
+	"context"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/tools"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"gorm.io/gorm"
+)
+
+type File struct {
+	tools.File
+	CreateTime string `json:"createTime"` // 创建时间
+	UpdateTime string `json:"updateTime"` // 更新时间
+}
+
+type FileFilter struct {
+	filters.Pagination
+	filters.Filters
+	filters.TimeRange
+	BucketId string `json:"bucketId"` // 存储桶ID
+	Name     string `json:"name"`     // 文件名称
+	MimeType string `json:"mimeType"` // 文件类型
+	Hash     string `json:"hash"`     // 内容哈希
+}
+
+func (f *FileFilter) QueryFilter(ctx context.Context, query *gorm.DB) *gorm.DB {
+	query = f.Filters.QueryFilter(ctx, query)
+	query = f.TimeRange.QueryFilter(ctx, query).
+		Order("create_time DESC")
+
+	if f.BucketId != "" {
+		query = query.Where("bucket_id = ?", f.BucketId)
+	}
+	if f.Name != "" {
+		query = query.Where("name LIKE ?", "%"+f.Name+"%")
+	}
+	if f.MimeType != "" {
+		query = query.Where("mimeType LIKE ?", f.MimeType+"%")
+	}
+	if f.Hash != "" {
+		query = query.Where("hash = ?", f.Hash)
+	}
+
+	return query
+}
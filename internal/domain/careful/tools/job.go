@@ -0,0 +1,73 @@
+/**
+ * Description：
+ * FileName：job.go
+ * Author：CJiaの用心
+ * Create：2025/11/7 10:46:22
+ * Remark：
+ */
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/tools"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/tools/job"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"gorm.io/gorm"
+)
+
+type Job struct {
+	tools.Job
+	Progress    float64         `json:"progress"`    // 执行进度(0-100)
+	Result      json.RawMessage `json:"result"`      // 结果详情
+	DownloadUrl string          `json:"downloadUrl"` // 结果文件下载地址
+	StartTime   string          `json:"startTime"`   // 开始时间
+	FinishTime  string          `json:"finishTime"`  // 结束时间
+	ExpireTime  string          `json:"expireTime"`  // 结果文件过期时间
+	CreateTime  string          `json:"createTime"`  // 创建时间
+	UpdateTime  string          `json:"updateTime"`  // 更新时间
+}
+
+// JobProgress 任务执行进度
+type JobProgress struct {
+	Total        int64 `json:"total"`        // 总条数
+	Processed    int64 `json:"processed"`    // 已处理条数
+	SuccessCount int64 `json:"successCount"` // 成功条数
+	FailCount    int64 `json:"failCount"`    // 失败条数
+}
+
+// JobOutcome 任务执行结果
+type JobOutcome struct {
+	JobProgress
+	Message      string // 结果信息
+	Result       any    // 结果详情，序列化为JSON保存
+	Artifact     string // 结果文件路径
+	ArtifactName string // 结果文件名
+}
+
+type JobFilter struct {
+	filters.Pagination
+	filters.Filters
+	Name   string          `json:"name"`   // 任务名称
+	Type   job.TypeConst   `json:"type"`   // 任务类型
+	Status job.StatusConst `json:"status"` // 任务状态
+}
+
+func (f *JobFilter) QueryFilter(ctx context.Context, query *gorm.DB) *gorm.DB {
+	// 任务仅对提交人可见，不按数据权限过滤
+	query = query.Where("creator = ?", f.Creator).
+		Order("create_time DESC")
+
+	if f.Name != "" {
+		query = query.Where("name LIKE ?", "%"+f.Name+"%")
+	}
+	if f.Type > 0 {
+		query = query.Where("type = ?", f.Type)
+	}
+	if f.Status > 0 {
+		query = query.Where("status = ?", f.Status)
+	}
+
+	return query
+}
@@ -0,0 +1,38 @@
+/**
+ * Description：
+ * FileName：recycle.go
+ * Author：CJiaの用心
+ * Create：2025/11/13 11:02:37
+ * Remark：
+ */
+
+package tools
+
+import (
+	"context"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"gorm.io/gorm"
+)
+
+// RecycleFilter 回收站查询条件，调用方需以 Unscoped 查询
+type RecycleFilter struct {
+	filters.Pagination
+	filters.Filters
+	Name      string `json:"name"`      // 名称
+	DeletedBy string `json:"deletedBy"` // 删除人
+}
+
+func (f *RecycleFilter) QueryFilter(ctx context.Context, query *gorm.DB) *gorm.DB {
+	query = f.Filters.QueryFilter(ctx, query).
+		Where("deleted_at <> ?", 0).
+		Order("deleted_at DESC")
+
+	if f.Name != "" {
+		query = query.Where("name LIKE ?", "%"+f.Name+"%")
+	}
+	if f.DeletedBy != "" {
+		query = query.Where("deleted_by = ?", f.DeletedBy)
+	}
+
+	return query
+}
@@ -0,0 +1,31 @@
+/**
+ * Description：
+ * FileName：upload.go
+ * Author：CJiaの用心
+ * Create：2025/11/12 09:41:27
+ * Remark：
+ */
+
+package tools
+
+// UploadSession 分片上传会话，保存在缓存中，过期后未完成的分片由定时任务清理
+type UploadSession struct {
+	Id          string `json:"uploadId"`    // 上传会话ID
+	UserId      string `json:"userId"`      // 上传人
+	DeptId      string `json:"deptId"`      // 上传人所属部门
+	Bucket      string `json:"bucket"`      // 存储桶编码，仅用于导入时可为空
+	Name        string `json:"name"`        // 文件名称
+	Size        int64  `json:"size"`        // 文件大小(字节)
+	Hash        string `json:"hash"`        // 文件内容哈希(SHA-256)
+	ChunkSize   int64  `json:"chunkSize"`   // 分片大小(字节)，最后一个分片可小于该值
+	TotalChunks int    `json:"totalChunks"` // 分片总数
+	Uploaded    []int  `json:"uploaded"`    // 已上传的分片序号(从0开始)
+}
+
+// ChunkLength 指定分片的字节数
+func (s *UploadSession) ChunkLength(index int) int64 {
+	if index == s.TotalChunks-1 {
+		return s.Size - int64(index)*s.ChunkSize
+	}
+	return s.ChunkSize
+}
@@ -40,23 +40,15 @@ func (l *CacheLogger) TableName() string {
 	return "careful_logger_cache_log"
 }
 
-func (l *CacheLogger) AutoMigrate(db *gorm.DB) {
-	err := db.Set("gorm:table_options", "ENGINE=InnoDB,COMMENT='缓存日志表'").AutoMigrate(&CacheLogger{})
-	if err != nil {
-		zap.L().Error("CacheLogger表模型迁移失败", zap.Error(err))
-	}
+// SkipDataLog 日志表不记录数据变更
+func (l *CacheLogger) SkipDataLog() bool {
+	return true
 }
 
 func (l *CacheLogger) Insert(ctx context.Context, db *gorm.DB, model CacheLogger) {
-	currentLogger := db.Config.Logger
-	// 临时禁用日志
-	db.Config.Logger = logger.Default.LogMode(logger.Silent)
-
-	err := db.WithContext(ctx).Create(&model).Error
+	// 会话级静默日志，避免修改共享的全局配置
+	err := db.Session(&gorm.Session{Logger: logger.Default.LogMode(logger.Silent)}).WithContext(ctx).Create(&model).Error
 	if err != nil {
 		zap.L().Error("缓存记录异常", zap.String("err", err.Error()))
 	}
-
-	// 恢复日志级别
-	db.Config.Logger = currentLogger
 }
@@ -0,0 +1,47 @@
+/**
+ * Description：
+ * FileName：data_log.go
+ * Author：CJiaの用心
+ * Create：2025/11/14 09:26:03
+ * Remark：
+ */
+
+package logger
+
+import (
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/logger/data_log"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+)
+
+// DataLogger 数据变更日志表，记录 CoreModels 数据表每次变更的字段前后值
+type DataLogger struct {
+	models.CoreModels
+
+	RecordTable string               `gorm:"type:varchar(100);not null;index:idx_record;column:recordTable;comment:数据表" json:"recordTable"` // 数据表
+	RecordId    string               `gorm:"type:varchar(110);not null;index:idx_record;column:recordId;comment:数据主键ID" json:"recordId"`    // 数据主键ID
+	Action      data_log.ActionConst `gorm:"type:tinyint;index:idx_action;column:action;comment:变更类型【1-新增 2-修改 3-删除】" json:"action"`        // 变更类型
+	Version     int64                `gorm:"type:bigint;column:version;comment:变更后的数据版本号(删除时为删除前版本号)" json:"version"`                       // 数据版本号
+	Operator    string               `gorm:"type:varchar(100);index:idx_operator;column:operator;comment:操作人" json:"operator"`              // 操作人
+	RequestId   string               `gorm:"type:varchar(100);index:idx_request_id;column:requestId;comment:请求ID" json:"requestId"`         // 请求ID
+	Changes     string               `gorm:"type:mediumtext;column:changes;comment:字段变更(JSON)" json:"-"`                                    // 字段变更(JSON)
+}
+
+// FieldChange 字段变更，敏感字段的值已脱敏
+type FieldChange struct {
+	Field string `json:"field"` // 字段(列名)
+	Old   any    `json:"old"`   // 变更前
+	New   any    `json:"new"`   // 变更后
+}
+
+func NewDataLogger() *DataLogger {
+	return &DataLogger{}
+}
+
+func (l *DataLogger) TableName() string {
+	return "careful_logger_data_log"
+}
+
+// SkipDataLog 日志表自身不记录数据变更
+func (l *DataLogger) SkipDataLog() bool {
+	return true
+}
@@ -47,23 +47,15 @@ func (l *LoginLogger) TableName() string {
 	return "careful_logger_login_log"
 }
 
-func (l *LoginLogger) AutoMigrate(db *gorm.DB) {
-	err := db.Set("gorm:table_options", "ENGINE=InnoDB,COMMENT='登录日志表'").AutoMigrate(&LoginLogger{})
-	if err != nil {
-		zap.L().Error("LoginLogger表模型迁移失败", zap.Error(err))
-	}
+// SkipDataLog 日志表不记录数据变更
+func (l *LoginLogger) SkipDataLog() bool {
+	return true
 }
 
 func (l *LoginLogger) Insert(ctx context.Context, db *gorm.DB, model LoginLogger) {
-	currentLogger := db.Config.Logger
-	// 临时禁用日志
-	db.Config.Logger = logger.Default.LogMode(logger.Silent)
-
-	err := db.WithContext(ctx).Create(&model).Error
+	// 会话级静默日志，避免修改共享的全局配置
+	err := db.Session(&gorm.Session{Logger: logger.Default.LogMode(logger.Silent)}).WithContext(ctx).Create(&model).Error
 	if err != nil {
 		zap.L().Error("登录日志异常", zap.String("err", err.Error()))
 	}
-
-	// 恢复日志级别
-	db.Config.Logger = currentLogger
 }
@@ -45,23 +45,15 @@ func (l *OperateLogger) TableName() string {
 	return "careful_logger_operate_log"
 }
 
-func (l *OperateLogger) AutoMigrate(db *gorm.DB) {
-	err := db.Set("gorm:table_options", "ENGINE=InnoDB,COMMENT='操作日志表'").AutoMigrate(&OperateLogger{})
-	if err != nil {
-		zap.L().Error("OperateLogger表模型迁移失败", zap.Error(err))
-	}
+// SkipDataLog 日志表不记录数据变更
+func (l *OperateLogger) SkipDataLog() bool {
+	return true
 }
 
 func (l *OperateLogger) Insert(ctx context.Context, db *gorm.DB, model OperateLogger) {
-	currentLogger := db.Config.Logger
-	// 临时禁用日志
-	db.Config.Logger = logger.Default.LogMode(logger.Silent)
-
-	err := db.WithContext(ctx).Create(&model).Error
+	// 会话级静默日志，避免修改共享的全局配置
+	err := db.Session(&gorm.Session{Logger: logger.Default.LogMode(logger.Silent)}).WithContext(ctx).Create(&model).Error
 	if err != nil {
 		zap.L().Error("日志记录异常", zap.String("err", err.Error()))
 	}
-
-	// 恢复日志级别
-	db.Config.Logger = currentLogger
 }
@@ -0,0 +1,20 @@
+-- 删除初始化的表结构，按外键依赖逆序删除
+
+DROP TABLE IF EXISTS `careful_logger_data_log`;
+DROP TABLE IF EXISTS `careful_logger_cache_log`;
+DROP TABLE IF EXISTS `careful_logger_operate_log`;
+DROP TABLE IF EXISTS `careful_logger_login_log`;
+DROP TABLE IF EXISTS `careful_tools_file`;
+DROP TABLE IF EXISTS `careful_tools_bucket`;
+DROP TABLE IF EXISTS `careful_tools_job`;
+DROP TABLE IF EXISTS `careful_tools_dict_type`;
+DROP TABLE IF EXISTS `careful_tools_dict`;
+DROP TABLE IF EXISTS `careful_system_role_dept`;
+DROP TABLE IF EXISTS `careful_system_role_menu`;
+DROP TABLE IF EXISTS `careful_system_menu`;
+DROP TABLE IF EXISTS `careful_system_role_permission`;
+DROP TABLE IF EXISTS `careful_system_user_role`;
+DROP TABLE IF EXISTS `careful_system_permission`;
+DROP TABLE IF EXISTS `careful_system_role`;
+DROP TABLE IF EXISTS `careful_system_users`;
+DROP TABLE IF EXISTS `careful_system_dept`;
@@ -0,0 +1,507 @@
+-- 初始化表结构
+-- 使用 IF NOT EXISTS，已由 AutoMigrate 建表的数据库可直接纳入版本管理
+
+-- 部门表
+CREATE TABLE IF NOT EXISTS `careful_system_dept` (
+    `id` varchar(110) COMMENT '主键ID',
+    `sort` bigint DEFAULT 1 COMMENT '显示排序',
+    `timestamp` bigint COMMENT '版本号(时间戳)',
+    `creator` varchar(100) COMMENT '创建人',
+    `modifier` varchar(100) COMMENT '修改人',
+    `belong_dept` varchar(100) COMMENT '数据归属部门',
+    `create_time` datetime(3) NULL COMMENT '创建时间',
+    `update_time` datetime(3) NULL COMMENT '修改时间',
+    `remark` varchar(512) COMMENT '备注',
+    `status` boolean DEFAULT true COMMENT '状态【true-启用 false-停用】',
+    `name` varchar(50) NOT NULL COMMENT '部门名称',
+    `code` varchar(50) NOT NULL COMMENT '部门编码',
+    `owner` varchar(32) COMMENT '负责人',
+    `phone` varchar(32) COMMENT '联系电话',
+    `email` varchar(32) COMMENT '邮箱',
+    `level` bigint DEFAULT 0 COMMENT '层级深度，根节点为0',
+    `path` varchar(512) COMMENT '节点路径，格式：/1/2/3/',
+    `user_count` bigint DEFAULT 0 COMMENT '用户数量',
+    `child_count` bigint DEFAULT 0 COMMENT '子部门数量',
+    `parent_id` varchar(100) COMMENT '上级部门ID',
+    PRIMARY KEY (`id`),
+    INDEX `idx_careful_system_dept_sort` (`sort`),
+    INDEX `idx_careful_system_dept_creator` (`creator`),
+    INDEX `idx_careful_system_dept_modifier` (`modifier`),
+    INDEX `idx_careful_system_dept_belong_dept` (`belong_dept`),
+    INDEX `idx_careful_system_dept_create_time` (`create_time`),
+    INDEX `idx_careful_system_dept_update_time` (`update_time`),
+    INDEX `idx_status` (`status`),
+    UNIQUE INDEX `uni_dept_name_code_parent` (`name`,`code`,`parent_id`),
+    INDEX `idx_level` (`level`),
+    INDEX `idx_path` (`path`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='部门表';
+
+-- 用户表
+CREATE TABLE IF NOT EXISTS `careful_system_users` (
+    `id` varchar(110) COMMENT '主键ID',
+    `sort` bigint DEFAULT 1 COMMENT '显示排序',
+    `timestamp` bigint COMMENT '版本号(时间戳)',
+    `creator` varchar(100) COMMENT '创建人',
+    `modifier` varchar(100) COMMENT '修改人',
+    `belong_dept` varchar(100) COMMENT '数据归属部门',
+    `create_time` datetime(3) NULL COMMENT '创建时间',
+    `update_time` datetime(3) NULL COMMENT '修改时间',
+    `remark` varchar(512) COMMENT '备注',
+    `status` boolean DEFAULT true COMMENT '状态【true-启用 false-停用】',
+    `username` varchar(50) NOT NULL COMMENT '用户名',
+    `password` varchar(512) NOT NULL COMMENT '密码',
+    `name` varchar(50) COMMENT '姓名',
+    `gender` tinyint DEFAULT 1 COMMENT '性别',
+    `email` varchar(50) COMMENT '邮箱',
+    `mobile` varchar(20) COMMENT '电话',
+    `avatar` mediumtext COMMENT '头像（url地址）',
+    `is_superuser` boolean DEFAULT false COMMENT '是否超级管理员（不受接口权限限制）',
+    `dept_id` varchar(110) COMMENT '部门ID（可为空）',
+    PRIMARY KEY (`id`),
+    INDEX `idx_careful_system_users_sort` (`sort`),
+    INDEX `idx_careful_system_users_creator` (`creator`),
+    INDEX `idx_careful_system_users_modifier` (`modifier`),
+    INDEX `idx_careful_system_users_belong_dept` (`belong_dept`),
+    INDEX `idx_careful_system_users_create_time` (`create_time`),
+    INDEX `idx_careful_system_users_update_time` (`update_time`),
+    INDEX `idx_status` (`status`),
+    UNIQUE INDEX `idx_careful_system_users_username` (`username`),
+    INDEX `idx_search` (`name`,`email`,`mobile`),
+    INDEX `idx_careful_system_users_dept_id` (`dept_id`),
+    CONSTRAINT `fk_careful_system_users_dept` FOREIGN KEY (`dept_id`) REFERENCES `careful_system_dept`(`id`) ON DELETE SET NULL ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='用户表';
+
+-- 角色表
+CREATE TABLE IF NOT EXISTS `careful_system_role` (
+    `id` varchar(110) COMMENT '主键ID',
+    `sort` bigint DEFAULT 1 COMMENT '显示排序',
+    `timestamp` bigint COMMENT '版本号(时间戳)',
+    `creator` varchar(100) COMMENT '创建人',
+    `modifier` varchar(100) COMMENT '修改人',
+    `belong_dept` varchar(100) COMMENT '数据归属部门',
+    `create_time` datetime(3) NULL COMMENT '创建时间',
+    `update_time` datetime(3) NULL COMMENT '修改时间',
+    `remark` varchar(512) COMMENT '备注',
+    `status` boolean DEFAULT true COMMENT '状态【true-启用 false-停用】',
+    `name` varchar(64) NOT NULL COMMENT '角色名称',
+    `code` varchar(64) NOT NULL COMMENT '角色编码',
+    `data_scope` tinyint DEFAULT 1 COMMENT '数据范围【1-全部 2-本部门 3-本部门及以下 4-自定义 5-仅本人】',
+    PRIMARY KEY (`id`),
+    INDEX `idx_careful_system_role_sort` (`sort`),
+    INDEX `idx_careful_system_role_creator` (`creator`),
+    INDEX `idx_careful_system_role_modifier` (`modifier`),
+    INDEX `idx_careful_system_role_belong_dept` (`belong_dept`),
+    INDEX `idx_careful_system_role_create_time` (`create_time`),
+    INDEX `idx_careful_system_role_update_time` (`update_time`),
+    INDEX `idx_status` (`status`),
+    UNIQUE INDEX `idx_careful_system_role_name` (`name`),
+    UNIQUE INDEX `idx_careful_system_role_code` (`code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='角色表';
+
+-- 接口权限表
+CREATE TABLE IF NOT EXISTS `careful_system_permission` (
+    `id` varchar(110) COMMENT '主键ID',
+    `sort` bigint DEFAULT 1 COMMENT '显示排序',
+    `timestamp` bigint COMMENT '版本号(时间戳)',
+    `creator` varchar(100) COMMENT '创建人',
+    `modifier` varchar(100) COMMENT '修改人',
+    `belong_dept` varchar(100) COMMENT '数据归属部门',
+    `create_time` datetime(3) NULL COMMENT '创建时间',
+    `update_time` datetime(3) NULL COMMENT '修改时间',
+    `remark` varchar(512) COMMENT '备注',
+    `status` boolean DEFAULT true COMMENT '状态【true-启用 false-停用】',
+    `name` varchar(64) NOT NULL COMMENT '权限名称',
+    `code` varchar(128) NOT NULL COMMENT '权限编码',
+    `method` varchar(10) NOT NULL COMMENT '请求方式，*表示全部',
+    `path` varchar(255) NOT NULL COMMENT '接口路径，与路由定义一致，如/v1/system/user/getById/:id',
+    PRIMARY KEY (`id`),
+    INDEX `idx_careful_system_permission_sort` (`sort`),
+    INDEX `idx_careful_system_permission_creator` (`creator`),
+    INDEX `idx_careful_system_permission_modifier` (`modifier`),
+    INDEX `idx_careful_system_permission_belong_dept` (`belong_dept`),
+    INDEX `idx_careful_system_permission_create_time` (`create_time`),
+    INDEX `idx_careful_system_permission_update_time` (`update_time`),
+    INDEX `idx_status` (`status`),
+    UNIQUE INDEX `idx_careful_system_permission_code` (`code`),
+    UNIQUE INDEX `uni_permission_method_path` (`method`,`path`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='接口权限表';
+
+-- 用户角色关联表
+CREATE TABLE IF NOT EXISTS `careful_system_user_role` (
+    `user_id` varchar(100) COMMENT '用户ID',
+    `role_id` varchar(100) COMMENT '角色ID',
+    PRIMARY KEY (`user_id`,`role_id`),
+    INDEX `idx_careful_system_user_role_role_id` (`role_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='用户角色关联表';
+
+-- 角色权限关联表
+CREATE TABLE IF NOT EXISTS `careful_system_role_permission` (
+    `role_id` varchar(100) COMMENT '角色ID',
+    `permission_id` varchar(100) COMMENT '权限ID',
+    PRIMARY KEY (`role_id`,`permission_id`),
+    INDEX `idx_careful_system_role_permission_permission_id` (`permission_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='角色权限关联表';
+
+-- 菜单表
+CREATE TABLE IF NOT EXISTS `careful_system_menu` (
+    `id` varchar(110) COMMENT '主键ID',
+    `sort` bigint DEFAULT 1 COMMENT '显示排序',
+    `timestamp` bigint COMMENT '版本号(时间戳)',
+    `creator` varchar(100) COMMENT '创建人',
+    `modifier` varchar(100) COMMENT '修改人',
+    `belong_dept` varchar(100) COMMENT '数据归属部门',
+    `create_time` datetime(3) NULL COMMENT '创建时间',
+    `update_time` datetime(3) NULL COMMENT '修改时间',
+    `remark` varchar(512) COMMENT '备注',
+    `status` boolean DEFAULT true COMMENT '状态【true-启用 false-停用】',
+    `type` tinyint NOT NULL DEFAULT 1 COMMENT '菜单类型【1-目录 2-菜单 3-按钮】',
+    `title` varchar(64) NOT NULL COMMENT '菜单标题',
+    `name` varchar(64) COMMENT '路由名称',
+    `code` varchar(128) COMMENT '权限标识，按钮必填且唯一',
+    `path` varchar(255) COMMENT '路由地址',
+    `component` varchar(255) COMMENT '组件路径',
+    `redirect` varchar(255) COMMENT '重定向地址',
+    `icon` varchar(64) COMMENT '菜单图标',
+    `visible` boolean DEFAULT true COMMENT '是否显示【true-显示 false-隐藏】',
+    `keep_alive` boolean DEFAULT false COMMENT '是否缓存页面',
+    `is_link` boolean DEFAULT false COMMENT '是否外链',
+    `parent_id` varchar(100) COMMENT '上级菜单ID',
+    PRIMARY KEY (`id`),
+    INDEX `idx_careful_system_menu_sort` (`sort`),
+    INDEX `idx_careful_system_menu_creator` (`creator`),
+    INDEX `idx_careful_system_menu_modifier` (`modifier`),
+    INDEX `idx_careful_system_menu_belong_dept` (`belong_dept`),
+    INDEX `idx_careful_system_menu_create_time` (`create_time`),
+    INDEX `idx_careful_system_menu_update_time` (`update_time`),
+    INDEX `idx_status` (`status`),
+    INDEX `idx_code` (`code`),
+    INDEX `idx_parent_id` (`parent_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='菜单表';
+
+-- 角色菜单关联表
+CREATE TABLE IF NOT EXISTS `careful_system_role_menu` (
+    `role_id` varchar(100) COMMENT '角色ID',
+    `menu_id` varchar(100) COMMENT '菜单ID',
+    PRIMARY KEY (`role_id`,`menu_id`),
+    INDEX `idx_careful_system_role_menu_menu_id` (`menu_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='角色菜单关联表';
+
+-- 角色数据范围部门关联表
+CREATE TABLE IF NOT EXISTS `careful_system_role_dept` (
+    `role_id` varchar(100) COMMENT '角色ID',
+    `dept_id` varchar(100) COMMENT '部门ID',
+    PRIMARY KEY (`role_id`,`dept_id`),
+    INDEX `idx_careful_system_role_dept_dept_id` (`dept_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='角色数据范围部门关联表';
+
+-- 字典表
+CREATE TABLE IF NOT EXISTS `careful_tools_dict` (
+    `id` varchar(110) COMMENT '主键ID',
+    `sort` bigint DEFAULT 1 COMMENT '显示排序',
+    `timestamp` bigint COMMENT '版本号(时间戳)',
+    `creator` varchar(100) COMMENT '创建人',
+    `modifier` varchar(100) COMMENT '修改人',
+    `belong_dept` varchar(100) COMMENT '数据归属部门',
+    `create_time` datetime(3) NULL COMMENT '创建时间',
+    `update_time` datetime(3) NULL COMMENT '修改时间',
+    `remark` varchar(512) COMMENT '备注',
+    `deleted_at` bigint NOT NULL DEFAULT 0 COMMENT '删除时间(毫秒时间戳，0-未删除)',
+    `deleted_by` varchar(100) COMMENT '删除人',
+    `status` boolean DEFAULT false COMMENT '状态【true-启用 false-停用】',
+    `name` varchar(100) NOT NULL COMMENT '字典名称',
+    `code` varchar(100) NOT NULL COMMENT '字典编码',
+    `type` tinyint DEFAULT 1 COMMENT '字典类型',
+    `valueType` tinyint DEFAULT 1 COMMENT '数据类型',
+    PRIMARY KEY (`id`),
+    INDEX `idx_careful_tools_dict_sort` (`sort`),
+    INDEX `idx_careful_tools_dict_creator` (`creator`),
+    INDEX `idx_careful_tools_dict_modifier` (`modifier`),
+    INDEX `idx_careful_tools_dict_belong_dept` (`belong_dept`),
+    INDEX `idx_careful_tools_dict_create_time` (`create_time`),
+    INDEX `idx_careful_tools_dict_update_time` (`update_time`),
+    INDEX `idx_careful_tools_dict_deleted_at` (`deleted_at`),
+    INDEX `idx_status` (`status`),
+    INDEX `idx_type` (`type`),
+    INDEX `idx_value_type` (`valueType`),
+    UNIQUE INDEX `uni_careful_tools_dict_name` (`name`,`deleted_at`),
+    UNIQUE INDEX `uni_careful_tools_dict_code` (`code`,`deleted_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='字典表';
+
+-- 字典项表
+CREATE TABLE IF NOT EXISTS `careful_tools_dict_type` (
+    `id` varchar(110) COMMENT '主键ID',
+    `sort` bigint DEFAULT 1 COMMENT '显示排序',
+    `timestamp` bigint COMMENT '版本号(时间戳)',
+    `creator` varchar(100) COMMENT '创建人',
+    `modifier` varchar(100) COMMENT '修改人',
+    `belong_dept` varchar(100) COMMENT '数据归属部门',
+    `create_time` datetime(3) NULL COMMENT '创建时间',
+    `update_time` datetime(3) NULL COMMENT '修改时间',
+    `remark` varchar(512) COMMENT '备注',
+    `deleted_at` bigint NOT NULL DEFAULT 0 COMMENT '删除时间(毫秒时间戳，0-未删除)',
+    `deleted_by` varchar(100) COMMENT '删除人',
+    `status` boolean DEFAULT true COMMENT '状态【true-启用 false-停用】',
+    `name` varchar(50) NOT NULL COMMENT '字典项名称',
+    `strValue` varchar(50) COMMENT '字符串-字典项值',
+    `intValue` tinyint COMMENT '整型-字典项值',
+    `boolValue` boolean COMMENT '布尔-字典项值',
+    `dictTag` varchar(10) DEFAULT 'primary' COMMENT '标签类型',
+    `dictColor` varchar(50) COMMENT '标签颜色',
+    `dictName` varchar(100) COMMENT '字典名称',
+    `valueType` tinyint DEFAULT 1 COMMENT '数据类型',
+    `dict_id` varchar(110) COMMENT '所属字典ID',
+    PRIMARY KEY (`id`),
+    INDEX `idx_careful_tools_dict_type_sort` (`sort`),
+    INDEX `idx_careful_tools_dict_type_creator` (`creator`),
+    INDEX `idx_careful_tools_dict_type_modifier` (`modifier`),
+    INDEX `idx_careful_tools_dict_type_belong_dept` (`belong_dept`),
+    INDEX `idx_careful_tools_dict_type_create_time` (`create_time`),
+    INDEX `idx_careful_tools_dict_type_update_time` (`update_time`),
+    INDEX `idx_careful_tools_dict_type_deleted_at` (`deleted_at`),
+    INDEX `idx_status` (`status`),
+    INDEX `idx_name` (`name`),
+    INDEX `idx_dict_tag` (`dictTag`),
+    INDEX `idx_dict_name` (`dictName`),
+    INDEX `idx_value_type` (`valueType`),
+    INDEX `idx_dict_id` (`dict_id`),
+    UNIQUE INDEX `uni_dict_name` (`dict_id`,`name`,`deleted_at`),
+    UNIQUE INDEX `uni_dict_str_value` (`dict_id`,`strValue`,`deleted_at`),
+    UNIQUE INDEX `uni_dict_int_value` (`dict_id`,`intValue`,`deleted_at`),
+    UNIQUE INDEX `uni_dict_bool_value` (`dict_id`,`boolValue`,`deleted_at`),
+    CONSTRAINT `fk_careful_tools_dict_type_dict` FOREIGN KEY (`dict_id`) REFERENCES `careful_tools_dict`(`id`) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='字典项表';
+
+-- 后台任务表
+CREATE TABLE IF NOT EXISTS `careful_tools_job` (
+    `id` varchar(110) COMMENT '主键ID',
+    `sort` bigint DEFAULT 1 COMMENT '显示排序',
+    `timestamp` bigint COMMENT '版本号(时间戳)',
+    `creator` varchar(100) COMMENT '创建人',
+    `modifier` varchar(100) COMMENT '修改人',
+    `belong_dept` varchar(100) COMMENT '数据归属部门',
+    `create_time` datetime(3) NULL COMMENT '创建时间',
+    `update_time` datetime(3) NULL COMMENT '修改时间',
+    `remark` varchar(512) COMMENT '备注',
+    `name` varchar(100) NOT NULL COMMENT '任务名称',
+    `type` tinyint COMMENT '任务类型【1-导入 2-导出】',
+    `status` tinyint DEFAULT 1 COMMENT '任务状态【1-排队中 2-执行中 3-已完成 4-失败 5-已过期】',
+    `total` bigint DEFAULT 0 COMMENT '总条数',
+    `processed` bigint DEFAULT 0 COMMENT '已处理条数',
+    `successCount` bigint DEFAULT 0 COMMENT '成功条数',
+    `failCount` bigint DEFAULT 0 COMMENT '失败条数',
+    `message` varchar(512) COMMENT '结果信息',
+    `result` mediumtext COMMENT '结果详情(JSON)',
+    `artifact` varchar(255) COMMENT '结果文件路径',
+    `artifactName` varchar(255) COMMENT '结果文件名',
+    `start_time` datetime(3) NULL COMMENT '开始时间',
+    `finish_time` datetime(3) NULL COMMENT '结束时间',
+    `expire_time` datetime(3) NULL COMMENT '结果文件过期时间',
+    PRIMARY KEY (`id`),
+    INDEX `idx_careful_tools_job_sort` (`sort`),
+    INDEX `idx_careful_tools_job_creator` (`creator`),
+    INDEX `idx_careful_tools_job_modifier` (`modifier`),
+    INDEX `idx_careful_tools_job_belong_dept` (`belong_dept`),
+    INDEX `idx_careful_tools_job_create_time` (`create_time`),
+    INDEX `idx_careful_tools_job_update_time` (`update_time`),
+    INDEX `idx_type` (`type`),
+    INDEX `idx_status` (`status`),
+    INDEX `idx_expire_time` (`expire_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='后台任务表';
+
+-- 存储桶表
+CREATE TABLE IF NOT EXISTS `careful_tools_bucket` (
+    `id` varchar(110) COMMENT '主键ID',
+    `sort` bigint DEFAULT 1 COMMENT '显示排序',
+    `timestamp` bigint COMMENT '版本号(时间戳)',
+    `creator` varchar(100) COMMENT '创建人',
+    `modifier` varchar(100) COMMENT '修改人',
+    `belong_dept` varchar(100) COMMENT '数据归属部门',
+    `create_time` datetime(3) NULL COMMENT '创建时间',
+    `update_time` datetime(3) NULL COMMENT '修改时间',
+    `remark` varchar(512) COMMENT '备注',
+    `status` boolean DEFAULT true COMMENT '状态【true-启用 false-停用】',
+    `name` varchar(100) NOT NULL COMMENT '存储桶名称',
+    `code` varchar(64) NOT NULL COMMENT '存储桶编码(文件存储路径前缀)',
+    `maxSize` bigint DEFAULT 0 COMMENT '单个文件大小上限(字节)，0表示不限制',
+    `allowedMimes` varchar(512) COMMENT '允许的文件类型，逗号分隔，支持 image/* 通配，为空表示不限制',
+    PRIMARY KEY (`id`),
+    INDEX `idx_careful_tools_bucket_sort` (`sort`),
+    INDEX `idx_careful_tools_bucket_creator` (`creator`),
+    INDEX `idx_careful_tools_bucket_modifier` (`modifier`),
+    INDEX `idx_careful_tools_bucket_belong_dept` (`belong_dept`),
+    INDEX `idx_careful_tools_bucket_create_time` (`create_time`),
+    INDEX `idx_careful_tools_bucket_update_time` (`update_time`),
+    INDEX `idx_status` (`status`),
+    UNIQUE INDEX `idx_careful_tools_bucket_name` (`name`),
+    UNIQUE INDEX `idx_careful_tools_bucket_code` (`code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='存储桶表';
+
+-- 文件表
+CREATE TABLE IF NOT EXISTS `careful_tools_file` (
+    `id` varchar(110) COMMENT '主键ID',
+    `sort` bigint DEFAULT 1 COMMENT '显示排序',
+    `timestamp` bigint COMMENT '版本号(时间戳)',
+    `creator` varchar(100) COMMENT '创建人',
+    `modifier` varchar(100) COMMENT '修改人',
+    `belong_dept` varchar(100) COMMENT '数据归属部门',
+    `create_time` datetime(3) NULL COMMENT '创建时间',
+    `update_time` datetime(3) NULL COMMENT '修改时间',
+    `remark` varchar(512) COMMENT '备注',
+    `bucket_id` varchar(110) NOT NULL COMMENT '存储桶ID',
+    `bucketCode` varchar(64) NOT NULL COMMENT '存储桶编码',
+    `name` varchar(255) NOT NULL COMMENT '文件名称',
+    `ext` varchar(32) COMMENT '扩展名',
+    `mimeType` varchar(128) COMMENT '文件类型',
+    `size` bigint DEFAULT 0 COMMENT '文件大小(字节)',
+    `hash` char(64) NOT NULL COMMENT '内容哈希(SHA-256)',
+    `objectKey` varchar(255) NOT NULL COMMENT '存储对象键',
+    `driver` varchar(20) NOT NULL COMMENT '存储驱动',
+    PRIMARY KEY (`id`),
+    INDEX `idx_careful_tools_file_sort` (`sort`),
+    INDEX `idx_careful_tools_file_creator` (`creator`),
+    INDEX `idx_careful_tools_file_modifier` (`modifier`),
+    INDEX `idx_careful_tools_file_belong_dept` (`belong_dept`),
+    INDEX `idx_careful_tools_file_create_time` (`create_time`),
+    INDEX `idx_careful_tools_file_update_time` (`update_time`),
+    INDEX `idx_bucket_hash` (`bucket_id`,`hash`),
+    INDEX `idx_name` (`name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='文件表';
+
+-- 登录日志表
+CREATE TABLE IF NOT EXISTS `careful_logger_login_log` (
+    `id` varchar(110) COMMENT '主键ID',
+    `sort` bigint DEFAULT 1 COMMENT '显示排序',
+    `timestamp` bigint COMMENT '版本号(时间戳)',
+    `creator` varchar(100) COMMENT '创建人',
+    `modifier` varchar(100) COMMENT '修改人',
+    `belong_dept` varchar(100) COMMENT '数据归属部门',
+    `create_time` datetime(3) NULL COMMENT '创建时间',
+    `update_time` datetime(3) NULL COMMENT '修改时间',
+    `remark` varchar(512) COMMENT '备注',
+    `status` boolean DEFAULT true COMMENT '状态【true-启用 false-停用】',
+    `loginUsername` varchar(40) COMMENT '登录用户名',
+    `ip` varchar(32) COMMENT '登录ip',
+    `agent` mediumtext COMMENT 'agent信息',
+    `browser` varchar(255) COMMENT '浏览器名',
+    `os` varchar(255) COMMENT '操作系统',
+    `continent` varchar(50) COMMENT '州',
+    `country` varchar(50) COMMENT '国家',
+    `province` varchar(50) COMMENT '省份',
+    `city` varchar(50) COMMENT '城市',
+    `district` varchar(50) COMMENT '县区',
+    `isp` varchar(50) COMMENT '运营商',
+    `area_code` varchar(50) COMMENT '区域代码',
+    `country_english` varchar(50) COMMENT '英文全称',
+    `country_code` varchar(50) COMMENT '简称',
+    `longitude` varchar(50) COMMENT '经度',
+    `latitude` varchar(50) COMMENT '纬度',
+    PRIMARY KEY (`id`),
+    INDEX `idx_careful_logger_login_log_sort` (`sort`),
+    INDEX `idx_careful_logger_login_log_creator` (`creator`),
+    INDEX `idx_careful_logger_login_log_modifier` (`modifier`),
+    INDEX `idx_careful_logger_login_log_belong_dept` (`belong_dept`),
+    INDEX `idx_careful_logger_login_log_create_time` (`create_time`),
+    INDEX `idx_careful_logger_login_log_update_time` (`update_time`),
+    INDEX `idx_status` (`status`),
+    INDEX `idx_search` (`loginUsername`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='登录日志表';
+
+-- 操作日志表
+CREATE TABLE IF NOT EXISTS `careful_logger_operate_log` (
+    `id` varchar(110) COMMENT '主键ID',
+    `sort` bigint DEFAULT 1 COMMENT '显示排序',
+    `timestamp` bigint COMMENT '版本号(时间戳)',
+    `creator` varchar(100) COMMENT '创建人',
+    `modifier` varchar(100) COMMENT '修改人',
+    `belong_dept` varchar(100) COMMENT '数据归属部门',
+    `create_time` datetime(3) NULL COMMENT '创建时间',
+    `update_time` datetime(3) NULL COMMENT '修改时间',
+    `remark` varchar(512) COMMENT '备注',
+    `status` boolean DEFAULT true COMMENT '状态【true-启用 false-停用】',
+    `requestUsername` varchar(40) COMMENT '请求用户名',
+    `requestTime` varchar(40) COMMENT '请求耗时',
+    `requestStatus` bigint COMMENT '响应状态码',
+    `requestMethod` varchar(20) COMMENT '请求方式',
+    `requestIp` varchar(20) COMMENT '请求IP地址',
+    `requestPath` varchar(255) COMMENT '请求地址',
+    `requestQuery` text COMMENT '请求查询参数',
+    `requestBody` mediumtext COMMENT '请求体(大文本)',
+    `requestOs` varchar(40) COMMENT '操作系统',
+    `requestBrowser` varchar(64) COMMENT '操作浏览器',
+    `userAgent` varchar(255) COMMENT '用户代理',
+    `requestCode` bigint COMMENT '自定义响应状态码',
+    `requestResult` text COMMENT '响应信息',
+    `requestInternal` text COMMENT '系统错误',
+    PRIMARY KEY (`id`),
+    INDEX `idx_careful_logger_operate_log_sort` (`sort`),
+    INDEX `idx_careful_logger_operate_log_creator` (`creator`),
+    INDEX `idx_careful_logger_operate_log_modifier` (`modifier`),
+    INDEX `idx_careful_logger_operate_log_belong_dept` (`belong_dept`),
+    INDEX `idx_careful_logger_operate_log_create_time` (`create_time`),
+    INDEX `idx_careful_logger_operate_log_update_time` (`update_time`),
+    INDEX `idx_status` (`status`),
+    INDEX `idx_search` (`requestUsername`,`requestMethod`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='操作日志表';
+
+-- 缓存日志表
+CREATE TABLE IF NOT EXISTS `careful_logger_cache_log` (
+    `id` varchar(110) COMMENT '主键ID',
+    `sort` bigint DEFAULT 1 COMMENT '显示排序',
+    `timestamp` bigint COMMENT '版本号(时间戳)',
+    `creator` varchar(100) COMMENT '创建人',
+    `modifier` varchar(100) COMMENT '修改人',
+    `belong_dept` varchar(100) COMMENT '数据归属部门',
+    `create_time` datetime(3) NULL COMMENT '创建时间',
+    `update_time` datetime(3) NULL COMMENT '修改时间',
+    `remark` varchar(512) COMMENT '备注',
+    `status` boolean DEFAULT true COMMENT '状态【true-启用 false-停用】',
+    `cacheHost` varchar(100) COMMENT '当前主机地址',
+    `cacheIp` varchar(100) COMMENT '缓存者IP',
+    `cacheUsername` varchar(40) COMMENT '缓存用户名',
+    `cacheMethod` varchar(10) COMMENT '缓存请求方式',
+    `cachePath` varchar(255) COMMENT '缓存请求地址',
+    `cacheTime` varchar(255) COMMENT '缓存记录时间',
+    `cacheKey` varchar(255) COMMENT '缓存key键',
+    `cacheValue` mediumtext COMMENT '缓存value值',
+    `cacheError` varchar(255) COMMENT '缓存Error错误',
+    PRIMARY KEY (`id`),
+    INDEX `idx_careful_logger_cache_log_sort` (`sort`),
+    INDEX `idx_careful_logger_cache_log_creator` (`creator`),
+    INDEX `idx_careful_logger_cache_log_modifier` (`modifier`),
+    INDEX `idx_careful_logger_cache_log_belong_dept` (`belong_dept`),
+    INDEX `idx_careful_logger_cache_log_create_time` (`create_time`),
+    INDEX `idx_careful_logger_cache_log_update_time` (`update_time`),
+    INDEX `idx_status` (`status`),
+    INDEX `idx_search` (`cacheUsername`,`cacheMethod`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='缓存日志表';
+
+-- 数据变更日志表
+CREATE TABLE IF NOT EXISTS `careful_logger_data_log` (
+    `id` varchar(110) COMMENT '主键ID',
+    `sort` bigint DEFAULT 1 COMMENT '显示排序',
+    `timestamp` bigint COMMENT '版本号(时间戳)',
+    `creator` varchar(100) COMMENT '创建人',
+    `modifier` varchar(100) COMMENT '修改人',
+    `belong_dept` varchar(100) COMMENT '数据归属部门',
+    `create_time` datetime(3) NULL COMMENT '创建时间',
+    `update_time` datetime(3) NULL COMMENT '修改时间',
+    `remark` varchar(512) COMMENT '备注',
+    `recordTable` varchar(100) NOT NULL COMMENT '数据表',
+    `recordId` varchar(110) NOT NULL COMMENT '数据主键ID',
+    `action` tinyint COMMENT '变更类型【1-新增 2-修改 3-删除】',
+    `version` bigint COMMENT '变更后的数据版本号(删除时为删除前版本号)',
+    `operator` varchar(100) COMMENT '操作人',
+    `requestId` varchar(100) COMMENT '请求ID',
+    `changes` mediumtext COMMENT '字段变更(JSON)',
+    PRIMARY KEY (`id`),
+    INDEX `idx_careful_logger_data_log_sort` (`sort`),
+    INDEX `idx_careful_logger_data_log_creator` (`creator`),
+    INDEX `idx_careful_logger_data_log_modifier` (`modifier`),
+    INDEX `idx_careful_logger_data_log_belong_dept` (`belong_dept`),
+    INDEX `idx_careful_logger_data_log_create_time` (`create_time`),
+    INDEX `idx_careful_logger_data_log_update_time` (`update_time`),
+    INDEX `idx_record` (`recordTable`,`recordId`),
+    INDEX `idx_action` (`action`),
+    INDEX `idx_operator` (`operator`),
+    INDEX `idx_request_id` (`requestId`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='数据变更日志表';
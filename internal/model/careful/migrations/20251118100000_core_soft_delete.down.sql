@@ -0,0 +1,69 @@
+-- 回滚用户、部门、角色、菜单、接口权限、存储桶与文件的软删除
+-- 先彻底删除回收站中的数据，否则恢复不含删除时间的唯一索引时可能冲突
+
+DELETE FROM `careful_tools_file` WHERE `deleted_at` <> 0;
+DELETE FROM `careful_tools_bucket` WHERE `deleted_at` <> 0;
+DELETE FROM `careful_system_role_menu` WHERE `menu_id` IN (SELECT `id` FROM `careful_system_menu` WHERE `deleted_at` <> 0);
+DELETE FROM `careful_system_menu` WHERE `deleted_at` <> 0;
+DELETE FROM `careful_system_role_permission` WHERE `permission_id` IN (SELECT `id` FROM `careful_system_permission` WHERE `deleted_at` <> 0);
+DELETE FROM `careful_system_permission` WHERE `deleted_at` <> 0;
+DELETE FROM `careful_system_user_role` WHERE `role_id` IN (SELECT `id` FROM `careful_system_role` WHERE `deleted_at` <> 0);
+DELETE FROM `careful_system_role_permission` WHERE `role_id` IN (SELECT `id` FROM `careful_system_role` WHERE `deleted_at` <> 0);
+DELETE FROM `careful_system_role_menu` WHERE `role_id` IN (SELECT `id` FROM `careful_system_role` WHERE `deleted_at` <> 0);
+DELETE FROM `careful_system_role_dept` WHERE `role_id` IN (SELECT `id` FROM `careful_system_role` WHERE `deleted_at` <> 0);
+DELETE FROM `careful_system_role` WHERE `deleted_at` <> 0;
+DELETE FROM `careful_system_user_role` WHERE `user_id` IN (SELECT `id` FROM `careful_system_users` WHERE `deleted_at` <> 0);
+DELETE FROM `careful_system_users` WHERE `deleted_at` <> 0;
+DELETE FROM `careful_system_role_dept` WHERE `dept_id` IN (SELECT `id` FROM `careful_system_dept` WHERE `deleted_at` <> 0);
+DELETE FROM `careful_system_dept` WHERE `deleted_at` <> 0;
+
+ALTER TABLE `careful_tools_file`
+    DROP INDEX `idx_careful_tools_file_deleted_at`,
+    DROP COLUMN `deleted_by`,
+    DROP COLUMN `deleted_at`;
+
+ALTER TABLE `careful_tools_bucket`
+    DROP INDEX `uni_careful_tools_bucket_name`,
+    DROP INDEX `uni_careful_tools_bucket_code`,
+    ADD UNIQUE INDEX `idx_careful_tools_bucket_name` (`name`),
+    ADD UNIQUE INDEX `idx_careful_tools_bucket_code` (`code`),
+    DROP INDEX `idx_careful_tools_bucket_deleted_at`,
+    DROP COLUMN `deleted_by`,
+    DROP COLUMN `deleted_at`;
+
+ALTER TABLE `careful_system_menu`
+    DROP INDEX `idx_careful_system_menu_deleted_at`,
+    DROP COLUMN `deleted_by`,
+    DROP COLUMN `deleted_at`;
+
+ALTER TABLE `careful_system_permission`
+    DROP INDEX `uni_careful_system_permission_code`,
+    DROP INDEX `uni_permission_method_path`,
+    ADD UNIQUE INDEX `idx_careful_system_permission_code` (`code`),
+    ADD UNIQUE INDEX `uni_permission_method_path` (`method`,`path`),
+    DROP INDEX `idx_careful_system_permission_deleted_at`,
+    DROP COLUMN `deleted_by`,
+    DROP COLUMN `deleted_at`;
+
+ALTER TABLE `careful_system_role`
+    DROP INDEX `uni_careful_system_role_name`,
+    DROP INDEX `uni_careful_system_role_code`,
+    ADD UNIQUE INDEX `idx_careful_system_role_name` (`name`),
+    ADD UNIQUE INDEX `idx_careful_system_role_code` (`code`),
+    DROP INDEX `idx_careful_system_role_deleted_at`,
+    DROP COLUMN `deleted_by`,
+    DROP COLUMN `deleted_at`;
+
+ALTER TABLE `careful_system_users`
+    DROP INDEX `uni_careful_system_users_username`,
+    ADD UNIQUE INDEX `idx_careful_system_users_username` (`username`),
+    DROP INDEX `idx_careful_system_users_deleted_at`,
+    DROP COLUMN `deleted_by`,
+    DROP COLUMN `deleted_at`;
+
+ALTER TABLE `careful_system_dept`
+    DROP INDEX `uni_dept_name_code_parent`,
+    ADD UNIQUE INDEX `uni_dept_name_code_parent` (`name`,`code`,`parent_id`),
+    DROP INDEX `idx_careful_system_dept_deleted_at`,
+    DROP COLUMN `deleted_by`,
+    DROP COLUMN `deleted_at`;
@@ -0,0 +1,60 @@
+-- 用户、部门、角色、菜单、接口权限、存储桶与文件启用软删除
+-- 唯一索引追加删除时间，已移入回收站的数据不再占用唯一值
+
+-- 部门表
+ALTER TABLE `careful_system_dept`
+    ADD COLUMN `deleted_at` bigint NOT NULL DEFAULT 0 COMMENT '删除时间(毫秒时间戳，0-未删除)',
+    ADD COLUMN `deleted_by` varchar(100) COMMENT '删除人',
+    ADD INDEX `idx_careful_system_dept_deleted_at` (`deleted_at`),
+    DROP INDEX `uni_dept_name_code_parent`,
+    ADD UNIQUE INDEX `uni_dept_name_code_parent` (`name`,`code`,`parent_id`,`deleted_at`);
+
+-- 用户表
+ALTER TABLE `careful_system_users`
+    ADD COLUMN `deleted_at` bigint NOT NULL DEFAULT 0 COMMENT '删除时间(毫秒时间戳，0-未删除)',
+    ADD COLUMN `deleted_by` varchar(100) COMMENT '删除人',
+    ADD INDEX `idx_careful_system_users_deleted_at` (`deleted_at`),
+    DROP INDEX `idx_careful_system_users_username`,
+    ADD UNIQUE INDEX `uni_careful_system_users_username` (`username`,`deleted_at`);
+
+-- 角色表
+ALTER TABLE `careful_system_role`
+    ADD COLUMN `deleted_at` bigint NOT NULL DEFAULT 0 COMMENT '删除时间(毫秒时间戳，0-未删除)',
+    ADD COLUMN `deleted_by` varchar(100) COMMENT '删除人',
+    ADD INDEX `idx_careful_system_role_deleted_at` (`deleted_at`),
+    DROP INDEX `idx_careful_system_role_name`,
+    DROP INDEX `idx_careful_system_role_code`,
+    ADD UNIQUE INDEX `uni_careful_system_role_name` (`name`,`deleted_at`),
+    ADD UNIQUE INDEX `uni_careful_system_role_code` (`code`,`deleted_at`);
+
+-- 接口权限表
+ALTER TABLE `careful_system_permission`
+    ADD COLUMN `deleted_at` bigint NOT NULL DEFAULT 0 COMMENT '删除时间(毫秒时间戳，0-未删除)',
+    ADD COLUMN `deleted_by` varchar(100) COMMENT '删除人',
+    ADD INDEX `idx_careful_system_permission_deleted_at` (`deleted_at`),
+    DROP INDEX `idx_careful_system_permission_code`,
+    DROP INDEX `uni_permission_method_path`,
+    ADD UNIQUE INDEX `uni_careful_system_permission_code` (`code`,`deleted_at`),
+    ADD UNIQUE INDEX `uni_permission_method_path` (`method`,`path`,`deleted_at`);
+
+-- 菜单表
+ALTER TABLE `careful_system_menu`
+    ADD COLUMN `deleted_at` bigint NOT NULL DEFAULT 0 COMMENT '删除时间(毫秒时间戳，0-未删除)',
+    ADD COLUMN `deleted_by` varchar(100) COMMENT '删除人',
+    ADD INDEX `idx_careful_system_menu_deleted_at` (`deleted_at`);
+
+-- 存储桶表
+ALTER TABLE `careful_tools_bucket`
+    ADD COLUMN `deleted_at` bigint NOT NULL DEFAULT 0 COMMENT '删除时间(毫秒时间戳，0-未删除)',
+    ADD COLUMN `deleted_by` varchar(100) COMMENT '删除人',
+    ADD INDEX `idx_careful_tools_bucket_deleted_at` (`deleted_at`),
+    DROP INDEX `idx_careful_tools_bucket_name`,
+    DROP INDEX `idx_careful_tools_bucket_code`,
+    ADD UNIQUE INDEX `uni_careful_tools_bucket_name` (`name`,`deleted_at`),
+    ADD UNIQUE INDEX `uni_careful_tools_bucket_code` (`code`,`deleted_at`);
+
+-- 文件表
+ALTER TABLE `careful_tools_file`
+    ADD COLUMN `deleted_at` bigint NOT NULL DEFAULT 0 COMMENT '删除时间(毫秒时间戳，0-未删除)',
+    ADD COLUMN `deleted_by` varchar(100) COMMENT '删除人',
+    ADD INDEX `idx_careful_tools_file_deleted_at` (`deleted_at`);
@@ -0,0 +1,22 @@
+/**
+ * Description：
+ * FileName：migrations.go
+ * Author：CJiaの用心
+ * Create：2025/11/14 17:05:32
+ * Remark：
+ */
+
+package migrations
+
+import (
+	"embed"
+)
+
+// Dir 迁移文件所在目录(相对项目根目录)，migrate create 默认在此创建迁移文件
+const Dir = "internal/model/careful/migrations"
+
+// FS 编译进程序的迁移文件，文件名格式：<版本号>_<名称>.up.sql / <版本号>_<名称>.down.sql
+// 已发布的迁移文件不可修改，表结构变更需新建迁移
+//
+//go:embed *.sql
+var FS embed.FS
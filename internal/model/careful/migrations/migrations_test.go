@@ -0,0 +1,27 @@
+/**
+ * Description：
+ * FileName：migrations_test.go
+ * Author：CJiaの用心
+ * Create：2025/11/14 18:02:17
+ * Remark：
+ */
+
+package migrations
+
+import (
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/migrate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestFS(t *testing.T) {
+	list, err := migrate.Load(FS)
+	require.NoError(t, err)
+	require.NotEmpty(t, list)
+
+	for _, migration := range list {
+		assert.NotEmpty(t, migrate.Split(migration.Up), "版本 %d 升级脚本为空", migration.Version)
+		assert.NotEmpty(t, migrate.Split(migration.Down), "版本 %d 缺少回滚脚本", migration.Version)
+	}
+}
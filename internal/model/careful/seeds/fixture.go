@@ -0,0 +1,251 @@
+/**
+ * Description：
+ * FileName：fixture.go
+ * Author：CJiaの用心
+ * Create：2025/11/15 09:12:36
+ * Remark：
+ */
+
+package seeds
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/system/menu"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/system/user"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/tools/dict"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/tools/dict_type"
+	"gopkg.in/yaml.v3"
+	"io/fs"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	ErrEnvNotFound = errors.New("未找到该环境的种子数据")
+)
+
+// CommonDir 各环境共用的种子数据目录，先于环境目录加载
+const CommonDir = "common"
+
+// envPattern 文件内容中的环境变量引用，格式：${NAME} 或 ${NAME:-默认值}，用于密码等不便提交到仓库的值
+var envPattern = regexp.MustCompile(`\$\{(\w+)(?::-([^}]*))?}`)
+
+// Fixture 种子数据，按 部门 → 用户 → 菜单 → 字典 的顺序导入
+type Fixture struct {
+	Depts []DeptSeed `yaml:"depts"`
+	Users []UserSeed `yaml:"users"`
+	Menus []MenuSeed `yaml:"menus"`
+	Dicts []DictSeed `yaml:"dicts"`
+}
+
+// DeptSeed 部门，按部门编码匹配，已存在的部门不调整上级部门
+type DeptSeed struct {
+	Code       string `yaml:"code"`       // 部门编码
+	Name       string `yaml:"name"`       // 部门名称
+	ParentCode string `yaml:"parentCode"` // 上级部门编码，需在当前种子数据或数据库中已存在
+	Owner      string `yaml:"owner"`      // 负责人
+	Phone      string `yaml:"phone"`      // 联系电话
+	Email      string `yaml:"email"`      // 邮箱
+	Status     *bool  `yaml:"status"`     // 状态，默认启用
+	Sort       int    `yaml:"sort"`       // 显示排序，默认1
+	Remark     string `yaml:"remark"`     // 备注
+}
+
+// UserSeed 用户，按用户名匹配，密码仅在创建时设置，不会覆盖已修改的密码
+type UserSeed struct {
+	Username    string           `yaml:"username"`    // 用户名
+	Password    string           `yaml:"password"`    // 明文密码，导入时加密
+	Name        string           `yaml:"name"`        // 姓名
+	Gender      user.GenderConst `yaml:"gender"`      // 性别，默认男
+	Email       string           `yaml:"email"`       // 邮箱
+	Mobile      string           `yaml:"mobile"`      // 电话
+	Avatar      string           `yaml:"avatar"`      // 头像
+	DeptCode    string           `yaml:"deptCode"`    // 所属部门编码
+	IsSuperuser bool             `yaml:"isSuperuser"` // 是否超级管理员
+	Status      *bool            `yaml:"status"`      // 状态，默认启用
+	Sort        int              `yaml:"sort"`        // 显示排序，默认1
+	Remark      string           `yaml:"remark"`      // 备注
+}
+
+// MenuSeed 菜单，按路由名称匹配
+type MenuSeed struct {
+	Name       string         `yaml:"name"`       // 路由名称
+	Title      string         `yaml:"title"`      // 菜单标题
+	Type       menu.TypeConst `yaml:"type"`       // 菜单类型，默认目录
+	Code       string         `yaml:"code"`       // 权限标识
+	Path       string         `yaml:"path"`       // 路由地址
+	Component  string         `yaml:"component"`  // 组件路径
+	Redirect   string         `yaml:"redirect"`   // 重定向地址
+	Icon       string         `yaml:"icon"`       // 菜单图标
+	Visible    *bool          `yaml:"visible"`    // 是否显示，默认显示
+	KeepAlive  bool           `yaml:"keepAlive"`  // 是否缓存页面
+	IsLink     bool           `yaml:"isLink"`     // 是否外链
+	ParentName string         `yaml:"parentName"` // 上级菜单路由名称
+	Status     *bool          `yaml:"status"`     // 状态，默认启用
+	Sort       int            `yaml:"sort"`       // 显示排序，默认1
+	Remark     string         `yaml:"remark"`     // 备注
+}
+
+// DictSeed 字典，按字典编码匹配
+type DictSeed struct {
+	Code      string              `yaml:"code"`      // 字典编码
+	Name      string              `yaml:"name"`      // 字典名称
+	Type      dict.TypeConst      `yaml:"type"`      // 字典类型，默认普通字典
+	ValueType dict.ValueTypeConst `yaml:"valueType"` // 数据类型，默认字符串
+	Status    *bool               `yaml:"status"`    // 状态，默认启用
+	Sort      int                 `yaml:"sort"`      // 显示排序，默认1
+	Remark    string              `yaml:"remark"`    // 备注
+	Items     []DictItemSeed      `yaml:"items"`     // 字典项
+}
+
+// DictItemSeed 字典项，按所属字典与字典项名称匹配
+type DictItemSeed struct {
+	Name      string                 `yaml:"name"`      // 字典项名称
+	Value     any                    `yaml:"value"`     // 字典项值，类型与字典数据类型一致
+	DictTag   dict_type.DictTagConst `yaml:"dictTag"`   // 标签类型，默认 primary
+	DictColor string                 `yaml:"dictColor"` // 标签颜色
+	Status    *bool                  `yaml:"status"`    // 状态，默认启用
+	Sort      int                    `yaml:"sort"`      // 显示排序，默认1
+	Remark    string                 `yaml:"remark"`    // 备注
+}
+
+// LoadFixture 依次读取 common 与环境目录下的 .yaml/.yml/.json 文件并合并，同一目录内按文件名排序
+// 后读取的数据按自然键覆盖先读取的数据，环境目录可覆盖 common 中的同名数据
+// JSON 是 YAML 的子集，两种格式使用同一解析器
+func LoadFixture(fsys fs.FS, env string) (Fixture, error) {
+	var fixture Fixture
+	if err := loadDir(fsys, CommonDir, &fixture); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fixture, err
+	}
+	if err := loadDir(fsys, env, &fixture); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return fixture, fmt.Errorf("%w: %s", ErrEnvNotFound, env)
+		}
+		return fixture, err
+	}
+	return fixture, fixture.Validate()
+}
+
+func loadDir(fsys fs.FS, dir string, fixture *Fixture) error {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return err
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name() < entries[j].Name()
+	})
+
+	for _, entry := range entries {
+		switch path.Ext(entry.Name()) {
+		case ".yaml", ".yml", ".json":
+		default:
+			continue
+		}
+		file := path.Join(dir, entry.Name())
+		content, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return err
+		}
+
+		var part Fixture
+		if err := yaml.Unmarshal([]byte(expandEnv(string(content))), &part); err != nil {
+			return fmt.Errorf("解析种子数据文件 %s 失败: %w", file, err)
+		}
+		fixture.Depts = merge(fixture.Depts, part.Depts, func(item DeptSeed) string { return item.Code })
+		fixture.Users = merge(fixture.Users, part.Users, func(item UserSeed) string { return item.Username })
+		fixture.Menus = merge(fixture.Menus, part.Menus, func(item MenuSeed) string { return item.Name })
+		fixture.Dicts = merge(fixture.Dicts, part.Dicts, func(item DictSeed) string { return item.Code })
+	}
+	return nil
+}
+
+// merge 按自然键合并，已存在时原位覆盖，保持先后顺序
+func merge[T any](list, items []T, key func(T) string) []T {
+	index := make(map[string]int, len(list))
+	for i, item := range list {
+		index[key(item)] = i
+	}
+	for _, item := range items {
+		if i, ok := index[key(item)]; ok {
+			list[i] = item
+			continue
+		}
+		index[key(item)] = len(list)
+		list = append(list, item)
+	}
+	return list
+}
+
+// expandEnv 替换环境变量引用，未设置时使用默认值，无默认值时替换为空
+func expandEnv(content string) string {
+	return envPattern.ReplaceAllStringFunc(content, func(match string) string {
+		groups := envPattern.FindStringSubmatch(match)
+		if value, ok := os.LookupEnv(groups[1]); ok && value != "" {
+			return value
+		}
+		return groups[2]
+	})
+}
+
+// Validate 校验自然键必填且不重复
+func (f Fixture) Validate() error {
+	depts := make(map[string]bool, len(f.Depts))
+	for _, dept := range f.Depts {
+		if dept.Code == "" || dept.Name == "" {
+			return errors.New("部门编码与部门名称不能为空")
+		}
+		if depts[dept.Code] {
+			return fmt.Errorf("部门编码重复: %s", dept.Code)
+		}
+		depts[dept.Code] = true
+	}
+
+	users := make(map[string]bool, len(f.Users))
+	for _, item := range f.Users {
+		if item.Username == "" {
+			return errors.New("用户名不能为空")
+		}
+		if users[item.Username] {
+			return fmt.Errorf("用户名重复: %s", item.Username)
+		}
+		users[item.Username] = true
+	}
+
+	menus := make(map[string]bool, len(f.Menus))
+	for _, item := range f.Menus {
+		if item.Name == "" || item.Title == "" {
+			return errors.New("菜单路由名称与菜单标题不能为空")
+		}
+		if menus[item.Name] {
+			return fmt.Errorf("菜单路由名称重复: %s", item.Name)
+		}
+		menus[item.Name] = true
+	}
+
+	dicts := make(map[string]bool, len(f.Dicts))
+	for _, item := range f.Dicts {
+		if item.Code == "" || item.Name == "" {
+			return errors.New("字典编码与字典名称不能为空")
+		}
+		if dicts[item.Code] {
+			return fmt.Errorf("字典编码重复: %s", item.Code)
+		}
+		dicts[item.Code] = true
+
+		names := make(map[string]bool, len(item.Items))
+		for _, option := range item.Items {
+			if strings.TrimSpace(option.Name) == "" {
+				return fmt.Errorf("字典 %s 的字典项名称不能为空", item.Code)
+			}
+			if names[option.Name] {
+				return fmt.Errorf("字典 %s 的字典项名称重复: %s", item.Code, option.Name)
+			}
+			names[option.Name] = true
+		}
+	}
+	return nil
+}
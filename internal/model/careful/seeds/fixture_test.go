@@ -0,0 +1,90 @@
+/**
+ * Description：
+ * FileName：fixture_test.go
+ * Author：CJiaの用心
+ * Create：2025/11/15 11:42:09
+ * Remark：
+ */
+
+package seeds
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoadFixture(t *testing.T) {
+	t.Setenv("SEED_ADMIN_PASSWORD", "secret")
+	fsys := fstest.MapFS{
+		"common/01_depts.yaml": {Data: []byte("depts:\n  - code: ROOT\n    name: 总部\n")},
+		"common/02_dicts.json": {Data: []byte(`{"dicts": [{"code": "gender", "name": "性别", "valueType": 2, "items": [{"name": "男", "value": 1}]}]}`)},
+		"common/readme.md":     {Data: []byte("忽略非数据文件")},
+		"development/users.yml": {Data: []byte("users:\n" +
+			"  - username: admin\n    password: ${SEED_ADMIN_PASSWORD}\n    deptCode: ROOT\n" +
+			"  - username: tester\n    password: ${SEED_TESTER_PASSWORD:-tester123}\n")},
+		"development/depts.yaml": {Data: []byte("depts:\n  - code: ROOT\n    name: 开发总部\n  - code: DEV\n    name: 研发部\n    parentCode: ROOT\n")},
+	}
+
+	fixture, err := LoadFixture(fsys, "development")
+	require.NoError(t, err)
+
+	// 环境目录按自然键覆盖 common 中的数据，保持原有顺序
+	require.Len(t, fixture.Depts, 2)
+	assert.Equal(t, "开发总部", fixture.Depts[0].Name)
+	assert.Equal(t, "DEV", fixture.Depts[1].Code)
+
+	require.Len(t, fixture.Users, 2)
+	assert.Equal(t, "secret", fixture.Users[0].Password)
+	assert.Equal(t, "tester123", fixture.Users[1].Password)
+
+	require.Len(t, fixture.Dicts, 1)
+	assert.Equal(t, 1, fixture.Dicts[0].Items[0].Value)
+
+	_, err = LoadFixture(fsys, "staging")
+	assert.ErrorIs(t, err, ErrEnvNotFound)
+}
+
+func TestFixture_Validate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		fixture Fixture
+		wantErr string
+	}{
+		{
+			name:    "部门编码为空",
+			fixture: Fixture{Depts: []DeptSeed{{Name: "总部"}}},
+			wantErr: "部门编码与部门名称不能为空",
+		},
+		{
+			name:    "用户名重复",
+			fixture: Fixture{Users: []UserSeed{{Username: "admin"}, {Username: "admin"}}},
+			wantErr: "用户名重复: admin",
+		},
+		{
+			name: "字典项名称重复",
+			fixture: Fixture{Dicts: []DictSeed{{Code: "gender", Name: "性别", Items: []DictItemSeed{
+				{Name: "男", Value: "1"}, {Name: "男", Value: "2"},
+			}}}},
+			wantErr: "字典 gender 的字典项名称重复: 男",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.EqualError(t, tc.fixture.Validate(), tc.wantErr)
+		})
+	}
+}
+
+func TestFS(t *testing.T) {
+	t.Setenv("CAREFUL_ADMIN_PASSWORD", "secret")
+
+	for _, env := range []string{"development", "production"} {
+		fixture, err := LoadFixture(FS, env)
+		require.NoError(t, err, env)
+		require.NotEmpty(t, fixture.Users, env)
+		assert.Equal(t, "secret", fixture.Users[0].Password, env)
+	}
+}
@@ -0,0 +1,362 @@
+/**
+ * Description：
+ * FileName：seeder.go
+ * Author：CJiaの用心
+ * Create：2025/11/15 10:05:48
+ * Remark：
+ */
+
+package seeds
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/tools"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/system/menu"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/system/user"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/tools/dict"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/tools/dict_type"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+	"reflect"
+	"time"
+)
+
+// Stat 单类数据的导入结果
+type Stat struct {
+	Created   int // 新增
+	Updated   int // 更新
+	Unchanged int // 无变化
+}
+
+// Result 种子数据导入结果
+type Result struct {
+	Depts     Stat
+	Users     Stat
+	Menus     Stat
+	Dicts     Stat
+	DictItems Stat
+}
+
+// Apply 在同一事务中导入种子数据，按自然键匹配已有数据，不存在时创建，存在时仅更新有变化的列，可重复执行
+// 上级部门、上级菜单需排在下级之前或已存在于数据库中
+func Apply(ctx context.Context, db *gorm.DB, fixture Fixture) (Result, error) {
+	if err := fixture.Validate(); err != nil {
+		return Result{}, err
+	}
+
+	var result Result
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		s := &seeder{
+			tx:     tx,
+			result: &result,
+			depts:  make(map[string]string, len(fixture.Depts)),
+			menus:  make(map[string]string, len(fixture.Menus)),
+		}
+		if err := s.applyDepts(fixture.Depts); err != nil {
+			return err
+		}
+		if err := s.applyUsers(fixture.Users); err != nil {
+			return err
+		}
+		if err := s.applyMenus(fixture.Menus); err != nil {
+			return err
+		}
+		return s.applyDicts(fixture.Dicts)
+	})
+	return result, err
+}
+
+type seeder struct {
+	tx     *gorm.DB
+	result *Result
+	depts  map[string]string // 部门编码 → 部门ID
+	menus  map[string]string // 路由名称 → 菜单ID
+}
+
+func (s *seeder) applyDepts(list []DeptSeed) error {
+	for _, item := range list {
+		parentId, err := s.lookupId(s.depts, &system.Dept{}, "code", item.ParentCode)
+		if err != nil {
+			return fmt.Errorf("部门 %s 的上级部门: %w", item.Code, err)
+		}
+
+		model := system.Dept{
+			CoreModels: models.CoreModels{Sort: sortOf(item.Sort), Remark: item.Remark},
+			Status:     enabled(item.Status),
+			Name:       item.Name,
+			Code:       item.Code,
+			Owner:      item.Owner,
+			Phone:      item.Phone,
+			Email:      item.Email,
+			ParentID:   nullString(parentId),
+		}
+		// 已存在的部门不调整上级部门，移动部门需同步改写子孙节点的路径
+		err = upsert(s.tx, &s.result.Depts, &model, []string{"code"},
+			[]string{"name", "owner", "phone", "email", "status", "sort", "remark"}, nil)
+		if err != nil {
+			return fmt.Errorf("导入部门 %s 失败: %w", item.Code, err)
+		}
+		s.depts[item.Code] = model.Id
+	}
+	return nil
+}
+
+func (s *seeder) applyUsers(list []UserSeed) error {
+	for _, item := range list {
+		deptId, err := s.lookupId(s.depts, &system.Dept{}, "code", item.DeptCode)
+		if err != nil {
+			return fmt.Errorf("用户 %s 的所属部门: %w", item.Username, err)
+		}
+
+		gender := item.Gender
+		if gender == 0 {
+			gender = user.GenderConstMale
+		}
+		model := system.User{
+			CoreModels:  models.CoreModels{Sort: sortOf(item.Sort), BelongDept: deptId, Remark: item.Remark},
+			Status:      enabled(item.Status),
+			Username:    item.Username,
+			Name:        item.Name,
+			Gender:      gender,
+			Email:       item.Email,
+			Mobile:      item.Mobile,
+			Avatar:      item.Avatar,
+			IsSuperuser: item.IsSuperuser,
+			DeptId:      nullString(deptId),
+		}
+		// 密码仅在创建时设置
+		onCreate := func() error {
+			if item.Password == "" {
+				return errors.New("密码不能为空，可通过 ${环境变量} 引用")
+			}
+			hash, err := bcrypt.GenerateFromPassword([]byte(item.Password), bcrypt.DefaultCost)
+			if err != nil {
+				return err
+			}
+			model.Password = string(hash)
+			return model.Validate()
+		}
+		err = upsert(s.tx, &s.result.Users, &model, []string{"username"},
+			[]string{"name", "gender", "email", "mobile", "avatar", "is_superuser", "dept_id", "belong_dept", "status", "sort", "remark"},
+			onCreate)
+		if err != nil {
+			return fmt.Errorf("导入用户 %s 失败: %w", item.Username, err)
+		}
+	}
+	return nil
+}
+
+func (s *seeder) applyMenus(list []MenuSeed) error {
+	for _, item := range list {
+		parentId, err := s.lookupId(s.menus, &system.Menu{}, "name", item.ParentName)
+		if err != nil {
+			return fmt.Errorf("菜单 %s 的上级菜单: %w", item.Name, err)
+		}
+
+		menuType := item.Type
+		if menuType == 0 {
+			menuType = menu.TypeConstDirectory
+		}
+		model := system.Menu{
+			CoreModels: models.CoreModels{Sort: sortOf(item.Sort), Remark: item.Remark},
+			Status:     enabled(item.Status),
+			Type:       menuType,
+			Title:      item.Title,
+			Name:       item.Name,
+			Code:       item.Code,
+			Path:       item.Path,
+			Component:  item.Component,
+			Redirect:   item.Redirect,
+			Icon:       item.Icon,
+			Visible:    enabled(item.Visible),
+			KeepAlive:  item.KeepAlive,
+			IsLink:     item.IsLink,
+			ParentID:   nullString(parentId),
+		}
+		err = upsert(s.tx, &s.result.Menus, &model, []string{"name"},
+			[]string{"type", "title", "code", "path", "component", "redirect", "icon", "visible", "keep_alive", "is_link", "parent_id", "status", "sort", "remark"},
+			nil)
+		if err != nil {
+			return fmt.Errorf("导入菜单 %s 失败: %w", item.Name, err)
+		}
+		s.menus[item.Name] = model.Id
+	}
+	return nil
+}
+
+func (s *seeder) applyDicts(list []DictSeed) error {
+	for _, item := range list {
+		dictType := item.Type
+		if dictType == 0 {
+			dictType = dict.TypeConstOrdinary
+		}
+		valueType := item.ValueType
+		if valueType == 0 {
+			valueType = dict.ValueTypeConstStr
+		}
+		model := tools.Dict{
+			CoreModels: models.CoreModels{Sort: sortOf(item.Sort), Remark: item.Remark},
+			Status:     enabled(item.Status),
+			Name:       item.Name,
+			Code:       item.Code,
+			Type:       dictType,
+			ValueType:  valueType,
+		}
+		err := upsert(s.tx, &s.result.Dicts, &model, []string{"code"},
+			[]string{"name", "type", "valueType", "status", "sort", "remark"}, nil)
+		if err != nil {
+			return fmt.Errorf("导入字典 %s 失败: %w", item.Code, err)
+		}
+
+		for _, option := range item.Items {
+			if err := s.applyDictItem(model, option); err != nil {
+				return fmt.Errorf("导入字典 %s 的字典项 %s 失败: %w", item.Code, option.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *seeder) applyDictItem(parent tools.Dict, item DictItemSeed) error {
+	tag := item.DictTag
+	if tag == "" {
+		tag = dict_type.DictTagConstPrimary
+	}
+	model := tools.DictType{
+		CoreModels: models.CoreModels{Sort: sortOf(item.Sort), Remark: item.Remark},
+		Status:     enabled(item.Status),
+		Name:       item.Name,
+		DictTag:    tag,
+		DictColor:  item.DictColor,
+		DictName:   parent.Name,
+		ValueType:  parent.ValueType,
+		DictId:     parent.Id,
+	}
+
+	switch parent.ValueType {
+	case dict.ValueTypeConstStr:
+		value, ok := item.Value.(string)
+		if !ok {
+			return fmt.Errorf("字典项值 %v 不是字符串", item.Value)
+		}
+		model.StrValue = sql.NullString{String: value, Valid: true}
+	case dict.ValueTypeConstInt:
+		value, ok := item.Value.(int)
+		if !ok {
+			return fmt.Errorf("字典项值 %v 不是整数", item.Value)
+		}
+		model.IntValue = sql.NullInt64{Int64: int64(value), Valid: true}
+	case dict.ValueTypeConstBool:
+		value, ok := item.Value.(bool)
+		if !ok {
+			return fmt.Errorf("字典项值 %v 不是布尔值", item.Value)
+		}
+		model.BoolValue = sql.NullBool{Bool: value, Valid: true}
+	default:
+		return tools.ErrDictTypeInvalidDictValueType
+	}
+
+	return upsert(s.tx, &s.result.DictItems, &model, []string{"dict_id", "name"},
+		[]string{"strValue", "intValue", "boolValue", "dictTag", "dictColor", "dictName", "valueType", "status", "sort", "remark"},
+		nil)
+}
+
+// lookupId 按自然键查找ID，优先使用本次已导入的数据，key 为空时返回空
+func (s *seeder) lookupId(imported map[string]string, model any, column, key string) (string, error) {
+	if key == "" {
+		return "", nil
+	}
+	if id, ok := imported[key]; ok {
+		return id, nil
+	}
+
+	var ids []string
+	if err := s.tx.Model(model).Where(column+" = ?", key).Limit(1).Pluck("id", &ids).Error; err != nil {
+		return "", err
+	}
+	if len(ids) == 0 {
+		return "", fmt.Errorf("%s 不存在", key)
+	}
+	imported[key] = ids[0]
+	return ids[0], nil
+}
+
+// upsert 按 keys 列查找记录，不存在时创建，存在时仅更新 columns 中值有变化的列，完成后将记录ID写回 model
+func upsert[T any](tx *gorm.DB, stat *Stat, model *T, keys, columns []string, onCreate func() error) error {
+	stmt := &gorm.Statement{DB: tx}
+	if err := stmt.Parse(model); err != nil {
+		return err
+	}
+	ctx := tx.Statement.Context
+	value := reflect.ValueOf(model).Elem()
+
+	conditions := make(map[string]any, len(keys))
+	for _, key := range keys {
+		conditions[key], _ = stmt.Schema.LookUpField(key).ValueOf(ctx, value)
+	}
+	var existing T
+	result := tx.Where(conditions).Limit(1).Find(&existing)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		if onCreate != nil {
+			if err := onCreate(); err != nil {
+				return err
+			}
+		}
+		// 选择全部字段，避免零值字段(如停用状态)被数据库默认值覆盖
+		if err := tx.Select("*").Create(model).Error; err != nil {
+			return err
+		}
+		stat.Created++
+		return nil
+	}
+
+	current := reflect.ValueOf(&existing).Elem()
+	changes := make(map[string]any)
+	for _, column := range columns {
+		field := stmt.Schema.LookUpField(column)
+		want, _ := field.ValueOf(ctx, value)
+		got, _ := field.ValueOf(ctx, current)
+		if !reflect.DeepEqual(want, got) {
+			changes[field.DBName] = want
+		}
+	}
+	if len(changes) == 0 {
+		stat.Unchanged++
+	} else {
+		changes["timestamp"] = time.Now().UnixMicro()
+		if err := tx.Model(&existing).Updates(changes).Error; err != nil {
+			return err
+		}
+		stat.Updated++
+	}
+
+	primary := stmt.Schema.PrioritizedPrimaryField
+	id, _ := primary.ValueOf(ctx, current)
+	return primary.Set(ctx, value, id)
+}
+
+// enabled 未设置时默认启用
+func enabled(value *bool) bool {
+	return value == nil || *value
+}
+
+// sortOf 未设置时默认排序为1，与字段默认值一致
+func sortOf(sort int) int {
+	if sort == 0 {
+		return 1
+	}
+	return sort
+}
+
+func nullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
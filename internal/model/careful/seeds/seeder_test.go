@@ -0,0 +1,161 @@
+/**
+ * Description：
+ * FileName：seeder_test.go
+ * Author：CJiaの用心
+ * Create：2025/11/15 12:06:44
+ * Remark：
+ */
+
+package seeds
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"io"
+	"strings"
+	"testing"
+)
+
+// fakeConn 按顺序返回预设查询结果并记录执行语句的数据库连接
+type fakeConn struct {
+	results []*fakeRows
+	execs   []fakeExec
+}
+
+type fakeExec struct {
+	query string
+	args  []driver.Value
+}
+
+func (c *fakeConn) Connect(context.Context) (driver.Conn, error) { return c, nil }
+func (c *fakeConn) Driver() driver.Driver                        { return nil }
+func (c *fakeConn) Prepare(q string) (driver.Stmt, error)        { return &fakeStmt{conn: c, query: q}, nil }
+func (c *fakeConn) Close() error                                 { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)                    { return c, nil }
+func (c *fakeConn) Commit() error                                { return nil }
+func (c *fakeConn) Rollback() error                              { return nil }
+
+type fakeStmt struct {
+	conn  *fakeConn
+	query string
+}
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.conn.execs = append(s.conn.execs, fakeExec{query: s.query, args: args})
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	if len(s.conn.results) == 0 {
+		return &fakeRows{}, nil
+	}
+	rows := s.conn.results[0]
+	s.conn.results = s.conn.results[1:]
+	return rows, nil
+}
+
+type fakeRows struct {
+	columns []string
+	values  [][]driver.Value
+	index   int
+}
+
+func (r *fakeRows) Columns() []string { return r.columns }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.index >= len(r.values) {
+		return io.EOF
+	}
+	copy(dest, r.values[r.index])
+	r.index++
+	return nil
+}
+
+// dictRow 已存在的字典
+func dictRow(name string) *fakeRows {
+	return &fakeRows{
+		columns: []string{"id", "sort", "status", "name", "code", "type", "valueType", "remark"},
+		values:  [][]driver.Value{{"D1", int64(1), true, name, "gender", int64(2), int64(2), ""}},
+	}
+}
+
+// dictItemRow 已存在的字典项
+func dictItemRow(name string, value int64) *fakeRows {
+	return &fakeRows{
+		columns: []string{"id", "sort", "status", "name", "intValue", "dictTag", "dictColor", "dictName", "valueType", "dict_id", "remark"},
+		values:  [][]driver.Value{{"T1", int64(1), true, name, value, "primary", "", "性别", int64(2), "D1", ""}},
+	}
+}
+
+func applyGender(t *testing.T, conn *fakeConn) Result {
+	db, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      sql.OpenDB(conn),
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{DisableAutomaticPing: true})
+	require.NoError(t, err)
+
+	result, err := Apply(context.Background(), db, Fixture{Dicts: []DictSeed{{
+		Code: "gender", Name: "性别", Type: 2, ValueType: 2,
+		Items: []DictItemSeed{{Name: "男", Value: 1}},
+	}}})
+	require.NoError(t, err)
+	return result
+}
+
+func (c *fakeConn) statements(prefix string) []fakeExec {
+	var list []fakeExec
+	for _, exec := range c.execs {
+		if strings.HasPrefix(exec.query, prefix) {
+			list = append(list, exec)
+		}
+	}
+	return list
+}
+
+func TestApply_Create(t *testing.T) {
+	conn := &fakeConn{}
+	result := applyGender(t, conn)
+
+	assert.Equal(t, Stat{Created: 1}, result.Dicts)
+	assert.Equal(t, Stat{Created: 1}, result.DictItems)
+	inserts := conn.statements("INSERT")
+	require.Len(t, inserts, 2)
+	assert.Contains(t, inserts[0].query, "careful_tools_dict")
+	// 字典项关联新建字典的ID
+	assert.Contains(t, inserts[1].query, "careful_tools_dict_type")
+	assert.Contains(t, inserts[1].args, inserts[0].args[0])
+}
+
+func TestApply_Unchanged(t *testing.T) {
+	conn := &fakeConn{results: []*fakeRows{dictRow("性别"), dictItemRow("男", 1)}}
+	result := applyGender(t, conn)
+
+	assert.Equal(t, Stat{Unchanged: 1}, result.Dicts)
+	assert.Equal(t, Stat{Unchanged: 1}, result.DictItems)
+	assert.Empty(t, conn.statements("INSERT"))
+	assert.Empty(t, conn.statements("UPDATE"))
+}
+
+func TestApply_Update(t *testing.T) {
+	conn := &fakeConn{results: []*fakeRows{dictRow("旧性别"), dictItemRow("男", 9)}}
+	result := applyGender(t, conn)
+
+	assert.Equal(t, Stat{Updated: 1}, result.Dicts)
+	assert.Equal(t, Stat{Updated: 1}, result.DictItems)
+	updates := conn.statements("UPDATE")
+	require.Len(t, updates, 2)
+	// 仅更新有变化的列与版本号
+	assert.Contains(t, updates[0].query, "`name`=?")
+	assert.NotContains(t, updates[0].query, "`code`")
+	assert.Contains(t, updates[1].query, "`intValue`=?")
+	assert.Contains(t, updates[1].query, "`timestamp`=?")
+}
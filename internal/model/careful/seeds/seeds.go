@@ -0,0 +1,18 @@
+/**
+ * Description：
+ * FileName：seeds.go
+ * Author：CJiaの用心
+ * Create：2025/11/15 09:05:21
+ * Remark：
+ */
+
+package seeds
+
+import (
+	"embed"
+)
+
+// FS 编译进程序的种子数据，common 目录为各环境共用数据，其余目录名与 application.environment 对应
+//
+//go:embed common development production
+var FS embed.FS
@@ -12,25 +12,26 @@ import (
 	"database/sql"
 	"fmt"
 	"github.com/carefuly/careful-admin-go-gin/pkg/models"
-	"go.uber.org/zap"
 	"gorm.io/gorm"
 )
 
 // Dept 部门表
+// 启用软删除，部门名称、编码与上级部门的唯一索引包含删除时间，见迁移文件
 type Dept struct {
 	models.CoreModels
-
-	Status     bool           `gorm:"type:boolean;index:idx_status;default:true;column:status;comment:状态【true-启用 false-停用】" json:"status"`                           // 状态
-	Name       string         `gorm:"type:varchar(50);not null;uniqueIndex:uni_dept_name_code_parent;column:name;comment:部门名称" json:"name"`                          // 部门名称
-	Code       string         `gorm:"type:varchar(50);not null;uniqueIndex:uni_dept_name_code_parent;column:code;comment:部门编码" json:"code"`                          // 部门编码
-	Owner      string         `gorm:"type:varchar(32);column:owner;comment:负责人" json:"owner"`                                                                        // 负责人
-	Phone      string         `gorm:"type:varchar(32);column:phone;comment:联系电话" json:"phone"`                                                                       // 联系电话
-	Email      string         `gorm:"type:varchar(32);column:email;comment:邮箱" json:"email"`                                                                         // 邮箱
-	Level      int            `gorm:"type:int;index:idx_level;default:0;column:level;comment:层级深度，根节点为0" json:"level"`                                               // 层级深度，根节点为0
-	Path       string         `gorm:"type:varchar(512);index:idx_path;column:path;comment:节点路径，格式：/1/2/3/" json:"path"`                                              // 节点路径，格式：/1/2/3/"
-	UserCount  int            `gorm:"type:int;default:0;column:user_count;comment:用户数量" json:"user_count"`                                                           // 用户数量
-	ChildCount int            `gorm:"type:int;default:0;column:child_count;comment:子部门数量" json:"child_count"`                                                        // 子部门数量
-	ParentID   sql.NullString `gorm:"type:varchar(100);uniqueIndex:uni_dept_name_code_parent;column:parent_id;comment:上级部门ID" swaggertype:"string" json:"parent_id"` // 上级部门ID
+	models.SoftDelete
+
+	Status     bool           `gorm:"type:boolean;index:idx_status;default:true;column:status;comment:状态【true-启用 false-停用】" json:"status"` // 状态
+	Name       string         `gorm:"type:varchar(50);not null;column:name;comment:部门名称" json:"name"`                                      // 部门名称
+	Code       string         `gorm:"type:varchar(50);not null;column:code;comment:部门编码" json:"code"`                                      // 部门编码
+	Owner      string         `gorm:"type:varchar(32);column:owner;comment:负责人" json:"owner"`                                              // 负责人
+	Phone      string         `gorm:"type:varchar(32);column:phone;comment:联系电话" json:"phone"`                                             // 联系电话
+	Email      string         `gorm:"type:varchar(32);column:email;comment:邮箱" json:"email"`                                               // 邮箱
+	Level      int            `gorm:"type:int;index:idx_level;default:0;column:level;comment:层级深度，根节点为0" json:"level"`                     // 层级深度，根节点为0
+	Path       string         `gorm:"type:varchar(512);index:idx_path;column:path;comment:节点路径，格式：/1/2/3/" json:"path"`                    // 节点路径，格式：/1/2/3/"
+	UserCount  int            `gorm:"type:int;default:0;column:user_count;comment:用户数量" json:"user_count"`                                 // 用户数量
+	ChildCount int            `gorm:"type:int;default:0;column:child_count;comment:子部门数量" json:"child_count"`                              // 子部门数量
+	ParentID   sql.NullString `gorm:"type:varchar(100);column:parent_id;comment:上级部门ID" swaggertype:"string" json:"parent_id"`             // 上级部门ID
 	// 关联查询字段（不存储到数据库）
 	Children []*Dept `gorm:"-" json:"children,omitempty"` // 子部门列表
 	Parent   *Dept   `gorm:"-" json:"parent,omitempty"`   // 父部门信息
@@ -44,22 +45,16 @@ func (d *Dept) TableName() string {
 	return "careful_system_dept"
 }
 
-func (d *Dept) AutoMigrate(db *gorm.DB) {
-	err := db.Set("gorm:table_options", "ENGINE=InnoDB,COMMENT='部门表'").AutoMigrate(&Dept{})
-	if err != nil {
-		zap.L().Error("Dept表模型迁移失败", zap.Error(err))
-	}
-}
-
 func (d *Dept) BeforeCreate(tx *gorm.DB) error {
+	// 覆盖了 CoreModels 的钩子，需先生成主键ID再计算路径
+	if err := d.CoreModels.BeforeCreate(tx); err != nil {
+		return err
+	}
 	return d.calculateTreeFields(tx)
 }
 
-func (d *Dept) BeforeUpdate(tx *gorm.DB) error {
-	return d.calculateTreeFields(tx)
-}
-
-// calculateTreeFields 如果ParentID发生变化，需要重新计算树形字段
+// calculateTreeFields 计算树形字段
+// 移动节点时由 DAO 在事务中批量改写子孙节点的 Path/Level
 func (d *Dept) calculateTreeFields(tx *gorm.DB) error {
 	if d.ParentID.Valid {
 		// 子节点
@@ -0,0 +1,44 @@
+/**
+ * Description：
+ * FileName：menu.go
+ * Author：CJiaの用心
+ * Create：2025/10/28 09:20:15
+ * Remark：
+ */
+
+package system
+
+import (
+	"database/sql"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/system/menu"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+)
+
+// Menu 菜单表
+// 启用软删除
+type Menu struct {
+	models.CoreModels
+	models.SoftDelete
+
+	Status    bool           `gorm:"type:boolean;index:idx_status;default:true;column:status;comment:状态【true-启用 false-停用】" json:"status"`         // 状态
+	Type      menu.TypeConst `gorm:"type:tinyint;not null;default:1;column:type;comment:菜单类型【1-目录 2-菜单 3-按钮】" json:"type"`                        // 菜单类型
+	Title     string         `gorm:"type:varchar(64);not null;column:title;comment:菜单标题" json:"title"`                                            // 菜单标题
+	Name      string         `gorm:"type:varchar(64);column:name;comment:路由名称" json:"name"`                                                       // 路由名称
+	Code      string         `gorm:"type:varchar(128);index:idx_code;column:code;comment:权限标识，按钮必填且唯一" json:"code"`                               // 权限标识
+	Path      string         `gorm:"type:varchar(255);column:path;comment:路由地址" json:"path"`                                                      // 路由地址
+	Component string         `gorm:"type:varchar(255);column:component;comment:组件路径" json:"component"`                                            // 组件路径
+	Redirect  string         `gorm:"type:varchar(255);column:redirect;comment:重定向地址" json:"redirect"`                                             // 重定向地址
+	Icon      string         `gorm:"type:varchar(64);column:icon;comment:菜单图标" json:"icon"`                                                       // 菜单图标
+	Visible   bool           `gorm:"type:boolean;default:true;column:visible;comment:是否显示【true-显示 false-隐藏】" json:"visible"`                      // 是否显示
+	KeepAlive bool           `gorm:"type:boolean;default:false;column:keep_alive;comment:是否缓存页面" json:"keep_alive"`                               // 是否缓存页面
+	IsLink    bool           `gorm:"type:boolean;default:false;column:is_link;comment:是否外链" json:"is_link"`                                       // 是否外链
+	ParentID  sql.NullString `gorm:"type:varchar(100);index:idx_parent_id;column:parent_id;comment:上级菜单ID" swaggertype:"string" json:"parent_id"` // 上级菜单ID
+}
+
+func NewMenu() *Menu {
+	return &Menu{}
+}
+
+func (m *Menu) TableName() string {
+	return "careful_system_menu"
+}
@@ -0,0 +1,34 @@
+/**
+ * Description：
+ * FileName：permission.go
+ * Author：CJiaの用心
+ * Create：2025/10/27 09:24:38
+ * Remark：
+ */
+
+package system
+
+import (
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+)
+
+// Permission 接口权限表
+// 启用软删除，权限编码与请求方式+接口路径的唯一索引包含删除时间，见迁移文件
+type Permission struct {
+	models.CoreModels
+	models.SoftDelete
+
+	Status bool   `gorm:"type:boolean;index:idx_status;default:true;column:status;comment:状态【true-启用 false-停用】" json:"status"`  // 状态
+	Name   string `gorm:"type:varchar(64);not null;column:name;comment:权限名称" json:"name"`                                       // 权限名称
+	Code   string `gorm:"type:varchar(128);not null;column:code;comment:权限编码" json:"code"`                                      // 权限编码
+	Method string `gorm:"type:varchar(10);not null;column:method;comment:请求方式，*表示全部" json:"method"`                             // 请求方式
+	Path   string `gorm:"type:varchar(255);not null;column:path;comment:接口路径，与路由定义一致，如/v1/system/user/getById/:id" json:"path"` // 接口路径
+}
+
+func NewPermission() *Permission {
+	return &Permission{}
+}
+
+func (p *Permission) TableName() string {
+	return "careful_system_permission"
+}
@@ -0,0 +1,35 @@
+/**
+ * Description：
+ * FileName：role.go
+ * Author：CJiaの用心
+ * Create：2025/10/27 09:20:14
+ * Remark：
+ */
+
+package system
+
+import (
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/system/role"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+)
+
+// Role 角色表
+// 启用软删除，角色名称与角色编码的唯一索引包含删除时间，见迁移文件
+type Role struct {
+	models.CoreModels
+	models.SoftDelete
+
+	Status bool   `gorm:"type:boolean;index:idx_status;default:true;column:status;comment:状态【true-启用 false-停用】" json:"status"` // 状态
+	Name   string `gorm:"type:varchar(64);not null;column:name;comment:角色名称" json:"name"`                                      // 角色名称
+	Code   string `gorm:"type:varchar(64);not null;column:code;comment:角色编码" json:"code"`                                      // 角色编码
+
+	DataScope role.DataScopeConst `gorm:"type:tinyint;default:1;column:data_scope;comment:数据范围【1-全部 2-本部门 3-本部门及以下 4-自定义 5-仅本人】" json:"data_scope"` // 数据范围
+}
+
+func NewRole() *Role {
+	return &Role{}
+}
+
+func (r *Role) TableName() string {
+	return "careful_system_role"
+}
@@ -0,0 +1,25 @@
+/**
+ * Description：
+ * FileName：role_dept.go
+ * Author：CJiaの用心
+ * Create：2025/10/29 09:11:48
+ * Remark：
+ */
+
+package system
+
+import ()
+
+// RoleDept 角色自定义数据范围部门关联表
+type RoleDept struct {
+	RoleId string `gorm:"type:varchar(100);primaryKey;column:role_id;comment:角色ID" json:"role_id"`       // 角色ID
+	DeptId string `gorm:"type:varchar(100);primaryKey;index;column:dept_id;comment:部门ID" json:"dept_id"` // 部门ID
+}
+
+func NewRoleDept() *RoleDept {
+	return &RoleDept{}
+}
+
+func (r *RoleDept) TableName() string {
+	return "careful_system_role_dept"
+}
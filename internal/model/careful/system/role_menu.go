@@ -0,0 +1,25 @@
+/**
+ * Description：
+ * FileName：role_menu.go
+ * Author：CJiaの用心
+ * Create：2025/10/28 09:26:52
+ * Remark：
+ */
+
+package system
+
+import ()
+
+// RoleMenu 角色菜单关联表
+type RoleMenu struct {
+	RoleId string `gorm:"type:varchar(100);primaryKey;column:role_id;comment:角色ID" json:"role_id"`       // 角色ID
+	MenuId string `gorm:"type:varchar(100);primaryKey;index;column:menu_id;comment:菜单ID" json:"menu_id"` // 菜单ID
+}
+
+func NewRoleMenu() *RoleMenu {
+	return &RoleMenu{}
+}
+
+func (r *RoleMenu) TableName() string {
+	return "careful_system_role_menu"
+}
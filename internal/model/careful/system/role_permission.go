@@ -0,0 +1,25 @@
+/**
+ * Description：
+ * FileName：role_permission.go
+ * Author：CJiaの用心
+ * Create：2025/10/27 09:33:45
+ * Remark：
+ */
+
+package system
+
+import ()
+
+// RolePermission 角色权限关联表
+type RolePermission struct {
+	RoleId       string `gorm:"type:varchar(100);primaryKey;column:role_id;comment:角色ID" json:"role_id"`                   // 角色ID
+	PermissionId string `gorm:"type:varchar(100);primaryKey;index;column:permission_id;comment:权限ID" json:"permission_id"` // 权限ID
+}
+
+func NewRolePermission() *RolePermission {
+	return &RolePermission{}
+}
+
+func (r *RolePermission) TableName() string {
+	return "careful_system_role_permission"
+}
@@ -0,0 +1,25 @@
+/**
+ * Description：
+ * FileName：user_role.go
+ * Author：CJiaの用心
+ * Create：2025/10/27 09:31:02
+ * Remark：
+ */
+
+package system
+
+import ()
+
+// UserRole 用户角色关联表
+type UserRole struct {
+	UserId string `gorm:"type:varchar(100);primaryKey;column:user_id;comment:用户ID" json:"user_id"`       // 用户ID
+	RoleId string `gorm:"type:varchar(100);primaryKey;index;column:role_id;comment:角色ID" json:"role_id"` // 角色ID
+}
+
+func NewUserRole() *UserRole {
+	return &UserRole{}
+}
+
+func (u *UserRole) TableName() string {
+	return "careful_system_user_role"
+}
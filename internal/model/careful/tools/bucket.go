@@ -0,0 +1,34 @@
+/**
+ * Description：
+ * FileName：bucket.go
+ * Author：CJiaの用心
+ * Create：2025/11/11 15:31:26
+ * Remark：
+ */
+
+package tools
+
+import (
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+)
+
+// Bucket 存储桶表
+// 启用软删除，存储桶名称与存储桶编码的唯一索引包含删除时间，见迁移文件
+type Bucket struct {
+	models.CoreModels
+	models.SoftDelete
+
+	Status       bool   `gorm:"type:boolean;index:idx_status;default:true;column:status;comment:状态【true-启用 false-停用】" json:"status"`  // 状态
+	Name         string `gorm:"type:varchar(100);not null;column:name;comment:存储桶名称" json:"name"`                                     // 存储桶名称
+	Code         string `gorm:"type:varchar(64);not null;column:code;comment:存储桶编码(文件存储路径前缀)" json:"code"`                            // 存储桶编码
+	MaxSize      int64  `gorm:"type:bigint;default:0;column:maxSize;comment:单个文件大小上限(字节)，0表示不限制" json:"maxSize"`                      // 单个文件大小上限
+	AllowedMimes string `gorm:"type:varchar(512);column:allowedMimes;comment:允许的文件类型，逗号分隔，支持 image/* 通配，为空表示不限制" json:"allowedMimes"` // 允许的文件类型
+}
+
+func NewBucket() *Bucket {
+	return &Bucket{}
+}
+
+func (b *Bucket) TableName() string {
+	return "careful_tools_bucket"
+}
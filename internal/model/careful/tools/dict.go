@@ -11,19 +11,19 @@ package tools
 import (
 	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/tools/dict"
 	"github.com/carefuly/careful-admin-go-gin/pkg/models"
-	"go.uber.org/zap"
-	"gorm.io/gorm"
 )
 
 // Dict 字典表
+// 启用软删除，字典名称与字典编码的唯一索引包含删除时间，见迁移文件
 type Dict struct {
 	models.CoreModels
+	models.SoftDelete
 
-	Status    bool                `gorm:"type:boolean;index:idx_status;default:false;column:status;comment:状态【true-启用 false-停用】" json:"status"` // 状态
-	Name      string              `gorm:"type:varchar(100);not null;uniqueIndex;column:name;comment:字典名称" json:"name"`                          // 字典名称
-	Code      string              `gorm:"type:varchar(100);not null;uniqueIndex;column:code;comment:字典编码" json:"code"`                          // 字典编码
-	Type      dict.TypeConst      `gorm:"type:tinyint;default:1;index:idx_type;column:type;comment:字典类型" json:"type"`                           // 字典类型
-	ValueType dict.ValueTypeConst `gorm:"type:tinyint;default:1;index:idx_value_type;column:valueType;comment:数据类型" json:"valueType"`           // 数据类型
+	Status    bool                `gorm:"type:boolean;index:idx_status;default:false;column:status;comment:状态【true-启用 false-停用】" json:"status" excel:"title=状态;width=10;enum=dict.StatusMapping;readonly;order=101"` // 状态
+	Name      string              `gorm:"type:varchar(100);not null;column:name;comment:字典名称" json:"name" excel:"title=字典名称;width=22;required"`                                                                      // 字典名称
+	Code      string              `gorm:"type:varchar(100);not null;column:code;comment:字典编码" json:"code" excel:"title=字典编码;width=17;required"`                                                                      // 字典编码
+	Type      dict.TypeConst      `gorm:"type:tinyint;default:1;index:idx_type;column:type;comment:字典类型" json:"type" excel:"title=字典类型;width=15;enum=dict.TypeMapping;required"`                                     // 字典类型
+	ValueType dict.ValueTypeConst `gorm:"type:tinyint;default:1;index:idx_value_type;column:valueType;comment:数据类型" json:"valueType" excel:"title=字典类型值;width=15;enum=dict.TypeValueMapping;required"`               // 数据类型
 }
 
 func NewDict() *Dict {
@@ -33,10 +33,3 @@ func NewDict() *Dict {
 func (d *Dict) TableName() string {
 	return "careful_tools_dict"
 }
-
-func (d *Dict) AutoMigrate(db *gorm.DB) {
-	err := db.Set("gorm:table_options", "ENGINE=InnoDB,COMMENT='字典表'").AutoMigrate(&Dict{})
-	if err != nil {
-		zap.L().Error("Dict表模型迁移失败", zap.Error(err))
-	}
-}
@@ -0,0 +1,38 @@
+/**
+ * Description：
+ * FileName：file.go
+ * Author：CJiaの用心
+ * Create：2025/11/11 15:40:03
+ * Remark：
+ */
+
+package tools
+
+import (
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+)
+
+// File 文件表，同一存储桶内内容相同的文件共用一个存储对象
+// 启用软删除，回收站中的文件仍引用存储对象，彻底删除后才释放
+type File struct {
+	models.CoreModels
+	models.SoftDelete
+
+	BucketId   string `gorm:"type:varchar(110);not null;index:idx_bucket_hash,priority:1;column:bucket_id;comment:存储桶ID" json:"bucketId"` // 存储桶ID
+	BucketCode string `gorm:"type:varchar(64);not null;column:bucketCode;comment:存储桶编码" json:"bucketCode"`                                // 存储桶编码
+	Name       string `gorm:"type:varchar(255);not null;index:idx_name;column:name;comment:文件名称" json:"name"`                             // 文件名称
+	Ext        string `gorm:"type:varchar(32);column:ext;comment:扩展名" json:"ext"`                                                         // 扩展名
+	MimeType   string `gorm:"type:varchar(128);column:mimeType;comment:文件类型" json:"mimeType"`                                             // 文件类型
+	Size       int64  `gorm:"type:bigint;default:0;column:size;comment:文件大小(字节)" json:"size"`                                             // 文件大小
+	Hash       string `gorm:"type:char(64);not null;index:idx_bucket_hash,priority:2;column:hash;comment:内容哈希(SHA-256)" json:"hash"`      // 内容哈希
+	ObjectKey  string `gorm:"type:varchar(255);not null;column:objectKey;comment:存储对象键" json:"-"`                                         // 存储对象键
+	Driver     string `gorm:"type:varchar(20);not null;column:driver;comment:存储驱动" json:"driver"`                                         // 存储驱动
+}
+
+func NewFile() *File {
+	return &File{}
+}
+
+func (f *File) TableName() string {
+	return "careful_tools_file"
+}
@@ -0,0 +1,48 @@
+/**
+ * Description：
+ * FileName：job.go
+ * Author：CJiaの用心
+ * Create：2025/11/7 10:31:08
+ * Remark：
+ */
+
+package tools
+
+import (
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/tools/job"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	"time"
+)
+
+// Job 后台任务表
+type Job struct {
+	models.CoreModels
+
+	Name         string          `gorm:"type:varchar(100);not null;column:name;comment:任务名称" json:"name"`                                                // 任务名称
+	Type         job.TypeConst   `gorm:"type:tinyint;index:idx_type;column:type;comment:任务类型【1-导入 2-导出】" json:"type"`                                    // 任务类型
+	Status       job.StatusConst `gorm:"type:tinyint;default:1;index:idx_status;column:status;comment:任务状态【1-排队中 2-执行中 3-已完成 4-失败 5-已过期】" json:"status"` // 任务状态
+	Total        int64           `gorm:"type:bigint;default:0;column:total;comment:总条数" json:"total"`                                                    // 总条数
+	Processed    int64           `gorm:"type:bigint;default:0;column:processed;comment:已处理条数" json:"processed"`                                          // 已处理条数
+	SuccessCount int64           `gorm:"type:bigint;default:0;column:successCount;comment:成功条数" json:"successCount"`                                     // 成功条数
+	FailCount    int64           `gorm:"type:bigint;default:0;column:failCount;comment:失败条数" json:"failCount"`                                           // 失败条数
+	Message      string          `gorm:"type:varchar(512);column:message;comment:结果信息" json:"message"`                                                   // 结果信息
+	Result       string          `gorm:"type:mediumtext;column:result;comment:结果详情(JSON)" json:"-"`                                                      // 结果详情(JSON)
+	Artifact     string          `gorm:"type:varchar(255);column:artifact;comment:结果文件路径" json:"-"`                                                      // 结果文件路径
+	ArtifactName string          `gorm:"type:varchar(255);column:artifactName;comment:结果文件名" json:"artifactName"`                                        // 结果文件名
+	StartTime    *time.Time      `gorm:"column:start_time;comment:开始时间" json:"-"`                                                                        // 开始时间
+	FinishTime   *time.Time      `gorm:"column:finish_time;comment:结束时间" json:"-"`                                                                       // 结束时间
+	ExpireTime   *time.Time      `gorm:"index:idx_expire_time;column:expire_time;comment:结果文件过期时间" json:"-"`                                             // 结果文件过期时间
+}
+
+func NewJob() *Job {
+	return &Job{}
+}
+
+func (j *Job) TableName() string {
+	return "careful_tools_job"
+}
+
+// SkipDataLog 任务进度频繁更新，不记录数据变更
+func (j *Job) SkipDataLog() bool {
+	return true
+}
@@ -0,0 +1,82 @@
+/**
+ * Description：
+ * FileName：data_scope.go
+ * Author：CJiaの用心
+ * Create：2025/11/3 10:16:27
+ * Remark：
+ */
+
+package system
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"github.com/redis/go-redis/v9"
+	"time"
+)
+
+var (
+	ErrDataScopeNotExist = redis.Nil
+	ErrDataScopeKey      = "careful:system:dataScope:user"
+)
+
+type DataScopeCache interface {
+	Get(ctx context.Context, userId string) (*filters.DataScope, error)
+	Set(ctx context.Context, userId string, scope filters.DataScope) error
+	Del(ctx context.Context, userIds ...string) error
+	Key(userId string) string
+}
+
+type RedisDataScopeCache struct {
+	cmd        redis.Cmdable
+	expiration time.Duration
+}
+
+// NewRedisDataScopeCache 用户数据权限缓存
+// 角色变更时主动清理，部门树与用户部门调整依赖较短的过期时间
+func NewRedisDataScopeCache(cmd redis.Cmdable) DataScopeCache {
+	return &RedisDataScopeCache{
+		cmd:        cmd,
+		expiration: time.Minute * 10,
+	}
+}
+
+func (c *RedisDataScopeCache) Get(ctx context.Context, userId string) (*filters.DataScope, error) {
+	data, err := c.cmd.Get(ctx, c.Key(userId)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrDataScopeNotExist
+		}
+		return nil, err
+	}
+
+	var scope filters.DataScope
+	err = json.Unmarshal([]byte(data), &scope)
+	return &scope, err
+}
+
+func (c *RedisDataScopeCache) Set(ctx context.Context, userId string, scope filters.DataScope) error {
+	data, err := json.Marshal(scope)
+	if err != nil {
+		return err
+	}
+	return c.cmd.Set(ctx, c.Key(userId), data, c.expiration).Err()
+}
+
+func (c *RedisDataScopeCache) Del(ctx context.Context, userIds ...string) error {
+	if len(userIds) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(userIds))
+	for _, userId := range userIds {
+		keys = append(keys, c.Key(userId))
+	}
+	return c.cmd.Del(ctx, keys...).Err()
+}
+
+func (c *RedisDataScopeCache) Key(userId string) string {
+	return fmt.Sprintf("%s:%s", ErrDataScopeKey, userId)
+}
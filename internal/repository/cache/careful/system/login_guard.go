@@ -0,0 +1,139 @@
+/**
+ * Description：
+ * FileName：login_guard.go
+ * Author：CJiaの用心
+ * Create：2025/10/31 14:48:52
+ * Remark：
+ */
+
+package system
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	"strconv"
+	"time"
+)
+
+var (
+	ErrCaptchaNotExist = redis.Nil
+	ErrLoginGuardKey   = "careful:auth:login"
+)
+
+type LoginGuardCache interface {
+	// GetFailures 获取用户名与IP在统计窗口内的失败次数
+	GetFailures(ctx context.Context, username, ip string) (int64, int64, error)
+	// IncrFailures 累加失败次数，首次失败时开始计算窗口
+	IncrFailures(ctx context.Context, username, ip string, window time.Duration) (int64, int64, error)
+	// ResetFailures 清除用户名与IP的失败次数与锁定
+	ResetFailures(ctx context.Context, username, ip string) error
+	// Lock 锁定用户名或IP，subject 为 user 或 ip
+	Lock(ctx context.Context, subject, value string, until time.Time) error
+	// GetLockedUntil 获取用户名与IP中较晚的解锁时间，未锁定返回零值
+	GetLockedUntil(ctx context.Context, username, ip string) (time.Time, error)
+	// SetCaptcha 保存验证码
+	SetCaptcha(ctx context.Context, id, code string, expiration time.Duration) error
+	// TakeCaptcha 获取并删除验证码，确保一次性使用
+	TakeCaptcha(ctx context.Context, id string) (string, error)
+}
+
+type RedisLoginGuardCache struct {
+	cmd redis.Cmdable
+}
+
+func NewRedisLoginGuardCache(cmd redis.Cmdable) LoginGuardCache {
+	return &RedisLoginGuardCache{
+		cmd: cmd,
+	}
+}
+
+func (c *RedisLoginGuardCache) GetFailures(ctx context.Context, username, ip string) (int64, int64, error) {
+	values, err := c.cmd.MGet(ctx, c.failKey("user", username), c.failKey("ip", ip)).Result()
+	if err != nil {
+		return 0, 0, err
+	}
+	return toInt64(values[0]), toInt64(values[1]), nil
+}
+
+func (c *RedisLoginGuardCache) IncrFailures(ctx context.Context, username, ip string, window time.Duration) (int64, int64, error) {
+	userKey, ipKey := c.failKey("user", username), c.failKey("ip", ip)
+
+	pipe := c.cmd.TxPipeline()
+	userIncr := pipe.Incr(ctx, userKey)
+	pipe.ExpireNX(ctx, userKey, window)
+	ipIncr := pipe.Incr(ctx, ipKey)
+	pipe.ExpireNX(ctx, ipKey, window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, 0, err
+	}
+	return userIncr.Val(), ipIncr.Val(), nil
+}
+
+func (c *RedisLoginGuardCache) ResetFailures(ctx context.Context, username, ip string) error {
+	return c.cmd.Del(ctx,
+		c.failKey("user", username), c.lockKey("user", username),
+		c.failKey("ip", ip), c.lockKey("ip", ip),
+	).Err()
+}
+
+func (c *RedisLoginGuardCache) Lock(ctx context.Context, subject, value string, until time.Time) error {
+	ttl := time.Until(until)
+	if ttl <= 0 {
+		return nil
+	}
+	return c.cmd.Set(ctx, c.lockKey(subject, value), until.Unix(), ttl).Err()
+}
+
+func (c *RedisLoginGuardCache) GetLockedUntil(ctx context.Context, username, ip string) (time.Time, error) {
+	values, err := c.cmd.MGet(ctx, c.lockKey("user", username), c.lockKey("ip", ip)).Result()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var until time.Time
+	for _, v := range values {
+		if ts := toInt64(v); ts > 0 && time.Unix(ts, 0).After(until) {
+			until = time.Unix(ts, 0)
+		}
+	}
+	return until, nil
+}
+
+func (c *RedisLoginGuardCache) SetCaptcha(ctx context.Context, id, code string, expiration time.Duration) error {
+	return c.cmd.Set(ctx, c.captchaKey(id), code, expiration).Err()
+}
+
+func (c *RedisLoginGuardCache) TakeCaptcha(ctx context.Context, id string) (string, error) {
+	code, err := c.cmd.GetDel(ctx, c.captchaKey(id)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", ErrCaptchaNotExist
+		}
+		return "", err
+	}
+	return code, nil
+}
+
+func (c *RedisLoginGuardCache) failKey(subject, value string) string {
+	return fmt.Sprintf("%s:fail:%s:%s", ErrLoginGuardKey, subject, value)
+}
+
+func (c *RedisLoginGuardCache) lockKey(subject, value string) string {
+	return fmt.Sprintf("%s:lock:%s:%s", ErrLoginGuardKey, subject, value)
+}
+
+func (c *RedisLoginGuardCache) captchaKey(id string) string {
+	return fmt.Sprintf("%s:captcha:%s", ErrLoginGuardKey, id)
+}
+
+// toInt64 解析 MGet 返回值，不存在或非法时返回0
+func toInt64(v interface{}) int64 {
+	s, ok := v.(string)
+	if !ok {
+		return 0
+	}
+	n, _ := strconv.ParseInt(s, 10, 64)
+	return n
+}
@@ -0,0 +1,80 @@
+/**
+ * Description：
+ * FileName：permission.go
+ * Author：CJiaの用心
+ * Create：2025/10/27 11:02:40
+ * Remark：
+ */
+
+package system
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
+	"github.com/redis/go-redis/v9"
+	"time"
+)
+
+var (
+	ErrUserPermissionNotExist = redis.Nil
+	ErrUserPermissionKey      = "careful:system:permission:user"
+)
+
+type UserPermissionCache interface {
+	Get(ctx context.Context, userId string) (*domainSystem.UserPermission, error)
+	Set(ctx context.Context, userId string, domain domainSystem.UserPermission) error
+	Del(ctx context.Context, userIds ...string) error
+	Key(userId string) string
+}
+
+type RedisUserPermissionCache struct {
+	cmd        redis.Cmdable
+	expiration time.Duration
+}
+
+func NewRedisUserPermissionCache(cmd redis.Cmdable) UserPermissionCache {
+	return &RedisUserPermissionCache{
+		cmd:        cmd,
+		expiration: time.Minute * 30,
+	}
+}
+
+func (c *RedisUserPermissionCache) Get(ctx context.Context, userId string) (*domainSystem.UserPermission, error) {
+	data, err := c.cmd.Get(ctx, c.Key(userId)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrUserPermissionNotExist
+		}
+		return nil, err
+	}
+
+	var doMain domainSystem.UserPermission
+	err = json.Unmarshal([]byte(data), &doMain)
+	return &doMain, err
+}
+
+func (c *RedisUserPermissionCache) Set(ctx context.Context, userId string, domain domainSystem.UserPermission) error {
+	data, err := json.Marshal(domain)
+	if err != nil {
+		return err
+	}
+	return c.cmd.Set(ctx, c.Key(userId), data, c.expiration).Err()
+}
+
+func (c *RedisUserPermissionCache) Del(ctx context.Context, userIds ...string) error {
+	if len(userIds) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(userIds))
+	for _, userId := range userIds {
+		keys = append(keys, c.Key(userId))
+	}
+	return c.cmd.Del(ctx, keys...).Err()
+}
+
+func (c *RedisUserPermissionCache) Key(userId string) string {
+	return fmt.Sprintf("%s:%s", ErrUserPermissionKey, userId)
+}
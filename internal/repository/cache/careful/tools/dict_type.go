@@ -21,6 +21,7 @@ import (
 var (
 	ErrDictTypeNotExist = redis.Nil
 	ErrDictTypeKey      = "careful:tools:dict_type:info"
+	ErrDictOptionsKey   = "careful:tools:dict_type:options"
 )
 
 type DictTypeCache interface {
@@ -28,6 +29,11 @@ type DictTypeCache interface {
 	Set(ctx context.Context, domain domainTools.DictType) error
 	Del(ctx context.Context, id string) error
 	SetNotFound(ctx context.Context, id string) error // 防止缓存穿透
+
+	// 按字典名称缓存字典选项，字典项变更时删除所属字典的缓存
+	GetOptions(ctx context.Context, dictName string) ([]domainTools.DictOption, error)
+	SetOptions(ctx context.Context, dictName string, options []domainTools.DictOption) error
+	DelOptions(ctx context.Context, dictNames ...string) error
 }
 
 type RedisDictTypeCache struct {
@@ -83,6 +89,48 @@ func (c *RedisDictTypeCache) SetNotFound(ctx context.Context, id string) error {
 	return c.cmd.Set(ctx, key, "not_found", time.Minute).Err()
 }
 
+func (c *RedisDictTypeCache) GetOptions(ctx context.Context, dictName string) ([]domainTools.DictOption, error) {
+	data, err := c.cmd.Get(ctx, c.optionsKey(dictName)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	var options []domainTools.DictOption
+	err = json.Unmarshal([]byte(data), &options)
+	return options, err
+}
+
+func (c *RedisDictTypeCache) SetOptions(ctx context.Context, dictName string, options []domainTools.DictOption) error {
+	if options == nil {
+		options = []domainTools.DictOption{}
+	}
+	data, err := json.Marshal(options)
+	if err != nil {
+		return err
+	}
+	// 空字典使用较短的有效期，避免新增字典项前长时间命中空缓存
+	expiration := c.expiration
+	if len(options) == 0 {
+		expiration = time.Minute
+	}
+	return c.cmd.Set(ctx, c.optionsKey(dictName), data, expiration).Err()
+}
+
+func (c *RedisDictTypeCache) DelOptions(ctx context.Context, dictNames ...string) error {
+	if len(dictNames) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(dictNames))
+	for _, name := range dictNames {
+		keys = append(keys, c.optionsKey(name))
+	}
+	return c.cmd.Del(ctx, keys...).Err()
+}
+
+func (c *RedisDictTypeCache) optionsKey(dictName string) string {
+	return fmt.Sprintf("%s:%s", ErrDictOptionsKey, dictName)
+}
+
 func (c *RedisDictTypeCache) key(id string) string {
 	return fmt.Sprintf("%s:%s", ErrDictTypeKey, id)
 }
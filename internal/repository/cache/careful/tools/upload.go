@@ -0,0 +1,142 @@
+/**
+ * Description：
+ * FileName：upload.go
+ * Author：CJiaの用心
+ * Create：2025/11/12 09:55:03
+ * Remark：
+ */
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	domainTools "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/tools"
+	"github.com/redis/go-redis/v9"
+	"sort"
+	"strconv"
+	"time"
+)
+
+var (
+	ErrUploadSessionNotExist = redis.Nil
+	ErrUploadKey             = "careful:tools:upload"
+)
+
+type UploadCache interface {
+	// SetSession 保存上传会话，并记录同一用户同一文件的会话用于断点续传
+	SetSession(ctx context.Context, session domainTools.UploadSession, expiration time.Duration) error
+	// GetSession 获取上传会话，不含已上传分片
+	GetSession(ctx context.Context, id string) (*domainTools.UploadSession, error)
+	// FindResumable 获取同一用户上传同一文件的未完成会话ID
+	FindResumable(ctx context.Context, userId, bucket, hash string) (string, error)
+	// AddChunk 记录已上传分片，并顺延会话过期时间
+	AddChunk(ctx context.Context, session domainTools.UploadSession, index int, expiration time.Duration) error
+	// Chunks 获取已上传的分片序号(升序)
+	Chunks(ctx context.Context, id string) ([]int, error)
+	// Del 删除上传会话
+	Del(ctx context.Context, session domainTools.UploadSession) error
+}
+
+type RedisUploadCache struct {
+	cmd redis.Cmdable
+}
+
+func NewRedisUploadCache(cmd redis.Cmdable) UploadCache {
+	return &RedisUploadCache{
+		cmd: cmd,
+	}
+}
+
+func (c *RedisUploadCache) SetSession(ctx context.Context, session domainTools.UploadSession, expiration time.Duration) error {
+	session.Uploaded = nil
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+
+	pipe := c.cmd.TxPipeline()
+	pipe.Set(ctx, c.sessionKey(session.Id), data, expiration)
+	pipe.Set(ctx, c.resumeKey(session.UserId, session.Bucket, session.Hash), session.Id, expiration)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+func (c *RedisUploadCache) GetSession(ctx context.Context, id string) (*domainTools.UploadSession, error) {
+	data, err := c.cmd.Get(ctx, c.sessionKey(id)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrUploadSessionNotExist
+		}
+		return nil, err
+	}
+
+	var session domainTools.UploadSession
+	if err := json.Unmarshal(data, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+func (c *RedisUploadCache) FindResumable(ctx context.Context, userId, bucket, hash string) (string, error) {
+	id, err := c.cmd.Get(ctx, c.resumeKey(userId, bucket, hash)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", ErrUploadSessionNotExist
+		}
+		return "", err
+	}
+	return id, nil
+}
+
+func (c *RedisUploadCache) AddChunk(ctx context.Context, session domainTools.UploadSession, index int, expiration time.Duration) error {
+	chunksKey := c.chunksKey(session.Id)
+
+	pipe := c.cmd.TxPipeline()
+	pipe.SAdd(ctx, chunksKey, index)
+	pipe.Expire(ctx, chunksKey, expiration)
+	pipe.Expire(ctx, c.sessionKey(session.Id), expiration)
+	pipe.Expire(ctx, c.resumeKey(session.UserId, session.Bucket, session.Hash), expiration)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (c *RedisUploadCache) Chunks(ctx context.Context, id string) ([]int, error) {
+	members, err := c.cmd.SMembers(ctx, c.chunksKey(id)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	chunks := make([]int, 0, len(members))
+	for _, member := range members {
+		index, err := strconv.Atoi(member)
+		if err != nil {
+			continue
+		}
+		chunks = append(chunks, index)
+	}
+	sort.Ints(chunks)
+	return chunks, nil
+}
+
+func (c *RedisUploadCache) Del(ctx context.Context, session domainTools.UploadSession) error {
+	return c.cmd.Del(ctx,
+		c.sessionKey(session.Id),
+		c.chunksKey(session.Id),
+		c.resumeKey(session.UserId, session.Bucket, session.Hash),
+	).Err()
+}
+
+func (c *RedisUploadCache) sessionKey(id string) string {
+	return fmt.Sprintf("%s:session:%s", ErrUploadKey, id)
+}
+
+func (c *RedisUploadCache) chunksKey(id string) string {
+	return fmt.Sprintf("%s:chunks:%s", ErrUploadKey, id)
+}
+
+func (c *RedisUploadCache) resumeKey(userId, bucket, hash string) string {
+	return fmt.Sprintf("%s:resume:%s:%s:%s", ErrUploadKey, userId, bucket, hash)
+}
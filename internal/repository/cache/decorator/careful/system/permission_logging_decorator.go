@@ -0,0 +1,121 @@
+/**
+ * Description：
+ * FileName：permission_logging_decorator.go
+ * Author：CJiaの用心
+ * Create：2025/10/27 11:10:18
+ * Remark：
+ */
+
+package system
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
+	modelLogger "github.com/carefuly/careful-admin-go-gin/internal/model/careful/logger"
+	cacheSystem "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/careful/system"
+	cacheRecord "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/decorator/record"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type UserPermissionCacheLoggingDecorator struct {
+	cache  cacheSystem.UserPermissionCache
+	logger cacheRecord.CacheLogger
+}
+
+func NewUserPermissionCacheLoggingDecorator(cache cacheSystem.UserPermissionCache, logger cacheRecord.CacheLogger) UserPermissionCacheLoggingDecorator {
+	return UserPermissionCacheLoggingDecorator{
+		cache:  cache,
+		logger: logger,
+	}
+}
+
+// 通用日志记录函数
+func (d *UserPermissionCacheLoggingDecorator) logOperation(
+	ctx context.Context,
+	key string,
+	value interface{},
+	err error,
+	start time.Time,
+) {
+	request, ok := ctx.Value("request").(*http.Request)
+	if !ok {
+		return // 没有请求上下文，不记录日志
+	}
+
+	entity := &modelLogger.CacheLogger{
+		CoreModels: models.CoreModels{
+			Creator:    d.getStringFromContext(ctx, "userId"),
+			Modifier:   d.getStringFromContext(ctx, "userId"),
+			BelongDept: d.getStringFromContext(ctx, "deptId"),
+		},
+		CacheHost:     request.Host,
+		CacheIp:       d.getStringFromContext(ctx, "requestIp"),
+		CacheUsername: d.getStringFromContext(ctx, "username"),
+		CacheMethod:   request.Method,
+		CachePath:     request.URL.Path,
+		CacheKey:      d.key(key),
+		CacheTime:     time.Since(start).String(),
+	}
+
+	if err != nil {
+		entity.CacheError = err.Error()
+	}
+
+	// 处理值
+	if value != nil {
+		if data, err := json.Marshal(value); err == nil {
+			entity.CacheValue = string(data)
+		}
+	}
+
+	// 异步记录日志
+	go d.logger.Log(ctx, entity)
+}
+
+// 从上下文中安全获取字符串值
+func (d *UserPermissionCacheLoggingDecorator) getStringFromContext(ctx context.Context, key string) string {
+	if val, ok := ctx.Value(key).(string); ok {
+		return val
+	}
+	return ""
+}
+
+func (d *UserPermissionCacheLoggingDecorator) Get(ctx context.Context, userId string) (*domainSystem.UserPermission, error) {
+	start := time.Now()
+	result, err := d.cache.Get(ctx, userId)
+
+	// 特殊处理"未找到"情况
+	var value interface{}
+	if errors.Is(err, cacheSystem.ErrUserPermissionNotExist) {
+		value = "not_found"
+	} else if result != nil {
+		value = result
+	}
+
+	d.logOperation(ctx, userId, value, err, start)
+	return result, err
+}
+
+func (d *UserPermissionCacheLoggingDecorator) Set(ctx context.Context, userId string, domain domainSystem.UserPermission) error {
+	start := time.Now()
+	err := d.cache.Set(ctx, userId, domain)
+	d.logOperation(ctx, userId, domain, err, start)
+	return err
+}
+
+func (d *UserPermissionCacheLoggingDecorator) Del(ctx context.Context, userIds ...string) error {
+	start := time.Now()
+	err := d.cache.Del(ctx, userIds...)
+	d.logOperation(ctx, strings.Join(userIds, ","), "not_found", err, start)
+	return err
+}
+
+func (d *UserPermissionCacheLoggingDecorator) key(userId string) string {
+	return fmt.Sprintf("%s:%s", cacheSystem.ErrUserPermissionKey, userId)
+}
@@ -58,7 +58,7 @@ func (d *DictTypeCacheLoggingDecorator) logOperation(
 		CacheUsername: d.getStringFromContext(ctx, "username"),
 		CacheMethod:   request.Method,
 		CachePath:     request.URL.Path,
-		CacheKey:      d.key(key),
+		CacheKey:      key,
 		CacheTime:     time.Since(start).String(),
 	}
 
@@ -97,31 +97,67 @@ func (d *DictTypeCacheLoggingDecorator) Get(ctx context.Context, id string) (*do
 		value = result
 	}
 
-	d.logOperation(ctx, id, value, err, start)
+	d.logOperation(ctx, d.key(id), value, err, start)
 	return result, err
 }
 
 func (d *DictTypeCacheLoggingDecorator) Set(ctx context.Context, domain domainTools.DictType) error {
 	start := time.Now()
 	err := d.cache.Set(ctx, domain)
-	d.logOperation(ctx, domain.Id, domain, err, start)
+	d.logOperation(ctx, d.key(domain.Id), domain, err, start)
 	return err
 }
 
 func (d *DictTypeCacheLoggingDecorator) Del(ctx context.Context, id string) error {
 	start := time.Now()
 	err := d.cache.Del(ctx, id)
-	d.logOperation(ctx, id, "not_found", err, start)
+	d.logOperation(ctx, d.key(id), "not_found", err, start)
 	return err
 }
 
 func (d *DictTypeCacheLoggingDecorator) SetNotFound(ctx context.Context, id string) error {
 	start := time.Now()
 	err := d.cache.SetNotFound(ctx, id)
-	d.logOperation(ctx, id, "not_found", err, start)
+	d.logOperation(ctx, d.key(id), "not_found", err, start)
+	return err
+}
+
+func (d *DictTypeCacheLoggingDecorator) GetOptions(ctx context.Context, dictName string) ([]domainTools.DictOption, error) {
+	start := time.Now()
+	result, err := d.cache.GetOptions(ctx, dictName)
+
+	// 特殊处理"未找到"情况
+	var value interface{}
+	if errors.Is(err, cacheTools.ErrDictTypeNotExist) {
+		value = "not_found"
+	} else if result != nil {
+		value = result
+	}
+
+	d.logOperation(ctx, d.optionsKey(dictName), value, err, start)
+	return result, err
+}
+
+func (d *DictTypeCacheLoggingDecorator) SetOptions(ctx context.Context, dictName string, options []domainTools.DictOption) error {
+	start := time.Now()
+	err := d.cache.SetOptions(ctx, dictName, options)
+	d.logOperation(ctx, d.optionsKey(dictName), options, err, start)
+	return err
+}
+
+func (d *DictTypeCacheLoggingDecorator) DelOptions(ctx context.Context, dictNames ...string) error {
+	start := time.Now()
+	err := d.cache.DelOptions(ctx, dictNames...)
+	for _, name := range dictNames {
+		d.logOperation(ctx, d.optionsKey(name), "not_found", err, start)
+	}
 	return err
 }
 
 func (d *DictTypeCacheLoggingDecorator) key(id string) string {
 	return fmt.Sprintf("%s:%s", cacheTools.ErrDictTypeKey, id)
 }
+
+func (d *DictTypeCacheLoggingDecorator) optionsKey(dictName string) string {
+	return fmt.Sprintf("%s:%s", cacheTools.ErrDictOptionsKey, dictName)
+}
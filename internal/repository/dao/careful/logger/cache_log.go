@@ -0,0 +1,107 @@
+/**
+ * Description：
+ * FileName：cache_log.go
+ * Author：CJiaの用心
+ * Create：2025/10/30 09:51:05
+ * Remark：
+ */
+
+package logger
+
+import (
+	"context"
+	domainLogger "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/logger"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/logger"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrCacheLogNotFound = gorm.ErrRecordNotFound
+)
+
+type CacheLogDAO interface {
+	BatchDelete(ctx context.Context, ids []string) error
+	Clear(ctx context.Context) error
+
+	FindById(ctx context.Context, id string) (*logger.CacheLogger, error)
+	FindListPage(ctx context.Context, filter domainLogger.CacheLogFilter) ([]*logger.CacheLogger, int64, error)
+	FindListAll(ctx context.Context, filter domainLogger.CacheLogFilter) ([]*logger.CacheLogger, error)
+	FindBatch(ctx context.Context, filter domainLogger.CacheLogFilter, cursor *filters.Cursor, limit int) ([]*logger.CacheLogger, error)
+}
+
+type GORMCacheLogDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMCacheLogDAO(db *gorm.DB) CacheLogDAO {
+	return &GORMCacheLogDAO{
+		db: db,
+	}
+}
+
+// BatchDelete 批量删除
+func (dao *GORMCacheLogDAO) BatchDelete(ctx context.Context, ids []string) error {
+	return dao.db.WithContext(ctx).Where("id IN ?", ids).Delete(&logger.CacheLogger{}).Error
+}
+
+// Clear 清空缓存日志
+func (dao *GORMCacheLogDAO) Clear(ctx context.Context) error {
+	return dao.db.WithContext(ctx).
+		Session(&gorm.Session{AllowGlobalUpdate: true}).
+		Delete(&logger.CacheLogger{}).Error
+}
+
+// FindById 根据id获取详情
+func (dao *GORMCacheLogDAO) FindById(ctx context.Context, id string) (*logger.CacheLogger, error) {
+	var model logger.CacheLogger
+	err := dao.db.WithContext(ctx).Where("id = ?", id).First(&model).Error
+	return &model, err
+}
+
+// FindListPage 分页查询
+func (dao *GORMCacheLogDAO) FindListPage(ctx context.Context, filter domainLogger.CacheLogFilter) ([]*logger.CacheLogger, int64, error) {
+	var total int64
+	var models []*logger.CacheLogger
+
+	query := dao.buildQuery(ctx, filter)
+
+	err := query.Count(&total).
+		Offset((filter.Page - 1) * filter.PageSize).
+		Limit(filter.PageSize).
+		Find(&models).Error
+
+	return models, total, err
+}
+
+// FindListAll 获取所有列表
+func (dao *GORMCacheLogDAO) FindListAll(ctx context.Context, filter domainLogger.CacheLogFilter) ([]*logger.CacheLogger, error) {
+	var models []*logger.CacheLogger
+
+	query := dao.buildQuery(ctx, filter)
+
+	// 查询
+	if err := query.Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+// FindBatch 按游标分批查询，用于大数据量导出
+func (dao *GORMCacheLogDAO) FindBatch(ctx context.Context, filter domainLogger.CacheLogFilter, cursor *filters.Cursor, limit int) ([]*logger.CacheLogger, error) {
+	var models []*logger.CacheLogger
+
+	query := cursor.QueryFilter(ctx, dao.buildQuery(ctx, filter))
+
+	if err := query.Limit(limit).Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+// buildQuery 构建查询条件
+func (dao *GORMCacheLogDAO) buildQuery(ctx context.Context, filter domainLogger.CacheLogFilter) *gorm.DB {
+	return filter.QueryFilter(ctx, dao.db.WithContext(ctx).Model(&logger.CacheLogger{}))
+}
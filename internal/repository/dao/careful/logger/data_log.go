@@ -0,0 +1,45 @@
+/**
+ * Description：
+ * FileName：data_log.go
+ * Author：CJiaの用心
+ * Create：2025/11/14 14:31:08
+ * Remark：
+ */
+
+package logger
+
+import (
+	"context"
+	domainLogger "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/logger"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/logger"
+	"gorm.io/gorm"
+)
+
+type DataLogDAO interface {
+	FindListPage(ctx context.Context, filter domainLogger.DataLogFilter) ([]*logger.DataLogger, int64, error)
+}
+
+type GORMDataLogDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMDataLogDAO(db *gorm.DB) DataLogDAO {
+	return &GORMDataLogDAO{
+		db: db,
+	}
+}
+
+// FindListPage 分页查询
+func (dao *GORMDataLogDAO) FindListPage(ctx context.Context, filter domainLogger.DataLogFilter) ([]*logger.DataLogger, int64, error) {
+	var total int64
+	var models []*logger.DataLogger
+
+	query := filter.QueryFilter(ctx, dao.db.WithContext(ctx).Model(&logger.DataLogger{}))
+
+	err := query.Count(&total).
+		Offset((filter.Page - 1) * filter.PageSize).
+		Limit(filter.PageSize).
+		Find(&models).Error
+
+	return models, total, err
+}
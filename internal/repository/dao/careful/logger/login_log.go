@@ -0,0 +1,107 @@
+/**
+ * Description：
+ * FileName：login_log.go
+ * Author：CJiaの用心
+ * Create：2025/10/30 09:46:38
+ * Remark：
+ */
+
+package logger
+
+import (
+	"context"
+	domainLogger "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/logger"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/logger"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrLoginLogNotFound = gorm.ErrRecordNotFound
+)
+
+type LoginLogDAO interface {
+	BatchDelete(ctx context.Context, ids []string) error
+	Clear(ctx context.Context) error
+
+	FindById(ctx context.Context, id string) (*logger.LoginLogger, error)
+	FindListPage(ctx context.Context, filter domainLogger.LoginLogFilter) ([]*logger.LoginLogger, int64, error)
+	FindListAll(ctx context.Context, filter domainLogger.LoginLogFilter) ([]*logger.LoginLogger, error)
+	FindBatch(ctx context.Context, filter domainLogger.LoginLogFilter, cursor *filters.Cursor, limit int) ([]*logger.LoginLogger, error)
+}
+
+type GORMLoginLogDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMLoginLogDAO(db *gorm.DB) LoginLogDAO {
+	return &GORMLoginLogDAO{
+		db: db,
+	}
+}
+
+// BatchDelete 批量删除
+func (dao *GORMLoginLogDAO) BatchDelete(ctx context.Context, ids []string) error {
+	return dao.db.WithContext(ctx).Where("id IN ?", ids).Delete(&logger.LoginLogger{}).Error
+}
+
+// Clear 清空登录日志
+func (dao *GORMLoginLogDAO) Clear(ctx context.Context) error {
+	return dao.db.WithContext(ctx).
+		Session(&gorm.Session{AllowGlobalUpdate: true}).
+		Delete(&logger.LoginLogger{}).Error
+}
+
+// FindById 根据id获取详情
+func (dao *GORMLoginLogDAO) FindById(ctx context.Context, id string) (*logger.LoginLogger, error) {
+	var model logger.LoginLogger
+	err := dao.db.WithContext(ctx).Where("id = ?", id).First(&model).Error
+	return &model, err
+}
+
+// FindListPage 分页查询
+func (dao *GORMLoginLogDAO) FindListPage(ctx context.Context, filter domainLogger.LoginLogFilter) ([]*logger.LoginLogger, int64, error) {
+	var total int64
+	var models []*logger.LoginLogger
+
+	query := dao.buildQuery(ctx, filter)
+
+	err := query.Count(&total).
+		Offset((filter.Page - 1) * filter.PageSize).
+		Limit(filter.PageSize).
+		Find(&models).Error
+
+	return models, total, err
+}
+
+// FindListAll 获取所有列表
+func (dao *GORMLoginLogDAO) FindListAll(ctx context.Context, filter domainLogger.LoginLogFilter) ([]*logger.LoginLogger, error) {
+	var models []*logger.LoginLogger
+
+	query := dao.buildQuery(ctx, filter)
+
+	// 查询
+	if err := query.Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+// FindBatch 按游标分批查询，用于大数据量导出
+func (dao *GORMLoginLogDAO) FindBatch(ctx context.Context, filter domainLogger.LoginLogFilter, cursor *filters.Cursor, limit int) ([]*logger.LoginLogger, error) {
+	var models []*logger.LoginLogger
+
+	query := cursor.QueryFilter(ctx, dao.buildQuery(ctx, filter))
+
+	if err := query.Limit(limit).Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+// buildQuery 构建查询条件
+func (dao *GORMLoginLogDAO) buildQuery(ctx context.Context, filter domainLogger.LoginLogFilter) *gorm.DB {
+	return filter.QueryFilter(ctx, dao.db.WithContext(ctx).Model(&logger.LoginLogger{}))
+}
@@ -0,0 +1,107 @@
+/**
+ * Description：
+ * FileName：operate_log.go
+ * Author：CJiaの用心
+ * Create：2025/10/30 09:40:13
+ * Remark：
+ */
+
+package logger
+
+import (
+	"context"
+	domainLogger "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/logger"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/logger"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrOperateLogNotFound = gorm.ErrRecordNotFound
+)
+
+type OperateLogDAO interface {
+	BatchDelete(ctx context.Context, ids []string) error
+	Clear(ctx context.Context) error
+
+	FindById(ctx context.Context, id string) (*logger.OperateLogger, error)
+	FindListPage(ctx context.Context, filter domainLogger.OperateLogFilter) ([]*logger.OperateLogger, int64, error)
+	FindListAll(ctx context.Context, filter domainLogger.OperateLogFilter) ([]*logger.OperateLogger, error)
+	FindBatch(ctx context.Context, filter domainLogger.OperateLogFilter, cursor *filters.Cursor, limit int) ([]*logger.OperateLogger, error)
+}
+
+type GORMOperateLogDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMOperateLogDAO(db *gorm.DB) OperateLogDAO {
+	return &GORMOperateLogDAO{
+		db: db,
+	}
+}
+
+// BatchDelete 批量删除
+func (dao *GORMOperateLogDAO) BatchDelete(ctx context.Context, ids []string) error {
+	return dao.db.WithContext(ctx).Where("id IN ?", ids).Delete(&logger.OperateLogger{}).Error
+}
+
+// Clear 清空操作日志
+func (dao *GORMOperateLogDAO) Clear(ctx context.Context) error {
+	return dao.db.WithContext(ctx).
+		Session(&gorm.Session{AllowGlobalUpdate: true}).
+		Delete(&logger.OperateLogger{}).Error
+}
+
+// FindById 根据id获取详情
+func (dao *GORMOperateLogDAO) FindById(ctx context.Context, id string) (*logger.OperateLogger, error) {
+	var model logger.OperateLogger
+	err := dao.db.WithContext(ctx).Where("id = ?", id).First(&model).Error
+	return &model, err
+}
+
+// FindListPage 分页查询
+func (dao *GORMOperateLogDAO) FindListPage(ctx context.Context, filter domainLogger.OperateLogFilter) ([]*logger.OperateLogger, int64, error) {
+	var total int64
+	var models []*logger.OperateLogger
+
+	query := dao.buildQuery(ctx, filter)
+
+	err := query.Count(&total).
+		Offset((filter.Page - 1) * filter.PageSize).
+		Limit(filter.PageSize).
+		Find(&models).Error
+
+	return models, total, err
+}
+
+// FindListAll 获取所有列表
+func (dao *GORMOperateLogDAO) FindListAll(ctx context.Context, filter domainLogger.OperateLogFilter) ([]*logger.OperateLogger, error) {
+	var models []*logger.OperateLogger
+
+	query := dao.buildQuery(ctx, filter)
+
+	// 查询
+	if err := query.Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+// FindBatch 按游标分批查询，用于大数据量导出
+func (dao *GORMOperateLogDAO) FindBatch(ctx context.Context, filter domainLogger.OperateLogFilter, cursor *filters.Cursor, limit int) ([]*logger.OperateLogger, error) {
+	var models []*logger.OperateLogger
+
+	query := cursor.QueryFilter(ctx, dao.buildQuery(ctx, filter))
+
+	if err := query.Limit(limit).Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+// buildQuery 构建查询条件
+func (dao *GORMOperateLogDAO) buildQuery(ctx context.Context, filter domainLogger.OperateLogFilter) *gorm.DB {
+	return filter.QueryFilter(ctx, dao.db.WithContext(ctx).Model(&logger.OperateLogger{}))
+}
@@ -0,0 +1,465 @@
+/**
+ * Description：
+ * FileName：dept.go
+ * Author：CJiaの用心
+ * Create：2025/10/26 09:52:40
+ * Remark：
+ */
+
+package system
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"strings"
+	"time"
+)
+
+var (
+	ErrDeptNotFound             = gorm.ErrRecordNotFound
+	ErrDeptDuplicate            = errors.New("同级下部门名称和编码已存在")
+	ErrDeptVersionInconsistency = errors.New("数据已被修改，请刷新后重试")
+	ErrDeptParentNotFound       = errors.New("上级部门不存在")
+	ErrDeptHasChildren          = errors.New("存在子部门，不允许删除")
+	ErrDeptHasUsers             = errors.New("部门下存在用户，不允许删除")
+	ErrDeptMoveToDescendant     = errors.New("不能移动到自身或子部门下")
+)
+
+type DeptDAO interface {
+	Insert(ctx context.Context, model system.Dept) (*system.Dept, error)
+	Delete(ctx context.Context, id string) error
+	Update(ctx context.Context, model system.Dept) error
+	Move(ctx context.Context, id, parentId string, timestamp int64, modifier string) ([]string, error)
+
+	FindById(ctx context.Context, id string) (*system.Dept, error)
+	FindListAll(ctx context.Context, filter domainSystem.DeptFilter) ([]*system.Dept, error)
+	FindSubtree(ctx context.Context, id string, filter domainSystem.DeptFilter) ([]*system.Dept, error)
+	FindAncestors(ctx context.Context, id string) ([]*system.Dept, error)
+	FindSubtreeIds(ctx context.Context, ids []string) ([]string, error)
+
+	FindDeletedById(ctx context.Context, id string) (*system.Dept, error)
+	FindRecycleListPage(ctx context.Context, filter domainSystem.RecycleFilter) ([]*system.Dept, int64, error)
+	Restore(ctx context.Context, model system.Dept) error
+	Purge(ctx context.Context, ids []string) (int64, error)
+	PurgeExpired(ctx context.Context, before time.Time) (int64, error)
+}
+
+type GORMDeptDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMDeptDAO(db *gorm.DB) DeptDAO {
+	return &GORMDeptDAO{
+		db: db,
+	}
+}
+
+// Insert 新增
+func (dao *GORMDeptDAO) Insert(ctx context.Context, model system.Dept) (*system.Dept, error) {
+	err := dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if model.ParentID.Valid {
+			var parent system.Dept
+			err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+				Select("id").
+				Where("id = ?", model.ParentID.String).
+				First(&parent).Error
+			if err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return ErrDeptParentNotFound
+				}
+				return err
+			}
+		}
+
+		// BeforeCreate 钩子中计算 Level/Path
+		if err := tx.Create(&model).Error; err != nil {
+			return err
+		}
+
+		if model.ParentID.Valid {
+			return dao.refreshCounts(tx, model.ParentID.String)
+		}
+		return nil
+	})
+	return &model, err
+}
+
+// Delete 删除，移入回收站，存在未删除的子部门或用户时不允许删除
+func (dao *GORMDeptDAO) Delete(ctx context.Context, id string) error {
+	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var model system.Dept
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&model).Error; err != nil {
+			return err
+		}
+
+		var count int64
+		if err := tx.Model(&system.Dept{}).Where("parent_id = ?", id).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrDeptHasChildren
+		}
+		if err := tx.Model(&system.User{}).Where("dept_id = ?", id).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrDeptHasUsers
+		}
+
+		if err := tx.Delete(&model).Error; err != nil {
+			return err
+		}
+
+		if model.ParentID.Valid {
+			return dao.refreshCounts(tx, model.ParentID.String)
+		}
+		return nil
+	})
+}
+
+// Update 更新
+func (dao *GORMDeptDAO) Update(ctx context.Context, model system.Dept) error {
+	result := dao.db.WithContext(ctx).Model(&system.Dept{}).
+		Where("id = ? AND timestamp = ?", model.Id, model.Timestamp).
+		Updates(map[string]any{
+			"name":      model.Name,
+			"code":      model.Code,
+			"owner":     model.Owner,
+			"phone":     model.Phone,
+			"email":     model.Email,
+			"sort":      model.Sort,
+			"timestamp": time.Now().UnixMicro(),
+			"status":    model.Status,
+			"modifier":  model.Modifier,
+			"remark":    model.Remark,
+		})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	// 处理行影响数为0的情况
+	if result.RowsAffected == 0 {
+		return dao.checkVersion(ctx, model.Id)
+	}
+
+	return nil
+}
+
+// Move 移动节点
+// 在同一事务中改写节点及全部子孙节点的 Path/Level，并重新统计新旧上级的子部门数，返回受影响的节点ID
+func (dao *GORMDeptDAO) Move(ctx context.Context, id, parentId string, timestamp int64, modifier string) ([]string, error) {
+	var affected []string
+
+	err := dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var node system.Dept
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&node).Error; err != nil {
+			return err
+		}
+		if node.Timestamp != timestamp {
+			return ErrDeptVersionInconsistency
+		}
+
+		// 上级未变化
+		if node.ParentID.String == parentId && node.ParentID.Valid == (parentId != "") {
+			return nil
+		}
+
+		newPath := fmt.Sprintf("/%s/", node.Id)
+		newLevel := 0
+		if parentId != "" {
+			var parent system.Dept
+			err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", parentId).First(&parent).Error
+			if err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return ErrDeptParentNotFound
+				}
+				return err
+			}
+			// 新上级不能是自身或子孙节点
+			if strings.HasPrefix(parent.Path, node.Path) {
+				return ErrDeptMoveToDescendant
+			}
+			newPath = parent.Path + fmt.Sprintf("%s/", node.Id)
+			newLevel = parent.Level + 1
+		}
+
+		subtree := tx.Model(&system.Dept{}).Where("path LIKE ?", node.Path+"%")
+		if err := subtree.Pluck("id", &affected).Error; err != nil {
+			return err
+		}
+
+		// 节点自身
+		parentValue := any(nil)
+		if parentId != "" {
+			parentValue = parentId
+		}
+		if err := tx.Model(&system.Dept{}).Where("id = ?", node.Id).
+			Updates(map[string]any{
+				"parent_id": parentValue,
+				"timestamp": time.Now().UnixMicro(),
+				"modifier":  modifier,
+			}).Error; err != nil {
+			return err
+		}
+
+		// 节点及子孙节点的路径和层级
+		if err := tx.Model(&system.Dept{}).
+			Where("path LIKE ?", node.Path+"%").
+			UpdateColumns(map[string]any{
+				"path":  gorm.Expr("CONCAT(?, SUBSTRING(path, ?))", newPath, len(node.Path)+1),
+				"level": gorm.Expr("level + ?", newLevel-node.Level),
+			}).Error; err != nil {
+			return err
+		}
+
+		var ids []string
+		if node.ParentID.Valid {
+			ids = append(ids, node.ParentID.String)
+		}
+		if parentId != "" {
+			ids = append(ids, parentId)
+		}
+		ids = append(ids, node.Id)
+		return dao.refreshCounts(tx, ids...)
+	})
+
+	return affected, err
+}
+
+// FindById 根据id获取详情
+func (dao *GORMDeptDAO) FindById(ctx context.Context, id string) (*system.Dept, error) {
+	var model system.Dept
+	err := dao.db.WithContext(ctx).Where("id = ?", id).First(&model).Error
+	return &model, err
+}
+
+// FindListAll 获取所有列表
+func (dao *GORMDeptDAO) FindListAll(ctx context.Context, filter domainSystem.DeptFilter) ([]*system.Dept, error) {
+	var models []*system.Dept
+
+	query := dao.buildQuery(ctx, filter)
+
+	// 查询
+	if err := query.Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+// FindSubtree 获取节点及其全部子孙节点
+func (dao *GORMDeptDAO) FindSubtree(ctx context.Context, id string, filter domainSystem.DeptFilter) ([]*system.Dept, error) {
+	root, err := dao.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var models []*system.Dept
+	err = dao.buildQuery(ctx, filter).
+		Where("path LIKE ?", root.Path+"%").
+		Find(&models).Error
+
+	return models, err
+}
+
+// FindSubtreeIds 获取指定部门及其全部子孙部门ID，不区分状态
+func (dao *GORMDeptDAO) FindSubtreeIds(ctx context.Context, ids []string) ([]string, error) {
+	var paths []string
+	if err := dao.db.WithContext(ctx).Model(&system.Dept{}).
+		Where("id IN ?", ids).
+		Pluck("path", &paths).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]string, 0)
+	if len(paths) == 0 {
+		return result, nil
+	}
+
+	query := dao.db.WithContext(ctx).Model(&system.Dept{})
+	conditions := dao.db.Where("path LIKE ?", paths[0]+"%")
+	for _, path := range paths[1:] {
+		conditions = conditions.Or("path LIKE ?", path+"%")
+	}
+	err := query.Where(conditions).Distinct("id").Pluck("id", &result).Error
+	return result, err
+}
+
+// FindAncestors 获取全部祖先节点，按层级升序
+func (dao *GORMDeptDAO) FindAncestors(ctx context.Context, id string) ([]*system.Dept, error) {
+	node, err := dao.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 通过path字段快速获取所有祖先节点，排除自己
+	pathParts := strings.Split(strings.Trim(node.Path, "/"), "/")
+	if len(pathParts) <= 1 {
+		return []*system.Dept{}, nil
+	}
+
+	var models []*system.Dept
+	err = dao.db.WithContext(ctx).
+		Where("id IN ?", pathParts[:len(pathParts)-1]).
+		Order("level ASC").
+		Find(&models).Error
+
+	return models, err
+}
+
+// buildQuery 构建查询条件
+func (dao *GORMDeptDAO) buildQuery(ctx context.Context, filter domainSystem.DeptFilter) *gorm.DB {
+	builder := &domainSystem.DeptFilter{
+		Filters: filters.Filters{
+			Creator:    filter.Creator,
+			Modifier:   filter.Modifier,
+			BelongDept: filter.BelongDept,
+		},
+		Status: filter.Status,
+		Name:   filter.Name,
+		Code:   filter.Code,
+	}
+	return builder.QueryFilter(ctx, dao.db.WithContext(ctx).Model(&system.Dept{}))
+}
+
+// FindDeletedById 根据id获取回收站中的部门
+func (dao *GORMDeptDAO) FindDeletedById(ctx context.Context, id string) (*system.Dept, error) {
+	var model system.Dept
+	err := dao.db.WithContext(ctx).Unscoped().
+		Where("id = ? AND deleted_at <> ?", id, 0).
+		First(&model).Error
+	return &model, err
+}
+
+// FindRecycleListPage 分页查询回收站
+func (dao *GORMDeptDAO) FindRecycleListPage(ctx context.Context, filter domainSystem.RecycleFilter) ([]*system.Dept, int64, error) {
+	var total int64
+	var models []*system.Dept
+
+	query := filter.QueryFilter(ctx, dao.db.WithContext(ctx).Unscoped().Model(&system.Dept{}))
+
+	err := query.Count(&total).
+		Offset((filter.Page - 1) * filter.PageSize).
+		Limit(filter.PageSize).
+		Find(&models).Error
+
+	return models, total, err
+}
+
+// Restore 恢复部门，上级部门需未被删除
+// 删除期间上级部门可能已移动，按上级部门重新计算 Path/Level，并重新统计上级的子部门数
+func (dao *GORMDeptDAO) Restore(ctx context.Context, model system.Dept) error {
+	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		columns := models.RestoreColumns()
+		columns["path"] = fmt.Sprintf("/%s/", model.Id)
+		columns["level"] = 0
+		if model.ParentID.Valid {
+			var parent system.Dept
+			err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+				Select("id", "path", "level").
+				Where("id = ?", model.ParentID.String).
+				First(&parent).Error
+			if err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return ErrDeptParentNotFound
+				}
+				return err
+			}
+			columns["path"] = parent.Path + fmt.Sprintf("%s/", model.Id)
+			columns["level"] = parent.Level + 1
+		}
+
+		result := tx.Unscoped().Model(&system.Dept{}).
+			Where("id = ? AND deleted_at = ?", model.Id, model.DeletedAt).
+			UpdateColumns(columns)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrDeptNotFound
+		}
+
+		ids := []string{model.Id}
+		if model.ParentID.Valid {
+			ids = append(ids, model.ParentID.String)
+		}
+		return dao.refreshCounts(tx, ids...)
+	})
+}
+
+// Purge 彻底删除回收站中的部门
+func (dao *GORMDeptDAO) Purge(ctx context.Context, ids []string) (int64, error) {
+	return dao.purge(ctx, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("id IN ? AND deleted_at <> ?", ids, 0)
+	})
+}
+
+// PurgeExpired 彻底删除指定时间之前移入回收站的部门
+func (dao *GORMDeptDAO) PurgeExpired(ctx context.Context, before time.Time) (int64, error) {
+	return dao.purge(ctx, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("deleted_at <> ? AND deleted_at < ?", 0, before.UnixMilli())
+	})
+}
+
+// purge 彻底删除 scope 圈定的已删除部门，同一事务内清理角色数据范围关联
+// 回收站中仍引用该部门的用户由外键置空
+func (dao *GORMDeptDAO) purge(ctx context.Context, scope func(tx *gorm.DB) *gorm.DB) (int64, error) {
+	var rows int64
+	err := dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var ids []string
+		if err := scope(tx.Unscoped().Model(&system.Dept{})).Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		if err := tx.Where("dept_id IN ?", ids).Delete(&system.RoleDept{}).Error; err != nil {
+			return err
+		}
+		result := tx.Unscoped().Where("id IN ?", ids).Delete(&system.Dept{})
+		rows = result.RowsAffected
+		return result.Error
+	})
+	return rows, err
+}
+
+// refreshCounts 按实际数据重新统计子部门数和用户数
+func (dao *GORMDeptDAO) refreshCounts(tx *gorm.DB, ids ...string) error {
+	for _, id := range ids {
+		var childCount, userCount int64
+		if err := tx.Model(&system.Dept{}).Where("parent_id = ?", id).Count(&childCount).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&system.User{}).Where("dept_id = ?", id).Count(&userCount).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&system.Dept{}).Where("id = ?", id).
+			UpdateColumns(map[string]any{
+				"child_count": childCount,
+				"user_count":  userCount,
+			}).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkVersion 行影响数为0时区分数据不存在和版本不一致
+func (dao *GORMDeptDAO) checkVersion(ctx context.Context, id string) error {
+	var count int64
+	if err := dao.db.WithContext(ctx).Model(&system.Dept{}).Where("id = ?", id).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return ErrDeptNotFound
+	}
+	return ErrDeptVersionInconsistency
+}
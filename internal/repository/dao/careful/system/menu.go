@@ -0,0 +1,327 @@
+/**
+ * Description：
+ * FileName：menu.go
+ * Author：CJiaの用心
+ * Create：2025/10/28 10:05:31
+ * Remark：
+ */
+
+package system
+
+import (
+	"context"
+	"errors"
+	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/system/menu"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	"gorm.io/gorm"
+	"time"
+)
+
+var (
+	ErrMenuNotFound             = gorm.ErrRecordNotFound
+	ErrMenuDuplicate            = errors.New("权限标识已存在")
+	ErrMenuVersionInconsistency = errors.New("数据已被修改，请刷新后重试")
+	ErrMenuParentNotFound       = errors.New("上级菜单不存在")
+	ErrMenuParentInvalid        = errors.New("上级菜单不能是按钮、自身或子菜单")
+	ErrMenuHasChildren          = errors.New("存在子菜单，不允许删除")
+)
+
+type MenuDAO interface {
+	Insert(ctx context.Context, model system.Menu) (*system.Menu, error)
+	Delete(ctx context.Context, id string) error
+	Update(ctx context.Context, model system.Menu) error
+
+	FindById(ctx context.Context, id string) (*system.Menu, error)
+	FindListAll(ctx context.Context, filter domainSystem.MenuFilter) ([]*system.Menu, error)
+	FindIdsByUserId(ctx context.Context, userId string) ([]string, error)
+
+	CheckExistByCode(ctx context.Context, code, excludeId string) (bool, error)
+
+	FindDeletedById(ctx context.Context, id string) (*system.Menu, error)
+	FindRecycleListPage(ctx context.Context, filter domainSystem.RecycleFilter) ([]*system.Menu, int64, error)
+	Restore(ctx context.Context, model system.Menu) error
+	Purge(ctx context.Context, ids []string) (int64, error)
+	PurgeExpired(ctx context.Context, before time.Time) (int64, error)
+}
+
+type GORMMenuDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMMenuDAO(db *gorm.DB) MenuDAO {
+	return &GORMMenuDAO{
+		db: db,
+	}
+}
+
+// Insert 新增
+func (dao *GORMMenuDAO) Insert(ctx context.Context, model system.Menu) (*system.Menu, error) {
+	err := dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := dao.checkParent(tx, "", model.ParentID.String); err != nil {
+			return err
+		}
+		return tx.Create(&model).Error
+	})
+	return &model, err
+}
+
+// Delete 删除，移入回收站并保留角色关联，彻底删除时清理
+func (dao *GORMMenuDAO) Delete(ctx context.Context, id string) error {
+	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var model system.Menu
+		if err := tx.Where("id = ?", id).First(&model).Error; err != nil {
+			return err
+		}
+
+		var count int64
+		if err := tx.Model(&system.Menu{}).Where("parent_id = ?", id).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrMenuHasChildren
+		}
+
+		return tx.Delete(&model).Error
+	})
+}
+
+// Update 更新，允许调整上级菜单
+func (dao *GORMMenuDAO) Update(ctx context.Context, model system.Menu) error {
+	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := dao.checkParent(tx, model.Id, model.ParentID.String); err != nil {
+			return err
+		}
+
+		result := tx.Model(&system.Menu{}).
+			Where("id = ? AND timestamp = ?", model.Id, model.Timestamp).
+			Updates(map[string]any{
+				"type":       model.Type,
+				"title":      model.Title,
+				"name":       model.Name,
+				"code":       model.Code,
+				"path":       model.Path,
+				"component":  model.Component,
+				"redirect":   model.Redirect,
+				"icon":       model.Icon,
+				"visible":    model.Visible,
+				"keep_alive": model.KeepAlive,
+				"is_link":    model.IsLink,
+				"parent_id":  model.ParentID,
+				"sort":       model.Sort,
+				"timestamp":  time.Now().UnixMicro(),
+				"status":     model.Status,
+				"modifier":   model.Modifier,
+				"remark":     model.Remark,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		// 处理行影响数为0的情况
+		if result.RowsAffected == 0 {
+			var count int64
+			if err := tx.Model(&system.Menu{}).Where("id = ?", model.Id).Count(&count).Error; err != nil {
+				return err
+			}
+			if count == 0 {
+				return ErrMenuNotFound
+			}
+			return ErrMenuVersionInconsistency
+		}
+		return nil
+	})
+}
+
+// checkParent 校验上级菜单存在、不是按钮，且不是自身或子孙节点
+func (dao *GORMMenuDAO) checkParent(tx *gorm.DB, id, parentId string) error {
+	if parentId == "" {
+		return nil
+	}
+	if parentId == id {
+		return ErrMenuParentInvalid
+	}
+
+	var parent system.Menu
+	if err := tx.Select("id", "type", "parent_id").Where("id = ?", parentId).First(&parent).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrMenuParentNotFound
+		}
+		return err
+	}
+	if parent.Type == menu.TypeConstButton {
+		return ErrMenuParentInvalid
+	}
+	if id == "" {
+		return nil
+	}
+
+	// 沿上级链向上查找，出现自身则说明移动到了子孙节点下
+	for current := parent; current.ParentID.Valid; {
+		if current.ParentID.String == id {
+			return ErrMenuParentInvalid
+		}
+		next := system.Menu{}
+		if err := tx.Select("id", "parent_id").Where("id = ?", current.ParentID.String).First(&next).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return err
+		}
+		current = next
+	}
+	return nil
+}
+
+// FindById 根据id获取详情
+func (dao *GORMMenuDAO) FindById(ctx context.Context, id string) (*system.Menu, error) {
+	var model system.Menu
+	err := dao.db.WithContext(ctx).Where("id = ?", id).First(&model).Error
+	return &model, err
+}
+
+// FindListAll 获取所有列表
+func (dao *GORMMenuDAO) FindListAll(ctx context.Context, filter domainSystem.MenuFilter) ([]*system.Menu, error) {
+	var models []*system.Menu
+
+	query := dao.buildQuery(ctx, filter)
+
+	// 查询
+	if err := query.Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+// FindIdsByUserId 获取用户通过已启用角色获得的菜单ID，不含回收站中的角色与菜单
+func (dao *GORMMenuDAO) FindIdsByUserId(ctx context.Context, userId string) ([]string, error) {
+	var ids []string
+
+	roleIds := dao.db.Model(&system.UserRole{}).
+		Select("careful_system_user_role.role_id").
+		Joins("JOIN careful_system_role ON careful_system_role.id = careful_system_user_role.role_id").
+		Where("careful_system_user_role.user_id = ? AND careful_system_role.status = ? AND careful_system_role.deleted_at = ?", userId, true, 0)
+
+	err := dao.db.WithContext(ctx).Model(&system.RoleMenu{}).
+		Distinct("menu_id").
+		Where("role_id IN (?) AND menu_id IN (?)", roleIds, dao.db.Model(&system.Menu{}).Select("id")).
+		Pluck("menu_id", &ids).Error
+
+	return ids, err
+}
+
+// buildQuery 构建查询条件
+func (dao *GORMMenuDAO) buildQuery(ctx context.Context, filter domainSystem.MenuFilter) *gorm.DB {
+	builder := &domainSystem.MenuFilter{
+		Filters: filters.Filters{
+			Creator:    filter.Creator,
+			Modifier:   filter.Modifier,
+			BelongDept: filter.BelongDept,
+		},
+		Status: filter.Status,
+		Title:  filter.Title,
+		Type:   filter.Type,
+	}
+	return builder.QueryFilter(ctx, dao.db.WithContext(ctx).Model(&system.Menu{}))
+}
+
+// CheckExistByCode 检查code是否存在
+func (dao *GORMMenuDAO) CheckExistByCode(ctx context.Context, code, excludeId string) (bool, error) {
+	var model system.Menu
+	query := dao.db.WithContext(ctx).Model(&system.Menu{}).
+		Select("id"). // 只查询必要的字段
+		Where("code = ?", code)
+
+	if excludeId != "" {
+		query = query.Where("id != ?", excludeId)
+	}
+
+	// 使用 LIMIT 1 快速判断是否存在
+	err := query.Limit(1).First(&model).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil // 不存在
+	}
+	return err == nil, err // 存在或查询出错
+}
+
+// FindDeletedById 根据id获取回收站中的菜单
+func (dao *GORMMenuDAO) FindDeletedById(ctx context.Context, id string) (*system.Menu, error) {
+	var model system.Menu
+	err := dao.db.WithContext(ctx).Unscoped().
+		Where("id = ? AND deleted_at <> ?", id, 0).
+		First(&model).Error
+	return &model, err
+}
+
+// FindRecycleListPage 分页查询回收站
+func (dao *GORMMenuDAO) FindRecycleListPage(ctx context.Context, filter domainSystem.RecycleFilter) ([]*system.Menu, int64, error) {
+	var total int64
+	var models []*system.Menu
+
+	query := filter.QueryFilter(ctx, dao.db.WithContext(ctx).Unscoped().Model(&system.Menu{}), "title")
+
+	err := query.Count(&total).
+		Offset((filter.Page - 1) * filter.PageSize).
+		Limit(filter.PageSize).
+		Find(&models).Error
+
+	return models, total, err
+}
+
+// Restore 恢复菜单，上级菜单需未被删除，删除时保留的角色关联随之生效
+func (dao *GORMMenuDAO) Restore(ctx context.Context, model system.Menu) error {
+	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := dao.checkParent(tx, model.Id, model.ParentID.String); err != nil {
+			return err
+		}
+
+		result := tx.Unscoped().Model(&system.Menu{}).
+			Where("id = ? AND deleted_at = ?", model.Id, model.DeletedAt).
+			UpdateColumns(models.RestoreColumns())
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrMenuNotFound
+		}
+		return nil
+	})
+}
+
+// Purge 彻底删除回收站中的菜单
+func (dao *GORMMenuDAO) Purge(ctx context.Context, ids []string) (int64, error) {
+	return dao.purge(ctx, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("id IN ? AND deleted_at <> ?", ids, 0)
+	})
+}
+
+// PurgeExpired 彻底删除指定时间之前移入回收站的菜单
+func (dao *GORMMenuDAO) PurgeExpired(ctx context.Context, before time.Time) (int64, error) {
+	return dao.purge(ctx, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("deleted_at <> ? AND deleted_at < ?", 0, before.UnixMilli())
+	})
+}
+
+// purge 彻底删除 scope 圈定的已删除菜单，同一事务内清理角色关联
+func (dao *GORMMenuDAO) purge(ctx context.Context, scope func(tx *gorm.DB) *gorm.DB) (int64, error) {
+	var rows int64
+	err := dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var ids []string
+		if err := scope(tx.Unscoped().Model(&system.Menu{})).Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		if err := tx.Where("menu_id IN ?", ids).Delete(&system.RoleMenu{}).Error; err != nil {
+			return err
+		}
+		result := tx.Unscoped().Where("id IN ?", ids).Delete(&system.Menu{})
+		rows = result.RowsAffected
+		return result.Error
+	})
+	return rows, err
+}
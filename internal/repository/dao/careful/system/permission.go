@@ -0,0 +1,268 @@
+/**
+ * Description：
+ * FileName：permission.go
+ * Author：CJiaの用心
+ * Create：2025/10/27 10:31:12
+ * Remark：
+ */
+
+package system
+
+import (
+	"context"
+	"errors"
+	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	"gorm.io/gorm"
+	"time"
+)
+
+var (
+	ErrPermissionNotFound             = gorm.ErrRecordNotFound
+	ErrPermissionDuplicate            = errors.New("权限编码或接口已存在")
+	ErrPermissionVersionInconsistency = errors.New("数据已被修改，请刷新后重试")
+)
+
+type PermissionDAO interface {
+	Insert(ctx context.Context, model system.Permission) (*system.Permission, error)
+	Delete(ctx context.Context, id string) error
+	BatchDelete(ctx context.Context, ids []string) error
+	Update(ctx context.Context, model system.Permission) error
+
+	FindById(ctx context.Context, id string) (*system.Permission, error)
+	FindListPage(ctx context.Context, filter domainSystem.PermissionFilter) ([]*system.Permission, int64, error)
+	FindListAll(ctx context.Context, filter domainSystem.PermissionFilter) ([]*system.Permission, error)
+	FindByUserId(ctx context.Context, userId string) ([]*system.Permission, error)
+	FindUserIds(ctx context.Context, permissionIds []string) ([]string, error)
+
+	FindDeletedById(ctx context.Context, id string) (*system.Permission, error)
+	FindRecycleListPage(ctx context.Context, filter domainSystem.RecycleFilter) ([]*system.Permission, int64, error)
+	Restore(ctx context.Context, model system.Permission) error
+	Purge(ctx context.Context, ids []string) (int64, error)
+	PurgeExpired(ctx context.Context, before time.Time) (int64, error)
+}
+
+type GORMPermissionDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMPermissionDAO(db *gorm.DB) PermissionDAO {
+	return &GORMPermissionDAO{
+		db: db,
+	}
+}
+
+// Insert 新增
+func (dao *GORMPermissionDAO) Insert(ctx context.Context, model system.Permission) (*system.Permission, error) {
+	return &model, dao.db.WithContext(ctx).Create(&model).Error
+}
+
+// Delete 删除，移入回收站并保留角色关联，彻底删除时清理
+func (dao *GORMPermissionDAO) Delete(ctx context.Context, id string) error {
+	return dao.BatchDelete(ctx, []string{id})
+}
+
+// BatchDelete 批量删除，移入回收站并保留角色关联，彻底删除时清理
+func (dao *GORMPermissionDAO) BatchDelete(ctx context.Context, ids []string) error {
+	return dao.db.WithContext(ctx).Where("id IN ?", ids).Delete(&system.Permission{}).Error
+}
+
+// Update 更新
+func (dao *GORMPermissionDAO) Update(ctx context.Context, model system.Permission) error {
+	result := dao.db.WithContext(ctx).Model(&model).
+		Where("id = ? AND timestamp = ?", model.Id, model.Timestamp).
+		Updates(map[string]any{
+			"name":      model.Name,
+			"code":      model.Code,
+			"method":    model.Method,
+			"path":      model.Path,
+			"sort":      model.Sort,
+			"timestamp": time.Now().UnixMicro(),
+			"status":    model.Status,
+			"modifier":  model.Modifier,
+			"remark":    model.Remark,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	// 处理行影响数为0的情况
+	if result.RowsAffected == 0 {
+		// 先检查记录是否存在
+		var exists bool
+		dao.db.WithContext(ctx).
+			Model(&system.Permission{}).
+			Select("1").
+			Where("id = ?", model.Id).
+			Limit(1).
+			Find(&exists)
+
+		if !exists {
+			return ErrPermissionNotFound
+		}
+		return ErrPermissionVersionInconsistency
+	}
+	return nil
+}
+
+// FindById 根据id获取详情
+func (dao *GORMPermissionDAO) FindById(ctx context.Context, id string) (*system.Permission, error) {
+	var model system.Permission
+	err := dao.db.WithContext(ctx).Where("id = ?", id).First(&model).Error
+	return &model, err
+}
+
+// FindListPage 分页查询
+func (dao *GORMPermissionDAO) FindListPage(ctx context.Context, filter domainSystem.PermissionFilter) ([]*system.Permission, int64, error) {
+	var total int64
+	var models []*system.Permission
+
+	query := dao.buildQuery(ctx, filter)
+
+	err := query.Count(&total).
+		Offset((filter.Page - 1) * filter.PageSize).
+		Limit(filter.PageSize).
+		Find(&models).Error
+
+	return models, total, err
+}
+
+// FindListAll 获取所有列表
+func (dao *GORMPermissionDAO) FindListAll(ctx context.Context, filter domainSystem.PermissionFilter) ([]*system.Permission, error) {
+	var models []*system.Permission
+
+	query := dao.buildQuery(ctx, filter)
+
+	// 查询
+	if err := query.Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+// FindByUserId 获取用户通过已启用角色获得的已启用接口权限，不含回收站中的角色
+func (dao *GORMPermissionDAO) FindByUserId(ctx context.Context, userId string) ([]*system.Permission, error) {
+	var models []*system.Permission
+
+	roleIds := dao.db.Model(&system.UserRole{}).
+		Select("careful_system_user_role.role_id").
+		Joins("JOIN careful_system_role ON careful_system_role.id = careful_system_user_role.role_id").
+		Where("careful_system_user_role.user_id = ? AND careful_system_role.status = ? AND careful_system_role.deleted_at = ?", userId, true, 0)
+	permissionIds := dao.db.Model(&system.RolePermission{}).
+		Select("permission_id").
+		Where("role_id IN (?)", roleIds)
+
+	err := dao.db.WithContext(ctx).
+		Where("id IN (?) AND status = ?", permissionIds, true).
+		Find(&models).Error
+
+	return models, err
+}
+
+// FindUserIds 获取通过角色拥有指定权限的用户ID
+func (dao *GORMPermissionDAO) FindUserIds(ctx context.Context, permissionIds []string) ([]string, error) {
+	var ids []string
+
+	roleIds := dao.db.Model(&system.RolePermission{}).
+		Select("role_id").
+		Where("permission_id IN ?", permissionIds)
+
+	err := dao.db.WithContext(ctx).Model(&system.UserRole{}).
+		Distinct("user_id").
+		Where("role_id IN (?)", roleIds).
+		Pluck("user_id", &ids).Error
+
+	return ids, err
+}
+
+// buildQuery 构建查询条件
+func (dao *GORMPermissionDAO) buildQuery(ctx context.Context, filter domainSystem.PermissionFilter) *gorm.DB {
+	builder := &domainSystem.PermissionFilter{
+		Filters: filters.Filters{
+			Creator:    filter.Creator,
+			Modifier:   filter.Modifier,
+			BelongDept: filter.BelongDept,
+		},
+		Status: filter.Status,
+		Name:   filter.Name,
+		Code:   filter.Code,
+		Method: filter.Method,
+		Path:   filter.Path,
+	}
+	return builder.QueryFilter(ctx, dao.db.WithContext(ctx).Model(&system.Permission{}))
+}
+
+// FindDeletedById 根据id获取回收站中的接口权限
+func (dao *GORMPermissionDAO) FindDeletedById(ctx context.Context, id string) (*system.Permission, error) {
+	var model system.Permission
+	err := dao.db.WithContext(ctx).Unscoped().
+		Where("id = ? AND deleted_at <> ?", id, 0).
+		First(&model).Error
+	return &model, err
+}
+
+// FindRecycleListPage 分页查询回收站
+func (dao *GORMPermissionDAO) FindRecycleListPage(ctx context.Context, filter domainSystem.RecycleFilter) ([]*system.Permission, int64, error) {
+	var total int64
+	var models []*system.Permission
+
+	query := filter.QueryFilter(ctx, dao.db.WithContext(ctx).Unscoped().Model(&system.Permission{}))
+
+	err := query.Count(&total).
+		Offset((filter.Page - 1) * filter.PageSize).
+		Limit(filter.PageSize).
+		Find(&models).Error
+
+	return models, total, err
+}
+
+// Restore 恢复接口权限，删除时保留的角色关联随之生效
+func (dao *GORMPermissionDAO) Restore(ctx context.Context, model system.Permission) error {
+	result := dao.db.WithContext(ctx).Unscoped().Model(&system.Permission{}).
+		Where("id = ? AND deleted_at = ?", model.Id, model.DeletedAt).
+		UpdateColumns(models.RestoreColumns())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrPermissionNotFound
+	}
+	return nil
+}
+
+// Purge 彻底删除回收站中的接口权限
+func (dao *GORMPermissionDAO) Purge(ctx context.Context, ids []string) (int64, error) {
+	return dao.purge(ctx, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("id IN ? AND deleted_at <> ?", ids, 0)
+	})
+}
+
+// PurgeExpired 彻底删除指定时间之前移入回收站的接口权限
+func (dao *GORMPermissionDAO) PurgeExpired(ctx context.Context, before time.Time) (int64, error) {
+	return dao.purge(ctx, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("deleted_at <> ? AND deleted_at < ?", 0, before.UnixMilli())
+	})
+}
+
+// purge 彻底删除 scope 圈定的已删除接口权限，同一事务内清理角色关联
+func (dao *GORMPermissionDAO) purge(ctx context.Context, scope func(tx *gorm.DB) *gorm.DB) (int64, error) {
+	var rows int64
+	err := dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var ids []string
+		if err := scope(tx.Unscoped().Model(&system.Permission{})).Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		if err := tx.Where("permission_id IN ?", ids).Delete(&system.RolePermission{}).Error; err != nil {
+			return err
+		}
+		result := tx.Unscoped().Where("id IN ?", ids).Delete(&system.Permission{})
+		rows = result.RowsAffected
+		return result.Error
+	})
+	return rows, err
+}
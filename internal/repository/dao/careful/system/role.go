@@ -0,0 +1,476 @@
+/**
+ * Description：
+ * FileName：role.go
+ * Author：CJiaの用心
+ * Create：2025/10/27 10:05:37
+ * Remark：
+ */
+
+package system
+
+import (
+	"context"
+	"errors"
+	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	"gorm.io/gorm"
+	"time"
+)
+
+var (
+	ErrRoleNotFound             = gorm.ErrRecordNotFound
+	ErrRoleNameDuplicate        = errors.New("角色名称已存在")
+	ErrRoleCodeDuplicate        = errors.New("角色编码已存在")
+	ErrRoleVersionInconsistency = errors.New("数据已被修改，请刷新后重试")
+	ErrRoleUserNotFound         = errors.New("用户不存在")
+	ErrRolePermissionNotFound   = errors.New("接口权限不存在")
+	ErrRoleMenuNotFound         = errors.New("菜单不存在")
+	ErrRoleDeptNotFound         = errors.New("部门不存在")
+)
+
+type RoleDAO interface {
+	Insert(ctx context.Context, model system.Role) (*system.Role, error)
+	Delete(ctx context.Context, id string) error
+	BatchDelete(ctx context.Context, ids []string) error
+	Update(ctx context.Context, model system.Role) error
+	SetPermissions(ctx context.Context, roleId string, permissionIds []string) error
+	SetUserRoles(ctx context.Context, userId string, roleIds []string) error
+	SetMenus(ctx context.Context, roleId string, menuIds []string) error
+	SetDepts(ctx context.Context, roleId string, deptIds []string) error
+
+	FindById(ctx context.Context, id string) (*system.Role, error)
+	FindListPage(ctx context.Context, filter domainSystem.RoleFilter) ([]*system.Role, int64, error)
+	FindListAll(ctx context.Context, filter domainSystem.RoleFilter) ([]*system.Role, error)
+	FindByUserId(ctx context.Context, userId string) ([]*system.Role, error)
+	FindPermissionIds(ctx context.Context, roleId string) ([]string, error)
+	FindMenuIds(ctx context.Context, roleId string) ([]string, error)
+	FindDeptIds(ctx context.Context, roleIds []string) ([]string, error)
+	FindUserIds(ctx context.Context, roleIds []string) ([]string, error)
+
+	CheckExistByName(ctx context.Context, name, excludeId string) (bool, error)
+	CheckExistByCode(ctx context.Context, code, excludeId string) (bool, error)
+
+	FindDeletedById(ctx context.Context, id string) (*system.Role, error)
+	FindRecycleListPage(ctx context.Context, filter domainSystem.RecycleFilter) ([]*system.Role, int64, error)
+	Restore(ctx context.Context, model system.Role) error
+	Purge(ctx context.Context, ids []string) (int64, error)
+	PurgeExpired(ctx context.Context, before time.Time) (int64, error)
+}
+
+type GORMRoleDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMRoleDAO(db *gorm.DB) RoleDAO {
+	return &GORMRoleDAO{
+		db: db,
+	}
+}
+
+// Insert 新增
+func (dao *GORMRoleDAO) Insert(ctx context.Context, model system.Role) (*system.Role, error) {
+	return &model, dao.db.WithContext(ctx).Create(&model).Error
+}
+
+// Delete 删除，移入回收站并保留关联关系，彻底删除时清理
+func (dao *GORMRoleDAO) Delete(ctx context.Context, id string) error {
+	return dao.BatchDelete(ctx, []string{id})
+}
+
+// BatchDelete 批量删除，移入回收站并保留关联关系，彻底删除时清理
+func (dao *GORMRoleDAO) BatchDelete(ctx context.Context, ids []string) error {
+	return dao.db.WithContext(ctx).Where("id IN ?", ids).Delete(&system.Role{}).Error
+}
+
+// Update 更新
+func (dao *GORMRoleDAO) Update(ctx context.Context, model system.Role) error {
+	result := dao.db.WithContext(ctx).Model(&model).
+		Where("id = ? AND timestamp = ?", model.Id, model.Timestamp).
+		Updates(map[string]any{
+			"name":       model.Name,
+			"code":       model.Code,
+			"data_scope": model.DataScope,
+			"sort":       model.Sort,
+			"timestamp":  time.Now().UnixMicro(),
+			"status":     model.Status,
+			"modifier":   model.Modifier,
+			"remark":     model.Remark,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	// 处理行影响数为0的情况
+	if result.RowsAffected == 0 {
+		// 先检查记录是否存在
+		var exists bool
+		dao.db.WithContext(ctx).
+			Model(&system.Role{}).
+			Select("1").
+			Where("id = ?", model.Id).
+			Limit(1).
+			Find(&exists)
+
+		if !exists {
+			return ErrRoleNotFound
+		}
+		return ErrRoleVersionInconsistency
+	}
+	return nil
+}
+
+// SetPermissions 覆盖设置角色的接口权限，permissionIds需去重
+func (dao *GORMRoleDAO) SetPermissions(ctx context.Context, roleId string, permissionIds []string) error {
+	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&system.Role{}).Where("id = ?", roleId).Count(&count).Error; err != nil {
+			return err
+		}
+		if count == 0 {
+			return ErrRoleNotFound
+		}
+
+		if err := tx.Where("role_id = ?", roleId).Delete(&system.RolePermission{}).Error; err != nil {
+			return err
+		}
+		if len(permissionIds) == 0 {
+			return nil
+		}
+		// 校验权限是否全部存在
+		if err := tx.Model(&system.Permission{}).Where("id IN ?", permissionIds).Count(&count).Error; err != nil {
+			return err
+		}
+		if count != int64(len(permissionIds)) {
+			return ErrRolePermissionNotFound
+		}
+
+		relations := make([]system.RolePermission, 0, len(permissionIds))
+		for _, permissionId := range permissionIds {
+			relations = append(relations, system.RolePermission{
+				RoleId:       roleId,
+				PermissionId: permissionId,
+			})
+		}
+		return tx.Create(&relations).Error
+	})
+}
+
+// SetUserRoles 覆盖设置用户的角色，roleIds需去重
+func (dao *GORMRoleDAO) SetUserRoles(ctx context.Context, userId string, roleIds []string) error {
+	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&system.User{}).Where("id = ?", userId).Count(&count).Error; err != nil {
+			return err
+		}
+		if count == 0 {
+			return ErrRoleUserNotFound
+		}
+
+		if err := tx.Where("user_id = ?", userId).Delete(&system.UserRole{}).Error; err != nil {
+			return err
+		}
+		if len(roleIds) == 0 {
+			return nil
+		}
+		// 校验角色是否全部存在
+		if err := tx.Model(&system.Role{}).Where("id IN ?", roleIds).Count(&count).Error; err != nil {
+			return err
+		}
+		if count != int64(len(roleIds)) {
+			return ErrRoleNotFound
+		}
+
+		relations := make([]system.UserRole, 0, len(roleIds))
+		for _, roleId := range roleIds {
+			relations = append(relations, system.UserRole{
+				UserId: userId,
+				RoleId: roleId,
+			})
+		}
+		return tx.Create(&relations).Error
+	})
+}
+
+// SetMenus 覆盖设置角色的菜单，menuIds需去重
+func (dao *GORMRoleDAO) SetMenus(ctx context.Context, roleId string, menuIds []string) error {
+	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&system.Role{}).Where("id = ?", roleId).Count(&count).Error; err != nil {
+			return err
+		}
+		if count == 0 {
+			return ErrRoleNotFound
+		}
+
+		if err := tx.Where("role_id = ?", roleId).Delete(&system.RoleMenu{}).Error; err != nil {
+			return err
+		}
+		if len(menuIds) == 0 {
+			return nil
+		}
+		// 校验菜单是否全部存在
+		if err := tx.Model(&system.Menu{}).Where("id IN ?", menuIds).Count(&count).Error; err != nil {
+			return err
+		}
+		if count != int64(len(menuIds)) {
+			return ErrRoleMenuNotFound
+		}
+
+		relations := make([]system.RoleMenu, 0, len(menuIds))
+		for _, menuId := range menuIds {
+			relations = append(relations, system.RoleMenu{
+				RoleId: roleId,
+				MenuId: menuId,
+			})
+		}
+		return tx.Create(&relations).Error
+	})
+}
+
+// SetDepts 覆盖设置角色自定义数据范围的部门，deptIds需去重
+func (dao *GORMRoleDAO) SetDepts(ctx context.Context, roleId string, deptIds []string) error {
+	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&system.Role{}).Where("id = ?", roleId).Count(&count).Error; err != nil {
+			return err
+		}
+		if count == 0 {
+			return ErrRoleNotFound
+		}
+
+		if err := tx.Where("role_id = ?", roleId).Delete(&system.RoleDept{}).Error; err != nil {
+			return err
+		}
+		if len(deptIds) == 0 {
+			return nil
+		}
+		// 校验部门是否全部存在
+		if err := tx.Model(&system.Dept{}).Where("id IN ?", deptIds).Count(&count).Error; err != nil {
+			return err
+		}
+		if count != int64(len(deptIds)) {
+			return ErrRoleDeptNotFound
+		}
+
+		relations := make([]system.RoleDept, 0, len(deptIds))
+		for _, deptId := range deptIds {
+			relations = append(relations, system.RoleDept{
+				RoleId: roleId,
+				DeptId: deptId,
+			})
+		}
+		return tx.Create(&relations).Error
+	})
+}
+
+// FindById 根据id获取详情
+func (dao *GORMRoleDAO) FindById(ctx context.Context, id string) (*system.Role, error) {
+	var model system.Role
+	err := dao.db.WithContext(ctx).Where("id = ?", id).First(&model).Error
+	return &model, err
+}
+
+// FindListPage 分页查询
+func (dao *GORMRoleDAO) FindListPage(ctx context.Context, filter domainSystem.RoleFilter) ([]*system.Role, int64, error) {
+	var total int64
+	var models []*system.Role
+
+	query := dao.buildQuery(ctx, filter)
+
+	err := query.Count(&total).
+		Offset((filter.Page - 1) * filter.PageSize).
+		Limit(filter.PageSize).
+		Find(&models).Error
+
+	return models, total, err
+}
+
+// FindListAll 获取所有列表
+func (dao *GORMRoleDAO) FindListAll(ctx context.Context, filter domainSystem.RoleFilter) ([]*system.Role, error) {
+	var models []*system.Role
+
+	query := dao.buildQuery(ctx, filter)
+
+	// 查询
+	if err := query.Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+// FindByUserId 获取用户的角色列表
+func (dao *GORMRoleDAO) FindByUserId(ctx context.Context, userId string) ([]*system.Role, error) {
+	var models []*system.Role
+	err := dao.db.WithContext(ctx).
+		Where("id IN (?)", dao.db.Model(&system.UserRole{}).Select("role_id").Where("user_id = ?", userId)).
+		Order("sort ASC").
+		Find(&models).Error
+	return models, err
+}
+
+// FindPermissionIds 获取角色的接口权限ID，不含回收站中的接口权限
+func (dao *GORMRoleDAO) FindPermissionIds(ctx context.Context, roleId string) ([]string, error) {
+	var ids []string
+	err := dao.db.WithContext(ctx).Model(&system.RolePermission{}).
+		Where("role_id = ? AND permission_id IN (?)", roleId, dao.db.Model(&system.Permission{}).Select("id")).
+		Pluck("permission_id", &ids).Error
+	return ids, err
+}
+
+// FindMenuIds 获取角色的菜单ID，不含回收站中的菜单
+func (dao *GORMRoleDAO) FindMenuIds(ctx context.Context, roleId string) ([]string, error) {
+	var ids []string
+	err := dao.db.WithContext(ctx).Model(&system.RoleMenu{}).
+		Where("role_id = ? AND menu_id IN (?)", roleId, dao.db.Model(&system.Menu{}).Select("id")).
+		Pluck("menu_id", &ids).Error
+	return ids, err
+}
+
+// FindDeptIds 获取角色自定义数据范围的部门ID，不含回收站中的部门
+func (dao *GORMRoleDAO) FindDeptIds(ctx context.Context, roleIds []string) ([]string, error) {
+	var ids []string
+	err := dao.db.WithContext(ctx).Model(&system.RoleDept{}).
+		Distinct("dept_id").
+		Where("role_id IN ? AND dept_id IN (?)", roleIds, dao.db.Model(&system.Dept{}).Select("id")).
+		Pluck("dept_id", &ids).Error
+	return ids, err
+}
+
+// FindUserIds 获取拥有指定角色的用户ID
+func (dao *GORMRoleDAO) FindUserIds(ctx context.Context, roleIds []string) ([]string, error) {
+	var ids []string
+	err := dao.db.WithContext(ctx).Model(&system.UserRole{}).
+		Distinct("user_id").
+		Where("role_id IN ?", roleIds).
+		Pluck("user_id", &ids).Error
+	return ids, err
+}
+
+// buildQuery 构建查询条件
+func (dao *GORMRoleDAO) buildQuery(ctx context.Context, filter domainSystem.RoleFilter) *gorm.DB {
+	builder := &domainSystem.RoleFilter{
+		Filters: filters.Filters{
+			Creator:    filter.Creator,
+			Modifier:   filter.Modifier,
+			BelongDept: filter.BelongDept,
+		},
+		Status: filter.Status,
+		Name:   filter.Name,
+		Code:   filter.Code,
+	}
+	return builder.QueryFilter(ctx, dao.db.WithContext(ctx).Model(&system.Role{}))
+}
+
+// CheckExistByName 检查name是否存在
+func (dao *GORMRoleDAO) CheckExistByName(ctx context.Context, name, excludeId string) (bool, error) {
+	return dao.checkExist(ctx, "name", name, excludeId)
+}
+
+// CheckExistByCode 检查code是否存在
+func (dao *GORMRoleDAO) CheckExistByCode(ctx context.Context, code, excludeId string) (bool, error) {
+	return dao.checkExist(ctx, "code", code, excludeId)
+}
+
+// checkExist 检查字段值是否存在
+func (dao *GORMRoleDAO) checkExist(ctx context.Context, column, value, excludeId string) (bool, error) {
+	var model system.Role
+	query := dao.db.WithContext(ctx).Model(&system.Role{}).
+		Select("id"). // 只查询必要的字段
+		Where(column+" = ?", value)
+
+	if excludeId != "" {
+		query = query.Where("id != ?", excludeId)
+	}
+
+	// 使用 LIMIT 1 快速判断是否存在
+	err := query.Limit(1).First(&model).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil // 不存在
+	}
+	return err == nil, err // 存在或查询出错
+}
+
+// FindDeletedById 根据id获取回收站中的角色
+func (dao *GORMRoleDAO) FindDeletedById(ctx context.Context, id string) (*system.Role, error) {
+	var model system.Role
+	err := dao.db.WithContext(ctx).Unscoped().
+		Where("id = ? AND deleted_at <> ?", id, 0).
+		First(&model).Error
+	return &model, err
+}
+
+// FindRecycleListPage 分页查询回收站
+func (dao *GORMRoleDAO) FindRecycleListPage(ctx context.Context, filter domainSystem.RecycleFilter) ([]*system.Role, int64, error) {
+	var total int64
+	var models []*system.Role
+
+	query := filter.QueryFilter(ctx, dao.db.WithContext(ctx).Unscoped().Model(&system.Role{}))
+
+	err := query.Count(&total).
+		Offset((filter.Page - 1) * filter.PageSize).
+		Limit(filter.PageSize).
+		Find(&models).Error
+
+	return models, total, err
+}
+
+// Restore 恢复角色，删除时保留的关联关系随之生效
+func (dao *GORMRoleDAO) Restore(ctx context.Context, model system.Role) error {
+	result := dao.db.WithContext(ctx).Unscoped().Model(&system.Role{}).
+		Where("id = ? AND deleted_at = ?", model.Id, model.DeletedAt).
+		UpdateColumns(models.RestoreColumns())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrRoleNotFound
+	}
+	return nil
+}
+
+// Purge 彻底删除回收站中的角色及其关联关系
+func (dao *GORMRoleDAO) Purge(ctx context.Context, ids []string) (int64, error) {
+	return dao.purge(ctx, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("id IN ? AND deleted_at <> ?", ids, 0)
+	})
+}
+
+// PurgeExpired 彻底删除指定时间之前移入回收站的角色
+func (dao *GORMRoleDAO) PurgeExpired(ctx context.Context, before time.Time) (int64, error) {
+	return dao.purge(ctx, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("deleted_at <> ? AND deleted_at < ?", 0, before.UnixMilli())
+	})
+}
+
+// purge 彻底删除 scope 圈定的已删除角色，同一事务内清理关联关系
+func (dao *GORMRoleDAO) purge(ctx context.Context, scope func(tx *gorm.DB) *gorm.DB) (int64, error) {
+	var rows int64
+	err := dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var ids []string
+		if err := scope(tx.Unscoped().Model(&system.Role{})).Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		if err := tx.Where("role_id IN ?", ids).Delete(&system.UserRole{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("role_id IN ?", ids).Delete(&system.RolePermission{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("role_id IN ?", ids).Delete(&system.RoleMenu{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("role_id IN ?", ids).Delete(&system.RoleDept{}).Error; err != nil {
+			return err
+		}
+		result := tx.Unscoped().Where("id IN ?", ids).Delete(&system.Role{})
+		rows = result.RowsAffected
+		return result.Error
+	})
+	return rows, err
+}
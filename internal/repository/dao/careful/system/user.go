@@ -10,19 +10,43 @@ package system
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
 	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
 	"gorm.io/gorm"
+	"time"
 )
 
 var (
-	ErrUserNotFound = gorm.ErrRecordNotFound
+	ErrUserNotFound             = gorm.ErrRecordNotFound
+	ErrUserUsernameDuplicate    = errors.New("用户名已存在")
+	ErrUserVersionInconsistency = errors.New("数据已被修改，请刷新后重试")
+	ErrUserDeptNotFound         = errors.New("部门不存在")
 )
 
 type UserDAO interface {
 	Insert(ctx context.Context, model system.User) (*system.User, error)
+	Delete(ctx context.Context, id string) error
+	BatchDelete(ctx context.Context, ids []string) error
+	Update(ctx context.Context, model system.User) error
+	UpdateStatus(ctx context.Context, id string, status bool, modifier string) error
+	UpdatePassword(ctx context.Context, id, password, modifier string) error
 
 	FindById(ctx context.Context, id string) (*system.User, error)
 	FindByUsername(ctx context.Context, username string) (*system.User, error)
+	FindListPage(ctx context.Context, filter domainSystem.UserFilter) ([]*system.User, int64, error)
+	FindListAll(ctx context.Context, filter domainSystem.UserFilter) ([]*system.User, error)
+
+	CheckExistByUsername(ctx context.Context, username, excludeId string) (bool, error)
+
+	FindDeletedById(ctx context.Context, id string) (*system.User, error)
+	FindRecycleListPage(ctx context.Context, filter domainSystem.RecycleFilter) ([]*system.User, int64, error)
+	Restore(ctx context.Context, model system.User) error
+	Purge(ctx context.Context, ids []string) (int64, error)
+	PurgeExpired(ctx context.Context, before time.Time) (int64, error)
 }
 
 type GORMUserDAO struct {
@@ -37,7 +61,147 @@ func NewGORMUserDAO(db *gorm.DB) UserDAO {
 
 // Insert 新增
 func (dao *GORMUserDAO) Insert(ctx context.Context, model system.User) (*system.User, error) {
-	return &model, dao.db.WithContext(ctx).Create(&model).Error
+	err := dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := dao.checkDept(tx, model.DeptId); err != nil {
+			return err
+		}
+		return tx.Create(&model).Error
+	})
+	return &model, err
+}
+
+// Delete 删除，移入回收站并保留角色关联，恢复后角色随之恢复
+func (dao *GORMUserDAO) Delete(ctx context.Context, id string) error {
+	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var model system.User
+		if err := tx.Where("id = ?", id).First(&model).Error; err != nil {
+			return err
+		}
+		// 以实体删除，触发 AfterDelete 钩子维护部门用户数
+		return tx.Delete(&model).Error
+	})
+}
+
+// BatchDelete 批量删除，移入回收站并保留角色关联
+func (dao *GORMUserDAO) BatchDelete(ctx context.Context, ids []string) error {
+	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var models []*system.User
+		if err := tx.Where("id IN ?", ids).Find(&models).Error; err != nil {
+			return err
+		}
+		if len(models) == 0 {
+			return nil
+		}
+		return tx.Delete(&models).Error
+	})
+}
+
+// Update 更新
+func (dao *GORMUserDAO) Update(ctx context.Context, model system.User) error {
+	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var old system.User
+		if err := tx.Select("id", "dept_id").Where("id = ?", model.Id).First(&old).Error; err != nil {
+			return err
+		}
+		if old.DeptId != model.DeptId {
+			if err := dao.checkDept(tx, model.DeptId); err != nil {
+				return err
+			}
+		}
+
+		result := tx.Model(&system.User{}).
+			Where("id = ? AND timestamp = ?", model.Id, model.Timestamp).
+			Updates(map[string]any{
+				"name":      model.Name,
+				"gender":    model.Gender,
+				"email":     model.Email,
+				"mobile":    model.Mobile,
+				"avatar":    model.Avatar,
+				"dept_id":   model.DeptId,
+				"sort":      model.Sort,
+				"timestamp": time.Now().UnixMicro(),
+				"status":    model.Status,
+				"modifier":  model.Modifier,
+				"remark":    model.Remark,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		// 处理行影响数为0的情况
+		if result.RowsAffected == 0 {
+			return ErrUserVersionInconsistency
+		}
+
+		// 部门变更时同步部门用户数
+		if old.DeptId != model.DeptId {
+			if old.DeptId.Valid {
+				if err := tx.Model(&system.Dept{}).Where("id = ?", old.DeptId.String).
+					UpdateColumn("user_count", gorm.Expr("user_count - ?", 1)).Error; err != nil {
+					return err
+				}
+			}
+			if model.DeptId.Valid {
+				if err := tx.Model(&system.Dept{}).Where("id = ?", model.DeptId.String).
+					UpdateColumn("user_count", gorm.Expr("user_count + ?", 1)).Error; err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+// checkDept 校验部门存在，回收站中的部门仍满足外键约束，需显式校验
+func (dao *GORMUserDAO) checkDept(tx *gorm.DB, deptId sql.NullString) error {
+	if !deptId.Valid {
+		return nil
+	}
+
+	var count int64
+	if err := tx.Model(&system.Dept{}).Where("id = ?", deptId.String).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return ErrUserDeptNotFound
+	}
+	return nil
+}
+
+// UpdateStatus 更新状态
+func (dao *GORMUserDAO) UpdateStatus(ctx context.Context, id string, status bool, modifier string) error {
+	result := dao.db.WithContext(ctx).Model(&system.User{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"status":    status,
+			"timestamp": time.Now().UnixMicro(),
+			"modifier":  modifier,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+// UpdatePassword 更新密码
+func (dao *GORMUserDAO) UpdatePassword(ctx context.Context, id, password, modifier string) error {
+	result := dao.db.WithContext(ctx).Model(&system.User{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"password":  password,
+			"timestamp": time.Now().UnixMicro(),
+			"modifier":  modifier,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrUserNotFound
+	}
+	return nil
 }
 
 // FindById 根据id获取详情
@@ -59,3 +223,165 @@ func (dao *GORMUserDAO) FindByUsername(ctx context.Context, username string) (*s
 		First(&model).Error
 	return &model, err
 }
+
+// FindListPage 分页查询
+func (dao *GORMUserDAO) FindListPage(ctx context.Context, filter domainSystem.UserFilter) ([]*system.User, int64, error) {
+	var total int64
+	var models []*system.User
+
+	query := dao.buildQuery(ctx, filter)
+
+	err := query.Count(&total).
+		Preload("Dept").
+		Offset((filter.Page - 1) * filter.PageSize).
+		Limit(filter.PageSize).
+		Find(&models).Error
+
+	return models, total, err
+}
+
+// FindListAll 获取所有列表
+func (dao *GORMUserDAO) FindListAll(ctx context.Context, filter domainSystem.UserFilter) ([]*system.User, error) {
+	var models []*system.User
+
+	query := dao.buildQuery(ctx, filter)
+
+	// 查询
+	if err := query.Preload("Dept").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+// buildQuery 构建查询条件
+func (dao *GORMUserDAO) buildQuery(ctx context.Context, filter domainSystem.UserFilter) *gorm.DB {
+	builder := &domainSystem.UserFilter{
+		Filters: filters.Filters{
+			Creator:    filter.Creator,
+			Modifier:   filter.Modifier,
+			BelongDept: filter.BelongDept,
+		},
+		Status:   filter.Status,
+		Username: filter.Username,
+		Name:     filter.Name,
+		Gender:   filter.Gender,
+		Email:    filter.Email,
+		Mobile:   filter.Mobile,
+		DeptId:   filter.DeptId,
+	}
+	return builder.QueryFilter(ctx, dao.db.WithContext(ctx).Model(&system.User{}))
+}
+
+// CheckExistByUsername 检查username是否存在
+func (dao *GORMUserDAO) CheckExistByUsername(ctx context.Context, username, excludeId string) (bool, error) {
+	var model system.User
+	query := dao.db.WithContext(ctx).Model(&system.User{}).
+		Select("id"). // 只查询必要的字段
+		Where("username = ?", username)
+
+	if excludeId != "" {
+		query = query.Where("id != ?", excludeId)
+	}
+
+	// 使用 LIMIT 1 快速判断是否存在
+	err := query.Limit(1).First(&model).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil // 不存在
+	}
+	return err == nil, err // 存在或查询出错
+}
+
+// FindDeletedById 根据id获取回收站中的用户
+func (dao *GORMUserDAO) FindDeletedById(ctx context.Context, id string) (*system.User, error) {
+	var model system.User
+	err := dao.db.WithContext(ctx).Unscoped().
+		Where("id = ? AND deleted_at <> ?", id, 0).
+		First(&model).Error
+	return &model, err
+}
+
+// FindRecycleListPage 分页查询回收站，名称匹配用户名或姓名
+func (dao *GORMUserDAO) FindRecycleListPage(ctx context.Context, filter domainSystem.RecycleFilter) ([]*system.User, int64, error) {
+	var total int64
+	var models []*system.User
+
+	query := filter.QueryFilter(ctx, dao.db.WithContext(ctx).Unscoped().Model(&system.User{}), "username", "name")
+
+	err := query.Count(&total).
+		Offset((filter.Page - 1) * filter.PageSize).
+		Limit(filter.PageSize).
+		Find(&models).Error
+
+	return models, total, err
+}
+
+// Restore 恢复用户，所属部门已删除时清空部门，否则恢复部门用户数
+func (dao *GORMUserDAO) Restore(ctx context.Context, model system.User) error {
+	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		columns := models.RestoreColumns()
+		if model.DeptId.Valid {
+			var count int64
+			if err := tx.Model(&system.Dept{}).Where("id = ?", model.DeptId.String).Count(&count).Error; err != nil {
+				return err
+			}
+			if count == 0 {
+				columns["dept_id"] = nil
+				model.DeptId = sql.NullString{}
+			}
+		}
+
+		result := tx.Unscoped().Model(&system.User{}).
+			Where("id = ? AND deleted_at = ?", model.Id, model.DeletedAt).
+			UpdateColumns(columns)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrUserNotFound
+		}
+
+		if model.DeptId.Valid {
+			return tx.Model(&system.Dept{}).Where("id = ?", model.DeptId.String).
+				UpdateColumn("user_count", gorm.Expr("user_count + ?", 1)).Error
+		}
+		return nil
+	})
+}
+
+// Purge 彻底删除回收站中的用户及其角色关联
+func (dao *GORMUserDAO) Purge(ctx context.Context, ids []string) (int64, error) {
+	return dao.purge(ctx, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("id IN ? AND deleted_at <> ?", ids, 0)
+	})
+}
+
+// PurgeExpired 彻底删除指定时间之前移入回收站的用户
+func (dao *GORMUserDAO) PurgeExpired(ctx context.Context, before time.Time) (int64, error) {
+	return dao.purge(ctx, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("deleted_at <> ? AND deleted_at < ?", 0, before.UnixMilli())
+	})
+}
+
+// purge 彻底删除 scope 圈定的已删除用户，同一事务内清理角色关联
+// 软删除时已扣减部门用户数，以空实体删除不再触发钩子
+func (dao *GORMUserDAO) purge(ctx context.Context, scope func(tx *gorm.DB) *gorm.DB) (int64, error) {
+	var rows int64
+	err := dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var ids []string
+		if err := scope(tx.Unscoped().Model(&system.User{})).Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		if err := tx.Where("user_id IN ?", ids).Delete(&system.UserRole{}).Error; err != nil {
+			return err
+		}
+		result := tx.Unscoped().Where("id IN ?", ids).Delete(&system.User{})
+		rows = result.RowsAffected
+		return result.Error
+	})
+	return rows, err
+}
@@ -0,0 +1,246 @@
+/**
+ * Description：
+ * FileName：bucket.go
+ * Author：CJiaの用心
+ * Create：2025/11/11 16:12:30
+ * Remark：
+ */
+
+package tools
+
+import (
+	"context"
+	"errors"
+	domainTools "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/tools"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/tools"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	"gorm.io/gorm"
+	"time"
+)
+
+var (
+	ErrBucketNotFound             = gorm.ErrRecordNotFound
+	ErrBucketCodeDuplicate        = errors.New("存储桶编码已存在")
+	ErrBucketNameDuplicate        = errors.New("存储桶名称已存在")
+	ErrBucketVersionInconsistency = errors.New("数据已被修改，请刷新后重试")
+)
+
+type BucketDAO interface {
+	Insert(ctx context.Context, model tools.Bucket) (*tools.Bucket, error)
+	Delete(ctx context.Context, id string) error
+	Update(ctx context.Context, model tools.Bucket) error
+
+	FindById(ctx context.Context, id string) (*tools.Bucket, error)
+	FindByCode(ctx context.Context, code string) (*tools.Bucket, error)
+	FindListPage(ctx context.Context, filter domainTools.BucketFilter) ([]*tools.Bucket, int64, error)
+	FindListAll(ctx context.Context, filter domainTools.BucketFilter) ([]*tools.Bucket, error)
+
+	CheckExistByCode(ctx context.Context, code, excludeId string) (bool, error)
+	CheckExistByName(ctx context.Context, name, excludeId string) (bool, error)
+
+	FindDeletedById(ctx context.Context, id string) (*tools.Bucket, error)
+	FindRecycleListPage(ctx context.Context, filter domainTools.RecycleFilter) ([]*tools.Bucket, int64, error)
+	Restore(ctx context.Context, model tools.Bucket) error
+	Purge(ctx context.Context, ids []string) (int64, error)
+	PurgeExpired(ctx context.Context, before time.Time) (int64, error)
+}
+
+type GORMBucketDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMBucketDAO(db *gorm.DB) BucketDAO {
+	return &GORMBucketDAO{
+		db: db,
+	}
+}
+
+// Insert 新增
+func (dao *GORMBucketDAO) Insert(ctx context.Context, model tools.Bucket) (*tools.Bucket, error) {
+	return &model, dao.db.WithContext(ctx).Create(&model).Error
+}
+
+// Delete 删除，移入回收站
+func (dao *GORMBucketDAO) Delete(ctx context.Context, id string) error {
+	return dao.db.WithContext(ctx).Where("id = ?", id).Delete(&tools.Bucket{}).Error
+}
+
+// Update 更新，编码作为文件存储路径前缀，创建后不允许修改
+func (dao *GORMBucketDAO) Update(ctx context.Context, model tools.Bucket) error {
+	result := dao.db.WithContext(ctx).Model(&model).
+		Where("id = ? AND timestamp = ?", model.Id, model.Timestamp).
+		Updates(map[string]any{
+			"name":         model.Name,
+			"sort":         model.Sort,
+			"timestamp":    time.Now().UnixMicro(),
+			"status":       model.Status,
+			"maxSize":      model.MaxSize,
+			"allowedMimes": model.AllowedMimes,
+			"modifier":     model.Modifier,
+			"remark":       model.Remark,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	// 处理行影响数为0的情况
+	if result.RowsAffected == 0 {
+		// 先检查记录是否存在
+		var exists bool
+		dao.db.WithContext(ctx).
+			Model(&tools.Bucket{}).
+			Select("1").
+			Where("id = ?", model.Id).
+			Limit(1).
+			Find(&exists)
+
+		if !exists {
+			return ErrBucketNotFound
+		}
+		return ErrBucketVersionInconsistency
+	}
+	return nil
+}
+
+// FindById 根据id获取详情
+func (dao *GORMBucketDAO) FindById(ctx context.Context, id string) (*tools.Bucket, error) {
+	var model tools.Bucket
+	err := dao.db.WithContext(ctx).Where("id = ?", id).First(&model).Error
+	return &model, err
+}
+
+// FindByCode 根据编码获取详情
+func (dao *GORMBucketDAO) FindByCode(ctx context.Context, code string) (*tools.Bucket, error) {
+	var model tools.Bucket
+	err := dao.db.WithContext(ctx).Where("code = ?", code).First(&model).Error
+	return &model, err
+}
+
+// FindListPage 分页查询
+func (dao *GORMBucketDAO) FindListPage(ctx context.Context, filter domainTools.BucketFilter) ([]*tools.Bucket, int64, error) {
+	var total int64
+	var models []*tools.Bucket
+
+	query := dao.buildQuery(ctx, filter)
+
+	err := query.Count(&total).
+		Offset((filter.Page - 1) * filter.PageSize).
+		Limit(filter.PageSize).
+		Find(&models).Error
+
+	return models, total, err
+}
+
+// FindListAll 获取所有列表
+func (dao *GORMBucketDAO) FindListAll(ctx context.Context, filter domainTools.BucketFilter) ([]*tools.Bucket, error) {
+	var models []*tools.Bucket
+	if err := dao.buildQuery(ctx, filter).Find(&models).Error; err != nil {
+		return nil, err
+	}
+	return models, nil
+}
+
+// buildQuery 构建查询条件
+func (dao *GORMBucketDAO) buildQuery(ctx context.Context, filter domainTools.BucketFilter) *gorm.DB {
+	builder := &domainTools.BucketFilter{
+		Filters: filters.Filters{
+			Creator:    filter.Creator,
+			Modifier:   filter.Modifier,
+			BelongDept: filter.BelongDept,
+		},
+		Status: filter.Status,
+		Name:   filter.Name,
+		Code:   filter.Code,
+	}
+	return builder.QueryFilter(ctx, dao.db.WithContext(ctx).Model(&tools.Bucket{}))
+}
+
+// CheckExistByCode 检查code是否存在
+func (dao *GORMBucketDAO) CheckExistByCode(ctx context.Context, code, excludeId string) (bool, error) {
+	return dao.checkExist(ctx, "code", code, excludeId)
+}
+
+// CheckExistByName 检查name是否存在
+func (dao *GORMBucketDAO) CheckExistByName(ctx context.Context, name, excludeId string) (bool, error) {
+	return dao.checkExist(ctx, "name", name, excludeId)
+}
+
+// checkExist 检查字段值是否已被其他记录使用
+func (dao *GORMBucketDAO) checkExist(ctx context.Context, column, value, excludeId string) (bool, error) {
+	var model tools.Bucket
+	query := dao.db.WithContext(ctx).Model(&tools.Bucket{}).
+		Select("id"). // 只查询必要的字段
+		Where(column+" = ?", value)
+
+	if excludeId != "" {
+		query = query.Where("id != ?", excludeId)
+	}
+
+	// 使用 LIMIT 1 快速判断是否存在
+	err := query.Limit(1).First(&model).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil // 不存在
+	}
+	return err == nil, err // 存在或查询出错
+}
+
+// FindDeletedById 根据id获取回收站中的存储桶
+func (dao *GORMBucketDAO) FindDeletedById(ctx context.Context, id string) (*tools.Bucket, error) {
+	var model tools.Bucket
+	err := dao.db.WithContext(ctx).Unscoped().
+		Where("id = ? AND deleted_at <> ?", id, 0).
+		First(&model).Error
+	return &model, err
+}
+
+// FindRecycleListPage 分页查询回收站
+func (dao *GORMBucketDAO) FindRecycleListPage(ctx context.Context, filter domainTools.RecycleFilter) ([]*tools.Bucket, int64, error) {
+	var total int64
+	var models []*tools.Bucket
+
+	query := filter.QueryFilter(ctx, dao.db.WithContext(ctx).Unscoped().Model(&tools.Bucket{}))
+
+	err := query.Count(&total).
+		Offset((filter.Page - 1) * filter.PageSize).
+		Limit(filter.PageSize).
+		Find(&models).Error
+
+	return models, total, err
+}
+
+// Restore 恢复存储桶
+func (dao *GORMBucketDAO) Restore(ctx context.Context, model tools.Bucket) error {
+	result := dao.db.WithContext(ctx).Unscoped().Model(&tools.Bucket{}).
+		Where("id = ? AND deleted_at = ?", model.Id, model.DeletedAt).
+		UpdateColumns(models.RestoreColumns())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrBucketNotFound
+	}
+	return nil
+}
+
+// Purge 彻底删除回收站中的存储桶，仍被文件(含回收站中的文件)引用的存储桶不删除
+func (dao *GORMBucketDAO) Purge(ctx context.Context, ids []string) (int64, error) {
+	result := dao.unreferenced(ctx).
+		Where("id IN ? AND deleted_at <> ?", ids, 0).
+		Delete(&tools.Bucket{})
+	return result.RowsAffected, result.Error
+}
+
+// PurgeExpired 彻底删除指定时间之前移入回收站的存储桶，仍被文件引用的存储桶不删除
+func (dao *GORMBucketDAO) PurgeExpired(ctx context.Context, before time.Time) (int64, error) {
+	result := dao.unreferenced(ctx).
+		Where("deleted_at <> ? AND deleted_at < ?", 0, before.UnixMilli()).
+		Delete(&tools.Bucket{})
+	return result.RowsAffected, result.Error
+}
+
+// unreferenced 未被任何文件引用的存储桶，文件在回收站中时仍引用存储桶
+func (dao *GORMBucketDAO) unreferenced(ctx context.Context) *gorm.DB {
+	return dao.db.WithContext(ctx).Unscoped().
+		Where("id NOT IN (?)", dao.db.Unscoped().Model(&tools.File{}).Select("bucket_id"))
+}
@@ -14,6 +14,7 @@ import (
 	domainTools "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/tools"
 	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/tools"
 	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
 	"gorm.io/gorm"
 	"time"
 )
@@ -26,8 +27,12 @@ var (
 	ErrDictVersionInconsistency = errors.New("数据已被修改，请刷新后重试")
 )
 
+// insertBatchSize 批量新增时每条 INSERT 语句包含的记录数
+const insertBatchSize = 100
+
 type DictDAO interface {
 	Insert(ctx context.Context, model tools.Dict) (*tools.Dict, error)
+	BatchInsert(ctx context.Context, models []tools.Dict) error
 	Delete(ctx context.Context, id string) error
 	BatchDelete(ctx context.Context, ids []string) error
 	Update(ctx context.Context, model tools.Dict) error
@@ -39,6 +44,14 @@ type DictDAO interface {
 
 	CheckExistByCode(ctx context.Context, code, excludeId string) (bool, error)
 	CheckExistByName(ctx context.Context, name, excludeId string) (bool, error)
+	FindExistingCodes(ctx context.Context, codes []string) ([]string, error)
+	FindExistingNames(ctx context.Context, names []string) ([]string, error)
+
+	FindDeletedById(ctx context.Context, id string) (*tools.Dict, error)
+	FindRecycleListPage(ctx context.Context, filter domainTools.RecycleFilter) ([]*tools.Dict, int64, error)
+	Restore(ctx context.Context, model tools.Dict) error
+	Purge(ctx context.Context, ids []string) (int64, error)
+	PurgeExpired(ctx context.Context, before time.Time) (int64, error)
 }
 
 type GORMDictDAO struct {
@@ -56,14 +69,32 @@ func (dao *GORMDictDAO) Insert(ctx context.Context, model tools.Dict) (*tools.Di
 	return &model, dao.db.WithContext(ctx).Create(&model).Error
 }
 
+// BatchInsert 批量新增，在同一事务内分批写入
+func (dao *GORMDictDAO) BatchInsert(ctx context.Context, models []tools.Dict) error {
+	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return tx.CreateInBatches(&models, insertBatchSize).Error
+	})
+}
+
 // Delete 删除
 func (dao *GORMDictDAO) Delete(ctx context.Context, id string) error {
-	return dao.db.WithContext(ctx).Where("id = ?", id).Delete(&tools.Dict{}).Error
+	return dao.BatchDelete(ctx, []string{id})
 }
 
-// BatchDelete 批量删除
+// BatchDelete 批量删除，字典及其字典项以相同删除时间移入回收站，恢复字典时一并恢复
 func (dao *GORMDictDAO) BatchDelete(ctx context.Context, ids []string) error {
-	return dao.db.WithContext(ctx).Where("id IN ?", ids).Delete(&tools.Dict{}).Error
+	deleted := models.NewSoftDelete(ctx).Columns()
+	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&tools.DictType{}).
+			Where("dict_id IN ?", ids).
+			UpdateColumns(deleted).Error
+		if err != nil {
+			return err
+		}
+		return tx.Model(&tools.Dict{}).
+			Where("id IN ?", ids).
+			UpdateColumns(deleted).Error
+	})
 }
 
 // Update 更新
@@ -196,3 +227,87 @@ func (dao *GORMDictDAO) CheckExistByName(ctx context.Context, name, excludeId st
 	}
 	return err == nil, err // 存在或查询出错
 }
+
+// FindExistingCodes 批量查询已存在的code
+func (dao *GORMDictDAO) FindExistingCodes(ctx context.Context, codes []string) ([]string, error) {
+	if len(codes) == 0 {
+		return []string{}, nil
+	}
+
+	var existing []string
+	err := dao.db.WithContext(ctx).Model(&tools.Dict{}).
+		Where("code IN ?", codes).
+		Pluck("code", &existing).Error
+	return existing, err
+}
+
+// FindExistingNames 批量查询已存在的name
+func (dao *GORMDictDAO) FindExistingNames(ctx context.Context, names []string) ([]string, error) {
+	if len(names) == 0 {
+		return []string{}, nil
+	}
+
+	var existing []string
+	err := dao.db.WithContext(ctx).Model(&tools.Dict{}).
+		Where("name IN ?", names).
+		Pluck("name", &existing).Error
+	return existing, err
+}
+
+// FindDeletedById 根据id获取回收站中的字典
+func (dao *GORMDictDAO) FindDeletedById(ctx context.Context, id string) (*tools.Dict, error) {
+	var model tools.Dict
+	err := dao.db.WithContext(ctx).Unscoped().
+		Where("id = ? AND deleted_at <> ?", id, 0).
+		First(&model).Error
+	return &model, err
+}
+
+// FindRecycleListPage 分页查询回收站
+func (dao *GORMDictDAO) FindRecycleListPage(ctx context.Context, filter domainTools.RecycleFilter) ([]*tools.Dict, int64, error) {
+	var total int64
+	var models []*tools.Dict
+
+	query := filter.QueryFilter(ctx, dao.db.WithContext(ctx).Unscoped().Model(&tools.Dict{}))
+
+	err := query.Count(&total).
+		Offset((filter.Page - 1) * filter.PageSize).
+		Limit(filter.PageSize).
+		Find(&models).Error
+
+	return models, total, err
+}
+
+// Restore 恢复字典及随其一同删除的字典项
+func (dao *GORMDictDAO) Restore(ctx context.Context, model tools.Dict) error {
+	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Unscoped().Model(&tools.Dict{}).
+			Where("id = ? AND deleted_at = ?", model.Id, model.DeletedAt).
+			UpdateColumns(models.RestoreColumns())
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrDictNotFound
+		}
+		return tx.Unscoped().Model(&tools.DictType{}).
+			Where("dict_id = ? AND deleted_at = ?", model.Id, model.DeletedAt).
+			UpdateColumns(models.RestoreColumns()).Error
+	})
+}
+
+// Purge 彻底删除回收站中的字典，字典项由外键级联删除
+func (dao *GORMDictDAO) Purge(ctx context.Context, ids []string) (int64, error) {
+	result := dao.db.WithContext(ctx).Unscoped().
+		Where("id IN ? AND deleted_at <> ?", ids, 0).
+		Delete(&tools.Dict{})
+	return result.RowsAffected, result.Error
+}
+
+// PurgeExpired 彻底删除指定时间之前移入回收站的字典
+func (dao *GORMDictDAO) PurgeExpired(ctx context.Context, before time.Time) (int64, error) {
+	result := dao.db.WithContext(ctx).Unscoped().
+		Where("deleted_at <> ? AND deleted_at < ?", 0, before.UnixMilli()).
+		Delete(&tools.Dict{})
+	return result.RowsAffected, result.Error
+}
@@ -11,9 +11,11 @@ package tools
 import (
 	"context"
 	"errors"
+	"fmt"
 	domainTools "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/tools"
 	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/tools"
 	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
 	"gorm.io/gorm"
 	"time"
 )
@@ -27,14 +29,25 @@ var (
 
 type DictTypeDAO interface {
 	Insert(ctx context.Context, model tools.DictType) (*tools.DictType, error)
+	BatchInsert(ctx context.Context, models []tools.DictType) error
 	Delete(ctx context.Context, id string) error
 	BatchDelete(ctx context.Context, ids []string) error
 	Update(ctx context.Context, model tools.DictType) error
 
 	FindById(ctx context.Context, id string) (*tools.DictType, error)
 	FindByDictNames(ctx context.Context, dictNames []string) ([]*tools.DictType, error)
+	FindDictNamesByIds(ctx context.Context, ids []string) ([]string, error)
 	FindListPage(ctx context.Context, filter domainTools.DictTypeFilter) ([]*tools.DictType, int64, error)
 	FindListAll(ctx context.Context, filter domainTools.DictTypeFilter) ([]*tools.DictType, error)
+
+	FindExistingNames(ctx context.Context, models []tools.DictType) ([]*tools.DictType, error)
+	FindExistingValues(ctx context.Context, models []tools.DictType) ([]*tools.DictType, error)
+
+	FindDeletedById(ctx context.Context, id string) (*tools.DictType, error)
+	FindRecycleListPage(ctx context.Context, filter domainTools.RecycleFilter) ([]*tools.DictType, int64, error)
+	Restore(ctx context.Context, model tools.DictType) error
+	Purge(ctx context.Context, ids []string) (int64, error)
+	PurgeExpired(ctx context.Context, before time.Time) (int64, error)
 }
 
 type GORMDictTypeDAO struct {
@@ -50,6 +63,13 @@ func (dao *GORMDictTypeDAO) Insert(ctx context.Context, model tools.DictType) (*
 	return &model, dao.db.WithContext(ctx).Create(&model).Error
 }
 
+// BatchInsert 批量新增，在同一事务内分批写入
+func (dao *GORMDictTypeDAO) BatchInsert(ctx context.Context, models []tools.DictType) error {
+	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return tx.CreateInBatches(&models, insertBatchSize).Error
+	})
+}
+
 // Delete 删除
 func (dao *GORMDictTypeDAO) Delete(ctx context.Context, id string) error {
 	return dao.db.WithContext(ctx).Where("id = ?", id).Delete(&tools.DictType{}).Error
@@ -102,20 +122,94 @@ func (dao *GORMDictTypeDAO) FindById(ctx context.Context, id string) (*tools.Dic
 
 // FindByDictNames 根据多个dictName获取详情
 func (dao *GORMDictTypeDAO) FindByDictNames(ctx context.Context, dictNames []string) ([]*tools.DictType, error) {
-	// if len(dictNames) == 0 {
-	// 	return []*tools.DictType{}, nil
-	// }
-	//
-	// var models []*tools.DictType
-	// err := dao.db.WithContext(ctx).
-	// 	Where("status = ?", true).
-	// 	Where("dictName IN ?", dictNames).
-	// 	Order("sort ASC, update_time DESC").
-	// 	Find(&models).Error
-	//
-	// return models, err
-
-	return nil, nil
+	if len(dictNames) == 0 {
+		return []*tools.DictType{}, nil
+	}
+
+	var models []*tools.DictType
+	err := dao.db.WithContext(ctx).
+		Where("status = ?", true).
+		Where("dictName IN ?", dictNames).
+		Order("sort ASC, update_time DESC").
+		Find(&models).Error
+
+	return models, err
+}
+
+// FindDictNamesByIds 根据多个id获取所属字典名称(去重)
+func (dao *GORMDictTypeDAO) FindDictNamesByIds(ctx context.Context, ids []string) ([]string, error) {
+	if len(ids) == 0 {
+		return []string{}, nil
+	}
+
+	var dictNames []string
+	err := dao.db.WithContext(ctx).
+		Model(&tools.DictType{}).
+		Where("id IN ?", ids).
+		Distinct().
+		Pluck("dictName", &dictNames).Error
+
+	return dictNames, err
+}
+
+// FindExistingNames 按(所属字典ID, 名称)批量查询已存在的字典信息
+func (dao *GORMDictTypeDAO) FindExistingNames(ctx context.Context, models []tools.DictType) ([]*tools.DictType, error) {
+	if len(models) == 0 {
+		return []*tools.DictType{}, nil
+	}
+
+	pairs := make([][]any, 0, len(models))
+	for _, model := range models {
+		pairs = append(pairs, []any{model.DictId, model.Name})
+	}
+
+	var existing []*tools.DictType
+	err := dao.db.WithContext(ctx).
+		Select("id", "dict_id", "dictName", "name").
+		Where("(dict_id, name) IN ?", pairs).
+		Find(&existing).Error
+
+	return existing, err
+}
+
+// FindExistingValues 按(所属字典ID, 字典值)批量查询已存在的字典信息，字典值列由数据类型决定
+func (dao *GORMDictTypeDAO) FindExistingValues(ctx context.Context, models []tools.DictType) ([]*tools.DictType, error) {
+	columns := []string{"strValue", "intValue", "boolValue"}
+	pairs := make(map[string][][]any, len(columns))
+	for _, model := range models {
+		switch {
+		case model.StrValue.Valid:
+			pairs["strValue"] = append(pairs["strValue"], []any{model.DictId, model.StrValue.String})
+		case model.IntValue.Valid:
+			pairs["intValue"] = append(pairs["intValue"], []any{model.DictId, model.IntValue.Int64})
+		case model.BoolValue.Valid:
+			pairs["boolValue"] = append(pairs["boolValue"], []any{model.DictId, model.BoolValue.Bool})
+		}
+	}
+
+	var conditions *gorm.DB
+	for _, column := range columns {
+		if len(pairs[column]) == 0 {
+			continue
+		}
+		condition := fmt.Sprintf("(dict_id, %s) IN ?", column)
+		if conditions == nil {
+			conditions = dao.db.Where(condition, pairs[column])
+		} else {
+			conditions = conditions.Or(condition, pairs[column])
+		}
+	}
+	if conditions == nil {
+		return []*tools.DictType{}, nil
+	}
+
+	var existing []*tools.DictType
+	err := dao.db.WithContext(ctx).
+		Select("id", "dict_id", "dictName", "name", "valueType", "strValue", "intValue", "boolValue").
+		Where(conditions).
+		Find(&existing).Error
+
+	return existing, err
 }
 
 // FindListPage 分页查询
@@ -164,3 +258,57 @@ func (dao *GORMDictTypeDAO) buildQuery(ctx context.Context, filter domainTools.D
 	}
 	return builder.QueryFilter(ctx, dao.db.WithContext(ctx).Model(&tools.DictType{}))
 }
+
+// FindDeletedById 根据id获取回收站中的字典信息
+func (dao *GORMDictTypeDAO) FindDeletedById(ctx context.Context, id string) (*tools.DictType, error) {
+	var model tools.DictType
+	err := dao.db.WithContext(ctx).Unscoped().
+		Where("id = ? AND deleted_at <> ?", id, 0).
+		First(&model).Error
+	return &model, err
+}
+
+// FindRecycleListPage 分页查询回收站
+func (dao *GORMDictTypeDAO) FindRecycleListPage(ctx context.Context, filter domainTools.RecycleFilter) ([]*tools.DictType, int64, error) {
+	var total int64
+	var models []*tools.DictType
+
+	query := filter.QueryFilter(ctx, dao.db.WithContext(ctx).Unscoped().Model(&tools.DictType{}))
+
+	err := query.Count(&total).
+		Offset((filter.Page - 1) * filter.PageSize).
+		Limit(filter.PageSize).
+		Find(&models).Error
+
+	return models, total, err
+}
+
+// Restore 恢复字典信息
+func (dao *GORMDictTypeDAO) Restore(ctx context.Context, model tools.DictType) error {
+	result := dao.db.WithContext(ctx).Unscoped().Model(&tools.DictType{}).
+		Where("id = ? AND deleted_at = ?", model.Id, model.DeletedAt).
+		UpdateColumns(models.RestoreColumns())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrDictTypeNotFound
+	}
+	return nil
+}
+
+// Purge 彻底删除回收站中的字典信息
+func (dao *GORMDictTypeDAO) Purge(ctx context.Context, ids []string) (int64, error) {
+	result := dao.db.WithContext(ctx).Unscoped().
+		Where("id IN ? AND deleted_at <> ?", ids, 0).
+		Delete(&tools.DictType{})
+	return result.RowsAffected, result.Error
+}
+
+// PurgeExpired 彻底删除指定时间之前移入回收站的字典信息
+func (dao *GORMDictTypeDAO) PurgeExpired(ctx context.Context, before time.Time) (int64, error) {
+	result := dao.db.WithContext(ctx).Unscoped().
+		Where("deleted_at <> ? AND deleted_at < ?", 0, before.UnixMilli()).
+		Delete(&tools.DictType{})
+	return result.RowsAffected, result.Error
+}
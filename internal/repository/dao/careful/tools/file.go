@@ -0,0 +1,217 @@
+/**
+ * Description：
+ * FileName：file.go
+ * Author：CJiaの用心
+ * Create：2025/11/11 16:25:08
+ * Remark：
+ */
+
+package tools
+
+import (
+	"context"
+	"errors"
+	domainTools "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/tools"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/tools"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	"gorm.io/gorm"
+	"time"
+)
+
+var (
+	ErrFileNotFound       = gorm.ErrRecordNotFound
+	ErrFileBucketNotFound = errors.New("文件所属存储桶不存在")
+)
+
+type FileDAO interface {
+	Insert(ctx context.Context, model tools.File) (*tools.File, error)
+	Delete(ctx context.Context, id string) error
+
+	FindById(ctx context.Context, id string) (*tools.File, error)
+	FindByHash(ctx context.Context, bucketId, hash string) (*tools.File, error)
+	FindListPage(ctx context.Context, filter domainTools.FileFilter) ([]*tools.File, int64, error)
+
+	CountByObjectKey(ctx context.Context, objectKey string) (int64, error)
+	CountByBucket(ctx context.Context, bucketId string) (int64, error)
+	CountAllByBucket(ctx context.Context, bucketId string) (int64, error)
+
+	FindDeletedById(ctx context.Context, id string) (*tools.File, error)
+	FindRecycleListPage(ctx context.Context, filter domainTools.RecycleFilter) ([]*tools.File, int64, error)
+	Restore(ctx context.Context, model tools.File) error
+	Purge(ctx context.Context, ids []string) ([]*tools.File, error)
+	PurgeExpired(ctx context.Context, before time.Time) ([]*tools.File, error)
+}
+
+type GORMFileDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMFileDAO(db *gorm.DB) FileDAO {
+	return &GORMFileDAO{
+		db: db,
+	}
+}
+
+// Insert 新增
+func (dao *GORMFileDAO) Insert(ctx context.Context, model tools.File) (*tools.File, error) {
+	return &model, dao.db.WithContext(ctx).Create(&model).Error
+}
+
+// Delete 删除，移入回收站，存储对象在彻底删除后释放
+func (dao *GORMFileDAO) Delete(ctx context.Context, id string) error {
+	return dao.db.WithContext(ctx).Where("id = ?", id).Delete(&tools.File{}).Error
+}
+
+// FindById 根据id获取详情
+func (dao *GORMFileDAO) FindById(ctx context.Context, id string) (*tools.File, error) {
+	var model tools.File
+	err := dao.db.WithContext(ctx).Where("id = ?", id).First(&model).Error
+	return &model, err
+}
+
+// FindByHash 查询存储桶内内容哈希相同的文件
+func (dao *GORMFileDAO) FindByHash(ctx context.Context, bucketId, hash string) (*tools.File, error) {
+	var model tools.File
+	err := dao.db.WithContext(ctx).
+		Where("bucket_id = ? AND hash = ?", bucketId, hash).
+		Order("create_time ASC").
+		First(&model).Error
+	return &model, err
+}
+
+// FindListPage 分页查询
+func (dao *GORMFileDAO) FindListPage(ctx context.Context, filter domainTools.FileFilter) ([]*tools.File, int64, error) {
+	var total int64
+	var models []*tools.File
+
+	builder := &domainTools.FileFilter{
+		Filters: filters.Filters{
+			Creator:    filter.Creator,
+			Modifier:   filter.Modifier,
+			BelongDept: filter.BelongDept,
+		},
+		TimeRange: filter.TimeRange,
+		BucketId:  filter.BucketId,
+		Name:      filter.Name,
+		MimeType:  filter.MimeType,
+		Hash:      filter.Hash,
+	}
+	query := builder.QueryFilter(ctx, dao.db.WithContext(ctx).Model(&tools.File{}))
+
+	err := query.Count(&total).
+		Offset((filter.Page - 1) * filter.PageSize).
+		Limit(filter.PageSize).
+		Find(&models).Error
+
+	return models, total, err
+}
+
+// CountByObjectKey 统计引用同一存储对象的文件数，包括回收站中的文件
+func (dao *GORMFileDAO) CountByObjectKey(ctx context.Context, objectKey string) (int64, error) {
+	var count int64
+	err := dao.db.WithContext(ctx).Unscoped().Model(&tools.File{}).
+		Where("objectKey = ?", objectKey).
+		Count(&count).Error
+	return count, err
+}
+
+// CountByBucket 统计存储桶内的文件数
+func (dao *GORMFileDAO) CountByBucket(ctx context.Context, bucketId string) (int64, error) {
+	var count int64
+	err := dao.db.WithContext(ctx).Model(&tools.File{}).
+		Where("bucket_id = ?", bucketId).
+		Count(&count).Error
+	return count, err
+}
+
+// CountAllByBucket 统计存储桶内的文件数，包括回收站中的文件
+func (dao *GORMFileDAO) CountAllByBucket(ctx context.Context, bucketId string) (int64, error) {
+	var count int64
+	err := dao.db.WithContext(ctx).Unscoped().Model(&tools.File{}).
+		Where("bucket_id = ?", bucketId).
+		Count(&count).Error
+	return count, err
+}
+
+// FindDeletedById 根据id获取回收站中的文件
+func (dao *GORMFileDAO) FindDeletedById(ctx context.Context, id string) (*tools.File, error) {
+	var model tools.File
+	err := dao.db.WithContext(ctx).Unscoped().
+		Where("id = ? AND deleted_at <> ?", id, 0).
+		First(&model).Error
+	return &model, err
+}
+
+// FindRecycleListPage 分页查询回收站
+func (dao *GORMFileDAO) FindRecycleListPage(ctx context.Context, filter domainTools.RecycleFilter) ([]*tools.File, int64, error) {
+	var total int64
+	var models []*tools.File
+
+	query := filter.QueryFilter(ctx, dao.db.WithContext(ctx).Unscoped().Model(&tools.File{}))
+
+	err := query.Count(&total).
+		Offset((filter.Page - 1) * filter.PageSize).
+		Limit(filter.PageSize).
+		Find(&models).Error
+
+	return models, total, err
+}
+
+// Restore 恢复文件，所属存储桶需未被删除
+func (dao *GORMFileDAO) Restore(ctx context.Context, model tools.File) error {
+	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&tools.Bucket{}).Where("id = ?", model.BucketId).Count(&count).Error; err != nil {
+			return err
+		}
+		if count == 0 {
+			return ErrFileBucketNotFound
+		}
+
+		result := tx.Unscoped().Model(&tools.File{}).
+			Where("id = ? AND deleted_at = ?", model.Id, model.DeletedAt).
+			UpdateColumns(models.RestoreColumns())
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrFileNotFound
+		}
+		return nil
+	})
+}
+
+// Purge 彻底删除回收站中的文件，返回被删除的文件以便释放存储对象
+func (dao *GORMFileDAO) Purge(ctx context.Context, ids []string) ([]*tools.File, error) {
+	return dao.purge(ctx, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("id IN ? AND deleted_at <> ?", ids, 0)
+	})
+}
+
+// PurgeExpired 彻底删除指定时间之前移入回收站的文件，返回被删除的文件以便释放存储对象
+func (dao *GORMFileDAO) PurgeExpired(ctx context.Context, before time.Time) ([]*tools.File, error) {
+	return dao.purge(ctx, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("deleted_at <> ? AND deleted_at < ?", 0, before.UnixMilli())
+	})
+}
+
+// purge 彻底删除 scope 圈定的已删除文件
+func (dao *GORMFileDAO) purge(ctx context.Context, scope func(tx *gorm.DB) *gorm.DB) ([]*tools.File, error) {
+	var models []*tools.File
+	err := dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := scope(tx.Unscoped()).Find(&models).Error; err != nil {
+			return err
+		}
+		if len(models) == 0 {
+			return nil
+		}
+
+		ids := make([]string, 0, len(models))
+		for _, model := range models {
+			ids = append(ids, model.Id)
+		}
+		return tx.Unscoped().Where("id IN ?", ids).Delete(&tools.File{}).Error
+	})
+	return models, err
+}
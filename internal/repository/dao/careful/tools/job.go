@@ -0,0 +1,123 @@
+/**
+ * Description：
+ * FileName：job.go
+ * Author：CJiaの用心
+ * Create：2025/11/7 11:05:49
+ * Remark：
+ */
+
+package tools
+
+import (
+	"context"
+	"errors"
+	domainTools "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/tools"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/tools"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/tools/job"
+	"gorm.io/gorm"
+	"time"
+)
+
+var (
+	ErrJobNotFound       = gorm.ErrRecordNotFound
+	ErrJobStatusConflict = errors.New("任务状态已变更，无法流转到目标状态")
+)
+
+type JobDAO interface {
+	Insert(ctx context.Context, model tools.Job) (*tools.Job, error)
+	Transition(ctx context.Context, id string, to job.StatusConst, updates map[string]any) error
+	UpdateProgress(ctx context.Context, id string, updates map[string]any) error
+	FailUnfinished(ctx context.Context, message string) (int64, error)
+
+	FindById(ctx context.Context, id string) (*tools.Job, error)
+	FindListPage(ctx context.Context, filter domainTools.JobFilter) ([]*tools.Job, int64, error)
+	FindExpired(ctx context.Context, before time.Time, limit int) ([]*tools.Job, error)
+}
+
+type GORMJobDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMJobDAO(db *gorm.DB) JobDAO {
+	return &GORMJobDAO{
+		db: db,
+	}
+}
+
+// Insert 新增
+func (dao *GORMJobDAO) Insert(ctx context.Context, model tools.Job) (*tools.Job, error) {
+	return &model, dao.db.WithContext(ctx).Create(&model).Error
+}
+
+// Transition 状态流转，仅当前状态允许流转到目标状态时更新
+func (dao *GORMJobDAO) Transition(ctx context.Context, id string, to job.StatusConst, updates map[string]any) error {
+	values := make(map[string]any, len(updates)+1)
+	for k, v := range updates {
+		values[k] = v
+	}
+	values["status"] = to
+
+	result := dao.db.WithContext(ctx).Model(&tools.Job{}).
+		Where("id = ? AND status IN ?", id, job.Sources(to)).
+		Updates(values)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrJobStatusConflict
+	}
+	return nil
+}
+
+// UpdateProgress 更新执行进度，仅执行中的任务生效
+func (dao *GORMJobDAO) UpdateProgress(ctx context.Context, id string, updates map[string]any) error {
+	return dao.db.WithContext(ctx).Model(&tools.Job{}).
+		Where("id = ? AND status = ?", id, job.StatusConstRunning).
+		Updates(updates).Error
+}
+
+// FailUnfinished 将未结束的任务标记为失败，用于服务重启后回收中断的任务
+func (dao *GORMJobDAO) FailUnfinished(ctx context.Context, message string) (int64, error) {
+	now := time.Now()
+	result := dao.db.WithContext(ctx).Model(&tools.Job{}).
+		Where("status IN ?", []job.StatusConst{job.StatusConstQueued, job.StatusConstRunning}).
+		Updates(map[string]any{
+			"status":      job.StatusConstFailed,
+			"message":     message,
+			"finish_time": &now,
+		})
+	return result.RowsAffected, result.Error
+}
+
+// FindById 根据id获取详情
+func (dao *GORMJobDAO) FindById(ctx context.Context, id string) (*tools.Job, error) {
+	var model tools.Job
+	err := dao.db.WithContext(ctx).Where("id = ?", id).First(&model).Error
+	return &model, err
+}
+
+// FindListPage 分页查询
+func (dao *GORMJobDAO) FindListPage(ctx context.Context, filter domainTools.JobFilter) ([]*tools.Job, int64, error) {
+	var total int64
+	var models []*tools.Job
+
+	query := filter.QueryFilter(ctx, dao.db.WithContext(ctx).Model(&tools.Job{}))
+
+	err := query.Count(&total).
+		Offset((filter.Page - 1) * filter.PageSize).
+		Limit(filter.PageSize).
+		Find(&models).Error
+
+	return models, total, err
+}
+
+// FindExpired 查询结果文件已过期的已完成任务
+func (dao *GORMJobDAO) FindExpired(ctx context.Context, before time.Time, limit int) ([]*tools.Job, error) {
+	var models []*tools.Job
+	err := dao.db.WithContext(ctx).
+		Where("status = ? AND expire_time < ?", job.StatusConstDone, before).
+		Order("expire_time ASC").
+		Limit(limit).
+		Find(&models).Error
+	return models, err
+}
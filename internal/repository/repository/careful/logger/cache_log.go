@@ -0,0 +1,112 @@
+/**
+ * Description：
+ * FileName：cache_log.go
+ * Author：CJiaの用心
+ * Create：2025/10/30 10:14:57
+ * Remark：
+ */
+
+package logger
+
+import (
+	"context"
+	domainLogger "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/logger"
+	modelLogger "github.com/carefuly/careful-admin-go-gin/internal/model/careful/logger"
+	daoLogger "github.com/carefuly/careful-admin-go-gin/internal/repository/dao/careful/logger"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+)
+
+var (
+	ErrCacheLogNotFound = daoLogger.ErrCacheLogNotFound
+)
+
+type CacheLogRepository interface {
+	BatchDelete(ctx context.Context, ids []string) error
+	Clear(ctx context.Context) error
+
+	GetById(ctx context.Context, id string) (domainLogger.CacheLog, error)
+	GetListPage(ctx context.Context, filter domainLogger.CacheLogFilter) ([]domainLogger.CacheLog, int64, error)
+	GetListAll(ctx context.Context, filter domainLogger.CacheLogFilter) ([]domainLogger.CacheLog, error)
+	GetBatch(ctx context.Context, filter domainLogger.CacheLogFilter, cursor *filters.Cursor, limit int) ([]domainLogger.CacheLog, error)
+}
+
+type cacheLogRepository struct {
+	dao daoLogger.CacheLogDAO
+}
+
+func NewCacheLogRepository(dao daoLogger.CacheLogDAO) CacheLogRepository {
+	return &cacheLogRepository{
+		dao: dao,
+	}
+}
+
+// BatchDelete 批量删除
+func (repo *cacheLogRepository) BatchDelete(ctx context.Context, ids []string) error {
+	return repo.dao.BatchDelete(ctx, ids)
+}
+
+// Clear 清空
+func (repo *cacheLogRepository) Clear(ctx context.Context) error {
+	return repo.dao.Clear(ctx)
+}
+
+// GetById 根据ID获取
+func (repo *cacheLogRepository) GetById(ctx context.Context, id string) (domainLogger.CacheLog, error) {
+	model, err := repo.dao.FindById(ctx, id)
+	if err != nil {
+		return domainLogger.CacheLog{}, err
+	}
+	return repo.toDomain(model), nil
+}
+
+// GetListPage 分页查询列表
+func (repo *cacheLogRepository) GetListPage(ctx context.Context, filter domainLogger.CacheLogFilter) ([]domainLogger.CacheLog, int64, error) {
+	list, row, err := repo.dao.FindListPage(ctx, filter)
+	if err != nil {
+		return []domainLogger.CacheLog{}, row, err
+	}
+	return repo.toDomains(list), row, nil
+}
+
+// GetListAll 查询所有列表
+func (repo *cacheLogRepository) GetListAll(ctx context.Context, filter domainLogger.CacheLogFilter) ([]domainLogger.CacheLog, error) {
+	list, err := repo.dao.FindListAll(ctx, filter)
+	if err != nil {
+		return []domainLogger.CacheLog{}, err
+	}
+	return repo.toDomains(list), nil
+}
+
+// GetBatch 按游标分批查询列表
+func (repo *cacheLogRepository) GetBatch(ctx context.Context, filter domainLogger.CacheLogFilter, cursor *filters.Cursor, limit int) ([]domainLogger.CacheLog, error) {
+	list, err := repo.dao.FindBatch(ctx, filter, cursor, limit)
+	if err != nil {
+		return []domainLogger.CacheLog{}, err
+	}
+	return repo.toDomains(list), nil
+}
+
+// toDomain 转换为领域模型
+func (repo *cacheLogRepository) toDomain(entity *modelLogger.CacheLogger) domainLogger.CacheLog {
+	model := domainLogger.CacheLog{
+		CacheLogger: *entity,
+	}
+
+	if entity.CreateTime != nil {
+		model.CreateTime = entity.CreateTime.Format("2006-01-02 15:04:05")
+	}
+	if entity.UpdateTime != nil {
+		model.UpdateTime = entity.UpdateTime.Format("2006-01-02 15:04:05")
+	}
+
+	return model
+}
+
+// toDomains 批量转换为领域模型
+func (repo *cacheLogRepository) toDomains(list []*modelLogger.CacheLogger) []domainLogger.CacheLog {
+	toDomain := make([]domainLogger.CacheLog, 0, len(list))
+	for _, v := range list {
+		toDomain = append(toDomain, repo.toDomain(v))
+	}
+	return toDomain
+}
@@ -0,0 +1,65 @@
+/**
+ * Description：
+ * FileName：data_log.go
+ * Author：CJiaの用心
+ * Create：2025/11/14 14:38:51
+ * Remark：
+ */
+
+package logger
+
+import (
+	"context"
+	"encoding/json"
+	domainLogger "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/logger"
+	modelLogger "github.com/carefuly/careful-admin-go-gin/internal/model/careful/logger"
+	daoLogger "github.com/carefuly/careful-admin-go-gin/internal/repository/dao/careful/logger"
+	"go.uber.org/zap"
+)
+
+type DataLogRepository interface {
+	GetListPage(ctx context.Context, filter domainLogger.DataLogFilter) ([]domainLogger.DataLog, int64, error)
+}
+
+type dataLogRepository struct {
+	dao daoLogger.DataLogDAO
+}
+
+func NewDataLogRepository(dao daoLogger.DataLogDAO) DataLogRepository {
+	return &dataLogRepository{
+		dao: dao,
+	}
+}
+
+// GetListPage 分页查询列表
+func (repo *dataLogRepository) GetListPage(ctx context.Context, filter domainLogger.DataLogFilter) ([]domainLogger.DataLog, int64, error) {
+	list, row, err := repo.dao.FindListPage(ctx, filter)
+	if err != nil {
+		return []domainLogger.DataLog{}, row, err
+	}
+
+	domains := make([]domainLogger.DataLog, 0, len(list))
+	for _, v := range list {
+		domains = append(domains, repo.toDomain(v))
+	}
+	return domains, row, nil
+}
+
+// toDomain 转换为领域模型
+func (repo *dataLogRepository) toDomain(entity *modelLogger.DataLogger) domainLogger.DataLog {
+	model := domainLogger.DataLog{
+		DataLogger: *entity,
+		Changes:    []modelLogger.FieldChange{},
+	}
+
+	if entity.Changes != "" {
+		if err := json.Unmarshal([]byte(entity.Changes), &model.Changes); err != nil {
+			zap.L().Error("数据变更日志字段变更解析失败", zap.String("id", entity.Id), zap.Error(err))
+		}
+	}
+	if entity.CreateTime != nil {
+		model.CreateTime = entity.CreateTime.Format("2006-01-02 15:04:05")
+	}
+
+	return model
+}
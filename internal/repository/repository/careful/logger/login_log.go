@@ -0,0 +1,112 @@
+/**
+ * Description：
+ * FileName：login_log.go
+ * Author：CJiaの用心
+ * Create：2025/10/30 10:11:20
+ * Remark：
+ */
+
+package logger
+
+import (
+	"context"
+	domainLogger "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/logger"
+	modelLogger "github.com/carefuly/careful-admin-go-gin/internal/model/careful/logger"
+	daoLogger "github.com/carefuly/careful-admin-go-gin/internal/repository/dao/careful/logger"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+)
+
+var (
+	ErrLoginLogNotFound = daoLogger.ErrLoginLogNotFound
+)
+
+type LoginLogRepository interface {
+	BatchDelete(ctx context.Context, ids []string) error
+	Clear(ctx context.Context) error
+
+	GetById(ctx context.Context, id string) (domainLogger.LoginLog, error)
+	GetListPage(ctx context.Context, filter domainLogger.LoginLogFilter) ([]domainLogger.LoginLog, int64, error)
+	GetListAll(ctx context.Context, filter domainLogger.LoginLogFilter) ([]domainLogger.LoginLog, error)
+	GetBatch(ctx context.Context, filter domainLogger.LoginLogFilter, cursor *filters.Cursor, limit int) ([]domainLogger.LoginLog, error)
+}
+
+type loginLogRepository struct {
+	dao daoLogger.LoginLogDAO
+}
+
+func NewLoginLogRepository(dao daoLogger.LoginLogDAO) LoginLogRepository {
+	return &loginLogRepository{
+		dao: dao,
+	}
+}
+
+// BatchDelete 批量删除
+func (repo *loginLogRepository) BatchDelete(ctx context.Context, ids []string) error {
+	return repo.dao.BatchDelete(ctx, ids)
+}
+
+// Clear 清空
+func (repo *loginLogRepository) Clear(ctx context.Context) error {
+	return repo.dao.Clear(ctx)
+}
+
+// GetById 根据ID获取
+func (repo *loginLogRepository) GetById(ctx context.Context, id string) (domainLogger.LoginLog, error) {
+	model, err := repo.dao.FindById(ctx, id)
+	if err != nil {
+		return domainLogger.LoginLog{}, err
+	}
+	return repo.toDomain(model), nil
+}
+
+// GetListPage 分页查询列表
+func (repo *loginLogRepository) GetListPage(ctx context.Context, filter domainLogger.LoginLogFilter) ([]domainLogger.LoginLog, int64, error) {
+	list, row, err := repo.dao.FindListPage(ctx, filter)
+	if err != nil {
+		return []domainLogger.LoginLog{}, row, err
+	}
+	return repo.toDomains(list), row, nil
+}
+
+// GetListAll 查询所有列表
+func (repo *loginLogRepository) GetListAll(ctx context.Context, filter domainLogger.LoginLogFilter) ([]domainLogger.LoginLog, error) {
+	list, err := repo.dao.FindListAll(ctx, filter)
+	if err != nil {
+		return []domainLogger.LoginLog{}, err
+	}
+	return repo.toDomains(list), nil
+}
+
+// GetBatch 按游标分批查询列表
+func (repo *loginLogRepository) GetBatch(ctx context.Context, filter domainLogger.LoginLogFilter, cursor *filters.Cursor, limit int) ([]domainLogger.LoginLog, error) {
+	list, err := repo.dao.FindBatch(ctx, filter, cursor, limit)
+	if err != nil {
+		return []domainLogger.LoginLog{}, err
+	}
+	return repo.toDomains(list), nil
+}
+
+// toDomain 转换为领域模型
+func (repo *loginLogRepository) toDomain(entity *modelLogger.LoginLogger) domainLogger.LoginLog {
+	model := domainLogger.LoginLog{
+		LoginLogger: *entity,
+	}
+
+	if entity.CreateTime != nil {
+		model.CreateTime = entity.CreateTime.Format("2006-01-02 15:04:05")
+	}
+	if entity.UpdateTime != nil {
+		model.UpdateTime = entity.UpdateTime.Format("2006-01-02 15:04:05")
+	}
+
+	return model
+}
+
+// toDomains 批量转换为领域模型
+func (repo *loginLogRepository) toDomains(list []*modelLogger.LoginLogger) []domainLogger.LoginLog {
+	toDomain := make([]domainLogger.LoginLog, 0, len(list))
+	for _, v := range list {
+		toDomain = append(toDomain, repo.toDomain(v))
+	}
+	return toDomain
+}
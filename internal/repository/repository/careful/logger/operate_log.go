@@ -0,0 +1,117 @@
+/**
+ * Description：
+ * FileName：operate_log.go
+ * Author：CJiaの用心
+ * Create：2025/10/30 10:05:44
+ * Remark：
+ */
+
+package logger
+
+import (
+	"context"
+	domainLogger "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/logger"
+	modelLogger "github.com/carefuly/careful-admin-go-gin/internal/model/careful/logger"
+	daoLogger "github.com/carefuly/careful-admin-go-gin/internal/repository/dao/careful/logger"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+)
+
+var (
+	ErrOperateLogNotFound = daoLogger.ErrOperateLogNotFound
+)
+
+type OperateLogRepository interface {
+	BatchDelete(ctx context.Context, ids []string) error
+	Clear(ctx context.Context) error
+
+	GetById(ctx context.Context, id string) (domainLogger.OperateLog, error)
+	GetListPage(ctx context.Context, filter domainLogger.OperateLogFilter) ([]domainLogger.OperateLog, int64, error)
+	GetListAll(ctx context.Context, filter domainLogger.OperateLogFilter) ([]domainLogger.OperateLog, error)
+	GetBatch(ctx context.Context, filter domainLogger.OperateLogFilter, cursor *filters.Cursor, limit int) ([]domainLogger.OperateLog, error)
+}
+
+type operateLogRepository struct {
+	dao daoLogger.OperateLogDAO
+}
+
+func NewOperateLogRepository(dao daoLogger.OperateLogDAO) OperateLogRepository {
+	return &operateLogRepository{
+		dao: dao,
+	}
+}
+
+// BatchDelete 批量删除
+func (repo *operateLogRepository) BatchDelete(ctx context.Context, ids []string) error {
+	return repo.dao.BatchDelete(ctx, ids)
+}
+
+// Clear 清空
+func (repo *operateLogRepository) Clear(ctx context.Context) error {
+	return repo.dao.Clear(ctx)
+}
+
+// GetById 根据ID获取
+func (repo *operateLogRepository) GetById(ctx context.Context, id string) (domainLogger.OperateLog, error) {
+	model, err := repo.dao.FindById(ctx, id)
+	if err != nil {
+		return domainLogger.OperateLog{}, err
+	}
+	return repo.toDomain(model), nil
+}
+
+// GetListPage 分页查询列表
+func (repo *operateLogRepository) GetListPage(ctx context.Context, filter domainLogger.OperateLogFilter) ([]domainLogger.OperateLog, int64, error) {
+	list, row, err := repo.dao.FindListPage(ctx, filter)
+	if err != nil {
+		return []domainLogger.OperateLog{}, row, err
+	}
+	return repo.toDomains(list), row, nil
+}
+
+// GetListAll 查询所有列表
+func (repo *operateLogRepository) GetListAll(ctx context.Context, filter domainLogger.OperateLogFilter) ([]domainLogger.OperateLog, error) {
+	list, err := repo.dao.FindListAll(ctx, filter)
+	if err != nil {
+		return []domainLogger.OperateLog{}, err
+	}
+	return repo.toDomains(list), nil
+}
+
+// GetBatch 按游标分批查询列表
+func (repo *operateLogRepository) GetBatch(ctx context.Context, filter domainLogger.OperateLogFilter, cursor *filters.Cursor, limit int) ([]domainLogger.OperateLog, error) {
+	list, err := repo.dao.FindBatch(ctx, filter, cursor, limit)
+	if err != nil {
+		return []domainLogger.OperateLog{}, err
+	}
+	return repo.toDomains(list), nil
+}
+
+// toDomain 转换为领域模型
+func (repo *operateLogRepository) toDomain(entity *modelLogger.OperateLogger) domainLogger.OperateLog {
+	model := domainLogger.OperateLog{
+		OperateLogger: *entity,
+	}
+
+	// 请求体以大文本存储，读取时驱动返回字节切片，转换为字符串避免序列化为base64
+	if body, ok := entity.RequestBody.([]byte); ok {
+		model.RequestBody = string(body)
+	}
+
+	if entity.CreateTime != nil {
+		model.CreateTime = entity.CreateTime.Format("2006-01-02 15:04:05")
+	}
+	if entity.UpdateTime != nil {
+		model.UpdateTime = entity.UpdateTime.Format("2006-01-02 15:04:05")
+	}
+
+	return model
+}
+
+// toDomains 批量转换为领域模型
+func (repo *operateLogRepository) toDomains(list []*modelLogger.OperateLogger) []domainLogger.OperateLog {
+	toDomain := make([]domainLogger.OperateLog, 0, len(list))
+	for _, v := range list {
+		toDomain = append(toDomain, repo.toDomain(v))
+	}
+	return toDomain
+}
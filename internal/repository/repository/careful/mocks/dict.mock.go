@@ -7,6 +7,7 @@ package repomocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	tools "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/tools"
 	gomock "github.com/golang/mock/gomock"
@@ -35,6 +36,20 @@ func (m *MockDictRepository) EXPECT() *MockDictRepositoryMockRecorder {
 	return m.recorder
 }
 
+// BatchCreate mocks base method.
+func (m *MockDictRepository) BatchCreate(ctx context.Context, domains []tools.Dict) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchCreate", ctx, domains)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchCreate indicates an expected call of BatchCreate.
+func (mr *MockDictRepositoryMockRecorder) BatchCreate(ctx, domains interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchCreate", reflect.TypeOf((*MockDictRepository)(nil).BatchCreate), ctx, domains)
+}
+
 // BatchDelete mocks base method.
 func (m *MockDictRepository) BatchDelete(ctx context.Context, ids []string) error {
 	m.ctrl.T.Helper()
@@ -138,6 +153,51 @@ func (mr *MockDictRepositoryMockRecorder) GetByName(ctx, name interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByName", reflect.TypeOf((*MockDictRepository)(nil).GetByName), ctx, name)
 }
 
+// GetDeletedById mocks base method.
+func (m *MockDictRepository) GetDeletedById(ctx context.Context, id string) (tools.Dict, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedById", ctx, id)
+	ret0, _ := ret[0].(tools.Dict)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedById indicates an expected call of GetDeletedById.
+func (mr *MockDictRepositoryMockRecorder) GetDeletedById(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedById", reflect.TypeOf((*MockDictRepository)(nil).GetDeletedById), ctx, id)
+}
+
+// GetExistingCodes mocks base method.
+func (m *MockDictRepository) GetExistingCodes(ctx context.Context, codes []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExistingCodes", ctx, codes)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExistingCodes indicates an expected call of GetExistingCodes.
+func (mr *MockDictRepositoryMockRecorder) GetExistingCodes(ctx, codes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExistingCodes", reflect.TypeOf((*MockDictRepository)(nil).GetExistingCodes), ctx, codes)
+}
+
+// GetExistingNames mocks base method.
+func (m *MockDictRepository) GetExistingNames(ctx context.Context, names []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExistingNames", ctx, names)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExistingNames indicates an expected call of GetExistingNames.
+func (mr *MockDictRepositoryMockRecorder) GetExistingNames(ctx, names interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExistingNames", reflect.TypeOf((*MockDictRepository)(nil).GetExistingNames), ctx, names)
+}
+
 // GetListAll mocks base method.
 func (m *MockDictRepository) GetListAll(ctx context.Context, filters tools.DictFilter) ([]tools.Dict, error) {
 	m.ctrl.T.Helper()
@@ -169,6 +229,66 @@ func (mr *MockDictRepositoryMockRecorder) GetListPage(ctx, filters interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListPage", reflect.TypeOf((*MockDictRepository)(nil).GetListPage), ctx, filters)
 }
 
+// GetRecycleListPage mocks base method.
+func (m *MockDictRepository) GetRecycleListPage(ctx context.Context, filter tools.RecycleFilter) ([]tools.Dict, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecycleListPage", ctx, filter)
+	ret0, _ := ret[0].([]tools.Dict)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRecycleListPage indicates an expected call of GetRecycleListPage.
+func (mr *MockDictRepositoryMockRecorder) GetRecycleListPage(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecycleListPage", reflect.TypeOf((*MockDictRepository)(nil).GetRecycleListPage), ctx, filter)
+}
+
+// Purge mocks base method.
+func (m *MockDictRepository) Purge(ctx context.Context, ids []string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, ids)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockDictRepositoryMockRecorder) Purge(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockDictRepository)(nil).Purge), ctx, ids)
+}
+
+// PurgeExpired mocks base method.
+func (m *MockDictRepository) PurgeExpired(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeExpired", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeExpired indicates an expected call of PurgeExpired.
+func (mr *MockDictRepositoryMockRecorder) PurgeExpired(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeExpired", reflect.TypeOf((*MockDictRepository)(nil).PurgeExpired), ctx, before)
+}
+
+// Restore mocks base method.
+func (m *MockDictRepository) Restore(ctx context.Context, domain tools.Dict) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, domain)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockDictRepositoryMockRecorder) Restore(ctx, domain interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockDictRepository)(nil).Restore), ctx, domain)
+}
+
 // Update mocks base method.
 func (m *MockDictRepository) Update(ctx context.Context, domain tools.Dict) error {
 	m.ctrl.T.Helper()
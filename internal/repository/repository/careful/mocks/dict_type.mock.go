@@ -7,6 +7,7 @@ package repomocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	tools "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/tools"
 	gomock "github.com/golang/mock/gomock"
@@ -35,6 +36,20 @@ func (m *MockDictTypeRepository) EXPECT() *MockDictTypeRepositoryMockRecorder {
 	return m.recorder
 }
 
+// BatchCreate mocks base method.
+func (m *MockDictTypeRepository) BatchCreate(ctx context.Context, domains []tools.DictType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchCreate", ctx, domains)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchCreate indicates an expected call of BatchCreate.
+func (mr *MockDictTypeRepositoryMockRecorder) BatchCreate(ctx, domains interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchCreate", reflect.TypeOf((*MockDictTypeRepository)(nil).BatchCreate), ctx, domains)
+}
+
 // BatchDelete mocks base method.
 func (m *MockDictTypeRepository) BatchDelete(ctx context.Context, ids []string) error {
 	m.ctrl.T.Helper()
@@ -64,6 +79,20 @@ func (mr *MockDictTypeRepositoryMockRecorder) Create(ctx, domain interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDictTypeRepository)(nil).Create), ctx, domain)
 }
 
+// DelOptions mocks base method.
+func (m *MockDictTypeRepository) DelOptions(ctx context.Context, dictNames []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DelOptions", ctx, dictNames)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DelOptions indicates an expected call of DelOptions.
+func (mr *MockDictTypeRepositoryMockRecorder) DelOptions(ctx, dictNames interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelOptions", reflect.TypeOf((*MockDictTypeRepository)(nil).DelOptions), ctx, dictNames)
+}
+
 // Delete mocks base method.
 func (m *MockDictTypeRepository) Delete(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -108,6 +137,51 @@ func (mr *MockDictTypeRepositoryMockRecorder) GetById(ctx, id interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockDictTypeRepository)(nil).GetById), ctx, id)
 }
 
+// GetDeletedById mocks base method.
+func (m *MockDictTypeRepository) GetDeletedById(ctx context.Context, id string) (tools.DictType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedById", ctx, id)
+	ret0, _ := ret[0].(tools.DictType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedById indicates an expected call of GetDeletedById.
+func (mr *MockDictTypeRepositoryMockRecorder) GetDeletedById(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedById", reflect.TypeOf((*MockDictTypeRepository)(nil).GetDeletedById), ctx, id)
+}
+
+// GetExistingNames mocks base method.
+func (m *MockDictTypeRepository) GetExistingNames(ctx context.Context, domains []tools.DictType) ([]tools.DictType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExistingNames", ctx, domains)
+	ret0, _ := ret[0].([]tools.DictType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExistingNames indicates an expected call of GetExistingNames.
+func (mr *MockDictTypeRepositoryMockRecorder) GetExistingNames(ctx, domains interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExistingNames", reflect.TypeOf((*MockDictTypeRepository)(nil).GetExistingNames), ctx, domains)
+}
+
+// GetExistingValues mocks base method.
+func (m *MockDictTypeRepository) GetExistingValues(ctx context.Context, domains []tools.DictType) ([]tools.DictType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExistingValues", ctx, domains)
+	ret0, _ := ret[0].([]tools.DictType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExistingValues indicates an expected call of GetExistingValues.
+func (mr *MockDictTypeRepositoryMockRecorder) GetExistingValues(ctx, domains interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExistingValues", reflect.TypeOf((*MockDictTypeRepository)(nil).GetExistingValues), ctx, domains)
+}
+
 // GetListAll mocks base method.
 func (m *MockDictTypeRepository) GetListAll(ctx context.Context, filters tools.DictTypeFilter) ([]tools.DictType, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +213,81 @@ func (mr *MockDictTypeRepositoryMockRecorder) GetListPage(ctx, filters interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListPage", reflect.TypeOf((*MockDictTypeRepository)(nil).GetListPage), ctx, filters)
 }
 
+// GetOptionsByDictNames mocks base method.
+func (m *MockDictTypeRepository) GetOptionsByDictNames(ctx context.Context, dictNames []string) (map[string][]tools.DictOption, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOptionsByDictNames", ctx, dictNames)
+	ret0, _ := ret[0].(map[string][]tools.DictOption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOptionsByDictNames indicates an expected call of GetOptionsByDictNames.
+func (mr *MockDictTypeRepositoryMockRecorder) GetOptionsByDictNames(ctx, dictNames interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptionsByDictNames", reflect.TypeOf((*MockDictTypeRepository)(nil).GetOptionsByDictNames), ctx, dictNames)
+}
+
+// GetRecycleListPage mocks base method.
+func (m *MockDictTypeRepository) GetRecycleListPage(ctx context.Context, filter tools.RecycleFilter) ([]tools.DictType, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecycleListPage", ctx, filter)
+	ret0, _ := ret[0].([]tools.DictType)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRecycleListPage indicates an expected call of GetRecycleListPage.
+func (mr *MockDictTypeRepositoryMockRecorder) GetRecycleListPage(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecycleListPage", reflect.TypeOf((*MockDictTypeRepository)(nil).GetRecycleListPage), ctx, filter)
+}
+
+// Purge mocks base method.
+func (m *MockDictTypeRepository) Purge(ctx context.Context, ids []string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, ids)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockDictTypeRepositoryMockRecorder) Purge(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockDictTypeRepository)(nil).Purge), ctx, ids)
+}
+
+// PurgeExpired mocks base method.
+func (m *MockDictTypeRepository) PurgeExpired(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeExpired", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeExpired indicates an expected call of PurgeExpired.
+func (mr *MockDictTypeRepositoryMockRecorder) PurgeExpired(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeExpired", reflect.TypeOf((*MockDictTypeRepository)(nil).PurgeExpired), ctx, before)
+}
+
+// Restore mocks base method.
+func (m *MockDictTypeRepository) Restore(ctx context.Context, domain tools.DictType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, domain)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockDictTypeRepositoryMockRecorder) Restore(ctx, domain interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockDictTypeRepository)(nil).Restore), ctx, domain)
+}
+
 // Update mocks base method.
 func (m *MockDictTypeRepository) Update(ctx context.Context, domain tools.DictType) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,299 @@
+/**
+ * Description：
+ * FileName：dept.go
+ * Author：CJiaの用心
+ * Create：2025/10/26 10:42:19
+ * Remark：
+ */
+
+package system
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
+	modelSystem "github.com/carefuly/careful-admin-go-gin/internal/model/careful/system"
+	cacheSystem "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/careful/system"
+	cacheDecorator "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/decorator/careful/system"
+	daoSystem "github.com/carefuly/careful-admin-go-gin/internal/repository/dao/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	"go.uber.org/zap"
+	"time"
+)
+
+var (
+	ErrDeptNotFound             = daoSystem.ErrDeptNotFound
+	ErrDeptDuplicate            = daoSystem.ErrDeptDuplicate
+	ErrDeptVersionInconsistency = daoSystem.ErrDeptVersionInconsistency
+	ErrDeptParentNotFound       = daoSystem.ErrDeptParentNotFound
+	ErrDeptHasChildren          = daoSystem.ErrDeptHasChildren
+	ErrDeptHasUsers             = daoSystem.ErrDeptHasUsers
+	ErrDeptMoveToDescendant     = daoSystem.ErrDeptMoveToDescendant
+)
+
+type DeptRepository interface {
+	Create(ctx context.Context, domain domainSystem.Dept) (domainSystem.Dept, error)
+	Delete(ctx context.Context, id string) error
+	Update(ctx context.Context, domain domainSystem.Dept) error
+	Move(ctx context.Context, id, parentId string, timestamp int64, modifier string) error
+
+	GetById(ctx context.Context, id string) (domainSystem.Dept, error)
+	GetListAll(ctx context.Context, filter domainSystem.DeptFilter) ([]domainSystem.Dept, error)
+	GetSubtree(ctx context.Context, id string, filter domainSystem.DeptFilter) ([]domainSystem.Dept, error)
+	GetAncestors(ctx context.Context, id string) ([]domainSystem.Dept, error)
+	GetSubtreeIds(ctx context.Context, ids ...string) ([]string, error)
+
+	GetDeletedById(ctx context.Context, id string) (domainSystem.Dept, error)
+	GetRecycleListPage(ctx context.Context, filter domainSystem.RecycleFilter) ([]domainSystem.Dept, int64, error)
+	Restore(ctx context.Context, domain domainSystem.Dept) error
+	Purge(ctx context.Context, ids []string) (int64, error)
+	PurgeExpired(ctx context.Context, before time.Time) (int64, error)
+}
+
+type deptRepository struct {
+	dao   daoSystem.DeptDAO
+	cache cacheDecorator.DeptCacheLoggingDecorator
+}
+
+func NewDeptRepository(dao daoSystem.DeptDAO, cache cacheDecorator.DeptCacheLoggingDecorator) DeptRepository {
+	return &deptRepository{
+		dao:   dao,
+		cache: cache,
+	}
+}
+
+// Create 创建
+func (repo *deptRepository) Create(ctx context.Context, domain domainSystem.Dept) (domainSystem.Dept, error) {
+	model, err := repo.dao.Insert(ctx, repo.toEntity(domain))
+	if err != nil {
+		return domainSystem.Dept{}, err
+	}
+
+	// 上级部门子部门数已变化
+	if domain.ParentId != "" {
+		repo.delCache(ctx, domain.ParentId)
+	}
+
+	return repo.toDomain(model), nil
+}
+
+// Delete 删除
+func (repo *deptRepository) Delete(ctx context.Context, id string) error {
+	// 先取上级，删除后需要清理上级缓存
+	model, err := repo.dao.FindById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := repo.dao.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	repo.delCache(ctx, id)
+	if model.ParentID.Valid {
+		repo.delCache(ctx, model.ParentID.String)
+	}
+
+	return nil
+}
+
+// Update 更新
+func (repo *deptRepository) Update(ctx context.Context, domain domainSystem.Dept) error {
+	if err := repo.dao.Update(ctx, repo.toEntity(domain)); err != nil {
+		return err
+	}
+
+	repo.delCache(ctx, domain.Id)
+	return nil
+}
+
+// Move 移动节点
+func (repo *deptRepository) Move(ctx context.Context, id, parentId string, timestamp int64, modifier string) error {
+	model, err := repo.dao.FindById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := repo.dao.Move(ctx, id, parentId, timestamp, modifier)
+	if err != nil {
+		return err
+	}
+
+	// 子孙节点路径已变化，新旧上级的子部门数已变化
+	for _, val := range affected {
+		repo.delCache(ctx, val)
+	}
+	if model.ParentID.Valid {
+		repo.delCache(ctx, model.ParentID.String)
+	}
+	if parentId != "" {
+		repo.delCache(ctx, parentId)
+	}
+
+	return nil
+}
+
+// GetById 根据ID获取
+func (repo *deptRepository) GetById(ctx context.Context, id string) (domainSystem.Dept, error) {
+	domain, err := repo.cache.Get(ctx, id)
+	if err == nil && domain != nil {
+		return *domain, nil // 命中缓存
+	}
+	if err != nil && !errors.Is(err, cacheSystem.ErrDeptNotExist) {
+		// 缓存查询出错但不是"不存在"错误，记录日志但继续查DB
+		zap.L().Error("缓存获取错误:", zap.Error(err))
+	}
+
+	entity, err := repo.dao.FindById(ctx, id)
+	if err != nil {
+		if errors.Is(err, daoSystem.ErrDeptNotFound) {
+			// 数据库不存在，设置防穿透标记
+			_ = repo.cache.SetNotFound(ctx, id)
+			return domainSystem.Dept{}, daoSystem.ErrDeptNotFound
+		}
+		return domainSystem.Dept{}, err
+	}
+
+	toDomain := repo.toDomain(entity)
+	if err := repo.cache.Set(ctx, toDomain); err != nil {
+		// 网络崩了，也可能是 redis 崩了
+		zap.L().Error("Redis异常", zap.Error(err))
+	}
+
+	return toDomain, nil
+}
+
+// GetListAll 查询所有列表
+func (repo *deptRepository) GetListAll(ctx context.Context, filter domainSystem.DeptFilter) ([]domainSystem.Dept, error) {
+	list, err := repo.dao.FindListAll(ctx, filter)
+	if err != nil {
+		return []domainSystem.Dept{}, err
+	}
+	return repo.toDomains(list), nil
+}
+
+// GetSubtree 查询节点及其子孙节点
+func (repo *deptRepository) GetSubtree(ctx context.Context, id string, filter domainSystem.DeptFilter) ([]domainSystem.Dept, error) {
+	list, err := repo.dao.FindSubtree(ctx, id, filter)
+	if err != nil {
+		return []domainSystem.Dept{}, err
+	}
+	return repo.toDomains(list), nil
+}
+
+// GetAncestors 查询祖先节点
+func (repo *deptRepository) GetAncestors(ctx context.Context, id string) ([]domainSystem.Dept, error) {
+	list, err := repo.dao.FindAncestors(ctx, id)
+	if err != nil {
+		return []domainSystem.Dept{}, err
+	}
+	return repo.toDomains(list), nil
+}
+
+// GetSubtreeIds 获取指定部门及其子孙部门ID
+func (repo *deptRepository) GetSubtreeIds(ctx context.Context, ids ...string) ([]string, error) {
+	return repo.dao.FindSubtreeIds(ctx, ids)
+}
+
+// GetDeletedById 根据ID获取回收站中的部门
+func (repo *deptRepository) GetDeletedById(ctx context.Context, id string) (domainSystem.Dept, error) {
+	model, err := repo.dao.FindDeletedById(ctx, id)
+	if err != nil {
+		return domainSystem.Dept{}, err
+	}
+	return repo.toDomain(model), nil
+}
+
+// GetRecycleListPage 分页查询回收站
+func (repo *deptRepository) GetRecycleListPage(ctx context.Context, filter domainSystem.RecycleFilter) ([]domainSystem.Dept, int64, error) {
+	list, row, err := repo.dao.FindRecycleListPage(ctx, filter)
+	if err != nil {
+		return []domainSystem.Dept{}, row, err
+	}
+	return repo.toDomains(list), row, nil
+}
+
+// Restore 恢复部门，上级部门的子部门数随之变化
+func (repo *deptRepository) Restore(ctx context.Context, domain domainSystem.Dept) error {
+	if err := repo.dao.Restore(ctx, repo.toEntity(domain)); err != nil {
+		return err
+	}
+
+	// 删除缓存，包括删除期间写入的防穿透标记
+	repo.delCache(ctx, domain.Id)
+	if domain.ParentId != "" {
+		repo.delCache(ctx, domain.ParentId)
+	}
+	return nil
+}
+
+// Purge 彻底删除回收站中的部门
+func (repo *deptRepository) Purge(ctx context.Context, ids []string) (int64, error) {
+	return repo.dao.Purge(ctx, ids)
+}
+
+// PurgeExpired 彻底删除指定时间之前移入回收站的部门
+func (repo *deptRepository) PurgeExpired(ctx context.Context, before time.Time) (int64, error) {
+	return repo.dao.PurgeExpired(ctx, before)
+}
+
+// delCache 删除缓存，失败不影响主流程
+func (repo *deptRepository) delCache(ctx context.Context, id string) {
+	if err := repo.cache.Del(ctx, id); err != nil {
+		// 网络崩了，也可能是 redis 崩了
+		zap.L().Error("Redis异常", zap.Error(err))
+	}
+}
+
+// toEntity 转换为实体模型
+func (repo *deptRepository) toEntity(domain domainSystem.Dept) modelSystem.Dept {
+	return modelSystem.Dept{
+		CoreModels: models.CoreModels{
+			Id:         domain.Id,
+			Sort:       domain.Sort,
+			Timestamp:  domain.Timestamp,
+			Creator:    domain.Creator,
+			Modifier:   domain.Modifier,
+			BelongDept: domain.BelongDept,
+			Remark:     domain.Remark,
+		},
+		SoftDelete: domain.SoftDelete,
+		Status:     domain.Status,
+		Name:       domain.Name,
+		Code:       domain.Code,
+		Owner:      domain.Owner,
+		Phone:      domain.Phone,
+		Email:      domain.Email,
+		ParentID: sql.NullString{
+			String: domain.ParentId,
+			Valid:  domain.ParentId != "",
+		},
+	}
+}
+
+// toDomain 转换为领域模型
+func (repo *deptRepository) toDomain(entity *modelSystem.Dept) domainSystem.Dept {
+	model := domainSystem.Dept{
+		Dept:     *entity,
+		ParentId: entity.ParentID.String,
+	}
+
+	if entity.CreateTime != nil {
+		model.CreateTime = entity.CreateTime.Format("2006-01-02 15:04:05")
+	}
+	if entity.UpdateTime != nil {
+		model.UpdateTime = entity.UpdateTime.Format("2006-01-02 15:04:05")
+	}
+
+	return model
+}
+
+// toDomains 批量转换为领域模型
+func (repo *deptRepository) toDomains(list []*modelSystem.Dept) []domainSystem.Dept {
+	toDomain := make([]domainSystem.Dept, 0, len(list))
+	for _, v := range list {
+		toDomain = append(toDomain, repo.toDomain(v))
+	}
+	return toDomain
+}
@@ -0,0 +1,191 @@
+/**
+ * Description：
+ * FileName：menu.go
+ * Author：CJiaの用心
+ * Create：2025/10/28 10:48:19
+ * Remark：
+ */
+
+package system
+
+import (
+	"context"
+	"database/sql"
+	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
+	modelSystem "github.com/carefuly/careful-admin-go-gin/internal/model/careful/system"
+	daoSystem "github.com/carefuly/careful-admin-go-gin/internal/repository/dao/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	"time"
+)
+
+var (
+	ErrMenuNotFound             = daoSystem.ErrMenuNotFound
+	ErrMenuDuplicate            = daoSystem.ErrMenuDuplicate
+	ErrMenuVersionInconsistency = daoSystem.ErrMenuVersionInconsistency
+	ErrMenuParentNotFound       = daoSystem.ErrMenuParentNotFound
+	ErrMenuParentInvalid        = daoSystem.ErrMenuParentInvalid
+	ErrMenuHasChildren          = daoSystem.ErrMenuHasChildren
+)
+
+type MenuRepository interface {
+	Create(ctx context.Context, domain domainSystem.Menu) (domainSystem.Menu, error)
+	Delete(ctx context.Context, id string) error
+	Update(ctx context.Context, domain domainSystem.Menu) error
+
+	GetById(ctx context.Context, id string) (domainSystem.Menu, error)
+	GetListAll(ctx context.Context, filter domainSystem.MenuFilter) ([]domainSystem.Menu, error)
+	GetIdsByUserId(ctx context.Context, userId string) ([]string, error)
+
+	CheckExistByCode(ctx context.Context, code, excludeId string) (bool, error)
+
+	GetDeletedById(ctx context.Context, id string) (domainSystem.Menu, error)
+	GetRecycleListPage(ctx context.Context, filter domainSystem.RecycleFilter) ([]domainSystem.Menu, int64, error)
+	Restore(ctx context.Context, domain domainSystem.Menu) error
+	Purge(ctx context.Context, ids []string) (int64, error)
+	PurgeExpired(ctx context.Context, before time.Time) (int64, error)
+}
+
+type menuRepository struct {
+	dao daoSystem.MenuDAO
+}
+
+func NewMenuRepository(dao daoSystem.MenuDAO) MenuRepository {
+	return &menuRepository{
+		dao: dao,
+	}
+}
+
+// Create 创建
+func (repo *menuRepository) Create(ctx context.Context, domain domainSystem.Menu) (domainSystem.Menu, error) {
+	model, err := repo.dao.Insert(ctx, repo.toEntity(domain))
+	return repo.toDomain(model), err
+}
+
+// Delete 删除
+func (repo *menuRepository) Delete(ctx context.Context, id string) error {
+	return repo.dao.Delete(ctx, id)
+}
+
+// Update 更新
+func (repo *menuRepository) Update(ctx context.Context, domain domainSystem.Menu) error {
+	return repo.dao.Update(ctx, repo.toEntity(domain))
+}
+
+// GetById 根据ID获取
+func (repo *menuRepository) GetById(ctx context.Context, id string) (domainSystem.Menu, error) {
+	model, err := repo.dao.FindById(ctx, id)
+	if err != nil {
+		return domainSystem.Menu{}, err
+	}
+	return repo.toDomain(model), nil
+}
+
+// GetListAll 查询所有列表
+func (repo *menuRepository) GetListAll(ctx context.Context, filter domainSystem.MenuFilter) ([]domainSystem.Menu, error) {
+	list, err := repo.dao.FindListAll(ctx, filter)
+	if err != nil {
+		return []domainSystem.Menu{}, err
+	}
+	return repo.toDomains(list), nil
+}
+
+// GetIdsByUserId 查询用户授权菜单ID
+func (repo *menuRepository) GetIdsByUserId(ctx context.Context, userId string) ([]string, error) {
+	return repo.dao.FindIdsByUserId(ctx, userId)
+}
+
+// CheckExistByCode 检查code是否存在
+func (repo *menuRepository) CheckExistByCode(ctx context.Context, code, excludeId string) (bool, error) {
+	return repo.dao.CheckExistByCode(ctx, code, excludeId)
+}
+
+// GetDeletedById 根据ID获取回收站中的菜单
+func (repo *menuRepository) GetDeletedById(ctx context.Context, id string) (domainSystem.Menu, error) {
+	model, err := repo.dao.FindDeletedById(ctx, id)
+	if err != nil {
+		return domainSystem.Menu{}, err
+	}
+	return repo.toDomain(model), nil
+}
+
+// GetRecycleListPage 分页查询回收站
+func (repo *menuRepository) GetRecycleListPage(ctx context.Context, filter domainSystem.RecycleFilter) ([]domainSystem.Menu, int64, error) {
+	list, row, err := repo.dao.FindRecycleListPage(ctx, filter)
+	if err != nil {
+		return []domainSystem.Menu{}, row, err
+	}
+	return repo.toDomains(list), row, nil
+}
+
+// Restore 恢复菜单
+func (repo *menuRepository) Restore(ctx context.Context, domain domainSystem.Menu) error {
+	return repo.dao.Restore(ctx, repo.toEntity(domain))
+}
+
+// Purge 彻底删除回收站中的菜单
+func (repo *menuRepository) Purge(ctx context.Context, ids []string) (int64, error) {
+	return repo.dao.Purge(ctx, ids)
+}
+
+// PurgeExpired 彻底删除指定时间之前移入回收站的菜单
+func (repo *menuRepository) PurgeExpired(ctx context.Context, before time.Time) (int64, error) {
+	return repo.dao.PurgeExpired(ctx, before)
+}
+
+// toEntity 转换为实体模型
+func (repo *menuRepository) toEntity(domain domainSystem.Menu) modelSystem.Menu {
+	return modelSystem.Menu{
+		CoreModels: models.CoreModels{
+			Id:         domain.Id,
+			Sort:       domain.Sort,
+			Timestamp:  domain.Timestamp,
+			Creator:    domain.Creator,
+			Modifier:   domain.Modifier,
+			BelongDept: domain.BelongDept,
+			Remark:     domain.Remark,
+		},
+		SoftDelete: domain.SoftDelete,
+		Status:     domain.Status,
+		Type:       domain.Type,
+		Title:      domain.Title,
+		Name:       domain.Name,
+		Code:       domain.Code,
+		Path:       domain.Path,
+		Component:  domain.Component,
+		Redirect:   domain.Redirect,
+		Icon:       domain.Icon,
+		Visible:    domain.Visible,
+		KeepAlive:  domain.KeepAlive,
+		IsLink:     domain.IsLink,
+		ParentID: sql.NullString{
+			String: domain.ParentId,
+			Valid:  domain.ParentId != "",
+		},
+	}
+}
+
+// toDomain 转换为领域模型
+func (repo *menuRepository) toDomain(entity *modelSystem.Menu) domainSystem.Menu {
+	model := domainSystem.Menu{
+		Menu:     *entity,
+		ParentId: entity.ParentID.String,
+	}
+
+	if entity.CreateTime != nil {
+		model.CreateTime = entity.CreateTime.Format("2006-01-02 15:04:05")
+	}
+	if entity.UpdateTime != nil {
+		model.UpdateTime = entity.UpdateTime.Format("2006-01-02 15:04:05")
+	}
+
+	return model
+}
+
+// toDomains 批量转换为领域模型
+func (repo *menuRepository) toDomains(list []*modelSystem.Menu) []domainSystem.Menu {
+	toDomain := make([]domainSystem.Menu, 0, len(list))
+	for _, v := range list {
+		toDomain = append(toDomain, repo.toDomain(v))
+	}
+	return toDomain
+}
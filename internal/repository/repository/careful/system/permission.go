@@ -0,0 +1,266 @@
+/**
+ * Description：
+ * FileName：permission.go
+ * Author：CJiaの用心
+ * Create：2025/10/27 11:48:03
+ * Remark：
+ */
+
+package system
+
+import (
+	"context"
+	"errors"
+	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
+	modelSystem "github.com/carefuly/careful-admin-go-gin/internal/model/careful/system"
+	cacheSystem "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/careful/system"
+	cacheDecorator "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/decorator/careful/system"
+	daoSystem "github.com/carefuly/careful-admin-go-gin/internal/repository/dao/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	"go.uber.org/zap"
+	"strings"
+	"time"
+)
+
+var (
+	ErrPermissionNotFound             = daoSystem.ErrPermissionNotFound
+	ErrPermissionDuplicate            = daoSystem.ErrPermissionDuplicate
+	ErrPermissionVersionInconsistency = daoSystem.ErrPermissionVersionInconsistency
+)
+
+type PermissionRepository interface {
+	Create(ctx context.Context, domain domainSystem.Permission) (domainSystem.Permission, error)
+	Delete(ctx context.Context, id string) error
+	BatchDelete(ctx context.Context, ids []string) error
+	Update(ctx context.Context, domain domainSystem.Permission) error
+
+	GetById(ctx context.Context, id string) (domainSystem.Permission, error)
+	GetListPage(ctx context.Context, filter domainSystem.PermissionFilter) ([]domainSystem.Permission, int64, error)
+	GetListAll(ctx context.Context, filter domainSystem.PermissionFilter) ([]domainSystem.Permission, error)
+	GetUserPermission(ctx context.Context, userId string) (domainSystem.UserPermission, error)
+
+	GetDeletedById(ctx context.Context, id string) (domainSystem.Permission, error)
+	GetRecycleListPage(ctx context.Context, filter domainSystem.RecycleFilter) ([]domainSystem.Permission, int64, error)
+	Restore(ctx context.Context, domain domainSystem.Permission) error
+	Purge(ctx context.Context, ids []string) (int64, error)
+	PurgeExpired(ctx context.Context, before time.Time) (int64, error)
+}
+
+type permissionRepository struct {
+	dao     daoSystem.PermissionDAO
+	userDao daoSystem.UserDAO
+	cache   cacheDecorator.UserPermissionCacheLoggingDecorator
+}
+
+func NewPermissionRepository(dao daoSystem.PermissionDAO, userDao daoSystem.UserDAO, cache cacheDecorator.UserPermissionCacheLoggingDecorator) PermissionRepository {
+	return &permissionRepository{
+		dao:     dao,
+		userDao: userDao,
+		cache:   cache,
+	}
+}
+
+// Create 创建
+func (repo *permissionRepository) Create(ctx context.Context, domain domainSystem.Permission) (domainSystem.Permission, error) {
+	model, err := repo.dao.Insert(ctx, repo.toEntity(domain))
+	return repo.toDomain(model), err
+}
+
+// Delete 删除
+func (repo *permissionRepository) Delete(ctx context.Context, id string) error {
+	return repo.BatchDelete(ctx, []string{id})
+}
+
+// BatchDelete 批量删除
+func (repo *permissionRepository) BatchDelete(ctx context.Context, ids []string) error {
+	// 删除前获取受影响的用户
+	userIds, err := repo.dao.FindUserIds(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	if err := repo.dao.BatchDelete(ctx, ids); err != nil {
+		return err
+	}
+
+	repo.delCache(ctx, userIds...)
+	return nil
+}
+
+// Update 更新
+func (repo *permissionRepository) Update(ctx context.Context, domain domainSystem.Permission) error {
+	if err := repo.dao.Update(ctx, repo.toEntity(domain)); err != nil {
+		return err
+	}
+
+	userIds, err := repo.dao.FindUserIds(ctx, []string{domain.Id})
+	if err != nil {
+		return err
+	}
+	repo.delCache(ctx, userIds...)
+	return nil
+}
+
+// GetById 根据ID获取
+func (repo *permissionRepository) GetById(ctx context.Context, id string) (domainSystem.Permission, error) {
+	model, err := repo.dao.FindById(ctx, id)
+	if err != nil {
+		return domainSystem.Permission{}, err
+	}
+	return repo.toDomain(model), nil
+}
+
+// GetListPage 分页查询列表
+func (repo *permissionRepository) GetListPage(ctx context.Context, filter domainSystem.PermissionFilter) ([]domainSystem.Permission, int64, error) {
+	list, row, err := repo.dao.FindListPage(ctx, filter)
+	if err != nil {
+		return []domainSystem.Permission{}, row, err
+	}
+	return repo.toDomains(list), row, nil
+}
+
+// GetListAll 查询所有列表
+func (repo *permissionRepository) GetListAll(ctx context.Context, filter domainSystem.PermissionFilter) ([]domainSystem.Permission, error) {
+	list, err := repo.dao.FindListAll(ctx, filter)
+	if err != nil {
+		return []domainSystem.Permission{}, err
+	}
+	return repo.toDomains(list), nil
+}
+
+// GetUserPermission 获取用户接口权限，优先读取缓存
+func (repo *permissionRepository) GetUserPermission(ctx context.Context, userId string) (domainSystem.UserPermission, error) {
+	domain, err := repo.cache.Get(ctx, userId)
+	if err == nil && domain != nil {
+		return *domain, nil // 命中缓存
+	}
+	if err != nil && !errors.Is(err, cacheSystem.ErrUserPermissionNotExist) {
+		// 缓存查询出错但不是"不存在"错误，记录日志但继续查DB
+		zap.L().Error("缓存获取错误:", zap.Error(err))
+	}
+
+	user, err := repo.userDao.FindById(ctx, userId)
+	if err != nil {
+		return domainSystem.UserPermission{}, err
+	}
+
+	result := domainSystem.UserPermission{
+		IsSuperuser: user.IsSuperuser,
+		Apis:        []string{},
+	}
+	if !user.IsSuperuser {
+		list, err := repo.dao.FindByUserId(ctx, userId)
+		if err != nil {
+			return domainSystem.UserPermission{}, err
+		}
+		for _, v := range list {
+			result.Apis = append(result.Apis, domainSystem.PermissionApiKey(v.Method, v.Path))
+		}
+	}
+
+	if err := repo.cache.Set(ctx, userId, result); err != nil {
+		// 网络崩了，也可能是 redis 崩了
+		zap.L().Error("Redis异常", zap.Error(err))
+	}
+
+	return result, nil
+}
+
+// GetDeletedById 根据ID获取回收站中的接口权限
+func (repo *permissionRepository) GetDeletedById(ctx context.Context, id string) (domainSystem.Permission, error) {
+	model, err := repo.dao.FindDeletedById(ctx, id)
+	if err != nil {
+		return domainSystem.Permission{}, err
+	}
+	return repo.toDomain(model), nil
+}
+
+// GetRecycleListPage 分页查询回收站
+func (repo *permissionRepository) GetRecycleListPage(ctx context.Context, filter domainSystem.RecycleFilter) ([]domainSystem.Permission, int64, error) {
+	list, row, err := repo.dao.FindRecycleListPage(ctx, filter)
+	if err != nil {
+		return []domainSystem.Permission{}, row, err
+	}
+	return repo.toDomains(list), row, nil
+}
+
+// Restore 恢复接口权限，通过角色拥有该权限的用户随之恢复
+func (repo *permissionRepository) Restore(ctx context.Context, domain domainSystem.Permission) error {
+	if err := repo.dao.Restore(ctx, repo.toEntity(domain)); err != nil {
+		return err
+	}
+
+	userIds, err := repo.dao.FindUserIds(ctx, []string{domain.Id})
+	if err != nil {
+		return err
+	}
+	repo.delCache(ctx, userIds...)
+	return nil
+}
+
+// Purge 彻底删除回收站中的接口权限
+func (repo *permissionRepository) Purge(ctx context.Context, ids []string) (int64, error) {
+	return repo.dao.Purge(ctx, ids)
+}
+
+// PurgeExpired 彻底删除指定时间之前移入回收站的接口权限
+func (repo *permissionRepository) PurgeExpired(ctx context.Context, before time.Time) (int64, error) {
+	return repo.dao.PurgeExpired(ctx, before)
+}
+
+// delCache 删除权限缓存，失败不影响主流程
+func (repo *permissionRepository) delCache(ctx context.Context, userIds ...string) {
+	if len(userIds) == 0 {
+		return
+	}
+	if err := repo.cache.Del(ctx, userIds...); err != nil {
+		// 网络崩了，也可能是 redis 崩了
+		zap.L().Error("Redis异常", zap.Error(err))
+	}
+}
+
+// toEntity 转换为实体模型
+func (repo *permissionRepository) toEntity(domain domainSystem.Permission) modelSystem.Permission {
+	return modelSystem.Permission{
+		CoreModels: models.CoreModels{
+			Id:         domain.Id,
+			Sort:       domain.Sort,
+			Timestamp:  domain.Timestamp,
+			Creator:    domain.Creator,
+			Modifier:   domain.Modifier,
+			BelongDept: domain.BelongDept,
+			Remark:     domain.Remark,
+		},
+		SoftDelete: domain.SoftDelete,
+		Status:     domain.Status,
+		Name:       domain.Name,
+		Code:       domain.Code,
+		Method:     strings.ToUpper(domain.Method),
+		Path:       domain.Path,
+	}
+}
+
+// toDomain 转换为领域模型
+func (repo *permissionRepository) toDomain(entity *modelSystem.Permission) domainSystem.Permission {
+	model := domainSystem.Permission{
+		Permission: *entity,
+	}
+
+	if entity.CreateTime != nil {
+		model.CreateTime = entity.CreateTime.Format("2006-01-02 15:04:05")
+	}
+	if entity.UpdateTime != nil {
+		model.UpdateTime = entity.UpdateTime.Format("2006-01-02 15:04:05")
+	}
+
+	return model
+}
+
+// toDomains 批量转换为领域模型
+func (repo *permissionRepository) toDomains(list []*modelSystem.Permission) []domainSystem.Permission {
+	toDomain := make([]domainSystem.Permission, 0, len(list))
+	for _, v := range list {
+		toDomain = append(toDomain, repo.toDomain(v))
+	}
+	return toDomain
+}
@@ -0,0 +1,308 @@
+/**
+ * Description：
+ * FileName：role.go
+ * Author：CJiaの用心
+ * Create：2025/10/27 11:25:50
+ * Remark：
+ */
+
+package system
+
+import (
+	"context"
+	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
+	modelSystem "github.com/carefuly/careful-admin-go-gin/internal/model/careful/system"
+	cacheSystem "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/careful/system"
+	cacheDecorator "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/decorator/careful/system"
+	daoSystem "github.com/carefuly/careful-admin-go-gin/internal/repository/dao/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	"go.uber.org/zap"
+	"time"
+)
+
+var (
+	ErrRoleNotFound             = daoSystem.ErrRoleNotFound
+	ErrRoleNameDuplicate        = daoSystem.ErrRoleNameDuplicate
+	ErrRoleCodeDuplicate        = daoSystem.ErrRoleCodeDuplicate
+	ErrRoleVersionInconsistency = daoSystem.ErrRoleVersionInconsistency
+	ErrRoleUserNotFound         = daoSystem.ErrRoleUserNotFound
+	ErrRolePermissionNotFound   = daoSystem.ErrRolePermissionNotFound
+	ErrRoleMenuNotFound         = daoSystem.ErrRoleMenuNotFound
+	ErrRoleDeptNotFound         = daoSystem.ErrRoleDeptNotFound
+)
+
+type RoleRepository interface {
+	Create(ctx context.Context, domain domainSystem.Role) (domainSystem.Role, error)
+	Delete(ctx context.Context, id string) error
+	BatchDelete(ctx context.Context, ids []string) error
+	Update(ctx context.Context, domain domainSystem.Role) error
+	SetPermissions(ctx context.Context, roleId string, permissionIds []string) error
+	SetUserRoles(ctx context.Context, userId string, roleIds []string) error
+	SetMenus(ctx context.Context, roleId string, menuIds []string) error
+	SetDepts(ctx context.Context, roleId string, deptIds []string) error
+
+	GetById(ctx context.Context, id string) (domainSystem.Role, error)
+	GetListPage(ctx context.Context, filter domainSystem.RoleFilter) ([]domainSystem.Role, int64, error)
+	GetListAll(ctx context.Context, filter domainSystem.RoleFilter) ([]domainSystem.Role, error)
+	GetByUserId(ctx context.Context, userId string) ([]domainSystem.Role, error)
+	GetPermissionIds(ctx context.Context, roleId string) ([]string, error)
+	GetMenuIds(ctx context.Context, roleId string) ([]string, error)
+	GetDeptIds(ctx context.Context, roleIds ...string) ([]string, error)
+
+	CheckExistByName(ctx context.Context, name, excludeId string) (bool, error)
+	CheckExistByCode(ctx context.Context, code, excludeId string) (bool, error)
+
+	GetDeletedById(ctx context.Context, id string) (domainSystem.Role, error)
+	GetRecycleListPage(ctx context.Context, filter domainSystem.RecycleFilter) ([]domainSystem.Role, int64, error)
+	Restore(ctx context.Context, domain domainSystem.Role) error
+	Purge(ctx context.Context, ids []string) (int64, error)
+	PurgeExpired(ctx context.Context, before time.Time) (int64, error)
+}
+
+type roleRepository struct {
+	dao             daoSystem.RoleDAO
+	permissionCache cacheDecorator.UserPermissionCacheLoggingDecorator
+	dataScopeCache  cacheSystem.DataScopeCache
+}
+
+func NewRoleRepository(dao daoSystem.RoleDAO, permissionCache cacheDecorator.UserPermissionCacheLoggingDecorator,
+	dataScopeCache cacheSystem.DataScopeCache) RoleRepository {
+	return &roleRepository{
+		dao:             dao,
+		permissionCache: permissionCache,
+		dataScopeCache:  dataScopeCache,
+	}
+}
+
+// Create 创建
+func (repo *roleRepository) Create(ctx context.Context, domain domainSystem.Role) (domainSystem.Role, error) {
+	model, err := repo.dao.Insert(ctx, repo.toEntity(domain))
+	return repo.toDomain(model), err
+}
+
+// Delete 删除
+func (repo *roleRepository) Delete(ctx context.Context, id string) error {
+	return repo.BatchDelete(ctx, []string{id})
+}
+
+// BatchDelete 批量删除
+func (repo *roleRepository) BatchDelete(ctx context.Context, ids []string) error {
+	// 删除前获取受影响的用户
+	userIds, err := repo.dao.FindUserIds(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	if err := repo.dao.BatchDelete(ctx, ids); err != nil {
+		return err
+	}
+
+	repo.delPermissionCache(ctx, userIds...)
+	return nil
+}
+
+// Update 更新
+func (repo *roleRepository) Update(ctx context.Context, domain domainSystem.Role) error {
+	if err := repo.dao.Update(ctx, repo.toEntity(domain)); err != nil {
+		return err
+	}
+
+	// 角色状态可能变化，清理拥有该角色用户的权限缓存
+	return repo.invalidateRoles(ctx, domain.Id)
+}
+
+// SetPermissions 设置角色接口权限
+func (repo *roleRepository) SetPermissions(ctx context.Context, roleId string, permissionIds []string) error {
+	if err := repo.dao.SetPermissions(ctx, roleId, permissionIds); err != nil {
+		return err
+	}
+	return repo.invalidateRoles(ctx, roleId)
+}
+
+// SetUserRoles 设置用户角色
+func (repo *roleRepository) SetUserRoles(ctx context.Context, userId string, roleIds []string) error {
+	if err := repo.dao.SetUserRoles(ctx, userId, roleIds); err != nil {
+		return err
+	}
+	repo.delPermissionCache(ctx, userId)
+	return nil
+}
+
+// SetMenus 设置角色菜单
+func (repo *roleRepository) SetMenus(ctx context.Context, roleId string, menuIds []string) error {
+	return repo.dao.SetMenus(ctx, roleId, menuIds)
+}
+
+// SetDepts 设置角色自定义数据范围部门
+func (repo *roleRepository) SetDepts(ctx context.Context, roleId string, deptIds []string) error {
+	if err := repo.dao.SetDepts(ctx, roleId, deptIds); err != nil {
+		return err
+	}
+	return repo.invalidateRoles(ctx, roleId)
+}
+
+// GetById 根据ID获取
+func (repo *roleRepository) GetById(ctx context.Context, id string) (domainSystem.Role, error) {
+	model, err := repo.dao.FindById(ctx, id)
+	if err != nil {
+		return domainSystem.Role{}, err
+	}
+	return repo.toDomain(model), nil
+}
+
+// GetListPage 分页查询列表
+func (repo *roleRepository) GetListPage(ctx context.Context, filter domainSystem.RoleFilter) ([]domainSystem.Role, int64, error) {
+	list, row, err := repo.dao.FindListPage(ctx, filter)
+	if err != nil {
+		return []domainSystem.Role{}, row, err
+	}
+	return repo.toDomains(list), row, nil
+}
+
+// GetListAll 查询所有列表
+func (repo *roleRepository) GetListAll(ctx context.Context, filter domainSystem.RoleFilter) ([]domainSystem.Role, error) {
+	list, err := repo.dao.FindListAll(ctx, filter)
+	if err != nil {
+		return []domainSystem.Role{}, err
+	}
+	return repo.toDomains(list), nil
+}
+
+// GetByUserId 查询用户角色
+func (repo *roleRepository) GetByUserId(ctx context.Context, userId string) ([]domainSystem.Role, error) {
+	list, err := repo.dao.FindByUserId(ctx, userId)
+	if err != nil {
+		return []domainSystem.Role{}, err
+	}
+	return repo.toDomains(list), nil
+}
+
+// GetPermissionIds 查询角色接口权限ID
+func (repo *roleRepository) GetPermissionIds(ctx context.Context, roleId string) ([]string, error) {
+	return repo.dao.FindPermissionIds(ctx, roleId)
+}
+
+// GetMenuIds 查询角色菜单ID
+func (repo *roleRepository) GetMenuIds(ctx context.Context, roleId string) ([]string, error) {
+	return repo.dao.FindMenuIds(ctx, roleId)
+}
+
+// GetDeptIds 获取角色自定义数据范围的部门ID
+func (repo *roleRepository) GetDeptIds(ctx context.Context, roleIds ...string) ([]string, error) {
+	return repo.dao.FindDeptIds(ctx, roleIds)
+}
+
+// CheckExistByName 检查name是否存在
+func (repo *roleRepository) CheckExistByName(ctx context.Context, name, excludeId string) (bool, error) {
+	return repo.dao.CheckExistByName(ctx, name, excludeId)
+}
+
+// CheckExistByCode 检查code是否存在
+func (repo *roleRepository) CheckExistByCode(ctx context.Context, code, excludeId string) (bool, error) {
+	return repo.dao.CheckExistByCode(ctx, code, excludeId)
+}
+
+// GetDeletedById 根据ID获取回收站中的角色
+func (repo *roleRepository) GetDeletedById(ctx context.Context, id string) (domainSystem.Role, error) {
+	model, err := repo.dao.FindDeletedById(ctx, id)
+	if err != nil {
+		return domainSystem.Role{}, err
+	}
+	return repo.toDomain(model), nil
+}
+
+// GetRecycleListPage 分页查询回收站
+func (repo *roleRepository) GetRecycleListPage(ctx context.Context, filter domainSystem.RecycleFilter) ([]domainSystem.Role, int64, error) {
+	list, row, err := repo.dao.FindRecycleListPage(ctx, filter)
+	if err != nil {
+		return []domainSystem.Role{}, row, err
+	}
+	return repo.toDomains(list), row, nil
+}
+
+// Restore 恢复角色，拥有该角色用户的权限随之恢复
+func (repo *roleRepository) Restore(ctx context.Context, domain domainSystem.Role) error {
+	if err := repo.dao.Restore(ctx, repo.toEntity(domain)); err != nil {
+		return err
+	}
+	return repo.invalidateRoles(ctx, domain.Id)
+}
+
+// Purge 彻底删除回收站中的角色
+func (repo *roleRepository) Purge(ctx context.Context, ids []string) (int64, error) {
+	return repo.dao.Purge(ctx, ids)
+}
+
+// PurgeExpired 彻底删除指定时间之前移入回收站的角色
+func (repo *roleRepository) PurgeExpired(ctx context.Context, before time.Time) (int64, error) {
+	return repo.dao.PurgeExpired(ctx, before)
+}
+
+// invalidateRoles 清理拥有指定角色用户的权限缓存
+func (repo *roleRepository) invalidateRoles(ctx context.Context, roleIds ...string) error {
+	userIds, err := repo.dao.FindUserIds(ctx, roleIds)
+	if err != nil {
+		return err
+	}
+	repo.delPermissionCache(ctx, userIds...)
+	return nil
+}
+
+// delPermissionCache 删除接口权限与数据权限缓存，失败不影响主流程
+func (repo *roleRepository) delPermissionCache(ctx context.Context, userIds ...string) {
+	if len(userIds) == 0 {
+		return
+	}
+	if err := repo.permissionCache.Del(ctx, userIds...); err != nil {
+		// 网络崩了，也可能是 redis 崩了
+		zap.L().Error("Redis异常", zap.Error(err))
+	}
+	if err := repo.dataScopeCache.Del(ctx, userIds...); err != nil {
+		zap.L().Error("Redis异常", zap.Error(err))
+	}
+}
+
+// toEntity 转换为实体模型
+func (repo *roleRepository) toEntity(domain domainSystem.Role) modelSystem.Role {
+	return modelSystem.Role{
+		CoreModels: models.CoreModels{
+			Id:         domain.Id,
+			Sort:       domain.Sort,
+			Timestamp:  domain.Timestamp,
+			Creator:    domain.Creator,
+			Modifier:   domain.Modifier,
+			BelongDept: domain.BelongDept,
+			Remark:     domain.Remark,
+		},
+		SoftDelete: domain.SoftDelete,
+		Status:     domain.Status,
+		Name:       domain.Name,
+		Code:       domain.Code,
+		DataScope:  domain.DataScope,
+	}
+}
+
+// toDomain 转换为领域模型
+func (repo *roleRepository) toDomain(entity *modelSystem.Role) domainSystem.Role {
+	model := domainSystem.Role{
+		Role: *entity,
+	}
+
+	if entity.CreateTime != nil {
+		model.CreateTime = entity.CreateTime.Format("2006-01-02 15:04:05")
+	}
+	if entity.UpdateTime != nil {
+		model.UpdateTime = entity.UpdateTime.Format("2006-01-02 15:04:05")
+	}
+
+	return model
+}
+
+// toDomains 批量转换为领域模型
+func (repo *roleRepository) toDomains(list []*modelSystem.Role) []domainSystem.Role {
+	toDomain := make([]domainSystem.Role, 0, len(list))
+	for _, v := range list {
+		toDomain = append(toDomain, repo.toDomain(v))
+	}
+	return toDomain
+}
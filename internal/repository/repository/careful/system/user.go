@@ -10,22 +10,45 @@ package system
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
 	modelSystem "github.com/carefuly/careful-admin-go-gin/internal/model/careful/system"
 	cacheSystem "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/careful/system"
 	cacheDecorator "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/decorator/careful/system"
 	daoSystem "github.com/carefuly/careful-admin-go-gin/internal/repository/dao/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
 	"go.uber.org/zap"
+	"time"
 )
 
 var (
-	ErrUserNotFound = daoSystem.ErrUserNotFound
+	ErrUserNotFound             = daoSystem.ErrUserNotFound
+	ErrUserUsernameDuplicate    = daoSystem.ErrUserUsernameDuplicate
+	ErrUserVersionInconsistency = daoSystem.ErrUserVersionInconsistency
+	ErrUserDeptNotFound         = daoSystem.ErrUserDeptNotFound
 )
 
 type UserRepository interface {
+	Create(ctx context.Context, domain domainSystem.User) (domainSystem.User, error)
+	Delete(ctx context.Context, id string) error
+	BatchDelete(ctx context.Context, ids []string) error
+	Update(ctx context.Context, domain domainSystem.User) error
+	UpdateStatus(ctx context.Context, id string, status bool, modifier string) error
+	UpdatePassword(ctx context.Context, id, password, modifier string) error
+
 	GetById(ctx context.Context, id string) (domainSystem.User, error)
 	GetByUsername(ctx context.Context, username string) (domainSystem.User, error)
+	GetListPage(ctx context.Context, filters domainSystem.UserFilter) ([]domainSystem.User, int64, error)
+	GetListAll(ctx context.Context, filters domainSystem.UserFilter) ([]domainSystem.User, error)
+
+	CheckExistByUsername(ctx context.Context, username, excludeId string) (bool, error)
+
+	GetDeletedById(ctx context.Context, id string) (domainSystem.User, error)
+	GetRecycleListPage(ctx context.Context, filter domainSystem.RecycleFilter) ([]domainSystem.User, int64, error)
+	Restore(ctx context.Context, domain domainSystem.User) error
+	Purge(ctx context.Context, ids []string) (int64, error)
+	PurgeExpired(ctx context.Context, before time.Time) (int64, error)
 }
 
 type userRepository struct {
@@ -40,6 +63,77 @@ func NewUserRepository(dao daoSystem.UserDAO, cache cacheDecorator.UserCacheLogg
 	}
 }
 
+// Create 创建
+func (repo *userRepository) Create(ctx context.Context, domain domainSystem.User) (domainSystem.User, error) {
+	model, err := repo.dao.Insert(ctx, repo.toEntity(domain))
+	return repo.toDomain(model), err
+}
+
+// Delete 删除
+func (repo *userRepository) Delete(ctx context.Context, id string) error {
+	if err := repo.dao.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	// 删除缓存
+	err := repo.cache.Del(ctx, id)
+	if err != nil {
+		// 网络崩了，也可能是 redis 崩了
+		zap.L().Error("Redis异常", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// BatchDelete 批量删除
+func (repo *userRepository) BatchDelete(ctx context.Context, ids []string) error {
+	err := repo.dao.BatchDelete(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	// 删除缓存
+	for _, val := range ids {
+		err = repo.cache.Del(ctx, val)
+		if err != nil {
+			// 网络崩了，也可能是 redis 崩了
+			zap.L().Error("Redis异常", zap.Error(err))
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Update 更新
+func (repo *userRepository) Update(ctx context.Context, domain domainSystem.User) error {
+	err := repo.dao.Update(ctx, repo.toEntity(domain))
+	if err != nil {
+		return err
+	}
+
+	return repo.delCache(ctx, domain.Id)
+}
+
+// UpdateStatus 更新状态
+func (repo *userRepository) UpdateStatus(ctx context.Context, id string, status bool, modifier string) error {
+	if err := repo.dao.UpdateStatus(ctx, id, status, modifier); err != nil {
+		return err
+	}
+
+	return repo.delCache(ctx, id)
+}
+
+// UpdatePassword 更新密码
+func (repo *userRepository) UpdatePassword(ctx context.Context, id, password, modifier string) error {
+	if err := repo.dao.UpdatePassword(ctx, id, password, modifier); err != nil {
+		return err
+	}
+
+	return repo.delCache(ctx, id)
+}
+
 // GetById 根据ID获取
 func (repo *userRepository) GetById(ctx context.Context, id string) (domainSystem.User, error) {
 	domain, err := repo.cache.Get(ctx, id)
@@ -82,6 +176,131 @@ func (repo *userRepository) GetByUsername(ctx context.Context, username string)
 	return repo.toDomain(user), nil
 }
 
+// GetListPage 分页查询列表
+func (repo *userRepository) GetListPage(ctx context.Context, filters domainSystem.UserFilter) ([]domainSystem.User, int64, error) {
+	list, row, err := repo.dao.FindListPage(ctx, filters)
+	if err != nil {
+		return []domainSystem.User{}, row, err
+	}
+
+	if len(list) == 0 {
+		return []domainSystem.User{}, row, nil
+	}
+
+	var domain []domainSystem.User
+	for _, v := range list {
+		domain = append(domain, repo.toDomain(v))
+	}
+
+	return domain, row, nil
+}
+
+// GetListAll 查询所有列表
+func (repo *userRepository) GetListAll(ctx context.Context, filters domainSystem.UserFilter) ([]domainSystem.User, error) {
+	list, err := repo.dao.FindListAll(ctx, filters)
+	if err != nil {
+		return []domainSystem.User{}, err
+	}
+
+	if len(list) == 0 {
+		return []domainSystem.User{}, nil
+	}
+
+	var toDomain []domainSystem.User
+	for _, v := range list {
+		toDomain = append(toDomain, repo.toDomain(v))
+	}
+
+	return toDomain, nil
+}
+
+// CheckExistByUsername 检查username是否存在
+func (repo *userRepository) CheckExistByUsername(ctx context.Context, username, excludeId string) (bool, error) {
+	return repo.dao.CheckExistByUsername(ctx, username, excludeId)
+}
+
+// GetDeletedById 根据ID获取回收站中的用户
+func (repo *userRepository) GetDeletedById(ctx context.Context, id string) (domainSystem.User, error) {
+	model, err := repo.dao.FindDeletedById(ctx, id)
+	if err != nil {
+		return domainSystem.User{}, err
+	}
+	return repo.toDomain(model), nil
+}
+
+// GetRecycleListPage 分页查询回收站
+func (repo *userRepository) GetRecycleListPage(ctx context.Context, filter domainSystem.RecycleFilter) ([]domainSystem.User, int64, error) {
+	list, row, err := repo.dao.FindRecycleListPage(ctx, filter)
+	if err != nil {
+		return []domainSystem.User{}, row, err
+	}
+
+	domains := make([]domainSystem.User, 0, len(list))
+	for _, v := range list {
+		domains = append(domains, repo.toDomain(v))
+	}
+
+	return domains, row, nil
+}
+
+// Restore 恢复用户，删除时保留的角色随之恢复
+func (repo *userRepository) Restore(ctx context.Context, domain domainSystem.User) error {
+	if err := repo.dao.Restore(ctx, repo.toEntity(domain)); err != nil {
+		return err
+	}
+
+	// 删除缓存，包括删除期间写入的防穿透标记
+	return repo.delCache(ctx, domain.Id)
+}
+
+// Purge 彻底删除回收站中的用户
+func (repo *userRepository) Purge(ctx context.Context, ids []string) (int64, error) {
+	return repo.dao.Purge(ctx, ids)
+}
+
+// PurgeExpired 彻底删除指定时间之前移入回收站的用户
+func (repo *userRepository) PurgeExpired(ctx context.Context, before time.Time) (int64, error) {
+	return repo.dao.PurgeExpired(ctx, before)
+}
+
+// delCache 删除缓存
+func (repo *userRepository) delCache(ctx context.Context, id string) error {
+	if err := repo.cache.Del(ctx, id); err != nil {
+		// 网络崩了，也可能是 redis 崩了
+		zap.L().Error("Redis异常", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// toEntity 转换为实体模型
+func (repo *userRepository) toEntity(domain domainSystem.User) modelSystem.User {
+	return modelSystem.User{
+		CoreModels: models.CoreModels{
+			Id:         domain.Id,
+			Sort:       domain.Sort,
+			Timestamp:  domain.Timestamp,
+			Creator:    domain.Creator,
+			Modifier:   domain.Modifier,
+			BelongDept: domain.BelongDept,
+			Remark:     domain.Remark,
+		},
+		SoftDelete: domain.SoftDelete,
+		Status:     domain.Status,
+		Username:   domain.Username,
+		Password:   domain.Password,
+		Name:       domain.Name,
+		Gender:     domain.Gender,
+		Email:      domain.Email,
+		Mobile:     domain.Mobile,
+		Avatar:     domain.Avatar,
+		DeptId: sql.NullString{
+			String: domain.DeptId,
+			Valid:  domain.DeptId != "",
+		},
+	}
+}
+
 // toDomain 转换为领域模型
 func (repo *userRepository) toDomain(entity *modelSystem.User) domainSystem.User {
 	model := domainSystem.User{
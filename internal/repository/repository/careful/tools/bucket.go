@@ -0,0 +1,199 @@
+/**
+ * Description：
+ * FileName：bucket.go
+ * Author：CJiaの用心
+ * Create：2025/11/11 16:38:52
+ * Remark：
+ */
+
+package tools
+
+import (
+	"context"
+	domainTools "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/tools"
+	modelTools "github.com/carefuly/careful-admin-go-gin/internal/model/careful/tools"
+	daoTools "github.com/carefuly/careful-admin-go-gin/internal/repository/dao/careful/tools"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	"time"
+)
+
+var (
+	ErrBucketNotFound             = daoTools.ErrBucketNotFound
+	ErrBucketCodeDuplicate        = daoTools.ErrBucketCodeDuplicate
+	ErrBucketNameDuplicate        = daoTools.ErrBucketNameDuplicate
+	ErrBucketVersionInconsistency = daoTools.ErrBucketVersionInconsistency
+)
+
+type BucketRepository interface {
+	Create(ctx context.Context, domain domainTools.Bucket) (domainTools.Bucket, error)
+	Delete(ctx context.Context, id string) error
+	Update(ctx context.Context, domain domainTools.Bucket) error
+
+	GetById(ctx context.Context, id string) (domainTools.Bucket, error)
+	GetByCode(ctx context.Context, code string) (domainTools.Bucket, error)
+	GetListPage(ctx context.Context, filter domainTools.BucketFilter) ([]domainTools.Bucket, int64, error)
+	GetListAll(ctx context.Context, filter domainTools.BucketFilter) ([]domainTools.Bucket, error)
+
+	CheckExistByCode(ctx context.Context, code, excludeId string) (bool, error)
+	CheckExistByName(ctx context.Context, name, excludeId string) (bool, error)
+
+	GetDeletedById(ctx context.Context, id string) (domainTools.Bucket, error)
+	GetRecycleListPage(ctx context.Context, filter domainTools.RecycleFilter) ([]domainTools.Bucket, int64, error)
+	Restore(ctx context.Context, domain domainTools.Bucket) error
+	Purge(ctx context.Context, ids []string) (int64, error)
+	PurgeExpired(ctx context.Context, before time.Time) (int64, error)
+}
+
+type bucketRepository struct {
+	dao daoTools.BucketDAO
+}
+
+func NewBucketRepository(dao daoTools.BucketDAO) BucketRepository {
+	return &bucketRepository{
+		dao: dao,
+	}
+}
+
+// Create 创建
+func (repo *bucketRepository) Create(ctx context.Context, domain domainTools.Bucket) (domainTools.Bucket, error) {
+	model, err := repo.dao.Insert(ctx, repo.toEntity(domain))
+	if err != nil {
+		return domainTools.Bucket{}, err
+	}
+	return repo.toDomain(model), nil
+}
+
+// Delete 删除
+func (repo *bucketRepository) Delete(ctx context.Context, id string) error {
+	return repo.dao.Delete(ctx, id)
+}
+
+// Update 更新
+func (repo *bucketRepository) Update(ctx context.Context, domain domainTools.Bucket) error {
+	return repo.dao.Update(ctx, repo.toEntity(domain))
+}
+
+// GetById 根据ID获取
+func (repo *bucketRepository) GetById(ctx context.Context, id string) (domainTools.Bucket, error) {
+	model, err := repo.dao.FindById(ctx, id)
+	if err != nil {
+		return domainTools.Bucket{}, err
+	}
+	return repo.toDomain(model), nil
+}
+
+// GetByCode 根据编码获取
+func (repo *bucketRepository) GetByCode(ctx context.Context, code string) (domainTools.Bucket, error) {
+	model, err := repo.dao.FindByCode(ctx, code)
+	if err != nil {
+		return domainTools.Bucket{}, err
+	}
+	return repo.toDomain(model), nil
+}
+
+// GetListPage 分页查询列表
+func (repo *bucketRepository) GetListPage(ctx context.Context, filter domainTools.BucketFilter) ([]domainTools.Bucket, int64, error) {
+	list, row, err := repo.dao.FindListPage(ctx, filter)
+	if err != nil {
+		return []domainTools.Bucket{}, row, err
+	}
+	return repo.toDomains(list), row, nil
+}
+
+// GetListAll 查询所有列表
+func (repo *bucketRepository) GetListAll(ctx context.Context, filter domainTools.BucketFilter) ([]domainTools.Bucket, error) {
+	list, err := repo.dao.FindListAll(ctx, filter)
+	if err != nil {
+		return []domainTools.Bucket{}, err
+	}
+	return repo.toDomains(list), nil
+}
+
+// CheckExistByCode 检查code是否存在
+func (repo *bucketRepository) CheckExistByCode(ctx context.Context, code, excludeId string) (bool, error) {
+	return repo.dao.CheckExistByCode(ctx, code, excludeId)
+}
+
+// CheckExistByName 检查name是否存在
+func (repo *bucketRepository) CheckExistByName(ctx context.Context, name, excludeId string) (bool, error) {
+	return repo.dao.CheckExistByName(ctx, name, excludeId)
+}
+
+// GetDeletedById 根据ID获取回收站中的存储桶
+func (repo *bucketRepository) GetDeletedById(ctx context.Context, id string) (domainTools.Bucket, error) {
+	model, err := repo.dao.FindDeletedById(ctx, id)
+	if err != nil {
+		return domainTools.Bucket{}, err
+	}
+	return repo.toDomain(model), nil
+}
+
+// GetRecycleListPage 分页查询回收站
+func (repo *bucketRepository) GetRecycleListPage(ctx context.Context, filter domainTools.RecycleFilter) ([]domainTools.Bucket, int64, error) {
+	list, row, err := repo.dao.FindRecycleListPage(ctx, filter)
+	if err != nil {
+		return []domainTools.Bucket{}, row, err
+	}
+	return repo.toDomains(list), row, nil
+}
+
+// Restore 恢复存储桶
+func (repo *bucketRepository) Restore(ctx context.Context, domain domainTools.Bucket) error {
+	return repo.dao.Restore(ctx, repo.toEntity(domain))
+}
+
+// Purge 彻底删除回收站中的存储桶
+func (repo *bucketRepository) Purge(ctx context.Context, ids []string) (int64, error) {
+	return repo.dao.Purge(ctx, ids)
+}
+
+// PurgeExpired 彻底删除指定时间之前移入回收站的存储桶
+func (repo *bucketRepository) PurgeExpired(ctx context.Context, before time.Time) (int64, error) {
+	return repo.dao.PurgeExpired(ctx, before)
+}
+
+// toEntity 转换为实体模型
+func (repo *bucketRepository) toEntity(domain domainTools.Bucket) modelTools.Bucket {
+	return modelTools.Bucket{
+		CoreModels: models.CoreModels{
+			Id:         domain.Id,
+			Sort:       domain.Sort,
+			Timestamp:  domain.Timestamp,
+			Creator:    domain.Creator,
+			Modifier:   domain.Modifier,
+			BelongDept: domain.BelongDept,
+			Remark:     domain.Remark,
+		},
+		SoftDelete:   domain.SoftDelete,
+		Status:       domain.Status,
+		Name:         domain.Name,
+		Code:         domain.Code,
+		MaxSize:      domain.MaxSize,
+		AllowedMimes: domain.AllowedMimes,
+	}
+}
+
+// toDomain 转换为领域模型
+func (repo *bucketRepository) toDomain(entity *modelTools.Bucket) domainTools.Bucket {
+	domain := domainTools.Bucket{
+		Bucket: *entity,
+	}
+
+	if entity.CreateTime != nil {
+		domain.CreateTime = entity.CreateTime.Format("2006-01-02 15:04:05")
+	}
+	if entity.UpdateTime != nil {
+		domain.UpdateTime = entity.UpdateTime.Format("2006-01-02 15:04:05")
+	}
+
+	return domain
+}
+
+// toDomains 批量转换为领域模型
+func (repo *bucketRepository) toDomains(list []*modelTools.Bucket) []domainTools.Bucket {
+	domains := make([]domainTools.Bucket, 0, len(list))
+	for _, entity := range list {
+		domains = append(domains, repo.toDomain(entity))
+	}
+	return domains
+}
@@ -18,10 +18,11 @@ import (
 	daoTools "github.com/carefuly/careful-admin-go-gin/internal/repository/dao/careful/tools"
 	"github.com/carefuly/careful-admin-go-gin/pkg/models"
 	"go.uber.org/zap"
+	"time"
 )
 
 var (
-	ErrDictNotFound             = daoTools.ErrDictDuplicate
+	ErrDictNotFound             = daoTools.ErrDictNotFound
 	ErrDictNameDuplicate        = daoTools.ErrDictNameDuplicate
 	ErrDictCodeDuplicate        = daoTools.ErrDictCodeDuplicate
 	ErrDictDuplicate            = daoTools.ErrDictDuplicate
@@ -30,6 +31,7 @@ var (
 
 type DictRepository interface {
 	Create(ctx context.Context, domain domainTools.Dict) (domainTools.Dict, error)
+	BatchCreate(ctx context.Context, domains []domainTools.Dict) error
 	Delete(ctx context.Context, id string) error
 	BatchDelete(ctx context.Context, ids []string) error
 	Update(ctx context.Context, domain domainTools.Dict) error
@@ -41,6 +43,14 @@ type DictRepository interface {
 
 	CheckExistByCode(ctx context.Context, code, excludeId string) (bool, error)
 	CheckExistByName(ctx context.Context, name, excludeId string) (bool, error)
+	GetExistingCodes(ctx context.Context, codes []string) ([]string, error)
+	GetExistingNames(ctx context.Context, names []string) ([]string, error)
+
+	GetDeletedById(ctx context.Context, id string) (domainTools.Dict, error)
+	GetRecycleListPage(ctx context.Context, filter domainTools.RecycleFilter) ([]domainTools.Dict, int64, error)
+	Restore(ctx context.Context, domain domainTools.Dict) error
+	Purge(ctx context.Context, ids []string) (int64, error)
+	PurgeExpired(ctx context.Context, before time.Time) (int64, error)
 }
 
 type dictRepository struct {
@@ -61,6 +71,15 @@ func (repo *dictRepository) Create(ctx context.Context, domain domainTools.Dict)
 	return repo.toDomain(model), err
 }
 
+// BatchCreate 批量创建，全部成功或全部失败
+func (repo *dictRepository) BatchCreate(ctx context.Context, domains []domainTools.Dict) error {
+	entities := make([]modelTools.Dict, 0, len(domains))
+	for _, domain := range domains {
+		entities = append(entities, repo.toEntity(domain))
+	}
+	return repo.dao.BatchInsert(ctx, entities)
+}
+
 // Delete 删除
 func (repo *dictRepository) Delete(ctx context.Context, id string) error {
 	if err := repo.dao.Delete(ctx, id); err != nil {
@@ -203,6 +222,67 @@ func (repo *dictRepository) CheckExistByName(ctx context.Context, name, excludeI
 	return repo.dao.CheckExistByName(ctx, name, excludeId)
 }
 
+// GetExistingCodes 批量查询已存在的code
+func (repo *dictRepository) GetExistingCodes(ctx context.Context, codes []string) ([]string, error) {
+	return repo.dao.FindExistingCodes(ctx, codes)
+}
+
+// GetExistingNames 批量查询已存在的name
+func (repo *dictRepository) GetExistingNames(ctx context.Context, names []string) ([]string, error) {
+	return repo.dao.FindExistingNames(ctx, names)
+}
+
+// GetDeletedById 根据ID获取回收站中的字典
+func (repo *dictRepository) GetDeletedById(ctx context.Context, id string) (domainTools.Dict, error) {
+	model, err := repo.dao.FindDeletedById(ctx, id)
+	if err != nil {
+		return domainTools.Dict{}, err
+	}
+	return repo.toDomain(model), nil
+}
+
+// GetRecycleListPage 分页查询回收站
+func (repo *dictRepository) GetRecycleListPage(ctx context.Context, filter domainTools.RecycleFilter) ([]domainTools.Dict, int64, error) {
+	list, row, err := repo.dao.FindRecycleListPage(ctx, filter)
+	if err != nil {
+		return []domainTools.Dict{}, row, err
+	}
+
+	domains := make([]domainTools.Dict, 0, len(list))
+	for _, v := range list {
+		domains = append(domains, repo.toDomain(v))
+	}
+
+	return domains, row, nil
+}
+
+// Restore 恢复字典及随其一同删除的字典项
+func (repo *dictRepository) Restore(ctx context.Context, domain domainTools.Dict) error {
+	if err := repo.dao.Restore(ctx, repo.toEntity(domain)); err != nil {
+		return err
+	}
+
+	// 删除缓存，包括删除期间写入的防穿透标记
+	err := repo.cache.Del(ctx, domain.Id)
+	if err != nil {
+		// 网络崩了，也可能是 redis 崩了
+		zap.L().Error("Redis异常", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// Purge 彻底删除回收站中的字典
+func (repo *dictRepository) Purge(ctx context.Context, ids []string) (int64, error) {
+	return repo.dao.Purge(ctx, ids)
+}
+
+// PurgeExpired 彻底删除指定时间之前移入回收站的字典
+func (repo *dictRepository) PurgeExpired(ctx context.Context, before time.Time) (int64, error) {
+	return repo.dao.PurgeExpired(ctx, before)
+}
+
 // toEntity 转换为实体模型
 func (repo *dictRepository) toEntity(domain domainTools.Dict) modelTools.Dict {
 	return modelTools.Dict{
@@ -215,11 +295,12 @@ func (repo *dictRepository) toEntity(domain domainTools.Dict) modelTools.Dict {
 			BelongDept: domain.BelongDept,
 			Remark:     domain.Remark,
 		},
-		Status:    domain.Status,
-		Name:      domain.Name,
-		Code:      domain.Code,
-		Type:      domain.Type,
-		ValueType: domain.ValueType,
+		SoftDelete: domain.SoftDelete,
+		Status:     domain.Status,
+		Name:       domain.Name,
+		Code:       domain.Code,
+		Type:       domain.Type,
+		ValueType:  domain.ValueType,
 	}
 }
 
@@ -19,6 +19,7 @@ import (
 	daoTools "github.com/carefuly/careful-admin-go-gin/internal/repository/dao/careful/tools"
 	"github.com/carefuly/careful-admin-go-gin/pkg/models"
 	"go.uber.org/zap"
+	"time"
 )
 
 var (
@@ -30,14 +31,27 @@ var (
 
 type DictTypeRepository interface {
 	Create(ctx context.Context, domain domainTools.DictType) (domainTools.DictType, error)
+	BatchCreate(ctx context.Context, domains []domainTools.DictType) error
 	Delete(ctx context.Context, id string) error
 	BatchDelete(ctx context.Context, ids []string) error
 	Update(ctx context.Context, domain domainTools.DictType) error
 
 	GetById(ctx context.Context, id string) (domainTools.DictType, error)
 	GetByDictNames(ctx context.Context, dictNames []string) ([]domainTools.DictType, error)
+	GetOptionsByDictNames(ctx context.Context, dictNames []string) (map[string][]domainTools.DictOption, error)
 	GetListPage(ctx context.Context, filters domainTools.DictTypeFilter) ([]domainTools.DictType, int64, error)
 	GetListAll(ctx context.Context, filters domainTools.DictTypeFilter) ([]domainTools.DictType, error)
+
+	GetExistingNames(ctx context.Context, domains []domainTools.DictType) ([]domainTools.DictType, error)
+	GetExistingValues(ctx context.Context, domains []domainTools.DictType) ([]domainTools.DictType, error)
+
+	DelOptions(ctx context.Context, dictNames []string) error
+
+	GetDeletedById(ctx context.Context, id string) (domainTools.DictType, error)
+	GetRecycleListPage(ctx context.Context, filter domainTools.RecycleFilter) ([]domainTools.DictType, int64, error)
+	Restore(ctx context.Context, domain domainTools.DictType) error
+	Purge(ctx context.Context, ids []string) (int64, error)
+	PurgeExpired(ctx context.Context, before time.Time) (int64, error)
 }
 
 type dictTypeRepository struct {
@@ -60,29 +74,67 @@ func (repo *dictTypeRepository) Create(ctx context.Context, domain domainTools.D
 	}
 	insert, err := repo.dao.Insert(ctx, model)
 	toDomain := repo.toDomain(insert)
+	if err == nil {
+		_ = repo.DelOptions(ctx, []string{domain.DictName})
+	}
 	return toDomain, err
 }
 
+// BatchCreate 批量创建，全部成功或全部失败
+func (repo *dictTypeRepository) BatchCreate(ctx context.Context, domains []domainTools.DictType) error {
+	entities := make([]modelTools.DictType, 0, len(domains))
+	dictNames := make([]string, 0)
+	seen := make(map[string]struct{})
+	for _, domain := range domains {
+		entity, err := repo.toEntity(domain)
+		if err != nil {
+			return err
+		}
+		entities = append(entities, entity)
+		if _, ok := seen[domain.DictName]; !ok {
+			seen[domain.DictName] = struct{}{}
+			dictNames = append(dictNames, domain.DictName)
+		}
+	}
+
+	if err := repo.dao.BatchInsert(ctx, entities); err != nil {
+		return err
+	}
+
+	_ = repo.DelOptions(ctx, dictNames)
+	return nil
+}
+
 // Delete 删除
 func (repo *dictTypeRepository) Delete(ctx context.Context, id string) error {
+	dictNames, err := repo.dao.FindDictNamesByIds(ctx, []string{id})
+	if err != nil {
+		return err
+	}
+
 	if err := repo.dao.Delete(ctx, id); err != nil {
 		return err
 	}
 
 	// 删除缓存
-	err := repo.cache.Del(ctx, id)
+	err = repo.cache.Del(ctx, id)
 	if err != nil {
 		// 网络崩了，也可能是 redis 崩了
 		zap.S().Error("Redis异常", zap.Error(err))
 		return err
 	}
 
-	return err
+	return repo.DelOptions(ctx, dictNames)
 }
 
 // BatchDelete 批量删除
 func (repo *dictTypeRepository) BatchDelete(ctx context.Context, ids []string) error {
-	err := repo.dao.BatchDelete(ctx, ids)
+	dictNames, err := repo.dao.FindDictNamesByIds(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	err = repo.dao.BatchDelete(ctx, ids)
 	if err != nil {
 		return err
 	}
@@ -97,7 +149,7 @@ func (repo *dictTypeRepository) BatchDelete(ctx context.Context, ids []string) e
 		}
 	}
 
-	return err
+	return repo.DelOptions(ctx, dictNames)
 }
 
 // Update 更新
@@ -107,6 +159,11 @@ func (repo *dictTypeRepository) Update(ctx context.Context, domain domainTools.D
 		return err
 	}
 
+	dictNames, err := repo.dao.FindDictNamesByIds(ctx, []string{domain.Id})
+	if err != nil {
+		return err
+	}
+
 	err = repo.dao.Update(ctx, entity)
 	if err != nil {
 		return err
@@ -120,7 +177,7 @@ func (repo *dictTypeRepository) Update(ctx context.Context, domain domainTools.D
 		return err
 	}
 
-	return nil
+	return repo.DelOptions(ctx, dictNames)
 }
 
 // GetById 根据ID获取
@@ -155,22 +212,64 @@ func (repo *dictTypeRepository) GetById(ctx context.Context, id string) (domainT
 
 // GetByDictNames 根据多个dictName获取详情
 func (repo *dictTypeRepository) GetByDictNames(ctx context.Context, dictNames []string) ([]domainTools.DictType, error) {
-	// list, err := repo.dao.FindByDictNames(ctx, dictNames)
-	// if err != nil {
-	// 	return []domainTools.DictType{}, err
-	// }
-	//
-	// if len(list) == 0 {
-	// 	return []domainTools.DictType{}, nil
-	// }
-	//
-	// var domains []domainTools.DictType
-	// for _, v := range list {
-	// 	domains = append(domains, repo.toDomain(v))
-	// }
-	//
-	// return domains, nil
-	return nil, nil
+	list, err := repo.dao.FindByDictNames(ctx, dictNames)
+	if err != nil {
+		return []domainTools.DictType{}, err
+	}
+
+	if len(list) == 0 {
+		return []domainTools.DictType{}, nil
+	}
+
+	var domains []domainTools.DictType
+	for _, v := range list {
+		domains = append(domains, repo.toDomain(v))
+	}
+
+	return domains, nil
+}
+
+// GetOptionsByDictNames 根据多个dictName获取字典选项，优先读取按字典缓存的选项
+func (repo *dictTypeRepository) GetOptionsByDictNames(ctx context.Context, dictNames []string) (map[string][]domainTools.DictOption, error) {
+	result := make(map[string][]domainTools.DictOption, len(dictNames))
+
+	// 未命中缓存的字典统一查库
+	var missing []string
+	for _, name := range dictNames {
+		options, err := repo.cache.GetOptions(ctx, name)
+		if err == nil {
+			result[name] = options
+			continue
+		}
+		if !errors.Is(err, cacheTools.ErrDictTypeNotExist) {
+			// 缓存查询出错但不是"不存在"错误，记录日志但继续查DB
+			zap.L().Error("缓存获取错误:", zap.Error(err))
+		}
+		missing = append(missing, name)
+	}
+	if len(missing) == 0 {
+		return result, nil
+	}
+
+	list, err := repo.GetByDictNames(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range missing {
+		result[name] = []domainTools.DictOption{}
+	}
+	for _, v := range list {
+		result[v.DictName] = append(result[v.DictName], v.Option())
+	}
+
+	for _, name := range missing {
+		if err := repo.cache.SetOptions(ctx, name, result[name]); err != nil {
+			// 网络崩了，也可能是 redis 崩了
+			zap.L().Error("Redis异常", zap.Error(err))
+		}
+	}
+
+	return result, nil
 }
 
 // GetListPage 分页查询列表
@@ -211,6 +310,92 @@ func (repo *dictTypeRepository) GetListAll(ctx context.Context, filters domainTo
 	return domains, nil
 }
 
+// GetExistingNames 按(所属字典ID, 名称)批量查询已存在的字典信息
+func (repo *dictTypeRepository) GetExistingNames(ctx context.Context, domains []domainTools.DictType) ([]domainTools.DictType, error) {
+	entities, err := repo.toEntities(domains)
+	if err != nil {
+		return nil, err
+	}
+	list, err := repo.dao.FindExistingNames(ctx, entities)
+	if err != nil {
+		return nil, err
+	}
+	return repo.toDomains(list), nil
+}
+
+// GetExistingValues 按(所属字典ID, 字典值)批量查询已存在的字典信息
+func (repo *dictTypeRepository) GetExistingValues(ctx context.Context, domains []domainTools.DictType) ([]domainTools.DictType, error) {
+	entities, err := repo.toEntities(domains)
+	if err != nil {
+		return nil, err
+	}
+	list, err := repo.dao.FindExistingValues(ctx, entities)
+	if err != nil {
+		return nil, err
+	}
+	return repo.toDomains(list), nil
+}
+
+// DelOptions 删除字典选项缓存
+func (repo *dictTypeRepository) DelOptions(ctx context.Context, dictNames []string) error {
+	if err := repo.cache.DelOptions(ctx, dictNames...); err != nil {
+		// 网络崩了，也可能是 redis 崩了
+		zap.L().Error("Redis异常", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// GetDeletedById 根据ID获取回收站中的字典信息
+func (repo *dictTypeRepository) GetDeletedById(ctx context.Context, id string) (domainTools.DictType, error) {
+	model, err := repo.dao.FindDeletedById(ctx, id)
+	if err != nil {
+		return domainTools.DictType{}, err
+	}
+	return repo.toDomain(model), nil
+}
+
+// GetRecycleListPage 分页查询回收站
+func (repo *dictTypeRepository) GetRecycleListPage(ctx context.Context, filter domainTools.RecycleFilter) ([]domainTools.DictType, int64, error) {
+	list, row, err := repo.dao.FindRecycleListPage(ctx, filter)
+	if err != nil {
+		return []domainTools.DictType{}, row, err
+	}
+	return repo.toDomains(list), row, nil
+}
+
+// Restore 恢复字典信息
+func (repo *dictTypeRepository) Restore(ctx context.Context, domain domainTools.DictType) error {
+	entity, err := repo.toEntity(domain)
+	if err != nil {
+		return err
+	}
+
+	if err := repo.dao.Restore(ctx, entity); err != nil {
+		return err
+	}
+
+	// 删除缓存，包括删除期间写入的防穿透标记
+	err = repo.cache.Del(ctx, domain.Id)
+	if err != nil {
+		// 网络崩了，也可能是 redis 崩了
+		zap.L().Error("Redis异常", zap.Error(err))
+		return err
+	}
+
+	return repo.DelOptions(ctx, []string{domain.DictName})
+}
+
+// Purge 彻底删除回收站中的字典信息
+func (repo *dictTypeRepository) Purge(ctx context.Context, ids []string) (int64, error) {
+	return repo.dao.Purge(ctx, ids)
+}
+
+// PurgeExpired 彻底删除指定时间之前移入回收站的字典信息
+func (repo *dictTypeRepository) PurgeExpired(ctx context.Context, before time.Time) (int64, error) {
+	return repo.dao.PurgeExpired(ctx, before)
+}
+
 // toEntity 转换为实体模型
 func (repo *dictTypeRepository) toEntity(domain domainTools.DictType) (modelTools.DictType, error) {
 	model := modelTools.DictType{
@@ -223,13 +408,14 @@ func (repo *dictTypeRepository) toEntity(domain domainTools.DictType) (modelTool
 			BelongDept: domain.BelongDept,
 			Remark:     domain.Remark,
 		},
-		Status:    domain.Status,
-		Name:      domain.Name,
-		DictTag:   domain.DictTag,
-		DictColor: domain.DictColor,
-		DictName:  domain.DictName,
-		ValueType: domain.ValueType,
-		DictId:    domain.DictId,
+		SoftDelete: domain.SoftDelete,
+		Status:     domain.Status,
+		Name:       domain.Name,
+		DictTag:    domain.DictTag,
+		DictColor:  domain.DictColor,
+		DictName:   domain.DictName,
+		ValueType:  domain.ValueType,
+		DictId:     domain.DictId,
 	}
 
 	// 根据类型设置值
@@ -262,6 +448,28 @@ func (repo *dictTypeRepository) toEntity(domain domainTools.DictType) (modelTool
 	return model, nil
 }
 
+// toEntities 批量转换为实体模型
+func (repo *dictTypeRepository) toEntities(domains []domainTools.DictType) ([]modelTools.DictType, error) {
+	entities := make([]modelTools.DictType, 0, len(domains))
+	for _, domain := range domains {
+		entity, err := repo.toEntity(domain)
+		if err != nil {
+			return nil, err
+		}
+		entities = append(entities, entity)
+	}
+	return entities, nil
+}
+
+// toDomains 批量转换为领域模型
+func (repo *dictTypeRepository) toDomains(list []*modelTools.DictType) []domainTools.DictType {
+	domains := make([]domainTools.DictType, 0, len(list))
+	for _, entity := range list {
+		domains = append(domains, repo.toDomain(entity))
+	}
+	return domains
+}
+
 // toDomain 转换为领域模型
 func (repo *dictTypeRepository) toDomain(entity *modelTools.DictType) domainTools.DictType {
 	model := domainTools.DictType{
@@ -0,0 +1,168 @@
+/**
+ * Description：
+ * FileName：file.go
+ * Author：CJiaの用心
+ * Create：2025/11/11 16:47:14
+ * Remark：
+ */
+
+package tools
+
+import (
+	"context"
+	domainTools "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/tools"
+	modelTools "github.com/carefuly/careful-admin-go-gin/internal/model/careful/tools"
+	daoTools "github.com/carefuly/careful-admin-go-gin/internal/repository/dao/careful/tools"
+	"time"
+)
+
+var (
+	ErrFileNotFound       = daoTools.ErrFileNotFound
+	ErrFileBucketNotFound = daoTools.ErrFileBucketNotFound
+)
+
+type FileRepository interface {
+	Create(ctx context.Context, domain domainTools.File) (domainTools.File, error)
+	Delete(ctx context.Context, id string) error
+
+	GetById(ctx context.Context, id string) (domainTools.File, error)
+	GetByHash(ctx context.Context, bucketId, hash string) (domainTools.File, error)
+	GetListPage(ctx context.Context, filter domainTools.FileFilter) ([]domainTools.File, int64, error)
+
+	CountByObjectKey(ctx context.Context, objectKey string) (int64, error)
+	CountByBucket(ctx context.Context, bucketId string) (int64, error)
+	CountAllByBucket(ctx context.Context, bucketId string) (int64, error)
+
+	GetDeletedById(ctx context.Context, id string) (domainTools.File, error)
+	GetRecycleListPage(ctx context.Context, filter domainTools.RecycleFilter) ([]domainTools.File, int64, error)
+	Restore(ctx context.Context, domain domainTools.File) error
+	Purge(ctx context.Context, ids []string) ([]domainTools.File, error)
+	PurgeExpired(ctx context.Context, before time.Time) ([]domainTools.File, error)
+}
+
+type fileRepository struct {
+	dao daoTools.FileDAO
+}
+
+func NewFileRepository(dao daoTools.FileDAO) FileRepository {
+	return &fileRepository{
+		dao: dao,
+	}
+}
+
+// Create 创建
+func (repo *fileRepository) Create(ctx context.Context, domain domainTools.File) (domainTools.File, error) {
+	model, err := repo.dao.Insert(ctx, domain.File)
+	if err != nil {
+		return domainTools.File{}, err
+	}
+	return repo.toDomain(model), nil
+}
+
+// Delete 删除
+func (repo *fileRepository) Delete(ctx context.Context, id string) error {
+	return repo.dao.Delete(ctx, id)
+}
+
+// GetById 根据ID获取
+func (repo *fileRepository) GetById(ctx context.Context, id string) (domainTools.File, error) {
+	model, err := repo.dao.FindById(ctx, id)
+	if err != nil {
+		return domainTools.File{}, err
+	}
+	return repo.toDomain(model), nil
+}
+
+// GetByHash 获取存储桶内内容哈希相同的文件
+func (repo *fileRepository) GetByHash(ctx context.Context, bucketId, hash string) (domainTools.File, error) {
+	model, err := repo.dao.FindByHash(ctx, bucketId, hash)
+	if err != nil {
+		return domainTools.File{}, err
+	}
+	return repo.toDomain(model), nil
+}
+
+// GetListPage 分页查询列表
+func (repo *fileRepository) GetListPage(ctx context.Context, filter domainTools.FileFilter) ([]domainTools.File, int64, error) {
+	list, row, err := repo.dao.FindListPage(ctx, filter)
+	if err != nil {
+		return []domainTools.File{}, row, err
+	}
+
+	return repo.toDomains(list), row, nil
+}
+
+// CountByObjectKey 统计引用同一存储对象的文件数
+func (repo *fileRepository) CountByObjectKey(ctx context.Context, objectKey string) (int64, error) {
+	return repo.dao.CountByObjectKey(ctx, objectKey)
+}
+
+// CountByBucket 统计存储桶内的文件数
+func (repo *fileRepository) CountByBucket(ctx context.Context, bucketId string) (int64, error) {
+	return repo.dao.CountByBucket(ctx, bucketId)
+}
+
+// CountAllByBucket 统计存储桶内的文件数，包括回收站中的文件
+func (repo *fileRepository) CountAllByBucket(ctx context.Context, bucketId string) (int64, error) {
+	return repo.dao.CountAllByBucket(ctx, bucketId)
+}
+
+// GetDeletedById 根据ID获取回收站中的文件
+func (repo *fileRepository) GetDeletedById(ctx context.Context, id string) (domainTools.File, error) {
+	model, err := repo.dao.FindDeletedById(ctx, id)
+	if err != nil {
+		return domainTools.File{}, err
+	}
+	return repo.toDomain(model), nil
+}
+
+// GetRecycleListPage 分页查询回收站
+func (repo *fileRepository) GetRecycleListPage(ctx context.Context, filter domainTools.RecycleFilter) ([]domainTools.File, int64, error) {
+	list, row, err := repo.dao.FindRecycleListPage(ctx, filter)
+	if err != nil {
+		return []domainTools.File{}, row, err
+	}
+	return repo.toDomains(list), row, nil
+}
+
+// Restore 恢复文件
+func (repo *fileRepository) Restore(ctx context.Context, domain domainTools.File) error {
+	return repo.dao.Restore(ctx, domain.File)
+}
+
+// Purge 彻底删除回收站中的文件，返回被删除的文件
+func (repo *fileRepository) Purge(ctx context.Context, ids []string) ([]domainTools.File, error) {
+	list, err := repo.dao.Purge(ctx, ids)
+	return repo.toDomains(list), err
+}
+
+// PurgeExpired 彻底删除指定时间之前移入回收站的文件，返回被删除的文件
+func (repo *fileRepository) PurgeExpired(ctx context.Context, before time.Time) ([]domainTools.File, error) {
+	list, err := repo.dao.PurgeExpired(ctx, before)
+	return repo.toDomains(list), err
+}
+
+// toDomain 转换为领域模型
+func (repo *fileRepository) toDomain(entity *modelTools.File) domainTools.File {
+	domain := domainTools.File{
+		File: *entity,
+	}
+
+	if entity.CreateTime != nil {
+		domain.CreateTime = entity.CreateTime.Format("2006-01-02 15:04:05")
+	}
+	if entity.UpdateTime != nil {
+		domain.UpdateTime = entity.UpdateTime.Format("2006-01-02 15:04:05")
+	}
+
+	return domain
+}
+
+// toDomains 批量转换为领域模型
+func (repo *fileRepository) toDomains(list []*modelTools.File) []domainTools.File {
+	domains := make([]domainTools.File, 0, len(list))
+	for _, entity := range list {
+		domains = append(domains, repo.toDomain(entity))
+	}
+	return domains
+}
@@ -0,0 +1,193 @@
+/**
+ * Description：
+ * FileName：job.go
+ * Author：CJiaの用心
+ * Create：2025/11/7 11:32:16
+ * Remark：
+ */
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	domainTools "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/tools"
+	modelTools "github.com/carefuly/careful-admin-go-gin/internal/model/careful/tools"
+	daoTools "github.com/carefuly/careful-admin-go-gin/internal/repository/dao/careful/tools"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/tools/job"
+	"math"
+	"time"
+)
+
+var (
+	ErrJobNotFound       = daoTools.ErrJobNotFound
+	ErrJobStatusConflict = daoTools.ErrJobStatusConflict
+)
+
+type JobRepository interface {
+	Create(ctx context.Context, domain domainTools.Job) (domainTools.Job, error)
+	Start(ctx context.Context, id string) error
+	UpdateProgress(ctx context.Context, id string, progress domainTools.JobProgress) error
+	Finish(ctx context.Context, id string, outcome domainTools.JobOutcome, expireTime time.Time) error
+	Fail(ctx context.Context, id, message string, progress domainTools.JobProgress) error
+	Expire(ctx context.Context, id string) error
+	FailUnfinished(ctx context.Context, message string) (int64, error)
+
+	GetById(ctx context.Context, id string) (domainTools.Job, error)
+	GetListPage(ctx context.Context, filter domainTools.JobFilter) ([]domainTools.Job, int64, error)
+	GetExpired(ctx context.Context, before time.Time, limit int) ([]domainTools.Job, error)
+}
+
+type jobRepository struct {
+	dao daoTools.JobDAO
+}
+
+func NewJobRepository(dao daoTools.JobDAO) JobRepository {
+	return &jobRepository{
+		dao: dao,
+	}
+}
+
+// Create 创建任务，初始状态为排队中
+func (repo *jobRepository) Create(ctx context.Context, domain domainTools.Job) (domainTools.Job, error) {
+	entity := domain.Job
+	entity.Status = job.StatusConstQueued
+	model, err := repo.dao.Insert(ctx, entity)
+	if err != nil {
+		return domainTools.Job{}, err
+	}
+	return repo.toDomain(model), nil
+}
+
+// Start 排队中 -> 执行中
+func (repo *jobRepository) Start(ctx context.Context, id string) error {
+	return repo.dao.Transition(ctx, id, job.StatusConstRunning, map[string]any{
+		"start_time": time.Now(),
+	})
+}
+
+// UpdateProgress 更新执行进度
+func (repo *jobRepository) UpdateProgress(ctx context.Context, id string, progress domainTools.JobProgress) error {
+	return repo.dao.UpdateProgress(ctx, id, repo.progressValues(progress))
+}
+
+// Finish 执行中 -> 已完成
+func (repo *jobRepository) Finish(ctx context.Context, id string, outcome domainTools.JobOutcome, expireTime time.Time) error {
+	values := repo.progressValues(outcome.JobProgress)
+	values["message"] = outcome.Message
+	values["artifact"] = outcome.Artifact
+	values["artifactName"] = outcome.ArtifactName
+	values["finish_time"] = time.Now()
+	if outcome.Artifact != "" {
+		values["expire_time"] = expireTime
+	}
+	if outcome.Result != nil {
+		data, err := json.Marshal(outcome.Result)
+		if err != nil {
+			return fmt.Errorf("序列化任务结果失败: %w", err)
+		}
+		values["result"] = string(data)
+	}
+	return repo.dao.Transition(ctx, id, job.StatusConstDone, values)
+}
+
+// Fail 排队中/执行中 -> 失败
+func (repo *jobRepository) Fail(ctx context.Context, id, message string, progress domainTools.JobProgress) error {
+	values := repo.progressValues(progress)
+	values["message"] = message
+	values["finish_time"] = time.Now()
+	return repo.dao.Transition(ctx, id, job.StatusConstFailed, values)
+}
+
+// Expire 已完成 -> 已过期，结果文件已清理
+func (repo *jobRepository) Expire(ctx context.Context, id string) error {
+	return repo.dao.Transition(ctx, id, job.StatusConstExpired, map[string]any{
+		"artifact": "",
+	})
+}
+
+// FailUnfinished 回收服务重启前未结束的任务
+func (repo *jobRepository) FailUnfinished(ctx context.Context, message string) (int64, error) {
+	return repo.dao.FailUnfinished(ctx, message)
+}
+
+// GetById 根据ID获取
+func (repo *jobRepository) GetById(ctx context.Context, id string) (domainTools.Job, error) {
+	model, err := repo.dao.FindById(ctx, id)
+	if err != nil {
+		return domainTools.Job{}, err
+	}
+	return repo.toDomain(model), nil
+}
+
+// GetListPage 分页查询列表
+func (repo *jobRepository) GetListPage(ctx context.Context, filter domainTools.JobFilter) ([]domainTools.Job, int64, error) {
+	list, row, err := repo.dao.FindListPage(ctx, filter)
+	if err != nil {
+		return []domainTools.Job{}, row, err
+	}
+	return repo.toDomains(list), row, nil
+}
+
+// GetExpired 查询结果文件已过期的任务
+func (repo *jobRepository) GetExpired(ctx context.Context, before time.Time, limit int) ([]domainTools.Job, error) {
+	list, err := repo.dao.FindExpired(ctx, before, limit)
+	if err != nil {
+		return []domainTools.Job{}, err
+	}
+	return repo.toDomains(list), nil
+}
+
+// progressValues 执行进度更新字段
+func (repo *jobRepository) progressValues(progress domainTools.JobProgress) map[string]any {
+	return map[string]any{
+		"total":        progress.Total,
+		"processed":    progress.Processed,
+		"successCount": progress.SuccessCount,
+		"failCount":    progress.FailCount,
+	}
+}
+
+// toDomain 转换为领域模型
+func (repo *jobRepository) toDomain(entity *modelTools.Job) domainTools.Job {
+	domain := domainTools.Job{
+		Job: *entity,
+	}
+
+	if entity.Total > 0 {
+		domain.Progress = math.Round(float64(entity.Processed)*10000/float64(entity.Total)) / 100
+	}
+	if entity.Status == job.StatusConstDone {
+		domain.Progress = 100
+	}
+	if entity.Result != "" {
+		domain.Result = json.RawMessage(entity.Result)
+	}
+	if entity.StartTime != nil {
+		domain.StartTime = entity.StartTime.Format("2006-01-02 15:04:05")
+	}
+	if entity.FinishTime != nil {
+		domain.FinishTime = entity.FinishTime.Format("2006-01-02 15:04:05")
+	}
+	if entity.ExpireTime != nil {
+		domain.ExpireTime = entity.ExpireTime.Format("2006-01-02 15:04:05")
+	}
+	if entity.CreateTime != nil {
+		domain.CreateTime = entity.CreateTime.Format("2006-01-02 15:04:05")
+	}
+	if entity.UpdateTime != nil {
+		domain.UpdateTime = entity.UpdateTime.Format("2006-01-02 15:04:05")
+	}
+
+	return domain
+}
+
+// toDomains 批量转换为领域模型
+func (repo *jobRepository) toDomains(list []*modelTools.Job) []domainTools.Job {
+	domains := make([]domainTools.Job, 0, len(list))
+	for _, entity := range list {
+		domains = append(domains, repo.toDomain(entity))
+	}
+	return domains
+}
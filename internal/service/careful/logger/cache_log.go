@@ -0,0 +1,88 @@
+/**
+ * Description：
+ * FileName：cache_log.go
+ * Author：CJiaの用心
+ * Create：2025/10/30 10:32:48
+ * Remark：
+ */
+
+package logger
+
+import (
+	"context"
+	"errors"
+	domainLogger "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/logger"
+	repositoryLogger "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/logger"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+)
+
+var (
+	ErrCacheLogNotFound = repositoryLogger.ErrCacheLogNotFound
+)
+
+type CacheLogService interface {
+	BatchDelete(ctx context.Context, ids []string) error
+	Clear(ctx context.Context) error
+
+	GetById(ctx context.Context, id string) (domainLogger.CacheLog, error)
+	GetListPage(ctx context.Context, filter domainLogger.CacheLogFilter) ([]domainLogger.CacheLog, int64, error)
+	GetListAll(ctx context.Context, filter domainLogger.CacheLogFilter) ([]domainLogger.CacheLog, error)
+	// GetBatch 按游标分批查询，cursor 为空时从第一条开始
+	GetBatch(ctx context.Context, filter domainLogger.CacheLogFilter, cursor *filters.Cursor, limit int) ([]domainLogger.CacheLog, error)
+}
+
+type cacheLogService struct {
+	repo repositoryLogger.CacheLogRepository
+}
+
+func NewCacheLogService(repo repositoryLogger.CacheLogRepository) CacheLogService {
+	return &cacheLogService{
+		repo: repo,
+	}
+}
+
+// BatchDelete 批量删除
+func (svc *cacheLogService) BatchDelete(ctx context.Context, ids []string) error {
+	return svc.repo.BatchDelete(ctx, ids)
+}
+
+// Clear 清空缓存日志
+func (svc *cacheLogService) Clear(ctx context.Context) error {
+	return svc.repo.Clear(ctx)
+}
+
+// GetById 获取详情
+func (svc *cacheLogService) GetById(ctx context.Context, id string) (domainLogger.CacheLog, error) {
+	domain, err := svc.repo.GetById(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositoryLogger.ErrCacheLogNotFound) {
+			return domainLogger.CacheLog{}, repositoryLogger.ErrCacheLogNotFound
+		}
+		return domainLogger.CacheLog{}, err
+	}
+	return domain, nil
+}
+
+// GetListPage 分页查询列表
+func (svc *cacheLogService) GetListPage(ctx context.Context, filter domainLogger.CacheLogFilter) ([]domainLogger.CacheLog, int64, error) {
+	if !filter.TimeRange.Valid() {
+		return nil, 0, filters.ErrTimeRangeInvalid
+	}
+	return svc.repo.GetListPage(ctx, filter)
+}
+
+// GetListAll 查询所有列表
+func (svc *cacheLogService) GetListAll(ctx context.Context, filter domainLogger.CacheLogFilter) ([]domainLogger.CacheLog, error) {
+	if !filter.TimeRange.Valid() {
+		return nil, filters.ErrTimeRangeInvalid
+	}
+	return svc.repo.GetListAll(ctx, filter)
+}
+
+// GetBatch 按游标分批查询列表
+func (svc *cacheLogService) GetBatch(ctx context.Context, filter domainLogger.CacheLogFilter, cursor *filters.Cursor, limit int) ([]domainLogger.CacheLog, error) {
+	if !filter.TimeRange.Valid() {
+		return nil, filters.ErrTimeRangeInvalid
+	}
+	return svc.repo.GetBatch(ctx, filter, cursor, limit)
+}
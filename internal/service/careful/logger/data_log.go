@@ -0,0 +1,49 @@
+/**
+ * Description：
+ * FileName：data_log.go
+ * Author：CJiaの用心
+ * Create：2025/11/14 14:45:19
+ * Remark：
+ */
+
+package logger
+
+import (
+	"context"
+	domainLogger "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/logger"
+	repositoryLogger "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/logger"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+)
+
+type DataLogService interface {
+	GetListPage(ctx context.Context, filter domainLogger.DataLogFilter) ([]domainLogger.DataLog, int64, error)
+	// GetHistory 查询指定数据的变更历史
+	GetHistory(ctx context.Context, table, id string, pagination filters.Pagination) ([]domainLogger.DataLog, int64, error)
+}
+
+type dataLogService struct {
+	repo repositoryLogger.DataLogRepository
+}
+
+func NewDataLogService(repo repositoryLogger.DataLogRepository) DataLogService {
+	return &dataLogService{
+		repo: repo,
+	}
+}
+
+// GetListPage 分页查询列表
+func (svc *dataLogService) GetListPage(ctx context.Context, filter domainLogger.DataLogFilter) ([]domainLogger.DataLog, int64, error) {
+	if !filter.TimeRange.Valid() {
+		return nil, 0, filters.ErrTimeRangeInvalid
+	}
+	return svc.repo.GetListPage(ctx, filter)
+}
+
+// GetHistory 查询变更历史
+func (svc *dataLogService) GetHistory(ctx context.Context, table, id string, pagination filters.Pagination) ([]domainLogger.DataLog, int64, error) {
+	return svc.repo.GetListPage(ctx, domainLogger.DataLogFilter{
+		Pagination:  pagination,
+		RecordTable: table,
+		RecordId:    id,
+	})
+}
@@ -0,0 +1,88 @@
+/**
+ * Description：
+ * FileName：login_log.go
+ * Author：CJiaの用心
+ * Create：2025/10/30 10:29:06
+ * Remark：
+ */
+
+package logger
+
+import (
+	"context"
+	"errors"
+	domainLogger "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/logger"
+	repositoryLogger "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/logger"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+)
+
+var (
+	ErrLoginLogNotFound = repositoryLogger.ErrLoginLogNotFound
+)
+
+type LoginLogService interface {
+	BatchDelete(ctx context.Context, ids []string) error
+	Clear(ctx context.Context) error
+
+	GetById(ctx context.Context, id string) (domainLogger.LoginLog, error)
+	GetListPage(ctx context.Context, filter domainLogger.LoginLogFilter) ([]domainLogger.LoginLog, int64, error)
+	GetListAll(ctx context.Context, filter domainLogger.LoginLogFilter) ([]domainLogger.LoginLog, error)
+	// GetBatch 按游标分批查询，cursor 为空时从第一条开始
+	GetBatch(ctx context.Context, filter domainLogger.LoginLogFilter, cursor *filters.Cursor, limit int) ([]domainLogger.LoginLog, error)
+}
+
+type loginLogService struct {
+	repo repositoryLogger.LoginLogRepository
+}
+
+func NewLoginLogService(repo repositoryLogger.LoginLogRepository) LoginLogService {
+	return &loginLogService{
+		repo: repo,
+	}
+}
+
+// BatchDelete 批量删除
+func (svc *loginLogService) BatchDelete(ctx context.Context, ids []string) error {
+	return svc.repo.BatchDelete(ctx, ids)
+}
+
+// Clear 清空登录日志
+func (svc *loginLogService) Clear(ctx context.Context) error {
+	return svc.repo.Clear(ctx)
+}
+
+// GetById 获取详情
+func (svc *loginLogService) GetById(ctx context.Context, id string) (domainLogger.LoginLog, error) {
+	domain, err := svc.repo.GetById(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositoryLogger.ErrLoginLogNotFound) {
+			return domainLogger.LoginLog{}, repositoryLogger.ErrLoginLogNotFound
+		}
+		return domainLogger.LoginLog{}, err
+	}
+	return domain, nil
+}
+
+// GetListPage 分页查询列表
+func (svc *loginLogService) GetListPage(ctx context.Context, filter domainLogger.LoginLogFilter) ([]domainLogger.LoginLog, int64, error) {
+	if !filter.TimeRange.Valid() {
+		return nil, 0, filters.ErrTimeRangeInvalid
+	}
+	return svc.repo.GetListPage(ctx, filter)
+}
+
+// GetListAll 查询所有列表
+func (svc *loginLogService) GetListAll(ctx context.Context, filter domainLogger.LoginLogFilter) ([]domainLogger.LoginLog, error) {
+	if !filter.TimeRange.Valid() {
+		return nil, filters.ErrTimeRangeInvalid
+	}
+	return svc.repo.GetListAll(ctx, filter)
+}
+
+// GetBatch 按游标分批查询列表
+func (svc *loginLogService) GetBatch(ctx context.Context, filter domainLogger.LoginLogFilter, cursor *filters.Cursor, limit int) ([]domainLogger.LoginLog, error) {
+	if !filter.TimeRange.Valid() {
+		return nil, filters.ErrTimeRangeInvalid
+	}
+	return svc.repo.GetBatch(ctx, filter, cursor, limit)
+}
@@ -0,0 +1,88 @@
+/**
+ * Description：
+ * FileName：operate_log.go
+ * Author：CJiaの用心
+ * Create：2025/10/30 10:25:31
+ * Remark：
+ */
+
+package logger
+
+import (
+	"context"
+	"errors"
+	domainLogger "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/logger"
+	repositoryLogger "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/logger"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+)
+
+var (
+	ErrOperateLogNotFound = repositoryLogger.ErrOperateLogNotFound
+)
+
+type OperateLogService interface {
+	BatchDelete(ctx context.Context, ids []string) error
+	Clear(ctx context.Context) error
+
+	GetById(ctx context.Context, id string) (domainLogger.OperateLog, error)
+	GetListPage(ctx context.Context, filter domainLogger.OperateLogFilter) ([]domainLogger.OperateLog, int64, error)
+	GetListAll(ctx context.Context, filter domainLogger.OperateLogFilter) ([]domainLogger.OperateLog, error)
+	// GetBatch 按游标分批查询，cursor 为空时从第一条开始
+	GetBatch(ctx context.Context, filter domainLogger.OperateLogFilter, cursor *filters.Cursor, limit int) ([]domainLogger.OperateLog, error)
+}
+
+type operateLogService struct {
+	repo repositoryLogger.OperateLogRepository
+}
+
+func NewOperateLogService(repo repositoryLogger.OperateLogRepository) OperateLogService {
+	return &operateLogService{
+		repo: repo,
+	}
+}
+
+// BatchDelete 批量删除
+func (svc *operateLogService) BatchDelete(ctx context.Context, ids []string) error {
+	return svc.repo.BatchDelete(ctx, ids)
+}
+
+// Clear 清空操作日志
+func (svc *operateLogService) Clear(ctx context.Context) error {
+	return svc.repo.Clear(ctx)
+}
+
+// GetById 获取详情
+func (svc *operateLogService) GetById(ctx context.Context, id string) (domainLogger.OperateLog, error) {
+	domain, err := svc.repo.GetById(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositoryLogger.ErrOperateLogNotFound) {
+			return domainLogger.OperateLog{}, repositoryLogger.ErrOperateLogNotFound
+		}
+		return domainLogger.OperateLog{}, err
+	}
+	return domain, nil
+}
+
+// GetListPage 分页查询列表
+func (svc *operateLogService) GetListPage(ctx context.Context, filter domainLogger.OperateLogFilter) ([]domainLogger.OperateLog, int64, error) {
+	if !filter.TimeRange.Valid() {
+		return nil, 0, filters.ErrTimeRangeInvalid
+	}
+	return svc.repo.GetListPage(ctx, filter)
+}
+
+// GetListAll 查询所有列表
+func (svc *operateLogService) GetListAll(ctx context.Context, filter domainLogger.OperateLogFilter) ([]domainLogger.OperateLog, error) {
+	if !filter.TimeRange.Valid() {
+		return nil, filters.ErrTimeRangeInvalid
+	}
+	return svc.repo.GetListAll(ctx, filter)
+}
+
+// GetBatch 按游标分批查询列表
+func (svc *operateLogService) GetBatch(ctx context.Context, filter domainLogger.OperateLogFilter, cursor *filters.Cursor, limit int) ([]domainLogger.OperateLog, error) {
+	if !filter.TimeRange.Valid() {
+		return nil, filters.ErrTimeRangeInvalid
+	}
+	return svc.repo.GetBatch(ctx, filter, cursor, limit)
+}
@@ -0,0 +1,124 @@
+/**
+ * Description：
+ * FileName：operate_log_test.go
+ * Author：CJiaの用心
+ * Create：2025/10/30 12:06:44
+ * Remark：
+ */
+
+package logger
+
+import (
+	"context"
+	domainLogger "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/logger"
+	repositoryLogger "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/logger"
+	repomocks "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/mocks"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func Test_operateLogService_GetListPage(t *testing.T) {
+	now := time.Now()
+
+	testCases := []struct {
+		name      string
+		mock      func(ctrl *gomock.Controller) repositoryLogger.OperateLogRepository
+		filter    domainLogger.OperateLogFilter
+		wantTotal int64
+		wantErr   error
+	}{
+		{
+			name: "查询成功",
+			mock: func(ctrl *gomock.Controller) repositoryLogger.OperateLogRepository {
+				repo := repomocks.NewMockOperateLogRepository(ctrl)
+				repo.EXPECT().GetListPage(gomock.Any(), gomock.Any()).Return([]domainLogger.OperateLog{{}}, int64(1), nil)
+				return repo
+			},
+			filter: domainLogger.OperateLogFilter{
+				TimeRange: filters.TimeRange{StartTime: now.Add(-time.Hour), EndTime: now},
+			},
+			wantTotal: 1,
+		},
+		{
+			name: "开始时间晚于结束时间",
+			mock: func(ctrl *gomock.Controller) repositoryLogger.OperateLogRepository {
+				return repomocks.NewMockOperateLogRepository(ctrl)
+			},
+			filter: domainLogger.OperateLogFilter{
+				TimeRange: filters.TimeRange{StartTime: now, EndTime: now.Add(-time.Hour)},
+			},
+			wantErr: filters.ErrTimeRangeInvalid,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc := NewOperateLogService(tc.mock(ctrl))
+			_, total, err := svc.GetListPage(context.Background(), tc.filter)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantTotal, total)
+		})
+	}
+}
+
+func Test_operateLogService_GetById(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockOperateLogRepository(ctrl)
+	repo.EXPECT().GetById(gomock.Any(), "1").Return(domainLogger.OperateLog{}, repositoryLogger.ErrOperateLogNotFound)
+
+	_, err := NewOperateLogService(repo).GetById(context.Background(), "1")
+	assert.ErrorIs(t, err, ErrOperateLogNotFound)
+}
+
+func Test_operateLogService_GetBatch(t *testing.T) {
+	now := time.Now()
+	cursor := &filters.Cursor{CreateTime: now, Id: "ID1"}
+
+	testCases := []struct {
+		name    string
+		mock    func(ctrl *gomock.Controller) repositoryLogger.OperateLogRepository
+		filter  domainLogger.OperateLogFilter
+		wantLen int
+		wantErr error
+	}{
+		{
+			name: "查询成功",
+			mock: func(ctrl *gomock.Controller) repositoryLogger.OperateLogRepository {
+				repo := repomocks.NewMockOperateLogRepository(ctrl)
+				repo.EXPECT().GetBatch(gomock.Any(), gomock.Any(), cursor, 100).Return([]domainLogger.OperateLog{{}, {}}, nil)
+				return repo
+			},
+			wantLen: 2,
+		},
+		{
+			name: "开始时间晚于结束时间",
+			mock: func(ctrl *gomock.Controller) repositoryLogger.OperateLogRepository {
+				return repomocks.NewMockOperateLogRepository(ctrl)
+			},
+			filter: domainLogger.OperateLogFilter{
+				TimeRange: filters.TimeRange{StartTime: now, EndTime: now.Add(-time.Hour)},
+			},
+			wantErr: filters.ErrTimeRangeInvalid,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc := NewOperateLogService(tc.mock(ctrl))
+			list, err := svc.GetBatch(context.Background(), tc.filter, cursor, 100)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Len(t, list, tc.wantLen)
+		})
+	}
+}
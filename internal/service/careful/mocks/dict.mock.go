@@ -140,18 +140,62 @@ func (mr *MockDictServiceMockRecorder) GetListPage(ctx, filters interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListPage", reflect.TypeOf((*MockDictService)(nil).GetListPage), ctx, filters)
 }
 
+// GetRecycleListPage mocks base method.
+func (m *MockDictService) GetRecycleListPage(ctx context.Context, filter tools.RecycleFilter) ([]tools.Dict, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecycleListPage", ctx, filter)
+	ret0, _ := ret[0].([]tools.Dict)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRecycleListPage indicates an expected call of GetRecycleListPage.
+func (mr *MockDictServiceMockRecorder) GetRecycleListPage(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecycleListPage", reflect.TypeOf((*MockDictService)(nil).GetRecycleListPage), ctx, filter)
+}
+
 // Import mocks base method.
-func (m *MockDictService) Import(ctx context.Context, user system.User, listMap []map[string]string) _import.ImportResult {
+func (m *MockDictService) Import(ctx context.Context, user system.User, listMap []map[string]string, mode _import.Mode) _import.ImportResult {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Import", ctx, user, listMap)
+	ret := m.ctrl.Call(m, "Import", ctx, user, listMap, mode)
 	ret0, _ := ret[0].(_import.ImportResult)
 	return ret0
 }
 
 // Import indicates an expected call of Import.
-func (mr *MockDictServiceMockRecorder) Import(ctx, user, listMap interface{}) *gomock.Call {
+func (mr *MockDictServiceMockRecorder) Import(ctx, user, listMap, mode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockDictService)(nil).Import), ctx, user, listMap, mode)
+}
+
+// Purge mocks base method.
+func (m *MockDictService) Purge(ctx context.Context, ids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockDictServiceMockRecorder) Purge(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockDictService)(nil).Purge), ctx, ids)
+}
+
+// Restore mocks base method.
+func (m *MockDictService) Restore(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockDictServiceMockRecorder) Restore(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockDictService)(nil).Import), ctx, user, listMap)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockDictService)(nil).Restore), ctx, id)
 }
 
 // Update mocks base method.
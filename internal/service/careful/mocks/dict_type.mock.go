@@ -8,7 +8,9 @@ import (
 	context "context"
 	reflect "reflect"
 
+	system "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
 	tools "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/tools"
+	_import "github.com/carefuly/careful-admin-go-gin/pkg/utils/common/import"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -78,10 +80,10 @@ func (mr *MockDictTypeServiceMockRecorder) Delete(ctx, id interface{}) *gomock.C
 }
 
 // GetByDictNames mocks base method.
-func (m *MockDictTypeService) GetByDictNames(ctx context.Context, dictNames []string) (map[string][]tools.DictType, error) {
+func (m *MockDictTypeService) GetByDictNames(ctx context.Context, dictNames []string) (map[string][]tools.DictOption, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetByDictNames", ctx, dictNames)
-	ret0, _ := ret[0].(map[string][]tools.DictType)
+	ret0, _ := ret[0].(map[string][]tools.DictOption)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -138,6 +140,64 @@ func (mr *MockDictTypeServiceMockRecorder) GetListPage(ctx, filter interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListPage", reflect.TypeOf((*MockDictTypeService)(nil).GetListPage), ctx, filter)
 }
 
+// GetRecycleListPage mocks base method.
+func (m *MockDictTypeService) GetRecycleListPage(ctx context.Context, filter tools.RecycleFilter) ([]tools.DictType, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecycleListPage", ctx, filter)
+	ret0, _ := ret[0].([]tools.DictType)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRecycleListPage indicates an expected call of GetRecycleListPage.
+func (mr *MockDictTypeServiceMockRecorder) GetRecycleListPage(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecycleListPage", reflect.TypeOf((*MockDictTypeService)(nil).GetRecycleListPage), ctx, filter)
+}
+
+// Import mocks base method.
+func (m *MockDictTypeService) Import(ctx context.Context, user system.User, listMap []map[string]string, mode _import.Mode) _import.ImportResult {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, user, listMap, mode)
+	ret0, _ := ret[0].(_import.ImportResult)
+	return ret0
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockDictTypeServiceMockRecorder) Import(ctx, user, listMap, mode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockDictTypeService)(nil).Import), ctx, user, listMap, mode)
+}
+
+// Purge mocks base method.
+func (m *MockDictTypeService) Purge(ctx context.Context, ids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockDictTypeServiceMockRecorder) Purge(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockDictTypeService)(nil).Purge), ctx, ids)
+}
+
+// Restore mocks base method.
+func (m *MockDictTypeService) Restore(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockDictTypeServiceMockRecorder) Restore(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockDictTypeService)(nil).Restore), ctx, id)
+}
+
 // Update mocks base method.
 func (m *MockDictTypeService) Update(ctx context.Context, domain tools.DictType) error {
 	m.ctrl.T.Helper()
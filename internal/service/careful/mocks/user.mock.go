@@ -35,6 +35,48 @@ func (m *MockUserService) EXPECT() *MockUserServiceMockRecorder {
 	return m.recorder
 }
 
+// BatchDelete mocks base method.
+func (m *MockUserService) BatchDelete(ctx context.Context, operatorId string, ids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchDelete", ctx, operatorId, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchDelete indicates an expected call of BatchDelete.
+func (mr *MockUserServiceMockRecorder) BatchDelete(ctx, operatorId, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDelete", reflect.TypeOf((*MockUserService)(nil).BatchDelete), ctx, operatorId, ids)
+}
+
+// Create mocks base method.
+func (m *MockUserService) Create(ctx context.Context, domain system.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, domain)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUserServiceMockRecorder) Create(ctx, domain interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUserService)(nil).Create), ctx, domain)
+}
+
+// Delete mocks base method.
+func (m *MockUserService) Delete(ctx context.Context, operatorId, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, operatorId, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockUserServiceMockRecorder) Delete(ctx, operatorId, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUserService)(nil).Delete), ctx, operatorId, id)
+}
+
 // GetById mocks base method.
 func (m *MockUserService) GetById(ctx context.Context, id string) (system.User, error) {
 	m.ctrl.T.Helper()
@@ -50,6 +92,53 @@ func (mr *MockUserServiceMockRecorder) GetById(ctx, id interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockUserService)(nil).GetById), ctx, id)
 }
 
+// GetListAll mocks base method.
+func (m *MockUserService) GetListAll(ctx context.Context, filter system.UserFilter) ([]system.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListAll", ctx, filter)
+	ret0, _ := ret[0].([]system.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListAll indicates an expected call of GetListAll.
+func (mr *MockUserServiceMockRecorder) GetListAll(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListAll", reflect.TypeOf((*MockUserService)(nil).GetListAll), ctx, filter)
+}
+
+// GetListPage mocks base method.
+func (m *MockUserService) GetListPage(ctx context.Context, filter system.UserFilter) ([]system.User, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListPage", ctx, filter)
+	ret0, _ := ret[0].([]system.User)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetListPage indicates an expected call of GetListPage.
+func (mr *MockUserServiceMockRecorder) GetListPage(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListPage", reflect.TypeOf((*MockUserService)(nil).GetListPage), ctx, filter)
+}
+
+// GetRecycleListPage mocks base method.
+func (m *MockUserService) GetRecycleListPage(ctx context.Context, filter system.RecycleFilter) ([]system.User, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecycleListPage", ctx, filter)
+	ret0, _ := ret[0].([]system.User)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRecycleListPage indicates an expected call of GetRecycleListPage.
+func (mr *MockUserServiceMockRecorder) GetRecycleListPage(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecycleListPage", reflect.TypeOf((*MockUserService)(nil).GetRecycleListPage), ctx, filter)
+}
+
 // Login mocks base method.
 func (m *MockUserService) Login(ctx context.Context, username, password string) (system.User, error) {
 	m.ctrl.T.Helper()
@@ -64,3 +153,73 @@ func (mr *MockUserServiceMockRecorder) Login(ctx, username, password interface{}
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockUserService)(nil).Login), ctx, username, password)
 }
+
+// Purge mocks base method.
+func (m *MockUserService) Purge(ctx context.Context, ids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockUserServiceMockRecorder) Purge(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockUserService)(nil).Purge), ctx, ids)
+}
+
+// ResetPassword mocks base method.
+func (m *MockUserService) ResetPassword(ctx context.Context, operatorId, id, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", ctx, operatorId, id, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockUserServiceMockRecorder) ResetPassword(ctx, operatorId, id, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockUserService)(nil).ResetPassword), ctx, operatorId, id, password)
+}
+
+// Restore mocks base method.
+func (m *MockUserService) Restore(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockUserServiceMockRecorder) Restore(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockUserService)(nil).Restore), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockUserService) Update(ctx context.Context, domain system.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, domain)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUserServiceMockRecorder) Update(ctx, domain interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserService)(nil).Update), ctx, domain)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockUserService) UpdateStatus(ctx context.Context, operatorId, id string, status bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, operatorId, id, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockUserServiceMockRecorder) UpdateStatus(ctx, operatorId, id, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockUserService)(nil).UpdateStatus), ctx, operatorId, id, status)
+}
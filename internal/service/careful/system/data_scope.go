@@ -0,0 +1,132 @@
+/**
+ * Description：
+ * FileName：data_scope.go
+ * Author：CJiaの用心
+ * Create：2025/10/29 11:08:55
+ * Remark：
+ */
+
+package system
+
+import (
+	"context"
+	"errors"
+	cacheSystem "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/careful/system"
+	repositorySystem "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/system/role"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"go.uber.org/zap"
+)
+
+type DataScopeService interface {
+	GetUserDataScope(ctx context.Context, userId string) (*filters.DataScope, error)
+}
+
+type dataScopeService struct {
+	userRepo repositorySystem.UserRepository
+	roleRepo repositorySystem.RoleRepository
+	deptRepo repositorySystem.DeptRepository
+	cache    cacheSystem.DataScopeCache
+}
+
+func NewDataScopeService(userRepo repositorySystem.UserRepository, roleRepo repositorySystem.RoleRepository,
+	deptRepo repositorySystem.DeptRepository, cache cacheSystem.DataScopeCache) DataScopeService {
+	return &dataScopeService{
+		userRepo: userRepo,
+		roleRepo: roleRepo,
+		deptRepo: deptRepo,
+		cache:    cache,
+	}
+}
+
+// GetUserDataScope 获取用户数据范围，优先读取缓存
+func (svc *dataScopeService) GetUserDataScope(ctx context.Context, userId string) (*filters.DataScope, error) {
+	scope, err := svc.cache.Get(ctx, userId)
+	if err == nil && scope != nil {
+		return scope, nil // 命中缓存
+	}
+	if err != nil && !errors.Is(err, cacheSystem.ErrDataScopeNotExist) {
+		// 缓存查询出错但不是"不存在"错误，记录日志但继续查DB
+		zap.L().Error("缓存获取错误:", zap.Error(err))
+	}
+
+	scope, err = svc.loadUserDataScope(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := svc.cache.Set(ctx, userId, *scope); err != nil {
+		// 网络崩了，也可能是 redis 崩了
+		zap.L().Error("Redis异常", zap.Error(err))
+	}
+
+	return scope, nil
+}
+
+// loadUserDataScope 合并用户已启用角色的数据范围
+// 超级管理员拥有全部数据；未分配角色时仅可访问本人数据
+func (svc *dataScopeService) loadUserDataScope(ctx context.Context, userId string) (*filters.DataScope, error) {
+	user, err := svc.userRepo.GetById(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if user.IsSuperuser {
+		return &filters.DataScope{All: true}, nil
+	}
+
+	roles, err := svc.roleRepo.GetByUserId(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	scope := &filters.DataScope{}
+	var deptIds, subtreeIds, customRoleIds []string
+	enabled := 0
+	for _, v := range roles {
+		if !v.Status {
+			continue
+		}
+		enabled++
+
+		switch v.DataScope {
+		case role.DataScopeConstAll:
+			return &filters.DataScope{All: true}, nil
+		case role.DataScopeConstDept:
+			deptIds = append(deptIds, user.DeptId)
+		case role.DataScopeConstDeptAndChild:
+			subtreeIds = append(subtreeIds, user.DeptId)
+		case role.DataScopeConstCustom:
+			customRoleIds = append(customRoleIds, v.Id)
+		case role.DataScopeConstSelf:
+			scope.UserId = userId
+		}
+	}
+	if enabled == 0 {
+		scope.UserId = userId
+		return scope, nil
+	}
+
+	// 用户未归属部门时本部门相关范围为空
+	if user.DeptId != "" && len(subtreeIds) > 0 {
+		ids, err := svc.deptRepo.GetSubtreeIds(ctx, user.DeptId)
+		if err != nil {
+			return nil, err
+		}
+		deptIds = append(deptIds, ids...)
+	}
+	if len(customRoleIds) > 0 {
+		ids, err := svc.roleRepo.GetDeptIds(ctx, customRoleIds...)
+		if err != nil {
+			return nil, err
+		}
+		deptIds = append(deptIds, ids...)
+	}
+
+	for _, id := range distinct(deptIds) {
+		if id != "" {
+			scope.DeptIds = append(scope.DeptIds, id)
+		}
+	}
+
+	return scope, nil
+}
@@ -0,0 +1,149 @@
+/**
+ * Description：
+ * FileName：data_scope_test.go
+ * Author：CJiaの用心
+ * Create：2025/10/29 14:20:31
+ * Remark：
+ */
+
+package system
+
+import (
+	"context"
+	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/system"
+	cacheSystem "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/careful/system"
+	repomocks "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/mocks"
+	repositorySystem "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/system/role"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_dataScopeService_GetUserDataScope(t *testing.T) {
+	newRole := func(id string, status bool, scope role.DataScopeConst) domainSystem.Role {
+		r := domainSystem.Role{Role: system.Role{Status: status, DataScope: scope}}
+		r.Id = id
+		return r
+	}
+	user := domainSystem.User{}
+	user.Id, user.DeptId = "u1", "d1"
+
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (repositorySystem.UserRepository,
+			repositorySystem.RoleRepository, repositorySystem.DeptRepository)
+		wantScope *filters.DataScope
+	}{
+		{
+			name: "超级管理员全部数据",
+			mock: func(ctrl *gomock.Controller) (repositorySystem.UserRepository, repositorySystem.RoleRepository, repositorySystem.DeptRepository) {
+				superuser := user
+				superuser.IsSuperuser = true
+				userRepo := repomocks.NewMockUserRepository(ctrl)
+				userRepo.EXPECT().GetById(gomock.Any(), "u1").Return(superuser, nil)
+				return userRepo, repomocks.NewMockRoleRepository(ctrl), repomocks.NewMockDeptRepository(ctrl)
+			},
+			wantScope: &filters.DataScope{All: true},
+		},
+		{
+			name: "未分配启用角色仅本人数据",
+			mock: func(ctrl *gomock.Controller) (repositorySystem.UserRepository, repositorySystem.RoleRepository, repositorySystem.DeptRepository) {
+				userRepo := repomocks.NewMockUserRepository(ctrl)
+				userRepo.EXPECT().GetById(gomock.Any(), "u1").Return(user, nil)
+				roleRepo := repomocks.NewMockRoleRepository(ctrl)
+				roleRepo.EXPECT().GetByUserId(gomock.Any(), "u1").
+					Return([]domainSystem.Role{newRole("r1", false, role.DataScopeConstAll)}, nil)
+				return userRepo, roleRepo, repomocks.NewMockDeptRepository(ctrl)
+			},
+			wantScope: &filters.DataScope{UserId: "u1"},
+		},
+		{
+			name: "任一角色为全部数据",
+			mock: func(ctrl *gomock.Controller) (repositorySystem.UserRepository, repositorySystem.RoleRepository, repositorySystem.DeptRepository) {
+				userRepo := repomocks.NewMockUserRepository(ctrl)
+				userRepo.EXPECT().GetById(gomock.Any(), "u1").Return(user, nil)
+				roleRepo := repomocks.NewMockRoleRepository(ctrl)
+				roleRepo.EXPECT().GetByUserId(gomock.Any(), "u1").Return([]domainSystem.Role{
+					newRole("r1", true, role.DataScopeConstSelf),
+					newRole("r2", true, role.DataScopeConstAll),
+				}, nil)
+				return userRepo, roleRepo, repomocks.NewMockDeptRepository(ctrl)
+			},
+			wantScope: &filters.DataScope{All: true},
+		},
+		{
+			name: "多角色合并本部门及以下、自定义与本人",
+			mock: func(ctrl *gomock.Controller) (repositorySystem.UserRepository, repositorySystem.RoleRepository, repositorySystem.DeptRepository) {
+				userRepo := repomocks.NewMockUserRepository(ctrl)
+				userRepo.EXPECT().GetById(gomock.Any(), "u1").Return(user, nil)
+				roleRepo := repomocks.NewMockRoleRepository(ctrl)
+				roleRepo.EXPECT().GetByUserId(gomock.Any(), "u1").Return([]domainSystem.Role{
+					newRole("r1", true, role.DataScopeConstDept),
+					newRole("r2", true, role.DataScopeConstDeptAndChild),
+					newRole("r3", true, role.DataScopeConstCustom),
+					newRole("r4", true, role.DataScopeConstSelf),
+				}, nil)
+				roleRepo.EXPECT().GetDeptIds(gomock.Any(), "r3").Return([]string{"d9", "d2"}, nil)
+				deptRepo := repomocks.NewMockDeptRepository(ctrl)
+				deptRepo.EXPECT().GetSubtreeIds(gomock.Any(), "d1").Return([]string{"d1", "d2", "d3"}, nil)
+				return userRepo, roleRepo, deptRepo
+			},
+			wantScope: &filters.DataScope{DeptIds: []string{"d1", "d2", "d3", "d9"}, UserId: "u1"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo, roleRepo, deptRepo := tc.mock(ctrl)
+			cache := newMemoryDataScopeCache()
+			dataScopeSvc := NewDataScopeService(userRepo, roleRepo, deptRepo, cache)
+			scope, err := dataScopeSvc.GetUserDataScope(context.Background(), "u1")
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantScope, scope)
+
+			// 再次获取命中缓存，不再查询数据库
+			scope, err = dataScopeSvc.GetUserDataScope(context.Background(), "u1")
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantScope, scope)
+		})
+	}
+}
+
+// memoryDataScopeCache 内存版数据权限缓存
+type memoryDataScopeCache struct {
+	scopes map[string]filters.DataScope
+}
+
+func newMemoryDataScopeCache() *memoryDataScopeCache {
+	return &memoryDataScopeCache{scopes: map[string]filters.DataScope{}}
+}
+
+func (c *memoryDataScopeCache) Get(_ context.Context, userId string) (*filters.DataScope, error) {
+	scope, ok := c.scopes[userId]
+	if !ok {
+		return nil, cacheSystem.ErrDataScopeNotExist
+	}
+	return &scope, nil
+}
+
+func (c *memoryDataScopeCache) Set(_ context.Context, userId string, scope filters.DataScope) error {
+	c.scopes[userId] = scope
+	return nil
+}
+
+func (c *memoryDataScopeCache) Del(_ context.Context, userIds ...string) error {
+	for _, userId := range userIds {
+		delete(c.scopes, userId)
+	}
+	return nil
+}
+
+func (c *memoryDataScopeCache) Key(userId string) string {
+	return userId
+}
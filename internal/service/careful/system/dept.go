@@ -0,0 +1,180 @@
+/**
+ * Description：
+ * FileName：dept.go
+ * Author：CJiaの用心
+ * Create：2025/10/26 11:05:33
+ * Remark：
+ */
+
+package system
+
+import (
+	"context"
+	"errors"
+	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
+	repositorySystem "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/system"
+	"github.com/go-sql-driver/mysql"
+)
+
+var (
+	ErrDeptNotFound             = repositorySystem.ErrDeptNotFound
+	ErrDeptDuplicate            = repositorySystem.ErrDeptDuplicate
+	ErrDeptVersionInconsistency = repositorySystem.ErrDeptVersionInconsistency
+	ErrDeptParentNotFound       = repositorySystem.ErrDeptParentNotFound
+	ErrDeptHasChildren          = repositorySystem.ErrDeptHasChildren
+	ErrDeptHasUsers             = repositorySystem.ErrDeptHasUsers
+	ErrDeptMoveToDescendant     = repositorySystem.ErrDeptMoveToDescendant
+)
+
+type DeptService interface {
+	Create(ctx context.Context, domain domainSystem.Dept) error
+	Delete(ctx context.Context, id string) error
+	Update(ctx context.Context, domain domainSystem.Dept) error
+	Move(ctx context.Context, id, parentId string, timestamp int64, modifier string) error
+
+	GetById(ctx context.Context, id string) (domainSystem.Dept, error)
+	GetTree(ctx context.Context, filter domainSystem.DeptFilter) ([]*domainSystem.Dept, error)
+	GetSubtree(ctx context.Context, id string, filter domainSystem.DeptFilter) ([]*domainSystem.Dept, error)
+	GetAncestors(ctx context.Context, id string) ([]domainSystem.Dept, error)
+
+	// GetRecycleListPage 分页查询回收站
+	GetRecycleListPage(ctx context.Context, filter domainSystem.RecycleFilter) ([]domainSystem.Dept, int64, error)
+	// Restore 从回收站恢复部门，上级部门需未被删除
+	Restore(ctx context.Context, id string) error
+	// Purge 彻底删除回收站中的部门
+	Purge(ctx context.Context, ids []string) error
+}
+
+type deptService struct {
+	repo repositorySystem.DeptRepository
+}
+
+func NewDeptService(repo repositorySystem.DeptRepository) DeptService {
+	return &deptService{
+		repo: repo,
+	}
+}
+
+// Create 创建
+func (svc *deptService) Create(ctx context.Context, domain domainSystem.Dept) error {
+	// 唯一性校验依赖mysql唯一性约束（同级下名称+编码唯一）
+	if _, err := svc.repo.Create(ctx, domain); err != nil {
+		if svc.IsDuplicateEntryError(err) {
+			return repositorySystem.ErrDeptDuplicate
+		}
+		return err
+	}
+	return nil
+}
+
+// Delete 删除
+func (svc *deptService) Delete(ctx context.Context, id string) error {
+	return svc.repo.Delete(ctx, id)
+}
+
+// Update 更新
+func (svc *deptService) Update(ctx context.Context, domain domainSystem.Dept) error {
+	err := svc.repo.Update(ctx, domain)
+
+	switch {
+	case err == nil:
+		return err
+	case errors.Is(err, repositorySystem.ErrDeptNotFound):
+		return repositorySystem.ErrDeptNotFound
+	case errors.Is(err, repositorySystem.ErrDeptVersionInconsistency):
+		return repositorySystem.ErrDeptVersionInconsistency
+	case svc.IsDuplicateEntryError(err):
+		return repositorySystem.ErrDeptDuplicate
+	default:
+		return err
+	}
+}
+
+// Move 移动节点
+func (svc *deptService) Move(ctx context.Context, id, parentId string, timestamp int64, modifier string) error {
+	if id == parentId {
+		return repositorySystem.ErrDeptMoveToDescendant
+	}
+
+	err := svc.repo.Move(ctx, id, parentId, timestamp, modifier)
+	if err != nil && svc.IsDuplicateEntryError(err) {
+		return repositorySystem.ErrDeptDuplicate
+	}
+	return err
+}
+
+// GetById 获取详情
+func (svc *deptService) GetById(ctx context.Context, id string) (domainSystem.Dept, error) {
+	domain, err := svc.repo.GetById(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositorySystem.ErrDeptNotFound) {
+			return domain, repositorySystem.ErrDeptNotFound
+		}
+		return domain, err
+	}
+	if domain.Id == "" {
+		return domain, repositorySystem.ErrDeptNotFound
+	}
+	return domain, nil
+}
+
+// GetTree 获取部门树
+func (svc *deptService) GetTree(ctx context.Context, filter domainSystem.DeptFilter) ([]*domainSystem.Dept, error) {
+	list, err := svc.repo.GetListAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return domainSystem.BuildDeptTree(list), nil
+}
+
+// GetSubtree 获取指定节点的子树
+func (svc *deptService) GetSubtree(ctx context.Context, id string, filter domainSystem.DeptFilter) ([]*domainSystem.Dept, error) {
+	list, err := svc.repo.GetSubtree(ctx, id, filter)
+	if err != nil {
+		return nil, err
+	}
+	return domainSystem.BuildDeptTree(list), nil
+}
+
+// GetAncestors 获取祖先节点
+func (svc *deptService) GetAncestors(ctx context.Context, id string) ([]domainSystem.Dept, error) {
+	return svc.repo.GetAncestors(ctx, id)
+}
+
+// GetRecycleListPage 分页查询回收站
+func (svc *deptService) GetRecycleListPage(ctx context.Context, filter domainSystem.RecycleFilter) ([]domainSystem.Dept, int64, error) {
+	return svc.repo.GetRecycleListPage(ctx, filter)
+}
+
+// Restore 恢复
+// 删除期间可能已在同级下新建相同名称和编码的部门，唯一性校验依赖mysql唯一性约束
+func (svc *deptService) Restore(ctx context.Context, id string) error {
+	deleted, err := svc.repo.GetDeletedById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := svc.repo.Restore(ctx, deleted); err != nil {
+		if svc.IsDuplicateEntryError(err) {
+			return repositorySystem.ErrDeptDuplicate
+		}
+		return err
+	}
+	return nil
+}
+
+// Purge 彻底删除
+func (svc *deptService) Purge(ctx context.Context, ids []string) error {
+	_, err := svc.repo.Purge(ctx, ids)
+	return err
+}
+
+// IsDuplicateEntryError 判断是否是唯一冲突错误
+func (svc *deptService) IsDuplicateEntryError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		// MySQL 错误码 1062 表示唯一冲突
+		return mysqlErr.Number == 1062
+	}
+	return false
+}
@@ -0,0 +1,290 @@
+/**
+ * Description：
+ * FileName：dept_test.go
+ * Author：CJiaの用心
+ * Create：2025/10/26 14:18:09
+ * Remark：
+ */
+
+package system
+
+import (
+	"context"
+	"errors"
+	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/system"
+	repomocks "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/mocks"
+	repositorySystem "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	"github.com/go-sql-driver/mysql"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func newTestDept(id, parentId, name string) domainSystem.Dept {
+	return domainSystem.Dept{
+		Dept: system.Dept{
+			CoreModels: models.CoreModels{
+				Id: id,
+			},
+			Name: name,
+		},
+		ParentId: parentId,
+	}
+}
+
+func Test_deptService_Create(t *testing.T) {
+	domain := newTestDept("", "1", "研发部")
+
+	testCases := []struct {
+		name    string
+		mock    func(ctrl *gomock.Controller) repositorySystem.DeptRepository
+		wantErr error
+	}{
+		{
+			name: "创建成功",
+			mock: func(ctrl *gomock.Controller) repositorySystem.DeptRepository {
+				repo := repomocks.NewMockDeptRepository(ctrl)
+				repo.EXPECT().Create(gomock.Any(), domain).Return(domain, nil)
+				return repo
+			},
+			wantErr: nil,
+		},
+		{
+			name: "同级部门已存在",
+			mock: func(ctrl *gomock.Controller) repositorySystem.DeptRepository {
+				repo := repomocks.NewMockDeptRepository(ctrl)
+				repo.EXPECT().Create(gomock.Any(), domain).
+					Return(domainSystem.Dept{}, &mysql.MySQLError{Number: 1062})
+				return repo
+			},
+			wantErr: ErrDeptDuplicate,
+		},
+		{
+			name: "上级部门不存在",
+			mock: func(ctrl *gomock.Controller) repositorySystem.DeptRepository {
+				repo := repomocks.NewMockDeptRepository(ctrl)
+				repo.EXPECT().Create(gomock.Any(), domain).
+					Return(domainSystem.Dept{}, ErrDeptParentNotFound)
+				return repo
+			},
+			wantErr: ErrDeptParentNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			deptSvc := NewDeptService(tc.mock(ctrl))
+			err := deptSvc.Create(context.Background(), domain)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func Test_deptService_Move(t *testing.T) {
+	testCases := []struct {
+		name     string
+		mock     func(ctrl *gomock.Controller) repositorySystem.DeptRepository
+		id       string
+		parentId string
+		wantErr  error
+	}{
+		{
+			name: "移动成功",
+			mock: func(ctrl *gomock.Controller) repositorySystem.DeptRepository {
+				repo := repomocks.NewMockDeptRepository(ctrl)
+				repo.EXPECT().Move(gomock.Any(), "2", "3", int64(1), "admin").Return(nil)
+				return repo
+			},
+			id:       "2",
+			parentId: "3",
+			wantErr:  nil,
+		},
+		{
+			name: "移动到自身",
+			mock: func(ctrl *gomock.Controller) repositorySystem.DeptRepository {
+				return repomocks.NewMockDeptRepository(ctrl)
+			},
+			id:       "2",
+			parentId: "2",
+			wantErr:  ErrDeptMoveToDescendant,
+		},
+		{
+			name: "移动到子部门",
+			mock: func(ctrl *gomock.Controller) repositorySystem.DeptRepository {
+				repo := repomocks.NewMockDeptRepository(ctrl)
+				repo.EXPECT().Move(gomock.Any(), "2", "4", int64(1), "admin").Return(ErrDeptMoveToDescendant)
+				return repo
+			},
+			id:       "2",
+			parentId: "4",
+			wantErr:  ErrDeptMoveToDescendant,
+		},
+		{
+			name: "新上级下存在同名部门",
+			mock: func(ctrl *gomock.Controller) repositorySystem.DeptRepository {
+				repo := repomocks.NewMockDeptRepository(ctrl)
+				repo.EXPECT().Move(gomock.Any(), "2", "3", int64(1), "admin").
+					Return(&mysql.MySQLError{Number: 1062})
+				return repo
+			},
+			id:       "2",
+			parentId: "3",
+			wantErr:  ErrDeptDuplicate,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			deptSvc := NewDeptService(tc.mock(ctrl))
+			err := deptSvc.Move(context.Background(), tc.id, tc.parentId, 1, "admin")
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func Test_deptService_GetTree(t *testing.T) {
+	testCases := []struct {
+		name     string
+		mock     func(ctrl *gomock.Controller) repositorySystem.DeptRepository
+		wantTree map[string][]string
+		wantRoot []string
+		wantErr  error
+	}{
+		{
+			name: "组装部门树",
+			mock: func(ctrl *gomock.Controller) repositorySystem.DeptRepository {
+				repo := repomocks.NewMockDeptRepository(ctrl)
+				repo.EXPECT().GetListAll(gomock.Any(), gomock.Any()).Return([]domainSystem.Dept{
+					newTestDept("1", "", "总部"),
+					newTestDept("2", "1", "研发部"),
+					newTestDept("3", "1", "市场部"),
+					newTestDept("4", "2", "后端组"),
+				}, nil)
+				return repo
+			},
+			wantRoot: []string{"1"},
+			wantTree: map[string][]string{
+				"1": {"2", "3"},
+				"2": {"4"},
+			},
+		},
+		{
+			name: "上级被过滤时作为根节点",
+			mock: func(ctrl *gomock.Controller) repositorySystem.DeptRepository {
+				repo := repomocks.NewMockDeptRepository(ctrl)
+				repo.EXPECT().GetListAll(gomock.Any(), gomock.Any()).Return([]domainSystem.Dept{
+					newTestDept("2", "1", "研发部"),
+					newTestDept("4", "2", "后端组"),
+				}, nil)
+				return repo
+			},
+			wantRoot: []string{"2"},
+			wantTree: map[string][]string{
+				"2": {"4"},
+			},
+		},
+		{
+			name: "数据库异常",
+			mock: func(ctrl *gomock.Controller) repositorySystem.DeptRepository {
+				repo := repomocks.NewMockDeptRepository(ctrl)
+				repo.EXPECT().GetListAll(gomock.Any(), gomock.Any()).
+					Return([]domainSystem.Dept{}, errors.New("数据库异常"))
+				return repo
+			},
+			wantErr: errors.New("数据库异常"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			deptSvc := NewDeptService(tc.mock(ctrl))
+			tree, err := deptSvc.GetTree(context.Background(), domainSystem.DeptFilter{})
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			var roots []string
+			children := map[string][]string{}
+			var walk func(nodes []*domainSystem.Dept)
+			walk = func(nodes []*domainSystem.Dept) {
+				for _, node := range nodes {
+					for _, child := range node.Children {
+						children[node.Id] = append(children[node.Id], child.Id)
+					}
+					walk(node.Children)
+				}
+			}
+			for _, node := range tree {
+				roots = append(roots, node.Id)
+			}
+			walk(tree)
+
+			assert.Equal(t, tc.wantRoot, roots)
+			assert.Equal(t, tc.wantTree, children)
+		})
+	}
+}
+
+func Test_deptService_Restore(t *testing.T) {
+	deleted := newTestDept("2", "1", "研发部")
+
+	testCases := []struct {
+		name    string
+		mock    func(ctrl *gomock.Controller) repositorySystem.DeptRepository
+		wantErr error
+	}{
+		{
+			name: "恢复成功",
+			mock: func(ctrl *gomock.Controller) repositorySystem.DeptRepository {
+				repo := repomocks.NewMockDeptRepository(ctrl)
+				repo.EXPECT().GetDeletedById(gomock.Any(), "2").Return(deleted, nil)
+				repo.EXPECT().Restore(gomock.Any(), deleted).Return(nil)
+				return repo
+			},
+			wantErr: nil,
+		},
+		{
+			name: "上级部门已删除",
+			mock: func(ctrl *gomock.Controller) repositorySystem.DeptRepository {
+				repo := repomocks.NewMockDeptRepository(ctrl)
+				repo.EXPECT().GetDeletedById(gomock.Any(), "2").Return(deleted, nil)
+				repo.EXPECT().Restore(gomock.Any(), deleted).Return(ErrDeptParentNotFound)
+				return repo
+			},
+			wantErr: ErrDeptParentNotFound,
+		},
+		{
+			name: "同级下已存在相同部门",
+			mock: func(ctrl *gomock.Controller) repositorySystem.DeptRepository {
+				repo := repomocks.NewMockDeptRepository(ctrl)
+				repo.EXPECT().GetDeletedById(gomock.Any(), "2").Return(deleted, nil)
+				repo.EXPECT().Restore(gomock.Any(), deleted).Return(&mysql.MySQLError{Number: 1062})
+				return repo
+			},
+			wantErr: ErrDeptDuplicate,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			deptSvc := NewDeptService(tc.mock(ctrl))
+			err := deptSvc.Restore(context.Background(), "2")
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,197 @@
+/**
+ * Description：
+ * FileName：login_guard.go
+ * Author：CJiaの用心
+ * Create：2025/10/31 15:20:08
+ * Remark：
+ */
+
+package system
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	cacheSystem "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/captcha"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+)
+
+var (
+	ErrUserLocked      = errors.New("账号已被锁定")
+	ErrCaptchaRequired = errors.New("请输入验证码")
+	ErrCaptchaInvalid  = errors.New("验证码错误或已过期")
+)
+
+// UserLockedError 账号锁定错误，携带解锁时间
+type UserLockedError struct {
+	LockedUntil time.Time
+}
+
+func (e *UserLockedError) Error() string {
+	return fmt.Sprintf("%s，请于%s后重试", ErrUserLocked.Error(), e.LockedUntil.Format(time.DateTime))
+}
+
+func (e *UserLockedError) Unwrap() error {
+	return ErrUserLocked
+}
+
+// LoginGuardConfig 登录防护配置
+type LoginGuardConfig struct {
+	MaxUserFailures  int64         // 同一用户名连续失败次数上限 (默认: 5)
+	MaxIpFailures    int64         // 同一IP连续失败次数上限 (默认: 20)
+	FailureWindow    time.Duration // 失败次数统计窗口 (默认: 15分钟)
+	LockDuration     time.Duration // 锁定时长 (默认: 15分钟)
+	CaptchaThreshold int64         // 失败多少次后需要验证码 (默认: 3)
+	CaptchaExpire    time.Duration // 验证码有效期 (默认: 2分钟)
+}
+
+// DefaultLoginGuardConfig 默认配置
+func DefaultLoginGuardConfig() LoginGuardConfig {
+	return LoginGuardConfig{
+		MaxUserFailures:  5,
+		MaxIpFailures:    20,
+		FailureWindow:    15 * time.Minute,
+		LockDuration:     15 * time.Minute,
+		CaptchaThreshold: 3,
+		CaptchaExpire:    2 * time.Minute,
+	}
+}
+
+type LoginGuardService interface {
+	// Check 登录前检查锁定状态与验证码
+	Check(ctx context.Context, username, ip, captchaId, captchaCode string) error
+	// RecordFailure 记录一次失败，达到上限时锁定并返回 UserLockedError
+	RecordFailure(ctx context.Context, username, ip string) (bool, error)
+	// RecordSuccess 登录成功后清除用户名与IP的失败记录
+	RecordSuccess(ctx context.Context, username, ip string) error
+	// CaptchaRequired 是否需要验证码
+	CaptchaRequired(ctx context.Context, username, ip string) (bool, error)
+	// NewCaptcha 生成图片验证码，返回验证码id与base64图片
+	NewCaptcha(ctx context.Context) (string, string, error)
+}
+
+type loginGuardService struct {
+	cache cacheSystem.LoginGuardCache
+	cfg   LoginGuardConfig
+	now   func() time.Time
+}
+
+func NewLoginGuardService(cache cacheSystem.LoginGuardCache, cfg LoginGuardConfig) LoginGuardService {
+	// 未配置的项使用默认值
+	def := DefaultLoginGuardConfig()
+	if cfg.MaxUserFailures <= 0 {
+		cfg.MaxUserFailures = def.MaxUserFailures
+	}
+	if cfg.MaxIpFailures <= 0 {
+		cfg.MaxIpFailures = def.MaxIpFailures
+	}
+	if cfg.FailureWindow <= 0 {
+		cfg.FailureWindow = def.FailureWindow
+	}
+	if cfg.LockDuration <= 0 {
+		cfg.LockDuration = def.LockDuration
+	}
+	if cfg.CaptchaThreshold <= 0 {
+		cfg.CaptchaThreshold = def.CaptchaThreshold
+	}
+	if cfg.CaptchaExpire <= 0 {
+		cfg.CaptchaExpire = def.CaptchaExpire
+	}
+
+	return &loginGuardService{
+		cache: cache,
+		cfg:   cfg,
+		now:   time.Now,
+	}
+}
+
+// Check 登录前检查
+func (svc *loginGuardService) Check(ctx context.Context, username, ip, captchaId, captchaCode string) error {
+	until, err := svc.cache.GetLockedUntil(ctx, username, ip)
+	if err != nil {
+		return err
+	}
+	if until.After(svc.now()) {
+		return &UserLockedError{LockedUntil: until}
+	}
+
+	required, err := svc.CaptchaRequired(ctx, username, ip)
+	if err != nil || !required {
+		return err
+	}
+
+	if captchaId == "" || captchaCode == "" {
+		return ErrCaptchaRequired
+	}
+	code, err := svc.cache.TakeCaptcha(ctx, captchaId)
+	if err != nil {
+		if errors.Is(err, cacheSystem.ErrCaptchaNotExist) {
+			return ErrCaptchaInvalid
+		}
+		return err
+	}
+	if !strings.EqualFold(code, strings.TrimSpace(captchaCode)) {
+		return ErrCaptchaInvalid
+	}
+	return nil
+}
+
+// RecordFailure 记录失败
+func (svc *loginGuardService) RecordFailure(ctx context.Context, username, ip string) (bool, error) {
+	userFailures, ipFailures, err := svc.cache.IncrFailures(ctx, username, ip, svc.cfg.FailureWindow)
+	if err != nil {
+		return false, err
+	}
+
+	captchaRequired := userFailures >= svc.cfg.CaptchaThreshold || ipFailures >= svc.cfg.CaptchaThreshold
+
+	until := svc.now().Add(svc.cfg.LockDuration)
+	locked := false
+	if userFailures >= svc.cfg.MaxUserFailures {
+		if err := svc.cache.Lock(ctx, "user", username, until); err != nil {
+			return captchaRequired, err
+		}
+		locked = true
+	}
+	if ipFailures >= svc.cfg.MaxIpFailures {
+		if err := svc.cache.Lock(ctx, "ip", ip, until); err != nil {
+			return captchaRequired, err
+		}
+		locked = true
+	}
+	if locked {
+		return captchaRequired, &UserLockedError{LockedUntil: until}
+	}
+	return captchaRequired, nil
+}
+
+// RecordSuccess 记录成功
+func (svc *loginGuardService) RecordSuccess(ctx context.Context, username, ip string) error {
+	return svc.cache.ResetFailures(ctx, username, ip)
+}
+
+// CaptchaRequired 是否需要验证码
+func (svc *loginGuardService) CaptchaRequired(ctx context.Context, username, ip string) (bool, error) {
+	userFailures, ipFailures, err := svc.cache.GetFailures(ctx, username, ip)
+	if err != nil {
+		return false, err
+	}
+	return userFailures >= svc.cfg.CaptchaThreshold || ipFailures >= svc.cfg.CaptchaThreshold, nil
+}
+
+// NewCaptcha 生成验证码
+func (svc *loginGuardService) NewCaptcha(ctx context.Context) (string, string, error) {
+	c, err := captcha.Generate(captcha.DefaultConfig())
+	if err != nil {
+		return "", "", err
+	}
+
+	id := strings.ReplaceAll(uuid.New().String(), "-", "")
+	if err := svc.cache.SetCaptcha(ctx, id, c.Code, svc.cfg.CaptchaExpire); err != nil {
+		return "", "", err
+	}
+	return id, c.DataURI(), nil
+}
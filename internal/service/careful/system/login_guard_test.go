@@ -0,0 +1,133 @@
+/**
+ * Description：
+ * FileName：login_guard_test.go
+ * Author：CJiaの用心
+ * Create：2025/10/31 16:02:45
+ * Remark：
+ */
+
+package system
+
+import (
+	"context"
+	"errors"
+	cacheSystem "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/careful/system"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// memoryLoginGuardCache 内存版登录防护缓存
+type memoryLoginGuardCache struct {
+	failures map[string]int64
+	locks    map[string]time.Time
+	captcha  map[string]string
+}
+
+func newMemoryLoginGuardCache() *memoryLoginGuardCache {
+	return &memoryLoginGuardCache{
+		failures: map[string]int64{},
+		locks:    map[string]time.Time{},
+		captcha:  map[string]string{},
+	}
+}
+
+func (c *memoryLoginGuardCache) GetFailures(_ context.Context, username, ip string) (int64, int64, error) {
+	return c.failures["user:"+username], c.failures["ip:"+ip], nil
+}
+
+func (c *memoryLoginGuardCache) IncrFailures(_ context.Context, username, ip string, _ time.Duration) (int64, int64, error) {
+	c.failures["user:"+username]++
+	c.failures["ip:"+ip]++
+	return c.failures["user:"+username], c.failures["ip:"+ip], nil
+}
+
+func (c *memoryLoginGuardCache) ResetFailures(_ context.Context, username, ip string) error {
+	delete(c.failures, "user:"+username)
+	delete(c.locks, "user:"+username)
+	delete(c.failures, "ip:"+ip)
+	delete(c.locks, "ip:"+ip)
+	return nil
+}
+
+func (c *memoryLoginGuardCache) Lock(_ context.Context, subject, value string, until time.Time) error {
+	c.locks[subject+":"+value] = until
+	return nil
+}
+
+func (c *memoryLoginGuardCache) GetLockedUntil(_ context.Context, username, ip string) (time.Time, error) {
+	until := c.locks["user:"+username]
+	if t := c.locks["ip:"+ip]; t.After(until) {
+		until = t
+	}
+	return until, nil
+}
+
+func (c *memoryLoginGuardCache) SetCaptcha(_ context.Context, id, code string, _ time.Duration) error {
+	c.captcha[id] = code
+	return nil
+}
+
+func (c *memoryLoginGuardCache) TakeCaptcha(_ context.Context, id string) (string, error) {
+	code, ok := c.captcha[id]
+	if !ok {
+		return "", cacheSystem.ErrCaptchaNotExist
+	}
+	delete(c.captcha, id)
+	return code, nil
+}
+
+func Test_loginGuardService(t *testing.T) {
+	ctx := context.Background()
+	cache := newMemoryLoginGuardCache()
+	cfg := LoginGuardConfig{
+		MaxUserFailures:  3,
+		MaxIpFailures:    10,
+		FailureWindow:    time.Minute,
+		LockDuration:     time.Minute,
+		CaptchaThreshold: 2,
+		CaptchaExpire:    time.Minute,
+	}
+	svc := NewLoginGuardService(cache, cfg)
+
+	// 首次失败无需验证码
+	captchaRequired, err := svc.RecordFailure(ctx, "admin", "127.0.0.1")
+	assert.NoError(t, err)
+	assert.False(t, captchaRequired)
+	assert.NoError(t, svc.Check(ctx, "admin", "127.0.0.1", "", ""))
+
+	// 达到阈值后需要验证码
+	captchaRequired, err = svc.RecordFailure(ctx, "admin", "127.0.0.1")
+	assert.NoError(t, err)
+	assert.True(t, captchaRequired)
+	assert.ErrorIs(t, svc.Check(ctx, "admin", "127.0.0.1", "", ""), ErrCaptchaRequired)
+
+	id, image, err := svc.NewCaptcha(ctx)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, image)
+	code := cache.captcha[id]
+	assert.ErrorIs(t, svc.Check(ctx, "admin", "127.0.0.1", id, "x"), ErrCaptchaInvalid)
+	// 验证码一次性使用
+	assert.ErrorIs(t, svc.Check(ctx, "admin", "127.0.0.1", id, code), ErrCaptchaInvalid)
+
+	id, _, _ = svc.NewCaptcha(ctx)
+	assert.NoError(t, svc.Check(ctx, "admin", "127.0.0.1", id, cache.captcha[id]))
+
+	// 达到上限后锁定
+	_, err = svc.RecordFailure(ctx, "admin", "127.0.0.1")
+	var lockedErr *UserLockedError
+	assert.True(t, errors.As(err, &lockedErr))
+	assert.ErrorIs(t, err, ErrUserLocked)
+	assert.ErrorIs(t, svc.Check(ctx, "admin", "127.0.0.1", "", ""), ErrUserLocked)
+
+	// 其他用户名不受影响（IP未达上限）
+	assert.NoError(t, svc.Check(ctx, "guest", "127.0.0.2", "", ""))
+
+	// 登录成功后清除用户名与IP的记录
+	assert.NoError(t, svc.RecordSuccess(ctx, "admin", "127.0.0.1"))
+	assert.NoError(t, svc.Check(ctx, "admin", "127.0.0.2", "", ""))
+	assert.NoError(t, svc.Check(ctx, "guest", "127.0.0.1", "", ""))
+	captchaRequired, err = svc.CaptchaRequired(ctx, "guest", "127.0.0.1")
+	assert.NoError(t, err)
+	assert.False(t, captchaRequired)
+}
@@ -0,0 +1,185 @@
+/**
+ * Description：
+ * FileName：menu.go
+ * Author：CJiaの用心
+ * Create：2025/10/28 11:15:42
+ * Remark：
+ */
+
+package system
+
+import (
+	"context"
+	"errors"
+	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
+	repositorySystem "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/system/menu"
+	"strings"
+)
+
+var (
+	ErrMenuNotFound             = repositorySystem.ErrMenuNotFound
+	ErrMenuDuplicate            = repositorySystem.ErrMenuDuplicate
+	ErrMenuVersionInconsistency = repositorySystem.ErrMenuVersionInconsistency
+	ErrMenuParentNotFound       = repositorySystem.ErrMenuParentNotFound
+	ErrMenuParentInvalid        = repositorySystem.ErrMenuParentInvalid
+	ErrMenuHasChildren          = repositorySystem.ErrMenuHasChildren
+	ErrMenuCodeRequired         = errors.New("按钮权限标识不能为空")
+)
+
+type MenuService interface {
+	Create(ctx context.Context, domain domainSystem.Menu) error
+	Delete(ctx context.Context, id string) error
+	Update(ctx context.Context, domain domainSystem.Menu) error
+
+	GetById(ctx context.Context, id string) (domainSystem.Menu, error)
+	GetTree(ctx context.Context, filter domainSystem.MenuFilter) ([]*domainSystem.Menu, error)
+	GetUserMenu(ctx context.Context, userId string) (domainSystem.UserMenu, error)
+
+	// GetRecycleListPage 分页查询回收站
+	GetRecycleListPage(ctx context.Context, filter domainSystem.RecycleFilter) ([]domainSystem.Menu, int64, error)
+	// Restore 从回收站恢复菜单，上级菜单需未被删除
+	Restore(ctx context.Context, id string) error
+	// Purge 彻底删除回收站中的菜单
+	Purge(ctx context.Context, ids []string) error
+}
+
+type menuService struct {
+	repo     repositorySystem.MenuRepository
+	userRepo repositorySystem.UserRepository
+}
+
+func NewMenuService(repo repositorySystem.MenuRepository, userRepo repositorySystem.UserRepository) MenuService {
+	return &menuService{
+		repo:     repo,
+		userRepo: userRepo,
+	}
+}
+
+// Create 创建
+func (svc *menuService) Create(ctx context.Context, domain domainSystem.Menu) error {
+	if err := svc.checkCode(ctx, &domain); err != nil {
+		return err
+	}
+
+	_, err := svc.repo.Create(ctx, domain)
+	return err
+}
+
+// Delete 删除
+func (svc *menuService) Delete(ctx context.Context, id string) error {
+	return svc.repo.Delete(ctx, id)
+}
+
+// Update 更新
+func (svc *menuService) Update(ctx context.Context, domain domainSystem.Menu) error {
+	if err := svc.checkCode(ctx, &domain); err != nil {
+		return err
+	}
+
+	err := svc.repo.Update(ctx, domain)
+
+	switch {
+	case err == nil:
+		return err
+	case errors.Is(err, repositorySystem.ErrMenuNotFound):
+		return repositorySystem.ErrMenuNotFound
+	case errors.Is(err, repositorySystem.ErrMenuVersionInconsistency):
+		return repositorySystem.ErrMenuVersionInconsistency
+	default:
+		return err
+	}
+}
+
+// GetById 获取详情
+func (svc *menuService) GetById(ctx context.Context, id string) (domainSystem.Menu, error) {
+	domain, err := svc.repo.GetById(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositorySystem.ErrMenuNotFound) {
+			return domainSystem.Menu{}, repositorySystem.ErrMenuNotFound
+		}
+		return domainSystem.Menu{}, err
+	}
+	if domain.Id == "" {
+		return domainSystem.Menu{}, repositorySystem.ErrMenuNotFound
+	}
+	return domain, nil
+}
+
+// GetTree 获取菜单树
+func (svc *menuService) GetTree(ctx context.Context, filter domainSystem.MenuFilter) ([]*domainSystem.Menu, error) {
+	list, err := svc.repo.GetListAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return domainSystem.BuildMenuTree(list), nil
+}
+
+// GetUserMenu 获取当前用户可见的菜单树及按钮权限标识
+func (svc *menuService) GetUserMenu(ctx context.Context, userId string) (domainSystem.UserMenu, error) {
+	user, err := svc.userRepo.GetById(ctx, userId)
+	if err != nil {
+		return domainSystem.UserMenu{}, err
+	}
+
+	all, err := svc.repo.GetListAll(ctx, domainSystem.MenuFilter{Status: true})
+	if err != nil {
+		return domainSystem.UserMenu{}, err
+	}
+
+	var allowedIds []string
+	if !user.IsSuperuser {
+		allowedIds, err = svc.repo.GetIdsByUserId(ctx, userId)
+		if err != nil {
+			return domainSystem.UserMenu{}, err
+		}
+	}
+
+	return domainSystem.BuildUserMenu(all, allowedIds, user.IsSuperuser), nil
+}
+
+// GetRecycleListPage 分页查询回收站
+func (svc *menuService) GetRecycleListPage(ctx context.Context, filter domainSystem.RecycleFilter) ([]domainSystem.Menu, int64, error) {
+	return svc.repo.GetRecycleListPage(ctx, filter)
+}
+
+// Restore 恢复
+// 删除期间可能已新建相同权限标识的菜单，恢复前重新校验唯一性
+func (svc *menuService) Restore(ctx context.Context, id string) error {
+	deleted, err := svc.repo.GetDeletedById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := svc.checkCode(ctx, &deleted); err != nil {
+		return err
+	}
+
+	return svc.repo.Restore(ctx, deleted)
+}
+
+// Purge 彻底删除
+func (svc *menuService) Purge(ctx context.Context, ids []string) error {
+	_, err := svc.repo.Purge(ctx, ids)
+	return err
+}
+
+// checkCode 按钮必须填写权限标识，权限标识全局唯一
+func (svc *menuService) checkCode(ctx context.Context, domain *domainSystem.Menu) error {
+	domain.Code = strings.TrimSpace(domain.Code)
+	if domain.Code == "" {
+		if domain.Type == menu.TypeConstButton {
+			return ErrMenuCodeRequired
+		}
+		return nil
+	}
+
+	exists, err := svc.repo.CheckExistByCode(ctx, domain.Code, domain.Id)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return repositorySystem.ErrMenuDuplicate
+	}
+	return nil
+}
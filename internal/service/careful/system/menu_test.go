@@ -0,0 +1,221 @@
+/**
+ * Description：
+ * FileName：menu_test.go
+ * Author：CJiaの用心
+ * Create：2025/10/28 14:05:12
+ * Remark：
+ */
+
+package system
+
+import (
+	"context"
+	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/system"
+	repomocks "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/mocks"
+	repositorySystem "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/system/menu"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_menuService_Create(t *testing.T) {
+	testCases := []struct {
+		name     string
+		mock     func(ctrl *gomock.Controller) repositorySystem.MenuRepository
+		menuType menu.TypeConst
+		code     string
+		wantErr  error
+	}{
+		{
+			name: "目录无需权限标识",
+			mock: func(ctrl *gomock.Controller) repositorySystem.MenuRepository {
+				repo := repomocks.NewMockMenuRepository(ctrl)
+				repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(domainSystem.Menu{}, nil)
+				return repo
+			},
+			menuType: menu.TypeConstDirectory,
+			wantErr:  nil,
+		},
+		{
+			name: "按钮缺少权限标识",
+			mock: func(ctrl *gomock.Controller) repositorySystem.MenuRepository {
+				return repomocks.NewMockMenuRepository(ctrl)
+			},
+			menuType: menu.TypeConstButton,
+			code:     "  ",
+			wantErr:  ErrMenuCodeRequired,
+		},
+		{
+			name: "权限标识已存在",
+			mock: func(ctrl *gomock.Controller) repositorySystem.MenuRepository {
+				repo := repomocks.NewMockMenuRepository(ctrl)
+				repo.EXPECT().CheckExistByCode(gomock.Any(), "system:user:create", "").Return(true, nil)
+				return repo
+			},
+			menuType: menu.TypeConstButton,
+			code:     " system:user:create ",
+			wantErr:  ErrMenuDuplicate,
+		},
+		{
+			name: "上级菜单不存在",
+			mock: func(ctrl *gomock.Controller) repositorySystem.MenuRepository {
+				repo := repomocks.NewMockMenuRepository(ctrl)
+				repo.EXPECT().CheckExistByCode(gomock.Any(), "system:user:create", "").Return(false, nil)
+				repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(domainSystem.Menu{}, ErrMenuParentNotFound)
+				return repo
+			},
+			menuType: menu.TypeConstButton,
+			code:     "system:user:create",
+			wantErr:  ErrMenuParentNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			menuSvc := NewMenuService(tc.mock(ctrl), repomocks.NewMockUserRepository(ctrl))
+			err := menuSvc.Create(context.Background(), domainSystem.Menu{
+				Menu: system.Menu{
+					Type:  tc.menuType,
+					Title: "用户管理",
+					Code:  tc.code,
+				},
+				ParentId: "p",
+			})
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func Test_menuService_GetUserMenu(t *testing.T) {
+	newMenu := func(id, parentId string, menuType menu.TypeConst, code string) domainSystem.Menu {
+		m := domainSystem.Menu{ParentId: parentId}
+		m.Id, m.Type, m.Code = id, menuType, code
+		return m
+	}
+	// 系统管理 -> 用户管理 -> 新增按钮；系统管理 -> 角色管理；日志目录的上级已停用
+	all := []domainSystem.Menu{
+		newMenu("sys", "", menu.TypeConstDirectory, ""),
+		newMenu("user", "sys", menu.TypeConstPage, ""),
+		newMenu("user-add", "user", menu.TypeConstButton, "system:user:create"),
+		newMenu("role", "sys", menu.TypeConstPage, ""),
+		newMenu("log", "disabled", menu.TypeConstPage, ""),
+	}
+
+	testCases := []struct {
+		name      string
+		mock      func(ctrl *gomock.Controller) (repositorySystem.MenuRepository, repositorySystem.UserRepository)
+		wantRoots []string
+		wantPerms []string
+	}{
+		{
+			name: "仅返回授权菜单及其上级",
+			mock: func(ctrl *gomock.Controller) (repositorySystem.MenuRepository, repositorySystem.UserRepository) {
+				userRepo := repomocks.NewMockUserRepository(ctrl)
+				userRepo.EXPECT().GetById(gomock.Any(), "1").Return(domainSystem.User{}, nil)
+				repo := repomocks.NewMockMenuRepository(ctrl)
+				repo.EXPECT().GetListAll(gomock.Any(), domainSystem.MenuFilter{Status: true}).Return(all, nil)
+				repo.EXPECT().GetIdsByUserId(gomock.Any(), "1").Return([]string{"user-add", "log"}, nil)
+				return repo, userRepo
+			},
+			wantRoots: []string{"sys"},
+			wantPerms: []string{"system:user:create"},
+		},
+		{
+			name: "超级管理员返回全部可达菜单",
+			mock: func(ctrl *gomock.Controller) (repositorySystem.MenuRepository, repositorySystem.UserRepository) {
+				user := domainSystem.User{}
+				user.IsSuperuser = true
+				userRepo := repomocks.NewMockUserRepository(ctrl)
+				userRepo.EXPECT().GetById(gomock.Any(), "1").Return(user, nil)
+				repo := repomocks.NewMockMenuRepository(ctrl)
+				repo.EXPECT().GetListAll(gomock.Any(), domainSystem.MenuFilter{Status: true}).Return(all, nil)
+				return repo, userRepo
+			},
+			wantRoots: []string{"sys"},
+			wantPerms: []string{"system:user:create"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			menuSvc := NewMenuService(tc.mock(ctrl))
+			userMenu, err := menuSvc.GetUserMenu(context.Background(), "1")
+			assert.NoError(t, err)
+
+			roots := make([]string, 0, len(userMenu.Menus))
+			for _, v := range userMenu.Menus {
+				roots = append(roots, v.Id)
+			}
+			assert.Equal(t, tc.wantRoots, roots)
+			assert.Equal(t, tc.wantPerms, userMenu.Permissions)
+		})
+	}
+}
+
+func Test_menuService_Restore(t *testing.T) {
+	deleted := domainSystem.Menu{
+		Menu: system.Menu{
+			Type: menu.TypeConstButton,
+			Code: "system:user:create",
+		},
+	}
+	deleted.Id = "1"
+
+	testCases := []struct {
+		name    string
+		mock    func(ctrl *gomock.Controller) repositorySystem.MenuRepository
+		wantErr error
+	}{
+		{
+			name: "恢复成功",
+			mock: func(ctrl *gomock.Controller) repositorySystem.MenuRepository {
+				repo := repomocks.NewMockMenuRepository(ctrl)
+				repo.EXPECT().GetDeletedById(gomock.Any(), "1").Return(deleted, nil)
+				repo.EXPECT().CheckExistByCode(gomock.Any(), "system:user:create", "1").Return(false, nil)
+				repo.EXPECT().Restore(gomock.Any(), deleted).Return(nil)
+				return repo
+			},
+			wantErr: nil,
+		},
+		{
+			name: "权限标识已被占用",
+			mock: func(ctrl *gomock.Controller) repositorySystem.MenuRepository {
+				repo := repomocks.NewMockMenuRepository(ctrl)
+				repo.EXPECT().GetDeletedById(gomock.Any(), "1").Return(deleted, nil)
+				repo.EXPECT().CheckExistByCode(gomock.Any(), "system:user:create", "1").Return(true, nil)
+				return repo
+			},
+			wantErr: ErrMenuDuplicate,
+		},
+		{
+			name: "上级菜单已删除",
+			mock: func(ctrl *gomock.Controller) repositorySystem.MenuRepository {
+				repo := repomocks.NewMockMenuRepository(ctrl)
+				repo.EXPECT().GetDeletedById(gomock.Any(), "1").Return(deleted, nil)
+				repo.EXPECT().CheckExistByCode(gomock.Any(), "system:user:create", "1").Return(false, nil)
+				repo.EXPECT().Restore(gomock.Any(), deleted).Return(ErrMenuParentNotFound)
+				return repo
+			},
+			wantErr: ErrMenuParentNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			menuSvc := NewMenuService(tc.mock(ctrl), repomocks.NewMockUserRepository(ctrl))
+			err := menuSvc.Restore(context.Background(), "1")
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,194 @@
+/**
+ * Description：
+ * FileName：permission.go
+ * Author：CJiaの用心
+ * Create：2025/10/27 13:36:14
+ * Remark：
+ */
+
+package system
+
+import (
+	"context"
+	"errors"
+	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
+	repositorySystem "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/system"
+	"github.com/go-sql-driver/mysql"
+	"strings"
+)
+
+var (
+	ErrPermissionNotFound             = repositorySystem.ErrPermissionNotFound
+	ErrPermissionDuplicate            = repositorySystem.ErrPermissionDuplicate
+	ErrPermissionVersionInconsistency = repositorySystem.ErrPermissionVersionInconsistency
+	ErrPermissionMethodInvalid        = errors.New("请求方式不合法")
+)
+
+// permissionMethods 支持的请求方式
+var permissionMethods = map[string]struct{}{
+	"*":      {},
+	"GET":    {},
+	"POST":   {},
+	"PUT":    {},
+	"PATCH":  {},
+	"DELETE": {},
+}
+
+type PermissionService interface {
+	Create(ctx context.Context, domain domainSystem.Permission) error
+	Delete(ctx context.Context, id string) error
+	BatchDelete(ctx context.Context, ids []string) error
+	Update(ctx context.Context, domain domainSystem.Permission) error
+
+	GetById(ctx context.Context, id string) (domainSystem.Permission, error)
+	GetListPage(ctx context.Context, filter domainSystem.PermissionFilter) ([]domainSystem.Permission, int64, error)
+	GetListAll(ctx context.Context, filter domainSystem.PermissionFilter) ([]domainSystem.Permission, error)
+	CheckPermission(ctx context.Context, userId, method, path string) (bool, error)
+
+	// GetRecycleListPage 分页查询回收站
+	GetRecycleListPage(ctx context.Context, filter domainSystem.RecycleFilter) ([]domainSystem.Permission, int64, error)
+	// Restore 从回收站恢复接口权限，删除时保留的角色关联随之恢复
+	Restore(ctx context.Context, id string) error
+	// Purge 彻底删除回收站中的接口权限
+	Purge(ctx context.Context, ids []string) error
+}
+
+type permissionService struct {
+	repo repositorySystem.PermissionRepository
+}
+
+func NewPermissionService(repo repositorySystem.PermissionRepository) PermissionService {
+	return &permissionService{
+		repo: repo,
+	}
+}
+
+// Create 创建
+func (svc *permissionService) Create(ctx context.Context, domain domainSystem.Permission) error {
+	if err := svc.normalize(&domain); err != nil {
+		return err
+	}
+
+	// 唯一性校验依赖mysql唯一性约束（编码唯一、请求方式+路径唯一）
+	if _, err := svc.repo.Create(ctx, domain); err != nil {
+		if svc.IsDuplicateEntryError(err) {
+			return repositorySystem.ErrPermissionDuplicate
+		}
+		return err
+	}
+	return nil
+}
+
+// Delete 删除
+func (svc *permissionService) Delete(ctx context.Context, id string) error {
+	return svc.repo.Delete(ctx, id)
+}
+
+// BatchDelete 批量删除
+func (svc *permissionService) BatchDelete(ctx context.Context, ids []string) error {
+	return svc.repo.BatchDelete(ctx, ids)
+}
+
+// Update 更新
+func (svc *permissionService) Update(ctx context.Context, domain domainSystem.Permission) error {
+	if err := svc.normalize(&domain); err != nil {
+		return err
+	}
+
+	err := svc.repo.Update(ctx, domain)
+
+	switch {
+	case err == nil:
+		return err
+	case errors.Is(err, repositorySystem.ErrPermissionNotFound):
+		return repositorySystem.ErrPermissionNotFound
+	case errors.Is(err, repositorySystem.ErrPermissionVersionInconsistency):
+		return repositorySystem.ErrPermissionVersionInconsistency
+	case svc.IsDuplicateEntryError(err):
+		return repositorySystem.ErrPermissionDuplicate
+	default:
+		return err
+	}
+}
+
+// GetById 获取详情
+func (svc *permissionService) GetById(ctx context.Context, id string) (domainSystem.Permission, error) {
+	domain, err := svc.repo.GetById(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositorySystem.ErrPermissionNotFound) {
+			return domainSystem.Permission{}, repositorySystem.ErrPermissionNotFound
+		}
+		return domainSystem.Permission{}, err
+	}
+	if domain.Id == "" {
+		return domainSystem.Permission{}, repositorySystem.ErrPermissionNotFound
+	}
+	return domain, nil
+}
+
+// GetListPage 分页查询列表
+func (svc *permissionService) GetListPage(ctx context.Context, filter domainSystem.PermissionFilter) ([]domainSystem.Permission, int64, error) {
+	return svc.repo.GetListPage(ctx, filter)
+}
+
+// GetListAll 查询所有列表
+func (svc *permissionService) GetListAll(ctx context.Context, filter domainSystem.PermissionFilter) ([]domainSystem.Permission, error) {
+	return svc.repo.GetListAll(ctx, filter)
+}
+
+// CheckPermission 校验用户是否拥有接口权限
+func (svc *permissionService) CheckPermission(ctx context.Context, userId, method, path string) (bool, error) {
+	permission, err := svc.repo.GetUserPermission(ctx, userId)
+	if err != nil {
+		return false, err
+	}
+	return permission.Allow(method, path), nil
+}
+
+// GetRecycleListPage 分页查询回收站
+func (svc *permissionService) GetRecycleListPage(ctx context.Context, filter domainSystem.RecycleFilter) ([]domainSystem.Permission, int64, error) {
+	return svc.repo.GetRecycleListPage(ctx, filter)
+}
+
+// Restore 恢复
+// 删除期间可能已新建相同编码或接口的权限，唯一性校验依赖mysql唯一性约束
+func (svc *permissionService) Restore(ctx context.Context, id string) error {
+	deleted, err := svc.repo.GetDeletedById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := svc.repo.Restore(ctx, deleted); err != nil {
+		if svc.IsDuplicateEntryError(err) {
+			return repositorySystem.ErrPermissionDuplicate
+		}
+		return err
+	}
+	return nil
+}
+
+// Purge 彻底删除
+func (svc *permissionService) Purge(ctx context.Context, ids []string) error {
+	_, err := svc.repo.Purge(ctx, ids)
+	return err
+}
+
+// normalize 规范化请求方式与路径
+func (svc *permissionService) normalize(domain *domainSystem.Permission) error {
+	domain.Method = strings.ToUpper(strings.TrimSpace(domain.Method))
+	if _, ok := permissionMethods[domain.Method]; !ok {
+		return ErrPermissionMethodInvalid
+	}
+	domain.Path = strings.TrimSpace(domain.Path)
+	return nil
+}
+
+// IsDuplicateEntryError 判断是否是唯一冲突错误
+func (svc *permissionService) IsDuplicateEntryError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		// MySQL 错误码 1062 表示唯一冲突
+		return mysqlErr.Number == 1062
+	}
+	return false
+}
@@ -0,0 +1,217 @@
+/**
+ * Description：
+ * FileName：permission_test.go
+ * Author：CJiaの用心
+ * Create：2025/10/27 16:41:07
+ * Remark：
+ */
+
+package system
+
+import (
+	"context"
+	"errors"
+	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/system"
+	repomocks "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/mocks"
+	repositorySystem "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/system"
+	"github.com/go-sql-driver/mysql"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_permissionService_Create(t *testing.T) {
+	testCases := []struct {
+		name    string
+		mock    func(ctrl *gomock.Controller) repositorySystem.PermissionRepository
+		method  string
+		wantErr error
+	}{
+		{
+			name: "创建成功，请求方式转大写",
+			mock: func(ctrl *gomock.Controller) repositorySystem.PermissionRepository {
+				repo := repomocks.NewMockPermissionRepository(ctrl)
+				repo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, domain domainSystem.Permission) (domainSystem.Permission, error) {
+						assert.Equal(t, "GET", domain.Method)
+						return domain, nil
+					})
+				return repo
+			},
+			method:  "get",
+			wantErr: nil,
+		},
+		{
+			name: "请求方式不合法",
+			mock: func(ctrl *gomock.Controller) repositorySystem.PermissionRepository {
+				return repomocks.NewMockPermissionRepository(ctrl)
+			},
+			method:  "FETCH",
+			wantErr: ErrPermissionMethodInvalid,
+		},
+		{
+			name: "权限已存在",
+			mock: func(ctrl *gomock.Controller) repositorySystem.PermissionRepository {
+				repo := repomocks.NewMockPermissionRepository(ctrl)
+				repo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					Return(domainSystem.Permission{}, &mysql.MySQLError{Number: 1062})
+				return repo
+			},
+			method:  "*",
+			wantErr: ErrPermissionDuplicate,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			permissionSvc := NewPermissionService(tc.mock(ctrl))
+			err := permissionSvc.Create(context.Background(), domainSystem.Permission{
+				Permission: system.Permission{
+					Name:   "用户详情",
+					Code:   "system:user:detail",
+					Method: tc.method,
+					Path:   "/v1/system/user/getById/:id",
+				},
+			})
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func Test_permissionService_CheckPermission(t *testing.T) {
+	testCases := []struct {
+		name      string
+		mock      func(ctrl *gomock.Controller) repositorySystem.PermissionRepository
+		method    string
+		path      string
+		wantAllow bool
+		wantErr   error
+	}{
+		{
+			name: "超级管理员放行",
+			mock: func(ctrl *gomock.Controller) repositorySystem.PermissionRepository {
+				repo := repomocks.NewMockPermissionRepository(ctrl)
+				repo.EXPECT().GetUserPermission(gomock.Any(), "1").
+					Return(domainSystem.UserPermission{IsSuperuser: true}, nil)
+				return repo
+			},
+			method:    "DELETE",
+			path:      "/v1/system/user/delete/:id",
+			wantAllow: true,
+		},
+		{
+			name: "精确匹配",
+			mock: func(ctrl *gomock.Controller) repositorySystem.PermissionRepository {
+				repo := repomocks.NewMockPermissionRepository(ctrl)
+				repo.EXPECT().GetUserPermission(gomock.Any(), "1").
+					Return(domainSystem.UserPermission{Apis: []string{"GET /v1/system/user/listPage"}}, nil)
+				return repo
+			},
+			method:    "GET",
+			path:      "/v1/system/user/listPage",
+			wantAllow: true,
+		},
+		{
+			name: "通配请求方式",
+			mock: func(ctrl *gomock.Controller) repositorySystem.PermissionRepository {
+				repo := repomocks.NewMockPermissionRepository(ctrl)
+				repo.EXPECT().GetUserPermission(gomock.Any(), "1").
+					Return(domainSystem.UserPermission{Apis: []string{"* /v1/system/role/update"}}, nil)
+				return repo
+			},
+			method:    "PUT",
+			path:      "/v1/system/role/update",
+			wantAllow: true,
+		},
+		{
+			name: "无权限",
+			mock: func(ctrl *gomock.Controller) repositorySystem.PermissionRepository {
+				repo := repomocks.NewMockPermissionRepository(ctrl)
+				repo.EXPECT().GetUserPermission(gomock.Any(), "1").
+					Return(domainSystem.UserPermission{Apis: []string{"GET /v1/system/user/listPage"}}, nil)
+				return repo
+			},
+			method:    "POST",
+			path:      "/v1/system/user/create",
+			wantAllow: false,
+		},
+		{
+			name: "查询异常",
+			mock: func(ctrl *gomock.Controller) repositorySystem.PermissionRepository {
+				repo := repomocks.NewMockPermissionRepository(ctrl)
+				repo.EXPECT().GetUserPermission(gomock.Any(), "1").
+					Return(domainSystem.UserPermission{}, errors.New("db error"))
+				return repo
+			},
+			method:    "GET",
+			path:      "/v1/system/user/listPage",
+			wantAllow: false,
+			wantErr:   errors.New("db error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			permissionSvc := NewPermissionService(tc.mock(ctrl))
+			allow, err := permissionSvc.CheckPermission(context.Background(), "1", tc.method, tc.path)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantAllow, allow)
+		})
+	}
+}
+
+func Test_permissionService_Restore(t *testing.T) {
+	testCases := []struct {
+		name    string
+		mock    func(ctrl *gomock.Controller) repositorySystem.PermissionRepository
+		wantErr error
+	}{
+		{
+			name: "恢复成功",
+			mock: func(ctrl *gomock.Controller) repositorySystem.PermissionRepository {
+				repo := repomocks.NewMockPermissionRepository(ctrl)
+				repo.EXPECT().GetDeletedById(gomock.Any(), "1").Return(domainSystem.Permission{}, nil)
+				repo.EXPECT().Restore(gomock.Any(), gomock.Any()).Return(nil)
+				return repo
+			},
+			wantErr: nil,
+		},
+		{
+			name: "回收站中不存在",
+			mock: func(ctrl *gomock.Controller) repositorySystem.PermissionRepository {
+				repo := repomocks.NewMockPermissionRepository(ctrl)
+				repo.EXPECT().GetDeletedById(gomock.Any(), "1").Return(domainSystem.Permission{}, ErrPermissionNotFound)
+				return repo
+			},
+			wantErr: ErrPermissionNotFound,
+		},
+		{
+			name: "编码或接口已被占用",
+			mock: func(ctrl *gomock.Controller) repositorySystem.PermissionRepository {
+				repo := repomocks.NewMockPermissionRepository(ctrl)
+				repo.EXPECT().GetDeletedById(gomock.Any(), "1").Return(domainSystem.Permission{}, nil)
+				repo.EXPECT().Restore(gomock.Any(), gomock.Any()).Return(&mysql.MySQLError{Number: 1062})
+				return repo
+			},
+			wantErr: ErrPermissionDuplicate,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			permissionSvc := NewPermissionService(tc.mock(ctrl))
+			err := permissionSvc.Restore(context.Background(), "1")
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
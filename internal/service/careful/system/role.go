@@ -0,0 +1,260 @@
+/**
+ * Description：
+ * FileName：role.go
+ * Author：CJiaの用心
+ * Create：2025/10/27 13:10:26
+ * Remark：
+ */
+
+package system
+
+import (
+	"context"
+	"errors"
+	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
+	repositorySystem "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/system/role"
+	"github.com/go-sql-driver/mysql"
+)
+
+var (
+	ErrRoleNotFound             = repositorySystem.ErrRoleNotFound
+	ErrRoleNameDuplicate        = repositorySystem.ErrRoleNameDuplicate
+	ErrRoleCodeDuplicate        = repositorySystem.ErrRoleCodeDuplicate
+	ErrRoleVersionInconsistency = repositorySystem.ErrRoleVersionInconsistency
+	ErrRoleUserNotFound         = repositorySystem.ErrRoleUserNotFound
+	ErrRolePermissionNotFound   = repositorySystem.ErrRolePermissionNotFound
+	ErrRoleMenuNotFound         = repositorySystem.ErrRoleMenuNotFound
+	ErrRoleDeptNotFound         = repositorySystem.ErrRoleDeptNotFound
+)
+
+type RoleService interface {
+	Create(ctx context.Context, domain domainSystem.Role) error
+	Delete(ctx context.Context, id string) error
+	BatchDelete(ctx context.Context, ids []string) error
+	Update(ctx context.Context, domain domainSystem.Role) error
+	AssignPermissions(ctx context.Context, roleId string, permissionIds []string) error
+	AssignUserRoles(ctx context.Context, userId string, roleIds []string) error
+	AssignMenus(ctx context.Context, roleId string, menuIds []string) error
+	AssignDepts(ctx context.Context, roleId string, deptIds []string) error
+
+	GetById(ctx context.Context, id string) (domainSystem.Role, error)
+	GetListPage(ctx context.Context, filter domainSystem.RoleFilter) ([]domainSystem.Role, int64, error)
+	GetListAll(ctx context.Context, filter domainSystem.RoleFilter) ([]domainSystem.Role, error)
+	GetByUserId(ctx context.Context, userId string) ([]domainSystem.Role, error)
+	GetPermissionIds(ctx context.Context, roleId string) ([]string, error)
+	GetMenuIds(ctx context.Context, roleId string) ([]string, error)
+	GetDeptIds(ctx context.Context, roleId string) ([]string, error)
+
+	// GetRecycleListPage 分页查询回收站
+	GetRecycleListPage(ctx context.Context, filter domainSystem.RecycleFilter) ([]domainSystem.Role, int64, error)
+	// Restore 从回收站恢复角色，删除时保留的用户、权限、菜单与数据范围随之恢复
+	Restore(ctx context.Context, id string) error
+	// Purge 彻底删除回收站中的角色及其关联关系
+	Purge(ctx context.Context, ids []string) error
+}
+
+type roleService struct {
+	repo repositorySystem.RoleRepository
+}
+
+func NewRoleService(repo repositorySystem.RoleRepository) RoleService {
+	return &roleService{
+		repo: repo,
+	}
+}
+
+// Create 创建
+func (svc *roleService) Create(ctx context.Context, domain domainSystem.Role) error {
+	if err := svc.checkUnique(ctx, domain.Name, domain.Code, ""); err != nil {
+		return err
+	}
+	if domain.DataScope == 0 {
+		domain.DataScope = role.DataScopeConstAll
+	}
+
+	if _, err := svc.repo.Create(ctx, domain); err != nil {
+		if svc.IsDuplicateEntryError(err) {
+			return repositorySystem.ErrRoleCodeDuplicate
+		}
+		return err
+	}
+	return nil
+}
+
+// Delete 删除
+func (svc *roleService) Delete(ctx context.Context, id string) error {
+	return svc.repo.Delete(ctx, id)
+}
+
+// BatchDelete 批量删除
+func (svc *roleService) BatchDelete(ctx context.Context, ids []string) error {
+	return svc.repo.BatchDelete(ctx, ids)
+}
+
+// Update 更新
+func (svc *roleService) Update(ctx context.Context, domain domainSystem.Role) error {
+	if err := svc.checkUnique(ctx, domain.Name, domain.Code, domain.Id); err != nil {
+		return err
+	}
+	if domain.DataScope == 0 {
+		domain.DataScope = role.DataScopeConstAll
+	}
+
+	err := svc.repo.Update(ctx, domain)
+
+	switch {
+	case err == nil:
+		return err
+	case errors.Is(err, repositorySystem.ErrRoleNotFound):
+		return repositorySystem.ErrRoleNotFound
+	case errors.Is(err, repositorySystem.ErrRoleVersionInconsistency):
+		return repositorySystem.ErrRoleVersionInconsistency
+	case svc.IsDuplicateEntryError(err):
+		return repositorySystem.ErrRoleCodeDuplicate
+	default:
+		return err
+	}
+}
+
+// AssignPermissions 分配角色接口权限
+func (svc *roleService) AssignPermissions(ctx context.Context, roleId string, permissionIds []string) error {
+	return svc.repo.SetPermissions(ctx, roleId, distinct(permissionIds))
+}
+
+// AssignUserRoles 分配用户角色
+func (svc *roleService) AssignUserRoles(ctx context.Context, userId string, roleIds []string) error {
+	return svc.repo.SetUserRoles(ctx, userId, distinct(roleIds))
+}
+
+// AssignMenus 分配角色菜单
+func (svc *roleService) AssignMenus(ctx context.Context, roleId string, menuIds []string) error {
+	return svc.repo.SetMenus(ctx, roleId, distinct(menuIds))
+}
+
+// AssignDepts 分配角色自定义数据范围部门
+func (svc *roleService) AssignDepts(ctx context.Context, roleId string, deptIds []string) error {
+	return svc.repo.SetDepts(ctx, roleId, distinct(deptIds))
+}
+
+// GetById 获取详情
+func (svc *roleService) GetById(ctx context.Context, id string) (domainSystem.Role, error) {
+	domain, err := svc.repo.GetById(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositorySystem.ErrRoleNotFound) {
+			return domainSystem.Role{}, repositorySystem.ErrRoleNotFound
+		}
+		return domainSystem.Role{}, err
+	}
+	if domain.Id == "" {
+		return domainSystem.Role{}, repositorySystem.ErrRoleNotFound
+	}
+	return domain, nil
+}
+
+// GetListPage 分页查询列表
+func (svc *roleService) GetListPage(ctx context.Context, filter domainSystem.RoleFilter) ([]domainSystem.Role, int64, error) {
+	return svc.repo.GetListPage(ctx, filter)
+}
+
+// GetListAll 查询所有列表
+func (svc *roleService) GetListAll(ctx context.Context, filter domainSystem.RoleFilter) ([]domainSystem.Role, error) {
+	return svc.repo.GetListAll(ctx, filter)
+}
+
+// GetByUserId 查询用户角色
+func (svc *roleService) GetByUserId(ctx context.Context, userId string) ([]domainSystem.Role, error) {
+	return svc.repo.GetByUserId(ctx, userId)
+}
+
+// GetPermissionIds 查询角色接口权限ID
+func (svc *roleService) GetPermissionIds(ctx context.Context, roleId string) ([]string, error) {
+	return svc.repo.GetPermissionIds(ctx, roleId)
+}
+
+// GetMenuIds 查询角色菜单ID
+func (svc *roleService) GetMenuIds(ctx context.Context, roleId string) ([]string, error) {
+	return svc.repo.GetMenuIds(ctx, roleId)
+}
+
+// GetDeptIds 查询角色自定义数据范围部门ID
+func (svc *roleService) GetDeptIds(ctx context.Context, roleId string) ([]string, error) {
+	return svc.repo.GetDeptIds(ctx, roleId)
+}
+
+// GetRecycleListPage 分页查询回收站
+func (svc *roleService) GetRecycleListPage(ctx context.Context, filter domainSystem.RecycleFilter) ([]domainSystem.Role, int64, error) {
+	return svc.repo.GetRecycleListPage(ctx, filter)
+}
+
+// Restore 恢复
+// 删除期间可能已新建同名或同编码的角色，恢复前重新校验唯一性
+func (svc *roleService) Restore(ctx context.Context, id string) error {
+	deleted, err := svc.repo.GetDeletedById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := svc.checkUnique(ctx, deleted.Name, deleted.Code, ""); err != nil {
+		return err
+	}
+
+	if err := svc.repo.Restore(ctx, deleted); err != nil {
+		// 并发创建时仍可能触发唯一索引冲突
+		if svc.IsDuplicateEntryError(err) {
+			return repositorySystem.ErrRoleCodeDuplicate
+		}
+		return err
+	}
+	return nil
+}
+
+// Purge 彻底删除
+func (svc *roleService) Purge(ctx context.Context, ids []string) error {
+	_, err := svc.repo.Purge(ctx, ids)
+	return err
+}
+
+// checkUnique 校验名称与编码唯一
+func (svc *roleService) checkUnique(ctx context.Context, name, code, excludeId string) error {
+	exists, err := svc.repo.CheckExistByName(ctx, name, excludeId)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return repositorySystem.ErrRoleNameDuplicate
+	}
+
+	exists, err = svc.repo.CheckExistByCode(ctx, code, excludeId)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return repositorySystem.ErrRoleCodeDuplicate
+	}
+	return nil
+}
+
+// distinct 去重
+func distinct(ids []string) []string {
+	seen := make(map[string]struct{}, len(ids))
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		result = append(result, id)
+	}
+	return result
+}
+
+// IsDuplicateEntryError 判断是否是唯一冲突错误
+func (svc *roleService) IsDuplicateEntryError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		// MySQL 错误码 1062 表示唯一冲突
+		return mysqlErr.Number == 1062
+	}
+	return false
+}
@@ -0,0 +1,203 @@
+/**
+ * Description：
+ * FileName：role_test.go
+ * Author：CJiaの用心
+ * Create：2025/10/27 16:22:45
+ * Remark：
+ */
+
+package system
+
+import (
+	"context"
+	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/system"
+	repomocks "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/mocks"
+	repositorySystem "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/system/role"
+	"github.com/go-sql-driver/mysql"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_roleService_Create(t *testing.T) {
+	domain := domainSystem.Role{
+		Role: system.Role{
+			Name:      "管理员",
+			Code:      "admin",
+			DataScope: role.DataScopeConstAll,
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		mock    func(ctrl *gomock.Controller) repositorySystem.RoleRepository
+		wantErr error
+	}{
+		{
+			name: "创建成功",
+			mock: func(ctrl *gomock.Controller) repositorySystem.RoleRepository {
+				repo := repomocks.NewMockRoleRepository(ctrl)
+				repo.EXPECT().CheckExistByName(gomock.Any(), "管理员", "").Return(false, nil)
+				repo.EXPECT().CheckExistByCode(gomock.Any(), "admin", "").Return(false, nil)
+				repo.EXPECT().Create(gomock.Any(), domain).Return(domain, nil)
+				return repo
+			},
+			wantErr: nil,
+		},
+		{
+			name: "角色名称已存在",
+			mock: func(ctrl *gomock.Controller) repositorySystem.RoleRepository {
+				repo := repomocks.NewMockRoleRepository(ctrl)
+				repo.EXPECT().CheckExistByName(gomock.Any(), "管理员", "").Return(true, nil)
+				return repo
+			},
+			wantErr: ErrRoleNameDuplicate,
+		},
+		{
+			name: "角色编码已存在",
+			mock: func(ctrl *gomock.Controller) repositorySystem.RoleRepository {
+				repo := repomocks.NewMockRoleRepository(ctrl)
+				repo.EXPECT().CheckExistByName(gomock.Any(), "管理员", "").Return(false, nil)
+				repo.EXPECT().CheckExistByCode(gomock.Any(), "admin", "").Return(true, nil)
+				return repo
+			},
+			wantErr: ErrRoleCodeDuplicate,
+		},
+		{
+			name: "并发插入唯一冲突",
+			mock: func(ctrl *gomock.Controller) repositorySystem.RoleRepository {
+				repo := repomocks.NewMockRoleRepository(ctrl)
+				repo.EXPECT().CheckExistByName(gomock.Any(), "管理员", "").Return(false, nil)
+				repo.EXPECT().CheckExistByCode(gomock.Any(), "admin", "").Return(false, nil)
+				repo.EXPECT().Create(gomock.Any(), domain).
+					Return(domainSystem.Role{}, &mysql.MySQLError{Number: 1062})
+				return repo
+			},
+			wantErr: ErrRoleCodeDuplicate,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			roleSvc := NewRoleService(tc.mock(ctrl))
+			err := roleSvc.Create(context.Background(), domain)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func Test_roleService_AssignPermissions(t *testing.T) {
+	testCases := []struct {
+		name          string
+		mock          func(ctrl *gomock.Controller) repositorySystem.RoleRepository
+		permissionIds []string
+		wantErr       error
+	}{
+		{
+			name: "分配成功并去重",
+			mock: func(ctrl *gomock.Controller) repositorySystem.RoleRepository {
+				repo := repomocks.NewMockRoleRepository(ctrl)
+				repo.EXPECT().SetPermissions(gomock.Any(), "1", []string{"a", "b"}).Return(nil)
+				return repo
+			},
+			permissionIds: []string{"a", "b", "a"},
+			wantErr:       nil,
+		},
+		{
+			name: "接口权限不存在",
+			mock: func(ctrl *gomock.Controller) repositorySystem.RoleRepository {
+				repo := repomocks.NewMockRoleRepository(ctrl)
+				repo.EXPECT().SetPermissions(gomock.Any(), "1", []string{"x"}).Return(ErrRolePermissionNotFound)
+				return repo
+			},
+			permissionIds: []string{"x"},
+			wantErr:       ErrRolePermissionNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			roleSvc := NewRoleService(tc.mock(ctrl))
+			err := roleSvc.AssignPermissions(context.Background(), "1", tc.permissionIds)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func Test_roleService_Restore(t *testing.T) {
+	deleted := domainSystem.Role{
+		Role: system.Role{
+			Name: "管理员",
+			Code: "admin",
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		mock    func(ctrl *gomock.Controller) repositorySystem.RoleRepository
+		wantErr error
+	}{
+		{
+			name: "恢复成功",
+			mock: func(ctrl *gomock.Controller) repositorySystem.RoleRepository {
+				repo := repomocks.NewMockRoleRepository(ctrl)
+				repo.EXPECT().GetDeletedById(gomock.Any(), "1").Return(deleted, nil)
+				repo.EXPECT().CheckExistByName(gomock.Any(), "管理员", "").Return(false, nil)
+				repo.EXPECT().CheckExistByCode(gomock.Any(), "admin", "").Return(false, nil)
+				repo.EXPECT().Restore(gomock.Any(), deleted).Return(nil)
+				return repo
+			},
+			wantErr: nil,
+		},
+		{
+			name: "回收站中不存在",
+			mock: func(ctrl *gomock.Controller) repositorySystem.RoleRepository {
+				repo := repomocks.NewMockRoleRepository(ctrl)
+				repo.EXPECT().GetDeletedById(gomock.Any(), "1").Return(domainSystem.Role{}, ErrRoleNotFound)
+				return repo
+			},
+			wantErr: ErrRoleNotFound,
+		},
+		{
+			name: "删除期间已创建同名角色",
+			mock: func(ctrl *gomock.Controller) repositorySystem.RoleRepository {
+				repo := repomocks.NewMockRoleRepository(ctrl)
+				repo.EXPECT().GetDeletedById(gomock.Any(), "1").Return(deleted, nil)
+				repo.EXPECT().CheckExistByName(gomock.Any(), "管理员", "").Return(true, nil)
+				return repo
+			},
+			wantErr: ErrRoleNameDuplicate,
+		},
+		{
+			name: "并发恢复唯一冲突",
+			mock: func(ctrl *gomock.Controller) repositorySystem.RoleRepository {
+				repo := repomocks.NewMockRoleRepository(ctrl)
+				repo.EXPECT().GetDeletedById(gomock.Any(), "1").Return(deleted, nil)
+				repo.EXPECT().CheckExistByName(gomock.Any(), "管理员", "").Return(false, nil)
+				repo.EXPECT().CheckExistByCode(gomock.Any(), "admin", "").Return(false, nil)
+				repo.EXPECT().Restore(gomock.Any(), deleted).Return(&mysql.MySQLError{Number: 1062})
+				return repo
+			},
+			wantErr: ErrRoleCodeDuplicate,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			roleSvc := NewRoleService(tc.mock(ctrl))
+			err := roleSvc.Restore(context.Background(), "1")
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
@@ -13,27 +13,56 @@ import (
 	"errors"
 	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
 	repositorySystem "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/system"
+	"github.com/go-sql-driver/mysql"
+	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
 )
 
 var (
-	ErrUserNotFound          = repositorySystem.ErrUserNotFound
-	ErrUserInvalidCredential = errors.New("用户名/密码错误")
-	ErrUserHasBeen           = errors.New("用户已被禁用")
+	ErrUserNotFound             = repositorySystem.ErrUserNotFound
+	ErrUserUsernameDuplicate    = repositorySystem.ErrUserUsernameDuplicate
+	ErrUserVersionInconsistency = repositorySystem.ErrUserVersionInconsistency
+	ErrUserInvalidCredential    = errors.New("用户名/密码错误")
+	ErrUserHasBeen              = errors.New("用户已被禁用")
+	ErrUserDeptNotFound         = repositorySystem.ErrUserDeptNotFound
+	ErrUserOperateSelf          = errors.New("不能对当前登录用户执行该操作")
 )
 
 type UserService interface {
 	Login(ctx context.Context, username, password string) (domainSystem.User, error)
+	Create(ctx context.Context, domain domainSystem.User) error
+	Delete(ctx context.Context, operatorId, id string) error
+	BatchDelete(ctx context.Context, operatorId string, ids []string) error
+	Update(ctx context.Context, domain domainSystem.User) error
+	UpdateStatus(ctx context.Context, operatorId, id string, status bool) error
+	ResetPassword(ctx context.Context, operatorId, id, password string) error
+
 	GetById(ctx context.Context, id string) (domainSystem.User, error)
+	GetListPage(ctx context.Context, filter domainSystem.UserFilter) ([]domainSystem.User, int64, error)
+	GetListAll(ctx context.Context, filter domainSystem.UserFilter) ([]domainSystem.User, error)
+
+	// GetRecycleListPage 分页查询回收站
+	GetRecycleListPage(ctx context.Context, filter domainSystem.RecycleFilter) ([]domainSystem.User, int64, error)
+	// Restore 从回收站恢复用户，删除时保留的角色随之恢复
+	Restore(ctx context.Context, id string) error
+	// Purge 彻底删除回收站中的用户
+	Purge(ctx context.Context, ids []string) error
+}
+
+// SessionRevoker 注销用户全部登录会话，由 jwt.RefreshTokenManager 实现
+type SessionRevoker interface {
+	RevokeUser(ctx context.Context, userId string, exceptIds ...string) (int, error)
 }
 
 type userService struct {
-	repo repositorySystem.UserRepository
+	repo     repositorySystem.UserRepository
+	sessions SessionRevoker
 }
 
-func NewUserService(repo repositorySystem.UserRepository) UserService {
+func NewUserService(repo repositorySystem.UserRepository, sessions SessionRevoker) UserService {
 	return &userService{
-		repo: repo,
+		repo:     repo,
+		sessions: sessions,
 	}
 }
 
@@ -48,20 +77,115 @@ func (svc *userService) Login(ctx context.Context, username, password string) (d
 		return domainSystem.User{}, err
 	}
 
-	// 验证密码
+	// 验证密码，失败时仍返回用户以便登录日志归属到用户及其部门
 	err = bcrypt.CompareHashAndPassword([]byte(domain.Password), []byte(password))
 	if err != nil {
-		return domainSystem.User{}, ErrUserInvalidCredential
+		return domain, ErrUserInvalidCredential
 	}
 
 	// 检查用户状态
 	if !domain.Status {
-		return domainSystem.User{}, ErrUserHasBeen
+		return domain, ErrUserHasBeen
 	}
 
 	return domain, nil
 }
 
+// Create 创建
+func (svc *userService) Create(ctx context.Context, domain domainSystem.User) error {
+	exists, err := svc.repo.CheckExistByUsername(ctx, domain.Username, "")
+	if err != nil {
+		return err
+	}
+	if exists {
+		return repositorySystem.ErrUserUsernameDuplicate
+	}
+
+	// 密码加密
+	hash, err := bcrypt.GenerateFromPassword([]byte(domain.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	domain.Password = string(hash)
+
+	if _, err := svc.repo.Create(ctx, domain); err != nil {
+		return svc.convertMysqlError(err)
+	}
+
+	return nil
+}
+
+// Delete 删除
+func (svc *userService) Delete(ctx context.Context, operatorId, id string) error {
+	if operatorId == id {
+		return ErrUserOperateSelf
+	}
+	if err := svc.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	svc.revokeSessions(ctx, id)
+	return nil
+}
+
+// BatchDelete 批量删除
+func (svc *userService) BatchDelete(ctx context.Context, operatorId string, ids []string) error {
+	for _, id := range ids {
+		if operatorId == id {
+			return ErrUserOperateSelf
+		}
+	}
+	if err := svc.repo.BatchDelete(ctx, ids); err != nil {
+		return err
+	}
+	svc.revokeSessions(ctx, ids...)
+	return nil
+}
+
+// Update 更新
+func (svc *userService) Update(ctx context.Context, domain domainSystem.User) error {
+	err := svc.repo.Update(ctx, domain)
+
+	switch {
+	case err == nil:
+		return err
+	case errors.Is(err, repositorySystem.ErrUserNotFound):
+		return repositorySystem.ErrUserNotFound
+	case errors.Is(err, repositorySystem.ErrUserVersionInconsistency):
+		return repositorySystem.ErrUserVersionInconsistency
+	default:
+		return svc.convertMysqlError(err)
+	}
+}
+
+// UpdateStatus 启用/停用
+func (svc *userService) UpdateStatus(ctx context.Context, operatorId, id string, status bool) error {
+	if operatorId == id && !status {
+		return ErrUserOperateSelf
+	}
+	if err := svc.repo.UpdateStatus(ctx, id, status, operatorId); err != nil {
+		return err
+	}
+	// 停用后注销其全部会话
+	if !status {
+		svc.revokeSessions(ctx, id)
+	}
+	return nil
+}
+
+// ResetPassword 管理员重置密码
+func (svc *userService) ResetPassword(ctx context.Context, operatorId, id, password string) error {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	if err := svc.repo.UpdatePassword(ctx, id, string(hash), operatorId); err != nil {
+		return err
+	}
+	// 重置密码后需重新登录
+	svc.revokeSessions(ctx, id)
+	return nil
+}
+
 // GetById 获取详情
 func (svc *userService) GetById(ctx context.Context, id string) (domainSystem.User, error) {
 	domain, err := svc.repo.GetById(ctx, id)
@@ -76,3 +200,70 @@ func (svc *userService) GetById(ctx context.Context, id string) (domainSystem.Us
 	}
 	return domain, nil
 }
+
+// GetListPage 分页查询列表
+func (svc *userService) GetListPage(ctx context.Context, filter domainSystem.UserFilter) ([]domainSystem.User, int64, error) {
+	return svc.repo.GetListPage(ctx, filter)
+}
+
+// GetListAll 查询所有列表
+func (svc *userService) GetListAll(ctx context.Context, filter domainSystem.UserFilter) ([]domainSystem.User, error) {
+	return svc.repo.GetListAll(ctx, filter)
+}
+
+// GetRecycleListPage 分页查询回收站
+func (svc *userService) GetRecycleListPage(ctx context.Context, filter domainSystem.RecycleFilter) ([]domainSystem.User, int64, error) {
+	return svc.repo.GetRecycleListPage(ctx, filter)
+}
+
+// Restore 恢复
+// 删除期间可能已新建同名用户，恢复前重新校验唯一性
+func (svc *userService) Restore(ctx context.Context, id string) error {
+	deleted, err := svc.repo.GetDeletedById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	exists, err := svc.repo.CheckExistByUsername(ctx, deleted.Username, "")
+	if err != nil {
+		return err
+	}
+	if exists {
+		return repositorySystem.ErrUserUsernameDuplicate
+	}
+
+	if err := svc.repo.Restore(ctx, deleted); err != nil {
+		// 并发创建时仍可能触发唯一索引冲突
+		return svc.convertMysqlError(err)
+	}
+	return nil
+}
+
+// Purge 彻底删除
+func (svc *userService) Purge(ctx context.Context, ids []string) error {
+	_, err := svc.repo.Purge(ctx, ids)
+	return err
+}
+
+// revokeSessions 注销用户会话，失败不影响主流程
+func (svc *userService) revokeSessions(ctx context.Context, userIds ...string) {
+	for _, userId := range userIds {
+		if _, err := svc.sessions.RevokeUser(ctx, userId); err != nil {
+			zap.L().Error("注销用户会话失败", zap.String("userId", userId), zap.Error(err))
+		}
+	}
+}
+
+// convertMysqlError 转换mysql约束错误
+func (svc *userService) convertMysqlError(err error) error {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		switch mysqlErr.Number {
+		case 1062: // 唯一冲突
+			return repositorySystem.ErrUserUsernameDuplicate
+		case 1452: // 外键约束失败
+			return ErrUserDeptNotFound
+		}
+	}
+	return err
+}
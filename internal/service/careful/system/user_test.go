@@ -0,0 +1,414 @@
+/**
+ * Description：
+ * FileName：user_test.go
+ * Author：CJiaの用心
+ * Create：2025/10/25 11:02:47
+ * Remark：
+ */
+
+package system
+
+import (
+	"context"
+	"errors"
+	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/system"
+	repomocks "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/mocks"
+	repositorySystem "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	"github.com/go-sql-driver/mysql"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+	"testing"
+)
+
+func Test_userService_Create(t *testing.T) {
+	testCases := []struct {
+		name    string
+		mock    func(ctrl *gomock.Controller) repositorySystem.UserRepository
+		domain  domainSystem.User
+		wantErr error
+	}{
+		{
+			name: "创建成功",
+			mock: func(ctrl *gomock.Controller) repositorySystem.UserRepository {
+				repo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().CheckExistByUsername(gomock.Any(), "careful", "").
+					Return(false, nil)
+				repo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, domain domainSystem.User) (domainSystem.User, error) {
+						// 密码需加密存储
+						assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(domain.Password), []byte("123456")))
+						return domain, nil
+					})
+				return repo
+			},
+			domain: domainSystem.User{
+				User: system.User{
+					Username: "careful",
+					Password: "123456",
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "用户名已存在",
+			mock: func(ctrl *gomock.Controller) repositorySystem.UserRepository {
+				repo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().CheckExistByUsername(gomock.Any(), "careful", "").
+					Return(true, nil)
+				return repo
+			},
+			domain: domainSystem.User{
+				User: system.User{
+					Username: "careful",
+					Password: "123456",
+				},
+			},
+			wantErr: ErrUserUsernameDuplicate,
+		},
+		{
+			name: "部门不存在",
+			mock: func(ctrl *gomock.Controller) repositorySystem.UserRepository {
+				repo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().CheckExistByUsername(gomock.Any(), "careful", "").
+					Return(false, nil)
+				repo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					Return(domainSystem.User{}, &mysql.MySQLError{Number: 1452})
+				return repo
+			},
+			domain: domainSystem.User{
+				User: system.User{
+					Username: "careful",
+					Password: "123456",
+				},
+				DeptId: "1",
+			},
+			wantErr: ErrUserDeptNotFound,
+		},
+		{
+			name: "数据库异常",
+			mock: func(ctrl *gomock.Controller) repositorySystem.UserRepository {
+				repo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().CheckExistByUsername(gomock.Any(), "careful", "").
+					Return(false, errors.New("数据库异常"))
+				return repo
+			},
+			domain: domainSystem.User{
+				User: system.User{
+					Username: "careful",
+					Password: "123456",
+				},
+			},
+			wantErr: errors.New("数据库异常"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userSvc := NewUserService(tc.mock(ctrl), &recordSessionRevoker{})
+			err := userSvc.Create(context.Background(), tc.domain)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func Test_userService_Delete(t *testing.T) {
+	testCases := []struct {
+		name       string
+		mock       func(ctrl *gomock.Controller) repositorySystem.UserRepository
+		operatorId string
+		id         string
+		wantErr    error
+	}{
+		{
+			name: "删除成功",
+			mock: func(ctrl *gomock.Controller) repositorySystem.UserRepository {
+				repo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().Delete(gomock.Any(), "2").Return(nil)
+				return repo
+			},
+			operatorId: "1",
+			id:         "2",
+			wantErr:    nil,
+		},
+		{
+			name: "不能删除自己",
+			mock: func(ctrl *gomock.Controller) repositorySystem.UserRepository {
+				return repomocks.NewMockUserRepository(ctrl)
+			},
+			operatorId: "1",
+			id:         "1",
+			wantErr:    ErrUserOperateSelf,
+		},
+		{
+			name: "用户不存在",
+			mock: func(ctrl *gomock.Controller) repositorySystem.UserRepository {
+				repo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().Delete(gomock.Any(), "2").Return(ErrUserNotFound)
+				return repo
+			},
+			operatorId: "1",
+			id:         "2",
+			wantErr:    ErrUserNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sessions := &recordSessionRevoker{}
+			userSvc := NewUserService(tc.mock(ctrl), sessions)
+			err := userSvc.Delete(context.Background(), tc.operatorId, tc.id)
+			assert.Equal(t, tc.wantErr, err)
+			// 删除成功后注销其会话
+			if tc.wantErr == nil {
+				assert.Equal(t, []string{tc.id}, sessions.revoked)
+			} else {
+				assert.Empty(t, sessions.revoked)
+			}
+		})
+	}
+}
+
+func Test_userService_BatchDelete(t *testing.T) {
+	testCases := []struct {
+		name       string
+		mock       func(ctrl *gomock.Controller) repositorySystem.UserRepository
+		operatorId string
+		ids        []string
+		wantErr    error
+	}{
+		{
+			name: "批量删除成功",
+			mock: func(ctrl *gomock.Controller) repositorySystem.UserRepository {
+				repo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().BatchDelete(gomock.Any(), []string{"2", "3"}).Return(nil)
+				return repo
+			},
+			operatorId: "1",
+			ids:        []string{"2", "3"},
+			wantErr:    nil,
+		},
+		{
+			name: "包含当前用户",
+			mock: func(ctrl *gomock.Controller) repositorySystem.UserRepository {
+				return repomocks.NewMockUserRepository(ctrl)
+			},
+			operatorId: "1",
+			ids:        []string{"1", "2"},
+			wantErr:    ErrUserOperateSelf,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userSvc := NewUserService(tc.mock(ctrl), &recordSessionRevoker{})
+			err := userSvc.BatchDelete(context.Background(), tc.operatorId, tc.ids)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func Test_userService_Update(t *testing.T) {
+	domain := domainSystem.User{
+		User: system.User{
+			CoreModels: models.CoreModels{
+				Id:        "1",
+				Timestamp: 1,
+			},
+			Name: "用心",
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		mock    func(ctrl *gomock.Controller) repositorySystem.UserRepository
+		wantErr error
+	}{
+		{
+			name: "更新成功",
+			mock: func(ctrl *gomock.Controller) repositorySystem.UserRepository {
+				repo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().Update(gomock.Any(), domain).Return(nil)
+				return repo
+			},
+			wantErr: nil,
+		},
+		{
+			name: "数据版本不一致",
+			mock: func(ctrl *gomock.Controller) repositorySystem.UserRepository {
+				repo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().Update(gomock.Any(), domain).Return(ErrUserVersionInconsistency)
+				return repo
+			},
+			wantErr: ErrUserVersionInconsistency,
+		},
+		{
+			name: "用户不存在",
+			mock: func(ctrl *gomock.Controller) repositorySystem.UserRepository {
+				repo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().Update(gomock.Any(), domain).Return(ErrUserNotFound)
+				return repo
+			},
+			wantErr: ErrUserNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userSvc := NewUserService(tc.mock(ctrl), &recordSessionRevoker{})
+			err := userSvc.Update(context.Background(), domain)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func Test_userService_UpdateStatus(t *testing.T) {
+	testCases := []struct {
+		name       string
+		mock       func(ctrl *gomock.Controller) repositorySystem.UserRepository
+		operatorId string
+		id         string
+		status     bool
+		wantErr    error
+	}{
+		{
+			name: "停用成功",
+			mock: func(ctrl *gomock.Controller) repositorySystem.UserRepository {
+				repo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().UpdateStatus(gomock.Any(), "2", false, "1").Return(nil)
+				return repo
+			},
+			operatorId: "1",
+			id:         "2",
+			status:     false,
+			wantErr:    nil,
+		},
+		{
+			name: "不能停用自己",
+			mock: func(ctrl *gomock.Controller) repositorySystem.UserRepository {
+				return repomocks.NewMockUserRepository(ctrl)
+			},
+			operatorId: "1",
+			id:         "1",
+			status:     false,
+			wantErr:    ErrUserOperateSelf,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sessions := &recordSessionRevoker{}
+			userSvc := NewUserService(tc.mock(ctrl), sessions)
+			err := userSvc.UpdateStatus(context.Background(), tc.operatorId, tc.id, tc.status)
+			assert.Equal(t, tc.wantErr, err)
+			// 停用成功后注销其会话
+			if tc.wantErr == nil && !tc.status {
+				assert.Equal(t, []string{tc.id}, sessions.revoked)
+			} else {
+				assert.Empty(t, sessions.revoked)
+			}
+		})
+	}
+}
+
+func Test_userService_ResetPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockUserRepository(ctrl)
+	repo.EXPECT().UpdatePassword(gomock.Any(), "2", gomock.Any(), "1").
+		DoAndReturn(func(ctx context.Context, id, password, modifier string) error {
+			assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(password), []byte("654321")))
+			return nil
+		})
+
+	sessions := &recordSessionRevoker{}
+	userSvc := NewUserService(repo, sessions)
+	err := userSvc.ResetPassword(context.Background(), "1", "2", "654321")
+	assert.NoError(t, err)
+	// 重置密码后注销其会话
+	assert.Equal(t, []string{"2"}, sessions.revoked)
+}
+
+// recordSessionRevoker 记录被注销会话的用户
+type recordSessionRevoker struct {
+	revoked []string
+}
+
+func (r *recordSessionRevoker) RevokeUser(_ context.Context, userId string, _ ...string) (int, error) {
+	r.revoked = append(r.revoked, userId)
+	return 1, nil
+}
+
+func Test_userService_Restore(t *testing.T) {
+	deleted := domainSystem.User{
+		User: system.User{
+			Username: "careful",
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		mock    func(ctrl *gomock.Controller) repositorySystem.UserRepository
+		wantErr error
+	}{
+		{
+			name: "恢复成功",
+			mock: func(ctrl *gomock.Controller) repositorySystem.UserRepository {
+				repo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().GetDeletedById(gomock.Any(), "1").Return(deleted, nil)
+				repo.EXPECT().CheckExistByUsername(gomock.Any(), "careful", "").Return(false, nil)
+				repo.EXPECT().Restore(gomock.Any(), deleted).Return(nil)
+				return repo
+			},
+			wantErr: nil,
+		},
+		{
+			name: "回收站中不存在",
+			mock: func(ctrl *gomock.Controller) repositorySystem.UserRepository {
+				repo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().GetDeletedById(gomock.Any(), "1").Return(domainSystem.User{}, ErrUserNotFound)
+				return repo
+			},
+			wantErr: ErrUserNotFound,
+		},
+		{
+			name: "删除期间已创建同名用户",
+			mock: func(ctrl *gomock.Controller) repositorySystem.UserRepository {
+				repo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().GetDeletedById(gomock.Any(), "1").Return(deleted, nil)
+				repo.EXPECT().CheckExistByUsername(gomock.Any(), "careful", "").Return(true, nil)
+				return repo
+			},
+			wantErr: ErrUserUsernameDuplicate,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userSvc := NewUserService(tc.mock(ctrl), &recordSessionRevoker{})
+			err := userSvc.Restore(context.Background(), "1")
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
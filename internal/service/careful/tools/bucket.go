@@ -0,0 +1,211 @@
+/**
+ * Description：
+ * FileName：bucket.go
+ * Author：CJiaの用心
+ * Create：2025/11/11 17:02:36
+ * Remark：
+ */
+
+package tools
+
+import (
+	"context"
+	"errors"
+	domainTools "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/tools"
+	repositoryTools "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/tools"
+	"github.com/go-sql-driver/mysql"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	ErrBucketNotFound             = repositoryTools.ErrBucketNotFound
+	ErrBucketCodeDuplicate        = repositoryTools.ErrBucketCodeDuplicate
+	ErrBucketNameDuplicate        = repositoryTools.ErrBucketNameDuplicate
+	ErrBucketVersionInconsistency = repositoryTools.ErrBucketVersionInconsistency
+	ErrBucketCodeInvalid          = errors.New("存储桶编码只能包含字母、数字、下划线和中划线，且以字母或数字开头")
+	ErrBucketNotEmpty             = errors.New("存储桶内仍有文件，无法删除")
+	ErrBucketDisabled             = errors.New("存储桶已停用")
+	ErrBucketHasDeletedFiles      = errors.New("回收站中仍有该存储桶的文件，请先彻底删除文件")
+)
+
+// bucketCodePattern 存储桶编码作为文件存储路径的第一级目录
+var bucketCodePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]{0,63}$`)
+
+type BucketService interface {
+	Create(ctx context.Context, domain domainTools.Bucket) error
+	// Delete 删除存储桶，桶内仍有文件时拒绝删除
+	Delete(ctx context.Context, id string) error
+	Update(ctx context.Context, domain domainTools.Bucket) error
+
+	GetById(ctx context.Context, id string) (domainTools.Bucket, error)
+	GetListPage(ctx context.Context, filter domainTools.BucketFilter) ([]domainTools.Bucket, int64, error)
+	GetListAll(ctx context.Context, filter domainTools.BucketFilter) ([]domainTools.Bucket, error)
+
+	// GetRecycleListPage 分页查询回收站
+	GetRecycleListPage(ctx context.Context, filter domainTools.RecycleFilter) ([]domainTools.Bucket, int64, error)
+	// Restore 从回收站恢复存储桶，名称与编码不能与现有存储桶重复
+	Restore(ctx context.Context, id string) error
+	// Purge 彻底删除回收站中的存储桶，回收站中仍有其文件时拒绝删除
+	Purge(ctx context.Context, ids []string) error
+	// PurgeExpired 彻底删除指定时间之前移入回收站且不再被文件引用的存储桶
+	PurgeExpired(ctx context.Context, before time.Time) (int64, error)
+}
+
+type bucketService struct {
+	repo     repositoryTools.BucketRepository
+	fileRepo repositoryTools.FileRepository
+}
+
+func NewBucketService(repo repositoryTools.BucketRepository, fileRepo repositoryTools.FileRepository) BucketService {
+	return &bucketService{
+		repo:     repo,
+		fileRepo: fileRepo,
+	}
+}
+
+// Create 创建
+func (svc *bucketService) Create(ctx context.Context, domain domainTools.Bucket) error {
+	if !bucketCodePattern.MatchString(domain.Code) {
+		return ErrBucketCodeInvalid
+	}
+	if err := svc.checkUnique(ctx, domain, ""); err != nil {
+		return err
+	}
+
+	if _, err := svc.repo.Create(ctx, domain); err != nil {
+		return svc.duplicateError(err)
+	}
+	return nil
+}
+
+// Delete 删除
+func (svc *bucketService) Delete(ctx context.Context, id string) error {
+	if _, err := svc.GetById(ctx, id); err != nil {
+		return err
+	}
+
+	count, err := svc.fileRepo.CountByBucket(ctx, id)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrBucketNotEmpty
+	}
+
+	return svc.repo.Delete(ctx, id)
+}
+
+// Update 更新，编码不可修改
+func (svc *bucketService) Update(ctx context.Context, domain domainTools.Bucket) error {
+	if err := svc.checkUnique(ctx, domain, domain.Id); err != nil {
+		return err
+	}
+
+	if err := svc.repo.Update(ctx, domain); err != nil {
+		return svc.duplicateError(err)
+	}
+	return nil
+}
+
+// GetById 获取详情
+func (svc *bucketService) GetById(ctx context.Context, id string) (domainTools.Bucket, error) {
+	domain, err := svc.repo.GetById(ctx, id)
+	if errors.Is(err, repositoryTools.ErrBucketNotFound) {
+		return domain, ErrBucketNotFound
+	}
+	return domain, err
+}
+
+// GetListPage 分页查询列表
+func (svc *bucketService) GetListPage(ctx context.Context, filter domainTools.BucketFilter) ([]domainTools.Bucket, int64, error) {
+	return svc.repo.GetListPage(ctx, filter)
+}
+
+// GetListAll 查询所有列表
+func (svc *bucketService) GetListAll(ctx context.Context, filter domainTools.BucketFilter) ([]domainTools.Bucket, error) {
+	return svc.repo.GetListAll(ctx, filter)
+}
+
+// GetRecycleListPage 分页查询回收站
+func (svc *bucketService) GetRecycleListPage(ctx context.Context, filter domainTools.RecycleFilter) ([]domainTools.Bucket, int64, error) {
+	return svc.repo.GetRecycleListPage(ctx, filter)
+}
+
+// Restore 恢复
+func (svc *bucketService) Restore(ctx context.Context, id string) error {
+	deleted, err := svc.repo.GetDeletedById(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := svc.checkUnique(ctx, deleted, ""); err != nil {
+		return err
+	}
+
+	if err := svc.repo.Restore(ctx, deleted); err != nil {
+		return svc.duplicateError(err)
+	}
+	return nil
+}
+
+// Purge 彻底删除
+// 回收站中的文件仍引用存储桶，需先彻底删除文件
+func (svc *bucketService) Purge(ctx context.Context, ids []string) error {
+	for _, id := range ids {
+		count, err := svc.fileRepo.CountAllByBucket(ctx, id)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrBucketHasDeletedFiles
+		}
+	}
+
+	_, err := svc.repo.Purge(ctx, ids)
+	return err
+}
+
+// PurgeExpired 彻底删除过期的存储桶
+func (svc *bucketService) PurgeExpired(ctx context.Context, before time.Time) (int64, error) {
+	return svc.repo.PurgeExpired(ctx, before)
+}
+
+// checkUnique 检查名称与编码是否已被其他存储桶使用
+func (svc *bucketService) checkUnique(ctx context.Context, domain domainTools.Bucket, excludeId string) error {
+	if excludeId == "" {
+		exists, err := svc.repo.CheckExistByCode(ctx, domain.Code, excludeId)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return ErrBucketCodeDuplicate
+		}
+	}
+
+	exists, err := svc.repo.CheckExistByName(ctx, domain.Name, excludeId)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return ErrBucketNameDuplicate
+	}
+	return nil
+}
+
+// duplicateError 并发写入时唯一索引冲突转换为业务错误
+func (svc *bucketService) duplicateError(err error) error {
+	var mysqlErr *mysql.MySQLError
+	// MySQL 错误码 1062 表示唯一冲突
+	if !errors.As(err, &mysqlErr) || mysqlErr.Number != 1062 {
+		return err
+	}
+
+	// 分析错误消息中的索引名
+	switch {
+	case strings.Contains(mysqlErr.Message, "uni_careful_tools_bucket_name"):
+		return ErrBucketNameDuplicate
+	default:
+		return ErrBucketCodeDuplicate
+	}
+}
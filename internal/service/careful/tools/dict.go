@@ -11,18 +11,12 @@ package tools
 import (
 	"context"
 	"errors"
-	"fmt"
 	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
 	domainTools "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/tools"
-	modelTools "github.com/carefuly/careful-admin-go-gin/internal/model/careful/tools"
 	repositoryTools "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/tools"
-	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/tools/dict"
-	"github.com/carefuly/careful-admin-go-gin/pkg/models"
 	_import "github.com/carefuly/careful-admin-go-gin/pkg/utils/common/import"
-	_string "github.com/carefuly/careful-admin-go-gin/pkg/utils/common/string"
-	"github.com/carefuly/careful-admin-go-gin/pkg/utils/enumconv"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/excelutil"
 	"github.com/go-sql-driver/mysql"
-	"strconv"
 	"strings"
 )
 
@@ -36,7 +30,7 @@ var (
 
 type DictService interface {
 	Create(ctx context.Context, domain domainTools.Dict) error
-	Import(ctx context.Context, user domainSystem.User, listMap []map[string]string) _import.ImportResult
+	Import(ctx context.Context, user domainSystem.User, listMap []map[string]string, mode _import.Mode) _import.ImportResult
 	Delete(ctx context.Context, id string) error
 	BatchDelete(ctx context.Context, ids []string) error
 	Update(ctx context.Context, domain domainTools.Dict) error
@@ -45,15 +39,24 @@ type DictService interface {
 	GetByName(ctx context.Context, name string) (domainTools.Dict, error)
 	GetListPage(ctx context.Context, filters domainTools.DictFilter) ([]domainTools.Dict, int64, error)
 	GetListAll(ctx context.Context, filters domainTools.DictFilter) ([]domainTools.Dict, error)
+
+	// GetRecycleListPage 分页查询回收站
+	GetRecycleListPage(ctx context.Context, filter domainTools.RecycleFilter) ([]domainTools.Dict, int64, error)
+	// Restore 从回收站恢复字典及随其一同删除的字典项
+	Restore(ctx context.Context, id string) error
+	// Purge 彻底删除回收站中的字典
+	Purge(ctx context.Context, ids []string) error
 }
 
 type dictService struct {
-	repo repositoryTools.DictRepository
+	repo         repositoryTools.DictRepository
+	dictTypeRepo repositoryTools.DictTypeRepository
 }
 
-func NewDictService(repo repositoryTools.DictRepository) DictService {
+func NewDictService(repo repositoryTools.DictRepository, dictTypeRepo repositoryTools.DictTypeRepository) DictService {
 	return &dictService{
-		repo: repo,
+		repo:         repo,
+		dictTypeRepo: dictTypeRepo,
 	}
 }
 
@@ -97,109 +100,88 @@ func (svc *dictService) Create(ctx context.Context, domain domainTools.Dict) err
 }
 
 // Import 导入
-func (svc *dictService) Import(ctx context.Context, user domainSystem.User, listMap []map[string]string) _import.ImportResult {
-	result := _import.ImportResult{}
-
-	// 遍历数据
-	for index, list := range listMap {
-		rowNumber := index + 2
-
-		// 数据清洗
-		name := _string.CleanInputString(list["字典名称"])
-		code := _string.CleanInputString(list["字典编码"])
-
-		// 字段校验
-		if name == "" {
-			result.AddError(rowNumber, "【字典名称】不能为空")
-			continue
-		}
-		if code == "" {
-			result.AddError(rowNumber, "【字典编码】不能为空")
-			continue
-		}
-
-		// 唯一性校验
-		exists, err := svc.repo.CheckExistByName(ctx, name, "")
-		if err != nil {
-			result.AddError(rowNumber, fmt.Sprintf("检查【字典名称：%s】唯一性失败：%s", name, err.Error()))
-			continue
-		}
-		if exists {
-			result.AddError(rowNumber, fmt.Sprintf("字典名称【%s】已存在", name))
-			continue
-		}
-		exists, err = svc.repo.CheckExistByCode(ctx, code, "")
-		if err != nil {
-			result.AddError(rowNumber, fmt.Sprintf("检查【字典编码：%s】唯一性失败：%s", code, err.Error()))
-			continue
-		}
-		if exists {
-			result.AddError(rowNumber, fmt.Sprintf("字典编码【%s】已存在", code))
-			continue
-		}
-
-		// 类型转换
-		typeValidValues := []string{"普通字典", "系统字典", "枚举字典"}
-		converter := enumconv.NewEnumConverter(dict.TypeMapping, dict.TypeImportMapping, typeValidValues, "字典分类")
-		dictType, err := converter.ToEnum(list["字典类型"])
-		if err != nil {
-			result.AddError(rowNumber, fmt.Sprintf("【字典类型】转换失败：%s", err.Error()))
-			continue
-		}
-		valueTypeValidValues := []string{"字符串", "整型", "布尔"}
-		valueTypeConverter := enumconv.NewEnumConverter(dict.TypeValueMapping, dict.TypeValueImportMapping, valueTypeValidValues, "字典值类型")
-		valueType, err := valueTypeConverter.ToEnum(list["字典类型值"])
-		if err != nil {
-			result.AddError(rowNumber, fmt.Sprintf("【字典类型值】转换失败：%s", err.Error()))
-			continue
-		}
-
-		// 处理字段
-		var sort int
-		if list["排序"] == "" {
-			sort = 1
-		} else {
-			sort, _ = strconv.Atoi(list["排序"])
-		}
+// 先完成全部行的字段与唯一性校验(文件内重复、库内已存在)，再按导入模式批量写入
+func (svc *dictService) Import(ctx context.Context, user domainSystem.User, listMap []map[string]string, mode _import.Mode) _import.ImportResult {
+	importer := _import.NewImporter(_import.Config[domainTools.Dict]{
+		Parse: func(ctx context.Context, list map[string]string) (domainTools.Dict, error) {
+			// 按 excel 标签校验并转换字段
+			var domain domainTools.Dict
+			if err := excelutil.Bind(list, &domain); err != nil {
+				return domainTools.Dict{}, err
+			}
 
-		// 构建领域模型
-		domain := domainTools.Dict{
-			Dict: modelTools.Dict{
-				CoreModels: models.CoreModels{
-					Creator:    user.Id,
-					Modifier:   user.Id,
-					BelongDept: user.DeptId,
-					Sort:       sort,
-					Remark:     list["备注"],
-				},
-				Status:    true,
-				Name:      name,
-				Code:      code,
-				Type:      dictType,
-				ValueType: valueType,
+			domain.Creator = user.Id
+			domain.Modifier = user.Id
+			domain.BelongDept = user.DeptId
+			domain.Status = true
+			return domain, nil
+		},
+		Unique: []_import.UniqueKey[domainTools.Dict]{
+			{
+				Title:  "字典名称",
+				Value:  func(d domainTools.Dict) string { return d.Name },
+				Exists: svc.repo.GetExistingNames,
 			},
-		}
-
-		// 创建记录
-		if _, err = svc.repo.Create(ctx, domain); err != nil {
-			result.AddError(rowNumber, "创建失败："+err.Error())
-			continue
-		}
-
-		result.SuccessCount++
-	}
+			{
+				Title:  "字典编码",
+				Value:  func(d domainTools.Dict) string { return d.Code },
+				Exists: svc.repo.GetExistingCodes,
+			},
+		},
+		Insert: func(ctx context.Context, items []domainTools.Dict) error {
+			if err := svc.repo.BatchCreate(ctx, items); err != nil {
+				// 并发导入时仍可能触发唯一索引冲突
+				if field, isDuplicate := svc.IsDuplicateEntryError(err); isDuplicate {
+					switch field {
+					case "name":
+						return repositoryTools.ErrDictNameDuplicate
+					case "code":
+						return repositoryTools.ErrDictCodeDuplicate
+					default:
+						return repositoryTools.ErrDictDuplicate
+					}
+				}
+				return err
+			}
+			return nil
+		},
+	})
 
-	return result
+	return importer.Run(ctx, listMap, mode)
 }
 
 // Delete 删除
 func (svc *dictService) Delete(ctx context.Context, id string) error {
-	return svc.repo.Delete(ctx, id)
+	old, err := svc.repo.GetById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := svc.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	// 字典删除后清理其字典选项缓存
+	return svc.dictTypeRepo.DelOptions(ctx, []string{old.Name})
 }
 
 // BatchDelete 批量删除
 func (svc *dictService) BatchDelete(ctx context.Context, ids []string) error {
-	return svc.repo.BatchDelete(ctx, ids)
+	names := make([]string, 0, len(ids))
+	for _, id := range ids {
+		old, err := svc.repo.GetById(ctx, id)
+		if err != nil {
+			return err
+		}
+		names = append(names, old.Name)
+	}
+
+	if err := svc.repo.BatchDelete(ctx, ids); err != nil {
+		return err
+	}
+
+	// 字典删除后清理其字典选项缓存
+	return svc.dictTypeRepo.DelOptions(ctx, names)
 }
 
 // Update 更新
@@ -220,6 +202,11 @@ func (svc *dictService) Update(ctx context.Context, domain domainTools.Dict) err
 		return repositoryTools.ErrDictNameDuplicate
 	}
 
+	old, err := svc.repo.GetById(ctx, domain.Id)
+	if err != nil {
+		return err
+	}
+
 	err = svc.repo.Update(ctx, domain)
 	if err != nil {
 		// 分析具体冲突字段
@@ -244,7 +231,8 @@ func (svc *dictService) Update(ctx context.Context, domain domainTools.Dict) err
 		}
 	}
 
-	return err
+	// 名称或状态变更后旧名称下的选项缓存失效
+	return svc.dictTypeRepo.DelOptions(ctx, []string{old.Name, domain.Name})
 }
 
 // GetById 获取详情
@@ -287,6 +275,60 @@ func (svc *dictService) GetListAll(ctx context.Context, filters domainTools.Dict
 	return svc.repo.GetListAll(ctx, filters)
 }
 
+// GetRecycleListPage 分页查询回收站
+func (svc *dictService) GetRecycleListPage(ctx context.Context, filter domainTools.RecycleFilter) ([]domainTools.Dict, int64, error) {
+	return svc.repo.GetRecycleListPage(ctx, filter)
+}
+
+// Restore 恢复
+// 删除期间可能已新建同名或同编码的字典，恢复前重新校验唯一性
+func (svc *dictService) Restore(ctx context.Context, id string) error {
+	deleted, err := svc.repo.GetDeletedById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	exists, err := svc.repo.CheckExistByName(ctx, deleted.Name, "")
+	if err != nil {
+		return err
+	}
+	if exists {
+		return repositoryTools.ErrDictNameDuplicate
+	}
+
+	exists, err = svc.repo.CheckExistByCode(ctx, deleted.Code, "")
+	if err != nil {
+		return err
+	}
+	if exists {
+		return repositoryTools.ErrDictCodeDuplicate
+	}
+
+	if err := svc.repo.Restore(ctx, deleted); err != nil {
+		// 并发创建时仍可能触发唯一索引冲突
+		if field, isDuplicate := svc.IsDuplicateEntryError(err); isDuplicate {
+			switch field {
+			case "name":
+				return repositoryTools.ErrDictNameDuplicate
+			case "code":
+				return repositoryTools.ErrDictCodeDuplicate
+			default:
+				return repositoryTools.ErrDictDuplicate
+			}
+		}
+		return err
+	}
+
+	// 恢复后字典选项缓存失效
+	return svc.dictTypeRepo.DelOptions(ctx, []string{deleted.Name})
+}
+
+// Purge 彻底删除
+func (svc *dictService) Purge(ctx context.Context, ids []string) error {
+	_, err := svc.repo.Purge(ctx, ids)
+	return err
+}
+
 // IsDuplicateEntryError 分析错误消息中的索引名
 func (svc *dictService) IsDuplicateEntryError(err error) (string, bool) {
 	var mysqlErr *mysql.MySQLError
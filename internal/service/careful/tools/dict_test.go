@@ -16,6 +16,7 @@ import (
 	repomocks "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/mocks"
 	repositoryTools "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/tools"
 	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	"github.com/go-sql-driver/mysql"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"testing"
@@ -122,7 +123,7 @@ func Test_dictService_Create(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			dictSvc := NewDictService(tc.mock(ctrl))
+			dictSvc := NewDictService(tc.mock(ctrl), repomocks.NewMockDictTypeRepository(ctrl))
 			err := dictSvc.Create(context.Background(), tc.domain)
 			assert.Equal(t, tc.wantErr, err)
 		})
@@ -131,18 +132,26 @@ func Test_dictService_Create(t *testing.T) {
 
 func Test_dictService_Delete(t *testing.T) {
 	testCases := []struct {
-		name    string
-		mock    func(ctrl *gomock.Controller) repositoryTools.DictRepository
-		id      string
-		wantErr error
+		name     string
+		mock     func(ctrl *gomock.Controller) repositoryTools.DictRepository
+		typeMock func(ctrl *gomock.Controller) repositoryTools.DictTypeRepository
+		id       string
+		wantErr  error
 	}{
 		{
 			name: "删除成功",
 			mock: func(ctrl *gomock.Controller) repositoryTools.DictRepository {
 				repo := repomocks.NewMockDictRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), "1").
+					Return(domainTools.Dict{Dict: tools.Dict{Name: "字典名称"}}, nil)
 				repo.EXPECT().Delete(gomock.Any(), "1").Return(nil)
 				return repo
 			},
+			typeMock: func(ctrl *gomock.Controller) repositoryTools.DictTypeRepository {
+				repo := repomocks.NewMockDictTypeRepository(ctrl)
+				repo.EXPECT().DelOptions(gomock.Any(), []string{"字典名称"}).Return(nil)
+				return repo
+			},
 			id:      "1",
 			wantErr: nil,
 		},
@@ -150,9 +159,14 @@ func Test_dictService_Delete(t *testing.T) {
 			name: "数据库异常",
 			mock: func(ctrl *gomock.Controller) repositoryTools.DictRepository {
 				repo := repomocks.NewMockDictRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), "1").
+					Return(domainTools.Dict{Dict: tools.Dict{Name: "字典名称"}}, nil)
 				repo.EXPECT().Delete(gomock.Any(), "1").Return(errors.New("数据库异常"))
 				return repo
 			},
+			typeMock: func(ctrl *gomock.Controller) repositoryTools.DictTypeRepository {
+				return repomocks.NewMockDictTypeRepository(ctrl)
+			},
 			id:      "1",
 			wantErr: errors.New("数据库异常"),
 		},
@@ -163,7 +177,7 @@ func Test_dictService_Delete(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			dictSvc := NewDictService(tc.mock(ctrl))
+			dictSvc := NewDictService(tc.mock(ctrl), tc.typeMock(ctrl))
 			err := dictSvc.Delete(context.Background(), tc.id)
 			assert.Equal(t, tc.wantErr, err)
 		})
@@ -172,10 +186,11 @@ func Test_dictService_Delete(t *testing.T) {
 
 func Test_dictService_Update(t *testing.T) {
 	testCases := []struct {
-		name    string
-		mock    func(ctrl *gomock.Controller) repositoryTools.DictRepository
-		domain  domainTools.Dict
-		wantErr error
+		name     string
+		mock     func(ctrl *gomock.Controller) repositoryTools.DictRepository
+		typeMock func(ctrl *gomock.Controller) repositoryTools.DictTypeRepository
+		domain   domainTools.Dict
+		wantErr  error
 	}{
 		{
 			name: "更新成功",
@@ -185,6 +200,8 @@ func Test_dictService_Update(t *testing.T) {
 					Return(false, nil)
 				repo.EXPECT().CheckExistByName(gomock.Any(), "字典名称", "").
 					Return(false, nil)
+				repo.EXPECT().GetById(gomock.Any(), "").
+					Return(domainTools.Dict{Dict: tools.Dict{Name: "旧字典名称"}}, nil)
 				repo.EXPECT().Update(gomock.Any(), domainTools.Dict{
 					Dict: tools.Dict{
 						Code: "字典编码",
@@ -193,6 +210,11 @@ func Test_dictService_Update(t *testing.T) {
 				}).Return(nil)
 				return repo
 			},
+			typeMock: func(ctrl *gomock.Controller) repositoryTools.DictTypeRepository {
+				repo := repomocks.NewMockDictTypeRepository(ctrl)
+				repo.EXPECT().DelOptions(gomock.Any(), []string{"旧字典名称", "字典名称"}).Return(nil)
+				return repo
+			},
 			domain: domainTools.Dict{
 				Dict: tools.Dict{
 					Code: "字典编码",
@@ -209,6 +231,9 @@ func Test_dictService_Update(t *testing.T) {
 					Return(true, nil)
 				return repo
 			},
+			typeMock: func(ctrl *gomock.Controller) repositoryTools.DictTypeRepository {
+				return repomocks.NewMockDictTypeRepository(ctrl)
+			},
 			domain: domainTools.Dict{
 				Dict: tools.Dict{
 					CoreModels: models.CoreModels{
@@ -230,6 +255,9 @@ func Test_dictService_Update(t *testing.T) {
 					Return(true, nil)
 				return repo
 			},
+			typeMock: func(ctrl *gomock.Controller) repositoryTools.DictTypeRepository {
+				return repomocks.NewMockDictTypeRepository(ctrl)
+			},
 			domain: domainTools.Dict{
 				Dict: tools.Dict{
 					CoreModels: models.CoreModels{
@@ -249,6 +277,8 @@ func Test_dictService_Update(t *testing.T) {
 					Return(false, nil)
 				repo.EXPECT().CheckExistByName(gomock.Any(), "字典名称", "1").
 					Return(false, nil)
+				repo.EXPECT().GetById(gomock.Any(), "1").
+					Return(domainTools.Dict{Dict: tools.Dict{Name: "字典名称"}}, nil)
 				repo.EXPECT().Update(gomock.Any(), domainTools.Dict{
 					Dict: tools.Dict{
 						CoreModels: models.CoreModels{
@@ -260,6 +290,9 @@ func Test_dictService_Update(t *testing.T) {
 				}).Return(errors.New("数据库异常"))
 				return repo
 			},
+			typeMock: func(ctrl *gomock.Controller) repositoryTools.DictTypeRepository {
+				return repomocks.NewMockDictTypeRepository(ctrl)
+			},
 			domain: domainTools.Dict{
 				Dict: tools.Dict{
 					CoreModels: models.CoreModels{
@@ -278,7 +311,7 @@ func Test_dictService_Update(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			dictSvc := NewDictService(tc.mock(ctrl))
+			dictSvc := NewDictService(tc.mock(ctrl), tc.typeMock(ctrl))
 			err := dictSvc.Update(context.Background(), tc.domain)
 			assert.Equal(t, tc.wantErr, err)
 		})
@@ -340,9 +373,103 @@ func Test_dictService_GetById(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			dictSvc := NewDictService(tc.mock(ctrl))
+			dictSvc := NewDictService(tc.mock(ctrl), repomocks.NewMockDictTypeRepository(ctrl))
 			_, err := dictSvc.GetById(context.Background(), tc.id)
 			assert.Equal(t, tc.wantErr, err)
 		})
 	}
 }
+
+func Test_dictService_Restore(t *testing.T) {
+	deleted := domainTools.Dict{Dict: tools.Dict{
+		CoreModels: models.CoreModels{Id: "1"},
+		SoftDelete: models.SoftDelete{DeletedAt: 1700000000000, DeletedBy: "admin"},
+		Name:       "字典名称",
+		Code:       "字典编码",
+	}}
+
+	testCases := []struct {
+		name     string
+		mock     func(ctrl *gomock.Controller) repositoryTools.DictRepository
+		typeMock func(ctrl *gomock.Controller) repositoryTools.DictTypeRepository
+		id       string
+		wantErr  error
+	}{
+		{
+			name: "恢复成功",
+			mock: func(ctrl *gomock.Controller) repositoryTools.DictRepository {
+				repo := repomocks.NewMockDictRepository(ctrl)
+				repo.EXPECT().GetDeletedById(gomock.Any(), "1").Return(deleted, nil)
+				repo.EXPECT().CheckExistByName(gomock.Any(), "字典名称", "").Return(false, nil)
+				repo.EXPECT().CheckExistByCode(gomock.Any(), "字典编码", "").Return(false, nil)
+				repo.EXPECT().Restore(gomock.Any(), deleted).Return(nil)
+				return repo
+			},
+			typeMock: func(ctrl *gomock.Controller) repositoryTools.DictTypeRepository {
+				repo := repomocks.NewMockDictTypeRepository(ctrl)
+				repo.EXPECT().DelOptions(gomock.Any(), []string{"字典名称"}).Return(nil)
+				return repo
+			},
+			id:      "1",
+			wantErr: nil,
+		},
+		{
+			name: "回收站中不存在",
+			mock: func(ctrl *gomock.Controller) repositoryTools.DictRepository {
+				repo := repomocks.NewMockDictRepository(ctrl)
+				repo.EXPECT().GetDeletedById(gomock.Any(), "1").
+					Return(domainTools.Dict{}, repositoryTools.ErrDictNotFound)
+				return repo
+			},
+			typeMock: func(ctrl *gomock.Controller) repositoryTools.DictTypeRepository {
+				return repomocks.NewMockDictTypeRepository(ctrl)
+			},
+			id:      "1",
+			wantErr: repositoryTools.ErrDictNotFound,
+		},
+		{
+			name: "删除期间已新建同名字典",
+			mock: func(ctrl *gomock.Controller) repositoryTools.DictRepository {
+				repo := repomocks.NewMockDictRepository(ctrl)
+				repo.EXPECT().GetDeletedById(gomock.Any(), "1").Return(deleted, nil)
+				repo.EXPECT().CheckExistByName(gomock.Any(), "字典名称", "").Return(true, nil)
+				return repo
+			},
+			typeMock: func(ctrl *gomock.Controller) repositoryTools.DictTypeRepository {
+				return repomocks.NewMockDictTypeRepository(ctrl)
+			},
+			id:      "1",
+			wantErr: repositoryTools.ErrDictNameDuplicate,
+		},
+		{
+			name: "并发创建触发唯一索引冲突",
+			mock: func(ctrl *gomock.Controller) repositoryTools.DictRepository {
+				repo := repomocks.NewMockDictRepository(ctrl)
+				repo.EXPECT().GetDeletedById(gomock.Any(), "1").Return(deleted, nil)
+				repo.EXPECT().CheckExistByName(gomock.Any(), "字典名称", "").Return(false, nil)
+				repo.EXPECT().CheckExistByCode(gomock.Any(), "字典编码", "").Return(false, nil)
+				repo.EXPECT().Restore(gomock.Any(), deleted).Return(&mysql.MySQLError{
+					Number:  1062,
+					Message: "Duplicate entry '字典编码-0' for key 'careful_tools_dict.uni_careful_tools_dict_code'",
+				})
+				return repo
+			},
+			typeMock: func(ctrl *gomock.Controller) repositoryTools.DictTypeRepository {
+				return repomocks.NewMockDictTypeRepository(ctrl)
+			},
+			id:      "1",
+			wantErr: repositoryTools.ErrDictCodeDuplicate,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			dictSvc := NewDictService(tc.mock(ctrl), tc.typeMock(ctrl))
+			err := dictSvc.Restore(context.Background(), tc.id)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
@@ -11,9 +11,20 @@ package tools
 import (
 	"context"
 	"errors"
+	"fmt"
+	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
 	domainTools "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/tools"
+	modelTools "github.com/carefuly/careful-admin-go-gin/internal/model/careful/tools"
 	repositoryTools "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/tools"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/tools/dict"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/tools/dict_type"
+	_import "github.com/carefuly/careful-admin-go-gin/pkg/utils/common/import"
+	_string "github.com/carefuly/careful-admin-go-gin/pkg/utils/common/string"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/enumconv"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/excelutil"
 	"github.com/go-sql-driver/mysql"
+	"strconv"
+	"strings"
 )
 
 var (
@@ -21,18 +32,33 @@ var (
 	ErrDictTypeNotFound             = repositoryTools.ErrDictTypeNotFound
 	ErrDictTypeDuplicate            = repositoryTools.ErrDictTypeDuplicate
 	ErrDictTypeVersionInconsistency = repositoryTools.ErrDictTypeVersionInconsistency
+	ErrDictNamesTooMany             = fmt.Errorf("单次最多查询%d个字典", MaxDictNames)
+	ErrDictTypeDictDeleted          = errors.New("所属字典已删除，请先恢复字典")
+	ErrDictTypeNameDuplicate        = errors.New("所属字典下已存在同名字典信息")
+	ErrDictTypeValueDuplicate       = errors.New("所属字典下已存在相同的字典值")
 )
 
+// MaxDictNames 单次批量查询的字典数量上限
+const MaxDictNames = 50
+
 type DictTypeService interface {
 	Create(ctx context.Context, domain domainTools.DictType) error
+	Import(ctx context.Context, user domainSystem.User, listMap []map[string]string, mode _import.Mode) _import.ImportResult
 	Delete(ctx context.Context, id string) error
 	BatchDelete(ctx context.Context, ids []string) error
 	Update(ctx context.Context, domain domainTools.DictType) error
 
 	GetById(ctx context.Context, id string) (domainTools.DictType, error)
-	GetByDictNames(ctx context.Context, dictNames []string) (map[string][]domainTools.DictType, error)
+	GetByDictNames(ctx context.Context, dictNames []string) (map[string][]domainTools.DictOption, error)
 	GetListPage(ctx context.Context, filter domainTools.DictTypeFilter) ([]domainTools.DictType, int64, error)
 	GetListAll(ctx context.Context, filter domainTools.DictTypeFilter) ([]domainTools.DictType, error)
+
+	// GetRecycleListPage 分页查询回收站
+	GetRecycleListPage(ctx context.Context, filter domainTools.RecycleFilter) ([]domainTools.DictType, int64, error)
+	// Restore 从回收站恢复字典信息，所属字典需未删除
+	Restore(ctx context.Context, id string) error
+	// Purge 彻底删除回收站中的字典信息
+	Purge(ctx context.Context, ids []string) error
 }
 
 type dictTypeService struct {
@@ -79,7 +105,190 @@ func (svc *dictTypeService) Create(ctx context.Context, domain domainTools.DictT
 }
 
 // Import 导入
-func (svc *dictTypeService) Import() {}
+// 同一字典下的名称与值在文件内不能重复，库内冲突由唯一索引(uni_dict_*)校验
+func (svc *dictTypeService) Import(ctx context.Context, user domainSystem.User, listMap []map[string]string, mode _import.Mode) _import.ImportResult {
+	// 所属字典按名称或编码匹配，仅允许导入到启用的字典
+	dicts, err := svc.dictRepo.GetListAll(ctx, domainTools.DictFilter{Status: true})
+	if err != nil {
+		result := _import.ImportResult{Mode: mode, DryRun: mode == _import.ModeDryRun}
+		for index := range listMap {
+			result.AddError(index+2, "查询所属字典失败："+err.Error())
+		}
+		return result
+	}
+	dictMap := make(map[string]domainTools.Dict, len(dicts)*2)
+	for _, d := range dicts {
+		dictMap[d.Code] = d
+	}
+	for _, d := range dicts {
+		dictMap[d.Name] = d
+	}
+
+	boolValues := []string{"是", "否"}
+	boolConverter := enumconv.NewEnumConverter(dict_type.BoolValueMapping, dict_type.BoolValueImportMapping, boolValues, "布尔值")
+
+	importer := _import.NewImporter(_import.Config[domainTools.DictType]{
+		Parse: func(ctx context.Context, list map[string]string) (domainTools.DictType, error) {
+			// 按 excel 标签校验并转换字段
+			var domain domainTools.DictType
+			err := excelutil.Bind(list, &domain)
+			if err != nil {
+				return domainTools.DictType{}, err
+			}
+
+			// 所属字典，按名称或编码匹配
+			parent, ok := dictMap[domain.DictName]
+			if !ok {
+				return domainTools.DictType{}, dictTypeValueError("所属字典", domain.DictName, fmt.Sprintf("字典【%s】不存在或已停用", domain.DictName))
+			}
+
+			domain.Creator = user.Id
+			domain.Modifier = user.Id
+			domain.BelongDept = user.DeptId
+			domain.Status = true
+			domain.DictId = parent.Id
+			domain.DictName = parent.Name
+			domain.ValueType = parent.ValueType
+
+			// 字典值列取决于所属字典的数据类型，无法通过标签声明
+			switch parent.ValueType {
+			case dict.ValueTypeConstStr:
+				raw := _string.CleanInputString(list["字符串值"])
+				if raw == "" {
+					return domainTools.DictType{}, dictTypeValueError("字符串值", raw, fmt.Sprintf("不能为空，字典【%s】为字符串类型", parent.Name))
+				}
+				domain.StrValue = raw
+			case dict.ValueTypeConstInt:
+				raw := _string.CleanInputString(list["整型值"])
+				if domain.IntValue, err = strconv.ParseInt(raw, 10, 64); err != nil {
+					return domainTools.DictType{}, dictTypeValueError("整型值", raw, fmt.Sprintf("必须为整数，字典【%s】为整型类型", parent.Name))
+				}
+			case dict.ValueTypeConstBool:
+				raw := _string.CleanInputString(list["布尔值"])
+				if domain.BoolValue, err = boolConverter.ToEnum(raw); err != nil {
+					return domainTools.DictType{}, dictTypeValueError("布尔值", raw, "转换失败："+err.Error())
+				}
+			default:
+				return domainTools.DictType{}, fmt.Errorf("字典【%s】的数据类型无效", parent.Name)
+			}
+
+			return domain, nil
+		},
+		Unique: []_import.UniqueKey[domainTools.DictType]{
+			{
+				Title: "字典信息名称",
+				Value: dictTypeNameKey,
+				Exists: func(ctx context.Context, values []string) ([]string, error) {
+					domains := svc.keysToDomains(dictMap, values, func(d *domainTools.DictType, name string) error {
+						d.Name = name
+						return nil
+					})
+					existing, err := svc.repo.GetExistingNames(ctx, domains)
+					if err != nil {
+						return nil, err
+					}
+					return svc.domainsToKeys(dictMap, existing, dictTypeNameKey), nil
+				},
+			},
+			{
+				Title: "字典值",
+				Value: dictTypeValueKey,
+				Exists: func(ctx context.Context, values []string) ([]string, error) {
+					domains := svc.keysToDomains(dictMap, values, func(d *domainTools.DictType, value string) error {
+						var err error
+						switch d.ValueType {
+						case dict.ValueTypeConstStr:
+							d.StrValue = value
+						case dict.ValueTypeConstInt:
+							d.IntValue, err = strconv.ParseInt(value, 10, 64)
+						case dict.ValueTypeConstBool:
+							d.BoolValue, err = strconv.ParseBool(value)
+						}
+						return err
+					})
+					existing, err := svc.repo.GetExistingValues(ctx, domains)
+					if err != nil {
+						return nil, err
+					}
+					return svc.domainsToKeys(dictMap, existing, dictTypeValueKey), nil
+				},
+			},
+		},
+		Insert: func(ctx context.Context, items []domainTools.DictType) error {
+			if err := svc.repo.BatchCreate(ctx, items); err != nil {
+				if svc.IsDuplicateEntryError(err) {
+					if len(items) == 1 {
+						return fmt.Errorf("字典【%s】下已存在相同的字典信息名称或值", items[0].DictName)
+					}
+					return repositoryTools.ErrDictTypeDuplicate
+				}
+				return err
+			}
+			return nil
+		},
+	})
+
+	return importer.Run(ctx, listMap, mode)
+}
+
+// dictTypeValueError 导入单元格错误
+func dictTypeValueError(column, value, message string) error {
+	return excelutil.CellErrors{{Column: column, Value: value, Message: message}}
+}
+
+// dictTypeNameKey 导入唯一键：所属字典/字典信息名称
+func dictTypeNameKey(d domainTools.DictType) string {
+	return d.DictName + "/" + d.Name
+}
+
+// dictTypeValueKey 导入唯一键：所属字典/字典值
+func dictTypeValueKey(d domainTools.DictType) string {
+	return fmt.Sprintf("%s/%v", d.DictName, d.Option().Value)
+}
+
+// keysToDomains 将导入唯一键还原为查询条件，所属字典名称不含 "/"
+func (svc *dictTypeService) keysToDomains(dictMap map[string]domainTools.Dict, keys []string, set func(d *domainTools.DictType, value string) error) []domainTools.DictType {
+	domains := make([]domainTools.DictType, 0, len(keys))
+	for _, key := range keys {
+		dictName, value, ok := strings.Cut(key, "/")
+		if !ok {
+			continue
+		}
+		parent, ok := dictMap[dictName]
+		if !ok {
+			continue
+		}
+		domain := domainTools.DictType{
+			DictType: modelTools.DictType{
+				DictId:    parent.Id,
+				DictName:  parent.Name,
+				ValueType: parent.ValueType,
+			},
+		}
+		if err := set(&domain, value); err != nil {
+			continue
+		}
+		domains = append(domains, domain)
+	}
+	return domains
+}
+
+// domainsToKeys 将库中已存在的字典信息转换为导入唯一键，所属字典名称以字典表为准
+func (svc *dictTypeService) domainsToKeys(dictMap map[string]domainTools.Dict, domains []domainTools.DictType, key func(d domainTools.DictType) string) []string {
+	dictNames := make(map[string]string, len(dictMap))
+	for _, d := range dictMap {
+		dictNames[d.Id] = d.Name
+	}
+
+	keys := make([]string, 0, len(domains))
+	for _, domain := range domains {
+		if name, ok := dictNames[domain.DictId]; ok {
+			domain.DictName = name
+		}
+		keys = append(keys, key(domain))
+	}
+	return keys
+}
 
 // Delete 删除
 func (svc *dictTypeService) Delete(ctx context.Context, id string) error {
@@ -142,43 +351,31 @@ func (svc *dictTypeService) GetById(ctx context.Context, id string) (domainTools
 	return domain, err
 }
 
-// GetByDictNames 根据多个dictName获取详情
-func (svc *dictTypeService) GetByDictNames(ctx context.Context, dictNames []string) (map[string][]domainTools.DictType, error) {
-	return nil, nil
-	// if len(dictNames) == 0 {
-	// 	return map[string][]domainTools.DictType{}, nil
-	// }
-	//
-	// dictTypes, err := svc.repo.GetByDictNames(ctx, dictNames)
-	// if err != nil {
-	// 	return nil, err
-	// }
-	//
-	// // 按dictName分组
-	// resultMap := make(map[string][]domainTools.DictType)
-	// for _, dt := range dictTypes {
-	// 	switch {
-	// 	case dt.ValueType == 1:
-	// 		dt.Label = dt.Name
-	// 		dt.Value = dt.StrValue
-	// 	case dt.ValueType == 2:
-	// 		dt.Label = dt.Name
-	// 		dt.Value = dt.IntValue
-	// 	case dt.ValueType == 3:
-	// 		dt.Label = dt.Name
-	// 		dt.Value = dt.BoolValue
-	// 	}
-	// 	resultMap[dt.DictName] = append(resultMap[dt.DictName], dt)
-	// }
-	//
-	// // 确保所有请求的键都存在（即使没有数据）
-	// for _, name := range dictNames {
-	// 	if _, ok := resultMap[name]; !ok {
-	// 		resultMap[name] = []domainTools.DictType{}
-	// 	}
-	// }
-	//
-	// return resultMap, nil
+// GetByDictNames 根据多个dictName获取字典选项，返回结果包含所有请求的字典名称
+func (svc *dictTypeService) GetByDictNames(ctx context.Context, dictNames []string) (map[string][]domainTools.DictOption, error) {
+	// 去除空值与重复值
+	names := make([]string, 0, len(dictNames))
+	seen := make(map[string]struct{}, len(dictNames))
+	for _, name := range dictNames {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		names = append(names, name)
+	}
+
+	if len(names) == 0 {
+		return map[string][]domainTools.DictOption{}, nil
+	}
+	if len(names) > MaxDictNames {
+		return nil, ErrDictNamesTooMany
+	}
+
+	return svc.repo.GetOptionsByDictNames(ctx, names)
 }
 
 // GetListPage 分页查询列表
@@ -191,6 +388,60 @@ func (svc *dictTypeService) GetListAll(ctx context.Context, filter domainTools.D
 	return svc.repo.GetListAll(ctx, filter)
 }
 
+// GetRecycleListPage 分页查询回收站
+func (svc *dictTypeService) GetRecycleListPage(ctx context.Context, filter domainTools.RecycleFilter) ([]domainTools.DictType, int64, error) {
+	return svc.repo.GetRecycleListPage(ctx, filter)
+}
+
+// Restore 恢复
+// 删除期间可能已新增同名或同值的字典信息，恢复前重新校验唯一性(uni_dict_*)
+func (svc *dictTypeService) Restore(ctx context.Context, id string) error {
+	deleted, err := svc.repo.GetDeletedById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	dict, err := svc.dictRepo.GetById(ctx, deleted.DictId)
+	if err != nil {
+		return err
+	}
+	if dict.Id == "" {
+		return ErrDictTypeDictDeleted
+	}
+
+	existing, err := svc.repo.GetExistingNames(ctx, []domainTools.DictType{deleted})
+	if err != nil {
+		return err
+	}
+	if len(existing) > 0 {
+		return ErrDictTypeNameDuplicate
+	}
+
+	existing, err = svc.repo.GetExistingValues(ctx, []domainTools.DictType{deleted})
+	if err != nil {
+		return err
+	}
+	if len(existing) > 0 {
+		return ErrDictTypeValueDuplicate
+	}
+
+	if err := svc.repo.Restore(ctx, deleted); err != nil {
+		// 并发创建时仍可能触发唯一索引冲突
+		if svc.IsDuplicateEntryError(err) {
+			return repositoryTools.ErrDictTypeDuplicate
+		}
+		return err
+	}
+
+	return nil
+}
+
+// Purge 彻底删除
+func (svc *dictTypeService) Purge(ctx context.Context, ids []string) error {
+	_, err := svc.repo.Purge(ctx, ids)
+	return err
+}
+
 // IsDuplicateEntryError 判断是否是唯一冲突错误
 func (svc *dictTypeService) IsDuplicateEntryError(err error) bool {
 	var mysqlErr *mysql.MySQLError
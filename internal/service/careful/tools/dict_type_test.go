@@ -11,13 +11,19 @@ package tools
 import (
 	"context"
 	"errors"
+	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
 	domainTools "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/tools"
 	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/tools"
 	repomocks "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/mocks"
 	repositoryTools "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/tools"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/tools/dict"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/tools/dict_type"
 	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	_import "github.com/carefuly/careful-admin-go-gin/pkg/utils/common/import"
+	"github.com/go-sql-driver/mysql"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"strconv"
 	"testing"
 )
 
@@ -406,3 +412,398 @@ func Test_dictTypeService_GetById(t *testing.T) {
 		})
 	}
 }
+
+func Test_dictTypeService_Import(t *testing.T) {
+	newDict := func(id, name, code string, valueType dict.ValueTypeConst) domainTools.Dict {
+		d := domainTools.Dict{}
+		d.Id, d.Name, d.Code, d.ValueType = id, name, code, valueType
+		return d
+	}
+	dicts := []domainTools.Dict{
+		newDict("1", "状态", "sys_status", dict.ValueTypeConstBool),
+		newDict("2", "性别", "sys_gender", dict.ValueTypeConstInt),
+	}
+
+	testCases := []struct {
+		name        string
+		mock        func(ctrl *gomock.Controller) (repositoryTools.DictTypeRepository, repositoryTools.DictRepository)
+		listMap     []map[string]string
+		mode        _import.Mode
+		wantSuccess int
+		wantValid   int
+		wantErrRows []int
+	}{
+		{
+			name: "按名称或编码匹配所属字典",
+			mock: func(ctrl *gomock.Controller) (repositoryTools.DictTypeRepository, repositoryTools.DictRepository) {
+				repo := repomocks.NewMockDictTypeRepository(ctrl)
+				dictRepo := repomocks.NewMockDictRepository(ctrl)
+				dictRepo.EXPECT().GetListAll(gomock.Any(), domainTools.DictFilter{Status: true}).Return(dicts, nil)
+				repo.EXPECT().GetExistingNames(gomock.Any(), gomock.Any()).Return(nil, nil)
+				repo.EXPECT().GetExistingValues(gomock.Any(), gomock.Any()).Return(nil, nil)
+				repo.EXPECT().BatchCreate(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, domains []domainTools.DictType) error {
+						assert.Len(t, domains, 2)
+						for _, domain := range domains {
+							switch domain.DictId {
+							case "1":
+								assert.Equal(t, dict_type.DictTagConstSuccess, domain.DictTag)
+								assert.True(t, domain.BoolValue)
+							case "2":
+								assert.Equal(t, dict_type.DictTagConstPrimary, domain.DictTag)
+								assert.Equal(t, int64(1), domain.IntValue)
+							}
+						}
+						return nil
+					})
+				return repo, dictRepo
+			},
+			listMap: []map[string]string{
+				{"字典信息名称": "启用", "布尔值": "是", "标签类型": "success", "所属字典": "状态"},
+				{"字典信息名称": "男", "整型值": "1", "所属字典": "sys_gender"},
+			},
+			wantSuccess: 2,
+			wantValid:   2,
+		},
+		{
+			name: "文件内同一字典名称或值重复",
+			mock: func(ctrl *gomock.Controller) (repositoryTools.DictTypeRepository, repositoryTools.DictRepository) {
+				repo := repomocks.NewMockDictTypeRepository(ctrl)
+				dictRepo := repomocks.NewMockDictRepository(ctrl)
+				dictRepo.EXPECT().GetListAll(gomock.Any(), gomock.Any()).Return(dicts, nil)
+				repo.EXPECT().GetExistingNames(gomock.Any(), gomock.Any()).Return(nil, nil)
+				repo.EXPECT().GetExistingValues(gomock.Any(), gomock.Any()).Return(nil, nil)
+				repo.EXPECT().BatchCreate(gomock.Any(), hasLen(2)).Return(nil)
+				return repo, dictRepo
+			},
+			listMap: []map[string]string{
+				{"字典信息名称": "男", "整型值": "1", "所属字典": "性别"},
+				{"字典信息名称": "男", "整型值": "2", "所属字典": "性别"},
+				{"字典信息名称": "女", "整型值": "1", "所属字典": "性别"},
+				{"字典信息名称": "男", "布尔值": "是", "所属字典": "状态"},
+			},
+			wantSuccess: 2,
+			wantValid:   2,
+			wantErrRows: []int{3, 4},
+		},
+		{
+			name: "批量写入冲突时逐行定位",
+			mock: func(ctrl *gomock.Controller) (repositoryTools.DictTypeRepository, repositoryTools.DictRepository) {
+				repo := repomocks.NewMockDictTypeRepository(ctrl)
+				dictRepo := repomocks.NewMockDictRepository(ctrl)
+				dictRepo.EXPECT().GetListAll(gomock.Any(), gomock.Any()).Return(dicts, nil)
+				repo.EXPECT().GetExistingNames(gomock.Any(), gomock.Any()).Return(nil, nil)
+				repo.EXPECT().GetExistingValues(gomock.Any(), gomock.Any()).Return(nil, nil)
+				duplicate := &mysql.MySQLError{Number: 1062, Message: "Duplicate entry for key 'uni_dict_name'"}
+				gomock.InOrder(
+					repo.EXPECT().BatchCreate(gomock.Any(), hasLen(2)).Return(duplicate),
+					repo.EXPECT().BatchCreate(gomock.Any(), hasLen(1)).Return(nil),
+					repo.EXPECT().BatchCreate(gomock.Any(), hasLen(1)).Return(duplicate),
+				)
+				return repo, dictRepo
+			},
+			listMap: []map[string]string{
+				{"字典信息名称": "男", "整型值": "1", "所属字典": "性别"},
+				{"字典信息名称": "女", "整型值": "2", "所属字典": "性别"},
+			},
+			wantSuccess: 1,
+			wantValid:   2,
+			wantErrRows: []int{3},
+		},
+		{
+			name: "预览模式不写入",
+			mock: func(ctrl *gomock.Controller) (repositoryTools.DictTypeRepository, repositoryTools.DictRepository) {
+				repo := repomocks.NewMockDictTypeRepository(ctrl)
+				dictRepo := repomocks.NewMockDictRepository(ctrl)
+				dictRepo.EXPECT().GetListAll(gomock.Any(), gomock.Any()).Return(dicts, nil)
+				repo.EXPECT().GetExistingNames(gomock.Any(), gomock.Any()).Return(nil, nil)
+				repo.EXPECT().GetExistingValues(gomock.Any(), gomock.Any()).Return(nil, nil)
+				return repo, dictRepo
+			},
+			listMap: []map[string]string{
+				{"字典信息名称": "男", "整型值": "1", "所属字典": "性别"},
+				{"字典信息名称": "", "所属字典": "性别"},
+			},
+			mode:        _import.ModeDryRun,
+			wantValid:   1,
+			wantErrRows: []int{3},
+		},
+		{
+			name: "全量模式存在错误时不写入",
+			mock: func(ctrl *gomock.Controller) (repositoryTools.DictTypeRepository, repositoryTools.DictRepository) {
+				repo := repomocks.NewMockDictTypeRepository(ctrl)
+				dictRepo := repomocks.NewMockDictRepository(ctrl)
+				dictRepo.EXPECT().GetListAll(gomock.Any(), gomock.Any()).Return(dicts, nil)
+				repo.EXPECT().GetExistingNames(gomock.Any(), gomock.Any()).Return(nil, nil)
+				repo.EXPECT().GetExistingValues(gomock.Any(), gomock.Any()).Return(nil, nil)
+				return repo, dictRepo
+			},
+			listMap: []map[string]string{
+				{"字典信息名称": "男", "整型值": "1", "所属字典": "性别"},
+				{"字典信息名称": "女", "整型值": "x", "所属字典": "性别"},
+			},
+			mode:        _import.ModeAllOrNothing,
+			wantValid:   1,
+			wantErrRows: []int{3},
+		},
+		{
+			name: "库内已存在的名称或值",
+			mock: func(ctrl *gomock.Controller) (repositoryTools.DictTypeRepository, repositoryTools.DictRepository) {
+				repo := repomocks.NewMockDictTypeRepository(ctrl)
+				dictRepo := repomocks.NewMockDictRepository(ctrl)
+				dictRepo.EXPECT().GetListAll(gomock.Any(), gomock.Any()).Return(dicts, nil)
+				repo.EXPECT().GetExistingNames(gomock.Any(), hasLen(3)).DoAndReturn(
+					func(ctx context.Context, domains []domainTools.DictType) ([]domainTools.DictType, error) {
+						existing := domainTools.DictType{}
+						existing.DictId, existing.DictName, existing.Name = "2", "sys_gender", "男"
+						return []domainTools.DictType{existing}, nil
+					})
+				repo.EXPECT().GetExistingValues(gomock.Any(), hasLen(2)).DoAndReturn(
+					func(ctx context.Context, domains []domainTools.DictType) ([]domainTools.DictType, error) {
+						for _, domain := range domains {
+							assert.Equal(t, "2", domain.DictId)
+						}
+						existing := domainTools.DictType{IntValue: 2}
+						existing.DictId, existing.ValueType = "2", dict.ValueTypeConstInt
+						return []domainTools.DictType{existing}, nil
+					})
+				repo.EXPECT().BatchCreate(gomock.Any(), hasLen(1)).Return(nil)
+				return repo, dictRepo
+			},
+			listMap: []map[string]string{
+				{"字典信息名称": "男", "整型值": "1", "所属字典": "性别"},
+				{"字典信息名称": "女", "整型值": "2", "所属字典": "性别"},
+				{"字典信息名称": "未知", "整型值": "3", "所属字典": "性别"},
+			},
+			wantSuccess: 1,
+			wantValid:   1,
+			wantErrRows: []int{2, 3},
+		},
+		{
+			name: "逐行校验失败",
+			mock: func(ctrl *gomock.Controller) (repositoryTools.DictTypeRepository, repositoryTools.DictRepository) {
+				repo := repomocks.NewMockDictTypeRepository(ctrl)
+				dictRepo := repomocks.NewMockDictRepository(ctrl)
+				dictRepo.EXPECT().GetListAll(gomock.Any(), gomock.Any()).Return(dicts, nil)
+				return repo, dictRepo
+			},
+			listMap: []map[string]string{
+				{"字典信息名称": "", "所属字典": "状态"},
+				{"字典信息名称": "启用", "布尔值": "true", "所属字典": "状态"},
+				{"字典信息名称": "男", "整型值": "男", "所属字典": "性别"},
+				{"字典信息名称": "男", "整型值": "1", "标签类型": "red", "所属字典": "性别"},
+				{"字典信息名称": "男", "整型值": "1", "所属字典": "不存在"},
+			},
+			wantErrRows: []int{2, 3, 4, 5, 6},
+		},
+		{
+			name: "查询所属字典失败",
+			mock: func(ctrl *gomock.Controller) (repositoryTools.DictTypeRepository, repositoryTools.DictRepository) {
+				repo := repomocks.NewMockDictTypeRepository(ctrl)
+				dictRepo := repomocks.NewMockDictRepository(ctrl)
+				dictRepo.EXPECT().GetListAll(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+				return repo, dictRepo
+			},
+			listMap: []map[string]string{
+				{"字典信息名称": "启用", "布尔值": "是", "所属字典": "状态"},
+			},
+			wantErrRows: []int{2},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo, dictRepo := tc.mock(ctrl)
+			svc := NewDictTypeService(repo, dictRepo)
+			result := svc.Import(context.Background(), domainSystem.User{}, tc.listMap, tc.mode)
+
+			assert.Equal(t, tc.wantSuccess, result.SuccessCount)
+			assert.Equal(t, tc.wantValid, result.ValidCount)
+			assert.Equal(t, len(tc.wantErrRows), result.FailCount)
+			rows := make([]int, 0, len(result.Errors))
+			for _, e := range result.Errors {
+				rows = append(rows, e.Row)
+			}
+			if len(tc.wantErrRows) > 0 {
+				assert.Equal(t, tc.wantErrRows, rows)
+			}
+		})
+	}
+}
+
+func Test_dictTypeService_GetByDictNames(t *testing.T) {
+	tooMany := make([]string, 0, MaxDictNames+1)
+	for i := 0; i <= MaxDictNames; i++ {
+		tooMany = append(tooMany, strconv.Itoa(i))
+	}
+
+	testCases := []struct {
+		name      string
+		mock      func(ctrl *gomock.Controller) repositoryTools.DictTypeRepository
+		dictNames []string
+		want      map[string][]domainTools.DictOption
+		wantErr   error
+	}{
+		{
+			name: "去除空值与重复值",
+			mock: func(ctrl *gomock.Controller) repositoryTools.DictTypeRepository {
+				repo := repomocks.NewMockDictTypeRepository(ctrl)
+				repo.EXPECT().GetOptionsByDictNames(gomock.Any(), []string{"性别", "状态"}).
+					Return(map[string][]domainTools.DictOption{
+						"性别": {{Label: "男", Value: int64(1)}},
+						"状态": {},
+					}, nil)
+				return repo
+			},
+			dictNames: []string{"性别", " ", "状态", " 性别"},
+			want: map[string][]domainTools.DictOption{
+				"性别": {{Label: "男", Value: int64(1)}},
+				"状态": {},
+			},
+		},
+		{
+			name: "未传字典名称",
+			mock: func(ctrl *gomock.Controller) repositoryTools.DictTypeRepository {
+				return repomocks.NewMockDictTypeRepository(ctrl)
+			},
+			dictNames: []string{""},
+			want:      map[string][]domainTools.DictOption{},
+		},
+		{
+			name: "超过查询上限",
+			mock: func(ctrl *gomock.Controller) repositoryTools.DictTypeRepository {
+				return repomocks.NewMockDictTypeRepository(ctrl)
+			},
+			dictNames: tooMany,
+			wantErr:   ErrDictNamesTooMany,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc := NewDictTypeService(tc.mock(ctrl), repomocks.NewMockDictRepository(ctrl))
+			got, err := svc.GetByDictNames(context.Background(), tc.dictNames)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestDictType_Option(t *testing.T) {
+	newDictType := func(valueType dict.ValueTypeConst) domainTools.DictType {
+		d := domainTools.DictType{StrValue: "kg", IntValue: 2, BoolValue: true}
+		d.Name, d.DictTag, d.DictColor, d.ValueType = "选项", dict_type.DictTagConstSuccess, "#67C23A", valueType
+		return d
+	}
+
+	assert.Equal(t, "kg", newDictType(dict.ValueTypeConstStr).Option().Value)
+	assert.Equal(t, int64(2), newDictType(dict.ValueTypeConstInt).Option().Value)
+	assert.Equal(t, true, newDictType(dict.ValueTypeConstBool).Option().Value)
+	assert.Equal(t, domainTools.DictOption{
+		Label: "选项",
+		Value: "kg",
+		Tag:   dict_type.DictTagConstSuccess,
+		Color: "#67C23A",
+	}, newDictType(dict.ValueTypeConstStr).Option())
+}
+
+// hasLen 匹配指定长度的批量写入参数
+type hasLen int
+
+func (m hasLen) Matches(x interface{}) bool {
+	v, ok := x.([]domainTools.DictType)
+	return ok && len(v) == int(m)
+}
+
+func (m hasLen) String() string {
+	return "has length " + strconv.Itoa(int(m))
+}
+
+func Test_dictTypeService_Restore(t *testing.T) {
+	deleted := domainTools.DictType{DictType: tools.DictType{
+		CoreModels: models.CoreModels{Id: "1"},
+		SoftDelete: models.SoftDelete{DeletedAt: 1700000000000, DeletedBy: "admin"},
+		Name:       "男",
+		DictId:     "d1",
+		DictName:   "gender",
+	}}
+
+	testCases := []struct {
+		name     string
+		mock     func(ctrl *gomock.Controller) repositoryTools.DictTypeRepository
+		dictMock func(ctrl *gomock.Controller) repositoryTools.DictRepository
+		id       string
+		wantErr  error
+	}{
+		{
+			name: "恢复成功",
+			mock: func(ctrl *gomock.Controller) repositoryTools.DictTypeRepository {
+				repo := repomocks.NewMockDictTypeRepository(ctrl)
+				repo.EXPECT().GetDeletedById(gomock.Any(), "1").Return(deleted, nil)
+				repo.EXPECT().GetExistingNames(gomock.Any(), []domainTools.DictType{deleted}).Return(nil, nil)
+				repo.EXPECT().GetExistingValues(gomock.Any(), []domainTools.DictType{deleted}).Return(nil, nil)
+				repo.EXPECT().Restore(gomock.Any(), deleted).Return(nil)
+				return repo
+			},
+			dictMock: func(ctrl *gomock.Controller) repositoryTools.DictRepository {
+				repo := repomocks.NewMockDictRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), "d1").
+					Return(domainTools.Dict{Dict: tools.Dict{CoreModels: models.CoreModels{Id: "d1"}}}, nil)
+				return repo
+			},
+			id:      "1",
+			wantErr: nil,
+		},
+		{
+			name: "所属字典已删除",
+			mock: func(ctrl *gomock.Controller) repositoryTools.DictTypeRepository {
+				repo := repomocks.NewMockDictTypeRepository(ctrl)
+				repo.EXPECT().GetDeletedById(gomock.Any(), "1").Return(deleted, nil)
+				return repo
+			},
+			dictMock: func(ctrl *gomock.Controller) repositoryTools.DictRepository {
+				repo := repomocks.NewMockDictRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), "d1").Return(domainTools.Dict{}, nil)
+				return repo
+			},
+			id:      "1",
+			wantErr: ErrDictTypeDictDeleted,
+		},
+		{
+			name: "删除期间已新增相同的字典值",
+			mock: func(ctrl *gomock.Controller) repositoryTools.DictTypeRepository {
+				repo := repomocks.NewMockDictTypeRepository(ctrl)
+				repo.EXPECT().GetDeletedById(gomock.Any(), "1").Return(deleted, nil)
+				repo.EXPECT().GetExistingNames(gomock.Any(), []domainTools.DictType{deleted}).Return(nil, nil)
+				repo.EXPECT().GetExistingValues(gomock.Any(), []domainTools.DictType{deleted}).
+					Return([]domainTools.DictType{deleted}, nil)
+				return repo
+			},
+			dictMock: func(ctrl *gomock.Controller) repositoryTools.DictRepository {
+				repo := repomocks.NewMockDictRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), "d1").
+					Return(domainTools.Dict{Dict: tools.Dict{CoreModels: models.CoreModels{Id: "d1"}}}, nil)
+				return repo
+			},
+			id:      "1",
+			wantErr: ErrDictTypeValueDuplicate,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			dictSvc := NewDictTypeService(tc.mock(ctrl), tc.dictMock(ctrl))
+			err := dictSvc.Restore(context.Background(), tc.id)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
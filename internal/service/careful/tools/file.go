@@ -0,0 +1,299 @@
+/**
+ * Description：
+ * FileName：file.go
+ * Author：CJiaの用心
+ * Create：2025/11/11 17:20:48
+ * Remark：
+ */
+
+package tools
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	domainTools "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/tools"
+	repositoryTools "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/tools"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/storage"
+	"go.uber.org/zap"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+var (
+	ErrFileNotFound       = repositoryTools.ErrFileNotFound
+	ErrFileBucketNotFound = repositoryTools.ErrFileBucketNotFound
+	ErrFileEmpty          = errors.New("上传文件不能为空")
+	ErrFileTooLarge       = errors.New("文件大小超出存储桶限制")
+	ErrFileMimeNotAllowed = errors.New("文件类型不在存储桶允许范围内")
+	ErrObjectNotFound     = storage.ErrObjectNotFound
+	ErrInvalidSignature   = storage.ErrInvalidSignature
+)
+
+// sniffLen 识别文件类型读取的字节数
+const sniffLen = 512
+
+type FileService interface {
+	// Upload 上传文件到存储桶，同一存储桶内内容相同的文件复用已有存储对象
+	Upload(ctx context.Context, bucketCode string, domain domainTools.File, r io.Reader) (domainTools.File, error)
+	// Delete 删除文件记录，移入回收站，存储对象在彻底删除后释放
+	Delete(ctx context.Context, id string) error
+	// Open 打开文件内容，调用方负责关闭
+	Open(ctx context.Context, id string) (domainTools.File, io.ReadCloser, error)
+	// Presign 生成限时下载地址
+	Presign(ctx context.Context, id string) (string, error)
+	// OpenObject 校验预签名参数后打开存储对象，仅本地存储驱动支持
+	OpenObject(ctx context.Context, key, name string, expires int64, signature string) (io.ReadCloser, error)
+
+	GetById(ctx context.Context, id string) (domainTools.File, error)
+	GetListPage(ctx context.Context, filter domainTools.FileFilter) ([]domainTools.File, int64, error)
+
+	// GetRecycleListPage 分页查询回收站
+	GetRecycleListPage(ctx context.Context, filter domainTools.RecycleFilter) ([]domainTools.File, int64, error)
+	// Restore 从回收站恢复文件，所属存储桶需未被删除
+	Restore(ctx context.Context, id string) error
+	// Purge 彻底删除回收站中的文件，存储对象不再被引用时一并删除
+	Purge(ctx context.Context, ids []string) error
+	// PurgeExpired 彻底删除指定时间之前移入回收站的文件，返回删除的数量
+	PurgeExpired(ctx context.Context, before time.Time) (int64, error)
+}
+
+type fileService struct {
+	repo          repositoryTools.FileRepository
+	bucketRepo    repositoryTools.BucketRepository
+	driver        storage.Driver
+	presignExpire time.Duration
+}
+
+func NewFileService(repo repositoryTools.FileRepository, bucketRepo repositoryTools.BucketRepository, driver storage.Driver, presignExpire time.Duration) FileService {
+	return &fileService{
+		repo:          repo,
+		bucketRepo:    bucketRepo,
+		driver:        driver,
+		presignExpire: presignExpire,
+	}
+}
+
+// Upload 上传
+// 先写入临时文件计算内容哈希并识别文件类型，校验通过后再写入存储
+func (svc *fileService) Upload(ctx context.Context, bucketCode string, domain domainTools.File, r io.Reader) (domainTools.File, error) {
+	bucket, err := svc.bucketRepo.GetByCode(ctx, bucketCode)
+	if err != nil {
+		if errors.Is(err, repositoryTools.ErrBucketNotFound) {
+			return domainTools.File{}, ErrBucketNotFound
+		}
+		return domainTools.File{}, err
+	}
+	if !bucket.Status {
+		return domainTools.File{}, ErrBucketDisabled
+	}
+	if bucket.MaxSize > 0 && domain.Size > bucket.MaxSize {
+		return domainTools.File{}, ErrFileTooLarge
+	}
+
+	tmp, err := os.CreateTemp("", "careful-upload-*")
+	if err != nil {
+		return domainTools.File{}, err
+	}
+	defer func() {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}()
+
+	// 按实际读取的字节数校验大小，不信任客户端声明的大小
+	reader := r
+	if bucket.MaxSize > 0 {
+		reader = io.LimitReader(r, bucket.MaxSize+1)
+	}
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(tmp, hash), reader)
+	if err != nil {
+		return domainTools.File{}, fmt.Errorf("读取上传文件失败: %w", err)
+	}
+	if size == 0 {
+		return domainTools.File{}, ErrFileEmpty
+	}
+	if bucket.MaxSize > 0 && size > bucket.MaxSize {
+		return domainTools.File{}, ErrFileTooLarge
+	}
+
+	// 以文件内容识别类型，不使用客户端提交的类型与扩展名
+	head := make([]byte, sniffLen)
+	n, err := tmp.ReadAt(head, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return domainTools.File{}, err
+	}
+	mimeType, _, _ := mime.ParseMediaType(http.DetectContentType(head[:n]))
+	if !bucket.AllowMime(mimeType) {
+		return domainTools.File{}, ErrFileMimeNotAllowed
+	}
+
+	domain.BucketId = bucket.Id
+	domain.BucketCode = bucket.Code
+	domain.Name = filepath.Base(domain.Name)
+	domain.Ext = strings.ToLower(filepath.Ext(domain.Name))
+	domain.MimeType = mimeType
+	domain.Size = size
+	domain.Hash = hex.EncodeToString(hash.Sum(nil))
+	domain.Driver = svc.driver.Name()
+
+	stored := false
+	existing, err := svc.repo.GetByHash(ctx, bucket.Id, domain.Hash)
+	switch {
+	case err == nil:
+		domain.ObjectKey = existing.ObjectKey
+	case errors.Is(err, repositoryTools.ErrFileNotFound):
+		domain.ObjectKey = svc.objectKey(bucket.Code, domain.Hash)
+		if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+			return domainTools.File{}, err
+		}
+		if err := svc.driver.Put(ctx, domain.ObjectKey, tmp, size, mimeType); err != nil {
+			return domainTools.File{}, fmt.Errorf("写入文件存储失败: %w", err)
+		}
+		stored = true
+	default:
+		return domainTools.File{}, err
+	}
+
+	created, err := svc.repo.Create(ctx, domain)
+	if err != nil {
+		if stored {
+			svc.release(ctx, domain.ObjectKey)
+		}
+		return domainTools.File{}, err
+	}
+	return created, nil
+}
+
+// Delete 删除
+// 回收站中的文件仍引用存储对象，恢复后可继续访问
+func (svc *fileService) Delete(ctx context.Context, id string) error {
+	if _, err := svc.GetById(ctx, id); err != nil {
+		return err
+	}
+	return svc.repo.Delete(ctx, id)
+}
+
+// Open 打开文件内容
+func (svc *fileService) Open(ctx context.Context, id string) (domainTools.File, io.ReadCloser, error) {
+	file, err := svc.GetById(ctx, id)
+	if err != nil {
+		return domainTools.File{}, nil, err
+	}
+
+	reader, err := svc.driver.Get(ctx, file.ObjectKey)
+	if err != nil {
+		return domainTools.File{}, nil, err
+	}
+	return file, reader, nil
+}
+
+// Presign 生成限时下载地址
+func (svc *fileService) Presign(ctx context.Context, id string) (string, error) {
+	file, err := svc.GetById(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	return svc.driver.PresignGet(ctx, file.ObjectKey, file.Name, svc.presignExpire)
+}
+
+// OpenObject 校验预签名参数后打开存储对象
+func (svc *fileService) OpenObject(ctx context.Context, key, name string, expires int64, signature string) (io.ReadCloser, error) {
+	verifier, ok := svc.driver.(storage.Verifier)
+	if !ok {
+		return nil, ErrInvalidSignature
+	}
+	if err := verifier.Verify(key, name, expires, signature); err != nil {
+		return nil, err
+	}
+	return svc.driver.Get(ctx, key)
+}
+
+// GetById 获取详情
+func (svc *fileService) GetById(ctx context.Context, id string) (domainTools.File, error) {
+	domain, err := svc.repo.GetById(ctx, id)
+	if errors.Is(err, repositoryTools.ErrFileNotFound) {
+		return domain, ErrFileNotFound
+	}
+	return domain, err
+}
+
+// GetListPage 分页查询列表
+func (svc *fileService) GetListPage(ctx context.Context, filter domainTools.FileFilter) ([]domainTools.File, int64, error) {
+	return svc.repo.GetListPage(ctx, filter)
+}
+
+// GetRecycleListPage 分页查询回收站
+func (svc *fileService) GetRecycleListPage(ctx context.Context, filter domainTools.RecycleFilter) ([]domainTools.File, int64, error) {
+	return svc.repo.GetRecycleListPage(ctx, filter)
+}
+
+// Restore 恢复
+func (svc *fileService) Restore(ctx context.Context, id string) error {
+	deleted, err := svc.repo.GetDeletedById(ctx, id)
+	if err != nil {
+		return err
+	}
+	return svc.repo.Restore(ctx, deleted)
+}
+
+// Purge 彻底删除
+func (svc *fileService) Purge(ctx context.Context, ids []string) error {
+	files, err := svc.repo.Purge(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	svc.releaseAll(ctx, files)
+	return nil
+}
+
+// PurgeExpired 彻底删除过期的文件
+func (svc *fileService) PurgeExpired(ctx context.Context, before time.Time) (int64, error) {
+	files, err := svc.repo.PurgeExpired(ctx, before)
+	if err != nil {
+		return 0, err
+	}
+
+	svc.releaseAll(ctx, files)
+	return int64(len(files)), nil
+}
+
+// objectKey 按内容哈希生成存储对象键：存储桶编码/哈希前两位/哈希
+func (svc *fileService) objectKey(bucketCode, hash string) string {
+	return bucketCode + "/" + hash[:2] + "/" + hash
+}
+
+// release 存储对象不再被任何文件引用时删除，失败仅记录日志
+func (svc *fileService) release(ctx context.Context, objectKey string) {
+	count, err := svc.repo.CountByObjectKey(ctx, objectKey)
+	if err != nil {
+		zap.L().Error("统计存储对象引用数失败", zap.String("objectKey", objectKey), zap.Error(err))
+		return
+	}
+	if count > 0 {
+		return
+	}
+	if err := svc.driver.Delete(ctx, objectKey); err != nil {
+		zap.L().Error("删除存储对象失败", zap.String("objectKey", objectKey), zap.Error(err))
+	}
+}
+
+// releaseAll 释放已彻底删除的文件引用的存储对象，相同存储对象只处理一次
+func (svc *fileService) releaseAll(ctx context.Context, files []domainTools.File) {
+	released := make(map[string]struct{}, len(files))
+	for _, file := range files {
+		if _, ok := released[file.ObjectKey]; ok {
+			continue
+		}
+		released[file.ObjectKey] = struct{}{}
+		svc.release(ctx, file.ObjectKey)
+	}
+}
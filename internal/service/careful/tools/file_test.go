@@ -0,0 +1,317 @@
+/**
+ * Description：
+ * FileName：file_test.go
+ * Author：CJiaの用心
+ * Create：2025/11/11 18:05:12
+ * Remark：
+ */
+
+package tools
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	domainTools "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/tools"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/tools"
+	repomocks "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/mocks"
+	repositoryTools "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/tools"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/storage"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testPNG PNG 文件头，识别为 image/png
+var testPNG = append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0}, 32)...)
+
+func newTestBucket(maxSize int64, mimes string) domainTools.Bucket {
+	return domainTools.Bucket{
+		Bucket: tools.Bucket{
+			CoreModels:   models.CoreModels{Id: "B1"},
+			Status:       true,
+			Name:         "头像",
+			Code:         "avatar",
+			MaxSize:      maxSize,
+			AllowedMimes: mimes,
+		},
+	}
+}
+
+func newTestDriver(t *testing.T) *storage.LocalDriver {
+	driver, err := storage.NewLocalDriver(storage.LocalConfig{Root: t.TempDir(), URL: "/object", Secret: "secret"})
+	require.NoError(t, err)
+	return driver
+}
+
+func testHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+func Test_fileService_Upload(t *testing.T) {
+	hash := testHash(testPNG)
+	objectKey := "avatar/" + hash[:2] + "/" + hash
+
+	testCases := []struct {
+		name      string
+		bucket    domainTools.Bucket
+		content   []byte
+		mock      func(ctrl *gomock.Controller) repositoryTools.FileRepository
+		wantErr   error
+		wantKey   string
+		wantExist bool
+	}{
+		{
+			name:    "新文件写入存储",
+			bucket:  newTestBucket(1024, "image/*"),
+			content: testPNG,
+			mock: func(ctrl *gomock.Controller) repositoryTools.FileRepository {
+				repo := repomocks.NewMockFileRepository(ctrl)
+				repo.EXPECT().GetByHash(gomock.Any(), "B1", hash).
+					Return(domainTools.File{}, repositoryTools.ErrFileNotFound)
+				repo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, domain domainTools.File) (domainTools.File, error) {
+						assert.Equal(t, "image/png", domain.MimeType)
+						assert.Equal(t, ".png", domain.Ext)
+						assert.Equal(t, int64(len(testPNG)), domain.Size)
+						assert.Equal(t, storage.DriverLocal, domain.Driver)
+						return domain, nil
+					})
+				return repo
+			},
+			wantKey:   objectKey,
+			wantExist: true,
+		},
+		{
+			name:    "内容相同复用存储对象",
+			bucket:  newTestBucket(0, ""),
+			content: testPNG,
+			mock: func(ctrl *gomock.Controller) repositoryTools.FileRepository {
+				repo := repomocks.NewMockFileRepository(ctrl)
+				existing := domainTools.File{File: tools.File{ObjectKey: "avatar/old/key"}}
+				repo.EXPECT().GetByHash(gomock.Any(), "B1", hash).Return(existing, nil)
+				repo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, domain domainTools.File) (domainTools.File, error) {
+						return domain, nil
+					})
+				return repo
+			},
+			wantKey:   "avatar/old/key",
+			wantExist: false,
+		},
+		{
+			name:    "超出大小限制",
+			bucket:  newTestBucket(8, ""),
+			content: testPNG,
+			mock: func(ctrl *gomock.Controller) repositoryTools.FileRepository {
+				return repomocks.NewMockFileRepository(ctrl)
+			},
+			wantErr: ErrFileTooLarge,
+		},
+		{
+			name:    "文件类型不允许",
+			bucket:  newTestBucket(0, "image/*"),
+			content: []byte("#!/bin/sh\necho hello"),
+			mock: func(ctrl *gomock.Controller) repositoryTools.FileRepository {
+				return repomocks.NewMockFileRepository(ctrl)
+			},
+			wantErr: ErrFileMimeNotAllowed,
+		},
+		{
+			name: "存储桶已停用",
+			bucket: func() domainTools.Bucket {
+				bucket := newTestBucket(0, "")
+				bucket.Status = false
+				return bucket
+			}(),
+			content: testPNG,
+			mock: func(ctrl *gomock.Controller) repositoryTools.FileRepository {
+				return repomocks.NewMockFileRepository(ctrl)
+			},
+			wantErr: ErrBucketDisabled,
+		},
+		{
+			name:    "空文件",
+			bucket:  newTestBucket(0, ""),
+			content: []byte{},
+			mock: func(ctrl *gomock.Controller) repositoryTools.FileRepository {
+				return repomocks.NewMockFileRepository(ctrl)
+			},
+			wantErr: ErrFileEmpty,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			bucketRepo := repomocks.NewMockBucketRepository(ctrl)
+			bucketRepo.EXPECT().GetByCode(gomock.Any(), "avatar").Return(tc.bucket, nil)
+			driver := newTestDriver(t)
+			svc := NewFileService(tc.mock(ctrl), bucketRepo, driver, time.Minute)
+
+			// 客户端声明的文件名与大小不作为校验依据
+			file, err := svc.Upload(context.Background(), "avatar", domainTools.File{
+				File: tools.File{Name: "../头像.PNG"},
+			}, bytes.NewReader(tc.content))
+			assert.ErrorIs(t, err, tc.wantErr)
+			if tc.wantErr != nil {
+				return
+			}
+
+			assert.Equal(t, "头像.PNG", file.Name)
+			assert.Equal(t, hash, file.Hash)
+			assert.Equal(t, tc.wantKey, file.ObjectKey)
+			exists, err := driver.Exists(context.Background(), objectKey)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantExist, exists)
+		})
+	}
+}
+
+func Test_fileService_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	driver := newTestDriver(t)
+	require.NoError(t, driver.Put(ctx, "avatar/ab/abc", strings.NewReader("x"), 1, ""))
+
+	repo := repomocks.NewMockFileRepository(ctrl)
+	repo.EXPECT().GetById(gomock.Any(), "F1").
+		Return(domainTools.File{File: tools.File{ObjectKey: "avatar/ab/abc"}}, nil)
+	repo.EXPECT().Delete(gomock.Any(), "F1").Return(nil)
+
+	svc := NewFileService(repo, repomocks.NewMockBucketRepository(ctrl), driver, time.Minute)
+	require.NoError(t, svc.Delete(ctx, "F1"))
+
+	// 移入回收站的文件仍引用存储对象
+	exists, err := driver.Exists(ctx, "avatar/ab/abc")
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
+
+func Test_fileService_Restore(t *testing.T) {
+	testCases := []struct {
+		name    string
+		mock    func(ctrl *gomock.Controller) repositoryTools.FileRepository
+		wantErr error
+	}{
+		{
+			name: "恢复成功",
+			mock: func(ctrl *gomock.Controller) repositoryTools.FileRepository {
+				repo := repomocks.NewMockFileRepository(ctrl)
+				deleted := domainTools.File{File: tools.File{CoreModels: models.CoreModels{Id: "F1"}, BucketId: "B1"}}
+				repo.EXPECT().GetDeletedById(gomock.Any(), "F1").Return(deleted, nil)
+				repo.EXPECT().Restore(gomock.Any(), deleted).Return(nil)
+				return repo
+			},
+		},
+		{
+			name: "回收站中不存在",
+			mock: func(ctrl *gomock.Controller) repositoryTools.FileRepository {
+				repo := repomocks.NewMockFileRepository(ctrl)
+				repo.EXPECT().GetDeletedById(gomock.Any(), "F1").Return(domainTools.File{}, ErrFileNotFound)
+				return repo
+			},
+			wantErr: ErrFileNotFound,
+		},
+		{
+			name: "所属存储桶已删除",
+			mock: func(ctrl *gomock.Controller) repositoryTools.FileRepository {
+				repo := repomocks.NewMockFileRepository(ctrl)
+				repo.EXPECT().GetDeletedById(gomock.Any(), "F1").Return(domainTools.File{}, nil)
+				repo.EXPECT().Restore(gomock.Any(), gomock.Any()).Return(ErrFileBucketNotFound)
+				return repo
+			},
+			wantErr: ErrFileBucketNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc := NewFileService(tc.mock(ctrl), repomocks.NewMockBucketRepository(ctrl), newTestDriver(t), time.Minute)
+			assert.ErrorIs(t, svc.Restore(context.Background(), "F1"), tc.wantErr)
+		})
+	}
+}
+
+func Test_fileService_Purge(t *testing.T) {
+	testCases := []struct {
+		name       string
+		references int64
+		wantExist  bool
+	}{
+		{name: "最后一个引用删除存储对象", references: 0, wantExist: false},
+		{name: "仍有引用保留存储对象", references: 1, wantExist: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			driver := newTestDriver(t)
+			require.NoError(t, driver.Put(ctx, "avatar/ab/abc", strings.NewReader("x"), 1, ""))
+
+			// 引用同一存储对象的多个文件只统计一次引用数
+			repo := repomocks.NewMockFileRepository(ctrl)
+			repo.EXPECT().Purge(gomock.Any(), []string{"F1", "F2"}).Return([]domainTools.File{
+				{File: tools.File{ObjectKey: "avatar/ab/abc"}},
+				{File: tools.File{ObjectKey: "avatar/ab/abc"}},
+			}, nil)
+			repo.EXPECT().CountByObjectKey(gomock.Any(), "avatar/ab/abc").Return(tc.references, nil)
+
+			svc := NewFileService(repo, repomocks.NewMockBucketRepository(ctrl), driver, time.Minute)
+			require.NoError(t, svc.Purge(ctx, []string{"F1", "F2"}))
+
+			exists, err := driver.Exists(ctx, "avatar/ab/abc")
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantExist, exists)
+		})
+	}
+}
+
+func Test_fileService_OpenObject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	driver := newTestDriver(t)
+	require.NoError(t, driver.Put(ctx, "avatar/ab/abc", strings.NewReader("hello"), 5, ""))
+
+	repo := repomocks.NewMockFileRepository(ctrl)
+	repo.EXPECT().GetById(gomock.Any(), "F1").
+		Return(domainTools.File{File: tools.File{Name: "a.txt", ObjectKey: "avatar/ab/abc"}}, nil)
+	svc := NewFileService(repo, repomocks.NewMockBucketRepository(ctrl), driver, time.Minute)
+
+	link, err := svc.Presign(ctx, "F1")
+	require.NoError(t, err)
+	u, err := url.Parse(link)
+	require.NoError(t, err)
+	query := u.Query()
+	expires, _ := strconv.ParseInt(query.Get("expires"), 10, 64)
+
+	reader, err := svc.OpenObject(ctx, query.Get("key"), query.Get("name"), expires, query.Get("signature"))
+	require.NoError(t, err)
+	body, _ := io.ReadAll(reader)
+	_ = reader.Close()
+	assert.Equal(t, "hello", string(body))
+
+	_, err = svc.OpenObject(ctx, query.Get("key"), query.Get("name"), expires, "forged")
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+}
@@ -0,0 +1,362 @@
+/**
+ * Description：
+ * FileName：job.go
+ * Author：CJiaの用心
+ * Create：2025/11/7 13:40:52
+ * Remark：
+ */
+
+package tools
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	domainTools "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/tools"
+	repositoryTools "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/tools"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/tools/job"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/workerpool"
+	"go.uber.org/zap"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	ErrJobNotFound         = repositoryTools.ErrJobNotFound
+	ErrJobBusy             = errors.New("后台任务繁忙，请稍后重试")
+	ErrJobArtifactNotReady = errors.New("任务未完成或结果文件已过期")
+)
+
+// JobDownloadURL 任务结果文件下载地址
+const JobDownloadURL = "/v1/jobs/%s/download"
+
+// jobContextKeys 提交任务时从请求上下文带入后台任务的键，保证数据权限与操作人一致
+var jobContextKeys = []string{filters.DataScopeKey, "userId", "deptId", "username"}
+
+// JobContext 任务执行期间的上报与资源分配
+type JobContext interface {
+	// Report 上报执行进度，按间隔节流写库
+	Report(processed, total int64)
+	// ArtifactPath 分配结果文件路径，文件名不可猜测
+	ArtifactPath(ext string) (string, error)
+}
+
+// JobFunc 任务执行函数
+type JobFunc func(ctx context.Context, jc JobContext) (domainTools.JobOutcome, error)
+
+// JobConfig 后台任务配置
+type JobConfig struct {
+	ArtifactDir      string        // 结果文件目录 (默认: uploads/jobs)，不可位于静态资源目录下，仅通过下载接口鉴权后访问
+	ArtifactTTL      time.Duration // 结果文件保留时长 (默认: 24小时)
+	ProgressInterval time.Duration // 进度写库最小间隔 (默认: 1秒)
+}
+
+// DefaultJobConfig 默认配置
+func DefaultJobConfig() JobConfig {
+	return JobConfig{
+		ArtifactDir:      filepath.Join(".", "uploads/jobs"),
+		ArtifactTTL:      24 * time.Hour,
+		ProgressInterval: time.Second,
+	}
+}
+
+type JobService interface {
+	// Submit 创建任务并提交到任务池异步执行
+	Submit(ctx context.Context, domain domainTools.Job, fn JobFunc) (domainTools.Job, error)
+	// GetById 获取本人提交的任务
+	GetById(ctx context.Context, id, userId string) (domainTools.Job, error)
+	GetListPage(ctx context.Context, filter domainTools.JobFilter) ([]domainTools.Job, int64, error)
+	// Artifact 获取已完成任务的结果文件路径与文件名
+	Artifact(ctx context.Context, id, userId string) (string, string, error)
+	// CleanExpired 清理过期的结果文件，返回清理的文件数
+	CleanExpired(ctx context.Context) (int, error)
+	// RecoverUnfinished 将服务重启前未结束的任务标记为失败
+	RecoverUnfinished(ctx context.Context) (int64, error)
+}
+
+type jobService struct {
+	repo repositoryTools.JobRepository
+	pool *workerpool.Pool
+	cfg  JobConfig
+}
+
+func NewJobService(repo repositoryTools.JobRepository, pool *workerpool.Pool, cfg JobConfig) JobService {
+	def := DefaultJobConfig()
+	if cfg.ArtifactDir == "" {
+		cfg.ArtifactDir = def.ArtifactDir
+	}
+	if cfg.ArtifactTTL <= 0 {
+		cfg.ArtifactTTL = def.ArtifactTTL
+	}
+	if cfg.ProgressInterval <= 0 {
+		cfg.ProgressInterval = def.ProgressInterval
+	}
+	return &jobService{
+		repo: repo,
+		pool: pool,
+		cfg:  cfg,
+	}
+}
+
+// Submit 提交任务
+func (svc *jobService) Submit(ctx context.Context, domain domainTools.Job, fn JobFunc) (domainTools.Job, error) {
+	created, err := svc.repo.Create(ctx, domain)
+	if err != nil {
+		return domainTools.Job{}, err
+	}
+
+	values := make(map[string]any, len(jobContextKeys))
+	for _, key := range jobContextKeys {
+		if v := ctx.Value(key); v != nil {
+			values[key] = v
+		}
+	}
+
+	err = svc.pool.Submit(func(poolCtx context.Context) {
+		runCtx := poolCtx
+		for key, v := range values {
+			runCtx = context.WithValue(runCtx, key, v)
+		}
+		svc.run(runCtx, created.Id, fn)
+	})
+	if err != nil {
+		if failErr := svc.repo.Fail(context.WithoutCancel(ctx), created.Id, ErrJobBusy.Error(), domainTools.JobProgress{}); failErr != nil {
+			zap.L().Error("标记后台任务失败异常", zap.String("id", created.Id), zap.Error(failErr))
+		}
+		if errors.Is(err, workerpool.ErrPoolFull) {
+			return domainTools.Job{}, ErrJobBusy
+		}
+		return domainTools.Job{}, err
+	}
+
+	return created, nil
+}
+
+// run 执行任务并记录状态流转
+func (svc *jobService) run(ctx context.Context, id string, fn JobFunc) {
+	// 状态写入不受任务取消影响，保证中断的任务能落为失败
+	store := context.WithoutCancel(ctx)
+
+	if err := svc.repo.Start(store, id); err != nil {
+		zap.L().Error("后台任务启动失败", zap.String("id", id), zap.Error(err))
+		return
+	}
+
+	jc := &jobContext{svc: svc, ctx: store, id: id}
+	outcome, err := svc.execute(ctx, jc, fn)
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		svc.removeArtifacts(jc.artifacts...)
+		zap.L().Warn("后台任务执行失败", zap.String("id", id), zap.Error(err))
+		if failErr := svc.repo.Fail(store, id, err.Error(), jc.snapshot(outcome.JobProgress)); failErr != nil {
+			zap.L().Error("标记后台任务失败异常", zap.String("id", id), zap.Error(failErr))
+		}
+		return
+	}
+
+	if err := svc.repo.Finish(store, id, outcome, time.Now().Add(svc.cfg.ArtifactTTL)); err != nil {
+		svc.removeArtifacts(jc.artifacts...)
+		zap.L().Error("标记后台任务完成异常", zap.String("id", id), zap.Error(err))
+	}
+}
+
+// execute 执行任务函数，异常转为任务失败
+func (svc *jobService) execute(ctx context.Context, jc *jobContext, fn JobFunc) (outcome domainTools.JobOutcome, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			zap.L().Error("后台任务执行异常", zap.String("id", jc.id), zap.Any("panic", r))
+			err = fmt.Errorf("任务执行异常: %v", r)
+		}
+	}()
+	return fn(ctx, jc)
+}
+
+// GetById 获取详情，非本人提交的任务视为不存在
+func (svc *jobService) GetById(ctx context.Context, id, userId string) (domainTools.Job, error) {
+	domain, err := svc.repo.GetById(ctx, id)
+	if err != nil {
+		return domainTools.Job{}, err
+	}
+	if domain.Creator != userId {
+		return domainTools.Job{}, ErrJobNotFound
+	}
+	return svc.withDownload(domain), nil
+}
+
+// GetListPage 分页查询列表
+func (svc *jobService) GetListPage(ctx context.Context, filter domainTools.JobFilter) ([]domainTools.Job, int64, error) {
+	list, total, err := svc.repo.GetListPage(ctx, filter)
+	if err != nil {
+		return list, total, err
+	}
+	for i := range list {
+		list[i] = svc.withDownload(list[i])
+	}
+	return list, total, nil
+}
+
+// Artifact 获取结果文件
+func (svc *jobService) Artifact(ctx context.Context, id, userId string) (string, string, error) {
+	domain, err := svc.GetById(ctx, id, userId)
+	if err != nil {
+		return "", "", err
+	}
+	if domain.Status != job.StatusConstDone || domain.Artifact == "" {
+		return "", "", ErrJobArtifactNotReady
+	}
+	if _, err := os.Stat(domain.Artifact); err != nil {
+		return "", "", ErrJobArtifactNotReady
+	}
+	return domain.Artifact, domain.ArtifactName, nil
+}
+
+// CleanExpired 清理过期任务的结果文件，并清理结果目录中超过保留时长的其他导出文件
+func (svc *jobService) CleanExpired(ctx context.Context) (int, error) {
+	now := time.Now()
+	removed := 0
+
+	for {
+		list, err := svc.repo.GetExpired(ctx, now, 100)
+		if err != nil {
+			return removed, err
+		}
+		for _, domain := range list {
+			removed += svc.removeArtifacts(domain.Artifact)
+			if err := svc.repo.Expire(ctx, domain.Id); err != nil && !errors.Is(err, repositoryTools.ErrJobStatusConflict) {
+				return removed, err
+			}
+		}
+		if len(list) < 100 {
+			break
+		}
+	}
+
+	removed += svc.sweepArtifactDir(now.Add(-svc.cfg.ArtifactTTL))
+	return removed, nil
+}
+
+// RecoverUnfinished 回收中断的任务
+func (svc *jobService) RecoverUnfinished(ctx context.Context) (int64, error) {
+	return svc.repo.FailUnfinished(ctx, "服务重启，任务已中断，请重新提交")
+}
+
+// sweepArtifactDir 清理结果目录中修改时间早于 before 的文件及空的日期目录
+func (svc *jobService) sweepArtifactDir(before time.Time) int {
+	removed := 0
+	_ = filepath.WalkDir(svc.cfg.ArtifactDir, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil || info.ModTime().After(before) {
+			return nil
+		}
+		if err := os.Remove(path); err == nil {
+			removed++
+		}
+		return nil
+	})
+
+	entries, err := os.ReadDir(svc.cfg.ArtifactDir)
+	if err != nil {
+		return removed
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			// 仅删除空目录，非空时忽略错误
+			_ = os.Remove(filepath.Join(svc.cfg.ArtifactDir, entry.Name()))
+		}
+	}
+	return removed
+}
+
+// removeArtifacts 删除结果文件，返回删除的文件数
+func (svc *jobService) removeArtifacts(paths ...string) int {
+	removed := 0
+	for _, path := range paths {
+		if path == "" {
+			continue
+		}
+		if err := os.Remove(path); err != nil {
+			if !os.IsNotExist(err) {
+				zap.L().Warn("删除任务结果文件失败", zap.String("path", path), zap.Error(err))
+			}
+			continue
+		}
+		removed++
+	}
+	return removed
+}
+
+// withDownload 已完成且存在结果文件的任务返回下载地址
+func (svc *jobService) withDownload(domain domainTools.Job) domainTools.Job {
+	if domain.Status == job.StatusConstDone && domain.Artifact != "" {
+		domain.DownloadUrl = fmt.Sprintf(JobDownloadURL, domain.Id)
+	}
+	return domain
+}
+
+// jobContext 单个任务的执行上下文
+type jobContext struct {
+	svc       *jobService
+	ctx       context.Context
+	id        string
+	mu        sync.Mutex
+	progress  domainTools.JobProgress
+	flushedAt time.Time
+	artifacts []string
+}
+
+// Report 上报进度，距上次写库不足间隔时仅更新内存
+func (jc *jobContext) Report(processed, total int64) {
+	jc.mu.Lock()
+	jc.progress.Processed, jc.progress.Total = processed, total
+	if time.Since(jc.flushedAt) < jc.svc.cfg.ProgressInterval && processed < total {
+		jc.mu.Unlock()
+		return
+	}
+	jc.flushedAt = time.Now()
+	progress := jc.progress
+	jc.mu.Unlock()
+
+	if err := jc.svc.repo.UpdateProgress(jc.ctx, jc.id, progress); err != nil {
+		zap.L().Warn("更新后台任务进度失败", zap.String("id", jc.id), zap.Error(err))
+	}
+}
+
+// ArtifactPath 分配结果文件路径：结果目录/日期/随机名.后缀
+func (jc *jobContext) ArtifactPath(ext string) (string, error) {
+	dir := filepath.Join(jc.svc.cfg.ArtifactDir, time.Now().Format("20060102"))
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("创建目录失败: %w", err)
+	}
+
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, hex.EncodeToString(buf)+"."+strings.TrimPrefix(ext, "."))
+
+	jc.mu.Lock()
+	jc.artifacts = append(jc.artifacts, path)
+	jc.mu.Unlock()
+	return path, nil
+}
+
+// snapshot 合并任务返回的进度与最后一次上报的进度
+func (jc *jobContext) snapshot(progress domainTools.JobProgress) domainTools.JobProgress {
+	jc.mu.Lock()
+	defer jc.mu.Unlock()
+	if progress.Total == 0 && progress.Processed == 0 {
+		progress.Total, progress.Processed = jc.progress.Total, jc.progress.Processed
+	}
+	return progress
+}
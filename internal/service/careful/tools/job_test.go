@@ -0,0 +1,214 @@
+/**
+ * Description：
+ * FileName：job_test.go
+ * Author：CJiaの用心
+ * Create：2025/11/7 15:02:36
+ * Remark：
+ */
+
+package tools
+
+import (
+	"context"
+	"errors"
+	domainTools "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/tools"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/tools"
+	repomocks "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/mocks"
+	repositoryTools "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/tools"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/tools/job"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/workerpool"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func newTestJob(id, creator string, status job.StatusConst) domainTools.Job {
+	return domainTools.Job{
+		Job: tools.Job{
+			CoreModels: models.CoreModels{Id: id, Creator: creator},
+			Name:       "字典导出",
+			Type:       job.TypeConstExport,
+			Status:     status,
+		},
+	}
+}
+
+func Test_jobService_Submit(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) repositoryTools.JobRepository
+		fn   JobFunc
+	}{
+		{
+			name: "执行成功",
+			mock: func(ctrl *gomock.Controller) repositoryTools.JobRepository {
+				repo := repomocks.NewMockJobRepository(ctrl)
+				repo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					Return(newTestJob("1", "admin", job.StatusConstQueued), nil)
+				repo.EXPECT().Start(gomock.Any(), "1").Return(nil)
+				repo.EXPECT().UpdateProgress(gomock.Any(), "1", domainTools.JobProgress{Total: 2, Processed: 2}).
+					Return(nil)
+				repo.EXPECT().Finish(gomock.Any(), "1", domainTools.JobOutcome{
+					JobProgress: domainTools.JobProgress{Total: 2, Processed: 2, SuccessCount: 2},
+				}, gomock.Any()).Return(nil)
+				return repo
+			},
+			fn: func(ctx context.Context, jc JobContext) (domainTools.JobOutcome, error) {
+				// 请求上下文中的操作人需带入后台任务
+				if ctx.Value("userId") != "admin" {
+					return domainTools.JobOutcome{}, errors.New("操作人丢失")
+				}
+				jc.Report(2, 2)
+				return domainTools.JobOutcome{
+					JobProgress: domainTools.JobProgress{Total: 2, Processed: 2, SuccessCount: 2},
+				}, nil
+			},
+		},
+		{
+			name: "执行失败",
+			mock: func(ctrl *gomock.Controller) repositoryTools.JobRepository {
+				repo := repomocks.NewMockJobRepository(ctrl)
+				repo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					Return(newTestJob("1", "admin", job.StatusConstQueued), nil)
+				repo.EXPECT().Start(gomock.Any(), "1").Return(nil)
+				repo.EXPECT().Fail(gomock.Any(), "1", "数据库错误", domainTools.JobProgress{}).
+					Return(nil)
+				return repo
+			},
+			fn: func(ctx context.Context, jc JobContext) (domainTools.JobOutcome, error) {
+				return domainTools.JobOutcome{}, errors.New("数据库错误")
+			},
+		},
+		{
+			name: "执行异常",
+			mock: func(ctrl *gomock.Controller) repositoryTools.JobRepository {
+				repo := repomocks.NewMockJobRepository(ctrl)
+				repo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					Return(newTestJob("1", "admin", job.StatusConstQueued), nil)
+				repo.EXPECT().Start(gomock.Any(), "1").Return(nil)
+				repo.EXPECT().Fail(gomock.Any(), "1", "任务执行异常: boom", domainTools.JobProgress{}).
+					Return(nil)
+				return repo
+			},
+			fn: func(ctx context.Context, jc JobContext) (domainTools.JobOutcome, error) {
+				panic("boom")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := workerpool.New(workerpool.Config{Workers: 1, QueueSize: 1})
+			svc := NewJobService(tc.mock(ctrl), pool, JobConfig{ArtifactDir: t.TempDir()})
+
+			ctx := context.WithValue(context.Background(), "userId", "admin")
+			created, err := svc.Submit(ctx, newTestJob("", "admin", 0), tc.fn)
+			assert.NoError(t, err)
+			assert.Equal(t, "1", created.Id)
+
+			// 关闭任务池等待任务执行完成
+			assert.NoError(t, pool.Close(context.Background()))
+		})
+	}
+}
+
+func Test_jobService_Artifact(t *testing.T) {
+	artifact := filepath.Join(t.TempDir(), "export.xlsx")
+	assert.NoError(t, os.WriteFile(artifact, []byte("data"), 0644))
+
+	done := newTestJob("1", "admin", job.StatusConstDone)
+	done.Artifact, done.ArtifactName = artifact, "字典信息.xlsx"
+
+	testCases := []struct {
+		name     string
+		mock     func(ctrl *gomock.Controller) repositoryTools.JobRepository
+		userId   string
+		wantPath string
+		wantErr  error
+	}{
+		{
+			name: "下载成功",
+			mock: func(ctrl *gomock.Controller) repositoryTools.JobRepository {
+				repo := repomocks.NewMockJobRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), "1").Return(done, nil)
+				return repo
+			},
+			userId:   "admin",
+			wantPath: artifact,
+		},
+		{
+			name: "非本人任务",
+			mock: func(ctrl *gomock.Controller) repositoryTools.JobRepository {
+				repo := repomocks.NewMockJobRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), "1").Return(done, nil)
+				return repo
+			},
+			userId:  "other",
+			wantErr: ErrJobNotFound,
+		},
+		{
+			name: "任务未完成",
+			mock: func(ctrl *gomock.Controller) repositoryTools.JobRepository {
+				repo := repomocks.NewMockJobRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), "1").
+					Return(newTestJob("1", "admin", job.StatusConstRunning), nil)
+				return repo
+			},
+			userId:  "admin",
+			wantErr: ErrJobArtifactNotReady,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc := NewJobService(tc.mock(ctrl), nil, JobConfig{})
+			path, _, err := svc.Artifact(context.Background(), "1", tc.userId)
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantPath, path)
+		})
+	}
+}
+
+func Test_jobService_CleanExpired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := t.TempDir()
+	artifact := filepath.Join(dir, "20251101", "expired.xlsx")
+	orphan := filepath.Join(dir, "20251101", "orphan.csv")
+	fresh := filepath.Join(dir, "20251107", "fresh.xlsx")
+	for _, path := range []string{artifact, orphan, fresh} {
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		assert.NoError(t, os.WriteFile(path, []byte("data"), 0644))
+	}
+	old := time.Now().Add(-48 * time.Hour)
+	assert.NoError(t, os.Chtimes(orphan, old, old))
+
+	expired := newTestJob("1", "admin", job.StatusConstDone)
+	expired.Artifact = artifact
+
+	repo := repomocks.NewMockJobRepository(ctrl)
+	repo.EXPECT().GetExpired(gomock.Any(), gomock.Any(), 100).
+		Return([]domainTools.Job{expired}, nil)
+	repo.EXPECT().Expire(gomock.Any(), "1").Return(nil)
+
+	svc := NewJobService(repo, nil, JobConfig{ArtifactDir: dir})
+	removed, err := svc.CleanExpired(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, removed)
+
+	assert.NoFileExists(t, artifact)
+	assert.NoFileExists(t, orphan)
+	assert.FileExists(t, fresh)
+	assert.NoDirExists(t, filepath.Join(dir, "20251101"))
+}
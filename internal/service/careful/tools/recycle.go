@@ -0,0 +1,68 @@
+/**
+ * Description：
+ * FileName：recycle.go
+ * Author：CJiaの用心
+ * Create：2025/11/13 16:05:37
+ * Remark：
+ */
+
+package tools
+
+import (
+	"context"
+	"time"
+)
+
+// RecycleConfig 回收站配置
+type RecycleConfig struct {
+	Retention time.Duration // 保留时长，超过后彻底删除 (默认: 30天)
+}
+
+// DefaultRecycleConfig 默认配置
+func DefaultRecycleConfig() RecycleConfig {
+	return RecycleConfig{
+		Retention: 30 * 24 * time.Hour,
+	}
+}
+
+// RecyclePurger 彻底删除指定时间之前移入回收站的数据
+type RecyclePurger interface {
+	PurgeExpired(ctx context.Context, before time.Time) (int64, error)
+}
+
+type RecycleService interface {
+	// PurgeExpired 彻底删除超过保留时长的数据，返回删除的数量
+	PurgeExpired(ctx context.Context) (int64, error)
+}
+
+type recycleService struct {
+	purgers []RecyclePurger
+	cfg     RecycleConfig
+}
+
+// NewRecycleService 按传入顺序依次清理，被引用的数据需排在引用方之后
+func NewRecycleService(cfg RecycleConfig, purgers ...RecyclePurger) RecycleService {
+	if cfg.Retention <= 0 {
+		cfg.Retention = DefaultRecycleConfig().Retention
+	}
+	return &recycleService{
+		purgers: purgers,
+		cfg:     cfg,
+	}
+}
+
+// PurgeExpired 彻底删除超过保留时长的数据
+// 任一清理失败即返回，已清理的数量一并返回
+func (svc *recycleService) PurgeExpired(ctx context.Context) (int64, error) {
+	before := time.Now().Add(-svc.cfg.Retention)
+
+	var total int64
+	for _, purger := range svc.purgers {
+		purged, err := purger.PurgeExpired(ctx, before)
+		if err != nil {
+			return total, err
+		}
+		total += purged
+	}
+	return total, nil
+}
@@ -0,0 +1,445 @@
+/**
+ * Description：
+ * FileName：upload.go
+ * Author：CJiaの用心
+ * Create：2025/11/12 10:18:36
+ * Remark：
+ */
+
+package tools
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	domainTools "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/tools"
+	modelTools "github.com/carefuly/careful-admin-go-gin/internal/model/careful/tools"
+	cacheTools "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/careful/tools"
+	repositoryTools "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/tools"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrUploadSessionNotFound = errors.New("上传会话不存在或已过期")
+	ErrUploadHashInvalid     = errors.New("文件哈希需为SHA-256十六进制字符串")
+	ErrUploadTooLarge        = errors.New("文件大小超出上传限制")
+	ErrUploadChunkIndex      = errors.New("分片序号超出范围")
+	ErrUploadChunkSize       = errors.New("分片大小与会话不一致")
+	ErrUploadChunkChecksum   = errors.New("分片校验和不一致")
+	ErrUploadIncomplete      = errors.New("仍有分片未上传")
+	ErrUploadHashMismatch    = errors.New("合并后的文件哈希与声明不一致")
+	ErrUploadBucketRequired  = errors.New("未指定存储桶的上传会话仅可用于导入")
+)
+
+var (
+	uploadHashPattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+	uploadIdPattern   = regexp.MustCompile(`^[0-9a-f]{32}$`)
+)
+
+// uploadOrphanGrace 分片目录最近修改后的保留时长，避免清理与正在写入的分片冲突
+const uploadOrphanGrace = 10 * time.Minute
+
+// UploadConfig 分片上传配置
+type UploadConfig struct {
+	ChunkDir   string        // 分片暂存目录 (默认: uploads/chunks)
+	ChunkSize  int64         // 分片大小 (默认: 5MB)
+	MaxSize    int64         // 未指定存储桶时的文件大小上限 (默认: 1GB)
+	SessionTTL time.Duration // 会话空闲过期时长，每次上传分片后顺延 (默认: 24小时)
+}
+
+// DefaultUploadConfig 默认配置
+func DefaultUploadConfig() UploadConfig {
+	return UploadConfig{
+		ChunkDir:   filepath.Join(".", "uploads/chunks"),
+		ChunkSize:  5 << 20,
+		MaxSize:    1 << 30,
+		SessionTTL: 24 * time.Hour,
+	}
+}
+
+type UploadService interface {
+	// Init 创建上传会话，同一用户上传同一文件时返回未完成的会话用于断点续传
+	Init(ctx context.Context, domain domainTools.UploadSession) (domainTools.UploadSession, error)
+	// PutChunk 上传分片，checksum 为分片内容的 SHA-256 十六进制串
+	PutChunk(ctx context.Context, id, userId string, index int, checksum string, r io.Reader) error
+	// Get 获取本人的上传会话及已上传分片
+	Get(ctx context.Context, id, userId string) (domainTools.UploadSession, error)
+	// Complete 合并分片并校验哈希后写入存储桶
+	Complete(ctx context.Context, id, userId string) (domainTools.File, error)
+	// Assemble 合并分片并校验哈希后写入本地目录，返回文件路径，用于导入
+	Assemble(ctx context.Context, id, userId, dir string) (string, error)
+	// Abort 取消上传会话并删除已上传分片
+	Abort(ctx context.Context, id, userId string) error
+	// CleanOrphans 清理会话已过期的分片目录，返回清理数量
+	CleanOrphans(ctx context.Context) (int, error)
+}
+
+type uploadService struct {
+	cache      cacheTools.UploadCache
+	bucketRepo repositoryTools.BucketRepository
+	fileSvc    FileService
+	cfg        UploadConfig
+}
+
+func NewUploadService(cache cacheTools.UploadCache, bucketRepo repositoryTools.BucketRepository, fileSvc FileService, cfg UploadConfig) UploadService {
+	def := DefaultUploadConfig()
+	if cfg.ChunkDir == "" {
+		cfg.ChunkDir = def.ChunkDir
+	}
+	if cfg.ChunkSize <= 0 {
+		cfg.ChunkSize = def.ChunkSize
+	}
+	if cfg.MaxSize <= 0 {
+		cfg.MaxSize = def.MaxSize
+	}
+	if cfg.SessionTTL <= 0 {
+		cfg.SessionTTL = def.SessionTTL
+	}
+	return &uploadService{
+		cache:      cache,
+		bucketRepo: bucketRepo,
+		fileSvc:    fileSvc,
+		cfg:        cfg,
+	}
+}
+
+// Init 创建上传会话
+func (svc *uploadService) Init(ctx context.Context, domain domainTools.UploadSession) (domainTools.UploadSession, error) {
+	domain.Hash = strings.ToLower(domain.Hash)
+	if !uploadHashPattern.MatchString(domain.Hash) {
+		return domainTools.UploadSession{}, ErrUploadHashInvalid
+	}
+	if domain.Size <= 0 {
+		return domainTools.UploadSession{}, ErrFileEmpty
+	}
+	if err := svc.checkSize(ctx, domain.Bucket, domain.Size); err != nil {
+		return domainTools.UploadSession{}, err
+	}
+
+	// 断点续传：返回同一用户同一文件未完成的会话
+	if id, err := svc.cache.FindResumable(ctx, domain.UserId, domain.Bucket, domain.Hash); err == nil {
+		session, err := svc.Get(ctx, id, domain.UserId)
+		if err == nil && session.Size == domain.Size {
+			return session, nil
+		}
+	} else if !errors.Is(err, cacheTools.ErrUploadSessionNotExist) {
+		return domainTools.UploadSession{}, err
+	}
+
+	domain.Id = strings.ReplaceAll(uuid.NewString(), "-", "")
+	domain.Name = filepath.Base(domain.Name)
+	domain.ChunkSize = svc.cfg.ChunkSize
+	domain.TotalChunks = int((domain.Size + domain.ChunkSize - 1) / domain.ChunkSize)
+	if err := svc.cache.SetSession(ctx, domain, svc.cfg.SessionTTL); err != nil {
+		return domainTools.UploadSession{}, err
+	}
+	domain.Uploaded = []int{}
+	return domain, nil
+}
+
+// PutChunk 上传分片
+// 分片先写入临时文件并校验长度与校验和，通过后再重命名，重复上传同一分片会覆盖
+func (svc *uploadService) PutChunk(ctx context.Context, id, userId string, index int, checksum string, r io.Reader) error {
+	session, err := svc.session(ctx, id, userId)
+	if err != nil {
+		return err
+	}
+	if index < 0 || index >= session.TotalChunks {
+		return ErrUploadChunkIndex
+	}
+
+	dir := svc.chunkDir(session.Id)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, "chunk-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}()
+
+	expected := session.ChunkLength(index)
+	sum := sha256.New()
+	size, err := io.Copy(io.MultiWriter(tmp, sum), io.LimitReader(r, expected+1))
+	if err != nil {
+		return fmt.Errorf("读取分片失败: %w", err)
+	}
+	if size != expected {
+		return ErrUploadChunkSize
+	}
+	if hex.EncodeToString(sum.Sum(nil)) != strings.ToLower(checksum) {
+		return ErrUploadChunkChecksum
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), svc.chunkPath(session.Id, index)); err != nil {
+		return err
+	}
+
+	return svc.cache.AddChunk(ctx, session, index, svc.cfg.SessionTTL)
+}
+
+// Get 获取上传会话
+func (svc *uploadService) Get(ctx context.Context, id, userId string) (domainTools.UploadSession, error) {
+	session, err := svc.session(ctx, id, userId)
+	if err != nil {
+		return domainTools.UploadSession{}, err
+	}
+	session.Uploaded, err = svc.cache.Chunks(ctx, session.Id)
+	if err != nil {
+		return domainTools.UploadSession{}, err
+	}
+	return session, nil
+}
+
+// Complete 合并分片写入存储桶
+func (svc *uploadService) Complete(ctx context.Context, id, userId string) (domainTools.File, error) {
+	session, err := svc.complete(ctx, id, userId)
+	if err != nil {
+		return domainTools.File{}, err
+	}
+	if session.Bucket == "" {
+		return domainTools.File{}, ErrUploadBucketRequired
+	}
+
+	reader, closeFn, err := svc.open(session)
+	if err != nil {
+		return domainTools.File{}, err
+	}
+	defer closeFn()
+
+	file, err := svc.fileSvc.Upload(ctx, session.Bucket, domainTools.File{
+		File: modelTools.File{
+			CoreModels: models.CoreModels{
+				Creator:    session.UserId,
+				Modifier:   session.UserId,
+				BelongDept: session.DeptId,
+			},
+			Name: session.Name,
+			Size: session.Size,
+		},
+	}, reader)
+	if err != nil {
+		return domainTools.File{}, err
+	}
+
+	svc.discard(ctx, session)
+	return file, nil
+}
+
+// Assemble 合并分片写入本地目录
+func (svc *uploadService) Assemble(ctx context.Context, id, userId, dir string) (string, error) {
+	session, err := svc.complete(ctx, id, userId)
+	if err != nil {
+		return "", err
+	}
+
+	reader, closeFn, err := svc.open(session)
+	if err != nil {
+		return "", err
+	}
+	defer closeFn()
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	dst, err := os.CreateTemp(dir, "*"+strings.ToLower(filepath.Ext(session.Name)))
+	if err != nil {
+		return "", err
+	}
+	if _, err := io.Copy(dst, reader); err != nil {
+		_ = dst.Close()
+		_ = os.Remove(dst.Name())
+		return "", fmt.Errorf("合并分片失败: %w", err)
+	}
+	if err := dst.Close(); err != nil {
+		_ = os.Remove(dst.Name())
+		return "", err
+	}
+
+	svc.discard(ctx, session)
+	return dst.Name(), nil
+}
+
+// Abort 取消上传会话
+func (svc *uploadService) Abort(ctx context.Context, id, userId string) error {
+	session, err := svc.session(ctx, id, userId)
+	if err != nil {
+		return err
+	}
+	if err := svc.cache.Del(ctx, session); err != nil {
+		return err
+	}
+	return os.RemoveAll(svc.chunkDir(session.Id))
+}
+
+// CleanOrphans 清理会话已过期的分片目录
+func (svc *uploadService) CleanOrphans(ctx context.Context) (int, error) {
+	entries, err := os.ReadDir(svc.cfg.ChunkDir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	removed := 0
+	for _, entry := range entries {
+		if !entry.IsDir() || !uploadIdPattern.MatchString(entry.Name()) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || time.Since(info.ModTime()) < uploadOrphanGrace {
+			continue
+		}
+
+		_, err = svc.cache.GetSession(ctx, entry.Name())
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, cacheTools.ErrUploadSessionNotExist) {
+			return removed, err
+		}
+		if err := os.RemoveAll(filepath.Join(svc.cfg.ChunkDir, entry.Name())); err != nil {
+			zap.L().Error("删除过期的上传分片失败", zap.String("uploadId", entry.Name()), zap.Error(err))
+			continue
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// checkSize 校验文件大小，指定存储桶时按存储桶限制，否则按上传配置限制
+func (svc *uploadService) checkSize(ctx context.Context, bucketCode string, size int64) error {
+	if bucketCode == "" {
+		if size > svc.cfg.MaxSize {
+			return ErrUploadTooLarge
+		}
+		return nil
+	}
+
+	bucket, err := svc.bucketRepo.GetByCode(ctx, bucketCode)
+	if err != nil {
+		if errors.Is(err, repositoryTools.ErrBucketNotFound) {
+			return ErrBucketNotFound
+		}
+		return err
+	}
+	if !bucket.Status {
+		return ErrBucketDisabled
+	}
+	if bucket.MaxSize > 0 && size > bucket.MaxSize {
+		return ErrFileTooLarge
+	}
+	return nil
+}
+
+// session 获取本人的上传会话，他人的会话视为不存在
+func (svc *uploadService) session(ctx context.Context, id, userId string) (domainTools.UploadSession, error) {
+	if !uploadIdPattern.MatchString(id) {
+		return domainTools.UploadSession{}, ErrUploadSessionNotFound
+	}
+	session, err := svc.cache.GetSession(ctx, id)
+	if err != nil {
+		if errors.Is(err, cacheTools.ErrUploadSessionNotExist) {
+			return domainTools.UploadSession{}, ErrUploadSessionNotFound
+		}
+		return domainTools.UploadSession{}, err
+	}
+	if session.UserId != userId {
+		return domainTools.UploadSession{}, ErrUploadSessionNotFound
+	}
+	return *session, nil
+}
+
+// complete 获取分片已全部上传的会话
+func (svc *uploadService) complete(ctx context.Context, id, userId string) (domainTools.UploadSession, error) {
+	session, err := svc.Get(ctx, id, userId)
+	if err != nil {
+		return domainTools.UploadSession{}, err
+	}
+	if len(session.Uploaded) != session.TotalChunks {
+		return domainTools.UploadSession{}, ErrUploadIncomplete
+	}
+	return session, nil
+}
+
+// open 按序串联全部分片，读取结束时校验整体哈希
+func (svc *uploadService) open(session domainTools.UploadSession) (io.Reader, func(), error) {
+	files := make([]*os.File, 0, session.TotalChunks)
+	closeFn := func() {
+		for _, f := range files {
+			_ = f.Close()
+		}
+	}
+
+	readers := make([]io.Reader, 0, session.TotalChunks)
+	for index := 0; index < session.TotalChunks; index++ {
+		f, err := os.Open(svc.chunkPath(session.Id, index))
+		if err != nil {
+			closeFn()
+			if errors.Is(err, os.ErrNotExist) {
+				return nil, nil, ErrUploadIncomplete
+			}
+			return nil, nil, err
+		}
+		files = append(files, f)
+		readers = append(readers, f)
+	}
+
+	return &hashVerifyReader{
+		r:    io.MultiReader(readers...),
+		hash: sha256.New(),
+		want: session.Hash,
+	}, closeFn, nil
+}
+
+// discard 上传完成后删除会话与分片，失败仅记录日志，残留分片由定时任务清理
+func (svc *uploadService) discard(ctx context.Context, session domainTools.UploadSession) {
+	if err := svc.cache.Del(ctx, session); err != nil {
+		zap.L().Error("删除上传会话失败", zap.String("uploadId", session.Id), zap.Error(err))
+	}
+	if err := os.RemoveAll(svc.chunkDir(session.Id)); err != nil {
+		zap.L().Error("删除上传分片失败", zap.String("uploadId", session.Id), zap.Error(err))
+	}
+}
+
+func (svc *uploadService) chunkDir(id string) string {
+	return filepath.Join(svc.cfg.ChunkDir, id)
+}
+
+func (svc *uploadService) chunkPath(id string, index int) string {
+	return filepath.Join(svc.chunkDir(id), strconv.Itoa(index))
+}
+
+// hashVerifyReader 读取到末尾时校验内容哈希，不一致返回 ErrUploadHashMismatch
+type hashVerifyReader struct {
+	r    io.Reader
+	hash hash.Hash
+	want string
+}
+
+func (r *hashVerifyReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.hash.Write(p[:n])
+	if errors.Is(err, io.EOF) && hex.EncodeToString(r.hash.Sum(nil)) != r.want {
+		return n, ErrUploadHashMismatch
+	}
+	return n, err
+}
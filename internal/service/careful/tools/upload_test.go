@@ -0,0 +1,311 @@
+/**
+ * Description：
+ * FileName：upload_test.go
+ * Author：CJiaの用心
+ * Create：2025/11/12 11:26:50
+ * Remark：
+ */
+
+package tools
+
+import (
+	"bytes"
+	"context"
+	domainTools "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/tools"
+	cacheTools "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/careful/tools"
+	repomocks "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/mocks"
+	repositoryTools "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/tools"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+// memoryUploadCache 内存实现的上传会话缓存，仅用于测试
+type memoryUploadCache struct {
+	mu       sync.Mutex
+	sessions map[string]domainTools.UploadSession
+	chunks   map[string]map[int]struct{}
+	resume   map[string]string
+}
+
+func newMemoryUploadCache() *memoryUploadCache {
+	return &memoryUploadCache{
+		sessions: make(map[string]domainTools.UploadSession),
+		chunks:   make(map[string]map[int]struct{}),
+		resume:   make(map[string]string),
+	}
+}
+
+func (c *memoryUploadCache) SetSession(ctx context.Context, session domainTools.UploadSession, expiration time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	session.Uploaded = nil
+	c.sessions[session.Id] = session
+	c.resume[session.UserId+session.Bucket+session.Hash] = session.Id
+	return nil
+}
+
+func (c *memoryUploadCache) GetSession(ctx context.Context, id string) (*domainTools.UploadSession, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	session, ok := c.sessions[id]
+	if !ok {
+		return nil, cacheTools.ErrUploadSessionNotExist
+	}
+	return &session, nil
+}
+
+func (c *memoryUploadCache) FindResumable(ctx context.Context, userId, bucket, hash string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	id, ok := c.resume[userId+bucket+hash]
+	if !ok {
+		return "", cacheTools.ErrUploadSessionNotExist
+	}
+	return id, nil
+}
+
+func (c *memoryUploadCache) AddChunk(ctx context.Context, session domainTools.UploadSession, index int, expiration time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.chunks[session.Id] == nil {
+		c.chunks[session.Id] = make(map[int]struct{})
+	}
+	c.chunks[session.Id][index] = struct{}{}
+	return nil
+}
+
+func (c *memoryUploadCache) Chunks(ctx context.Context, id string) ([]int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	chunks := make([]int, 0, len(c.chunks[id]))
+	for index := range c.chunks[id] {
+		chunks = append(chunks, index)
+	}
+	sort.Ints(chunks)
+	return chunks, nil
+}
+
+func (c *memoryUploadCache) Del(ctx context.Context, session domainTools.UploadSession) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.sessions, session.Id)
+	delete(c.chunks, session.Id)
+	delete(c.resume, session.UserId+session.Bucket+session.Hash)
+	return nil
+}
+
+func newTestUploadService(t *testing.T, cache cacheTools.UploadCache, bucketRepo repositoryTools.BucketRepository, fileSvc FileService) UploadService {
+	return NewUploadService(cache, bucketRepo, fileSvc, UploadConfig{
+		ChunkDir:  t.TempDir(),
+		ChunkSize: 16,
+	})
+}
+
+// putChunks 按分片大小切分内容并逐个上传
+func putChunks(t *testing.T, svc UploadService, session domainTools.UploadSession, content []byte) {
+	for index := 0; index < session.TotalChunks; index++ {
+		end := min(int64(index+1)*session.ChunkSize, int64(len(content)))
+		chunk := content[int64(index)*session.ChunkSize : end]
+		require.NoError(t, svc.PutChunk(context.Background(), session.Id, "U1", index, testHash(chunk), bytes.NewReader(chunk)))
+	}
+}
+
+func Test_uploadService_Init(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	svc := newTestUploadService(t, newMemoryUploadCache(), repomocks.NewMockBucketRepository(ctrl), nil)
+	content := bytes.Repeat([]byte("a"), 40)
+
+	session, err := svc.Init(ctx, domainTools.UploadSession{UserId: "U1", Name: "../dict.xlsx", Size: 40, Hash: testHash(content)})
+	require.NoError(t, err)
+	assert.Equal(t, "dict.xlsx", session.Name)
+	assert.Equal(t, int64(16), session.ChunkSize)
+	assert.Equal(t, 3, session.TotalChunks)
+	assert.Empty(t, session.Uploaded)
+
+	// 同一用户同一文件续传返回原会话及已上传分片
+	require.NoError(t, svc.PutChunk(ctx, session.Id, "U1", 2, testHash(content[32:]), bytes.NewReader(content[32:])))
+	resumed, err := svc.Init(ctx, domainTools.UploadSession{UserId: "U1", Name: "dict.xlsx", Size: 40, Hash: testHash(content)})
+	require.NoError(t, err)
+	assert.Equal(t, session.Id, resumed.Id)
+	assert.Equal(t, []int{2}, resumed.Uploaded)
+
+	// 其他用户创建新会话，且无法访问他人的会话
+	other, err := svc.Init(ctx, domainTools.UploadSession{UserId: "U2", Name: "dict.xlsx", Size: 40, Hash: testHash(content)})
+	require.NoError(t, err)
+	assert.NotEqual(t, session.Id, other.Id)
+	_, err = svc.Get(ctx, session.Id, "U2")
+	assert.ErrorIs(t, err, ErrUploadSessionNotFound)
+
+	_, err = svc.Init(ctx, domainTools.UploadSession{UserId: "U1", Size: 40, Hash: "abc"})
+	assert.ErrorIs(t, err, ErrUploadHashInvalid)
+	_, err = svc.Init(ctx, domainTools.UploadSession{UserId: "U1", Size: 2 << 30, Hash: testHash(content)})
+	assert.ErrorIs(t, err, ErrUploadTooLarge)
+}
+
+func Test_uploadService_PutChunk(t *testing.T) {
+	content := bytes.Repeat([]byte("a"), 40)
+
+	testCases := []struct {
+		name     string
+		index    int
+		chunk    []byte
+		checksum string
+		wantErr  error
+	}{
+		{name: "完整分片", index: 0, chunk: content[:16], checksum: testHash(content[:16])},
+		{name: "最后一个分片可小于分片大小", index: 2, chunk: content[32:], checksum: testHash(content[32:])},
+		{name: "分片序号越界", index: 3, chunk: content[:16], checksum: testHash(content[:16]), wantErr: ErrUploadChunkIndex},
+		{name: "分片长度不足", index: 0, chunk: content[:10], checksum: testHash(content[:10]), wantErr: ErrUploadChunkSize},
+		{name: "分片长度超出", index: 2, chunk: content[:16], checksum: testHash(content[:16]), wantErr: ErrUploadChunkSize},
+		{name: "校验和不一致", index: 1, chunk: content[:16], checksum: testHash(content[:8]), wantErr: ErrUploadChunkChecksum},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			svc := newTestUploadService(t, newMemoryUploadCache(), repomocks.NewMockBucketRepository(ctrl), nil)
+			session, err := svc.Init(ctx, domainTools.UploadSession{UserId: "U1", Name: "a.txt", Size: 40, Hash: testHash(content)})
+			require.NoError(t, err)
+
+			err = svc.PutChunk(ctx, session.Id, "U1", tc.index, tc.checksum, bytes.NewReader(tc.chunk))
+			assert.ErrorIs(t, err, tc.wantErr)
+
+			session, err = svc.Get(ctx, session.Id, "U1")
+			require.NoError(t, err)
+			if tc.wantErr != nil {
+				assert.Empty(t, session.Uploaded)
+				return
+			}
+			assert.Equal(t, []int{tc.index}, session.Uploaded)
+		})
+	}
+}
+
+func Test_uploadService_Complete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	content := append(append([]byte{}, testPNG...), bytes.Repeat([]byte{1}, 20)...)
+	hash := testHash(content)
+
+	bucketRepo := repomocks.NewMockBucketRepository(ctrl)
+	bucketRepo.EXPECT().GetByCode(gomock.Any(), "avatar").Return(newTestBucket(1024, "image/*"), nil).Times(2)
+	repo := repomocks.NewMockFileRepository(ctrl)
+	repo.EXPECT().GetByHash(gomock.Any(), "B1", hash).Return(domainTools.File{}, repositoryTools.ErrFileNotFound)
+	repo.EXPECT().Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, domain domainTools.File) (domainTools.File, error) {
+			assert.Equal(t, "U1", domain.Creator)
+			assert.Equal(t, "D1", domain.BelongDept)
+			assert.Equal(t, "头像.png", domain.Name)
+			assert.Equal(t, int64(len(content)), domain.Size)
+			return domain, nil
+		})
+	driver := newTestDriver(t)
+	cache := newMemoryUploadCache()
+	svc := newTestUploadService(t, cache, bucketRepo, NewFileService(repo, bucketRepo, driver, time.Minute))
+
+	session, err := svc.Init(ctx, domainTools.UploadSession{
+		UserId: "U1", DeptId: "D1", Bucket: "avatar", Name: "头像.png", Size: int64(len(content)), Hash: hash,
+	})
+	require.NoError(t, err)
+
+	_, err = svc.Complete(ctx, session.Id, "U1")
+	assert.ErrorIs(t, err, ErrUploadIncomplete)
+
+	putChunks(t, svc, session, content)
+	file, err := svc.Complete(ctx, session.Id, "U1")
+	require.NoError(t, err)
+	assert.Equal(t, hash, file.Hash)
+
+	exists, err := driver.Exists(ctx, file.ObjectKey)
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	// 完成后会话与分片均已删除
+	_, err = svc.Get(ctx, session.Id, "U1")
+	assert.ErrorIs(t, err, ErrUploadSessionNotFound)
+	entries, err := os.ReadDir(svc.(*uploadService).cfg.ChunkDir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func Test_uploadService_Assemble(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	content := bytes.Repeat([]byte("0123456789"), 5)
+	svc := newTestUploadService(t, newMemoryUploadCache(), repomocks.NewMockBucketRepository(ctrl), nil)
+	dir := t.TempDir()
+
+	session, err := svc.Init(ctx, domainTools.UploadSession{UserId: "U1", Name: "dict.XLSX", Size: int64(len(content)), Hash: testHash(content)})
+	require.NoError(t, err)
+	_, err = svc.Complete(ctx, session.Id, "U1")
+	assert.ErrorIs(t, err, ErrUploadIncomplete)
+
+	putChunks(t, svc, session, content)
+	path, err := svc.Assemble(ctx, session.Id, "U1", dir)
+	require.NoError(t, err)
+	assert.Equal(t, ".xlsx", filepath.Ext(path))
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, content, data)
+
+	// 声明的哈希与分片内容不一致时不产生文件
+	forged := bytes.Repeat([]byte("x"), len(content))
+	session, err = svc.Init(ctx, domainTools.UploadSession{UserId: "U1", Name: "dict.xlsx", Size: int64(len(content)), Hash: testHash(forged)})
+	require.NoError(t, err)
+	putChunks(t, svc, session, content)
+	_, err = svc.Assemble(ctx, session.Id, "U1", dir)
+	assert.ErrorIs(t, err, ErrUploadHashMismatch)
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func Test_uploadService_CleanOrphans(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	cache := newMemoryUploadCache()
+	svc := newTestUploadService(t, cache, repomocks.NewMockBucketRepository(ctrl), nil)
+	chunkDir := svc.(*uploadService).cfg.ChunkDir
+	content := bytes.Repeat([]byte("a"), 40)
+
+	active, err := svc.Init(ctx, domainTools.UploadSession{UserId: "U1", Name: "a.txt", Size: 40, Hash: testHash(content)})
+	require.NoError(t, err)
+	putChunks(t, svc, active, content)
+	expired, err := svc.Init(ctx, domainTools.UploadSession{UserId: "U2", Name: "a.txt", Size: 40, Hash: testHash(content)})
+	require.NoError(t, err)
+	require.NoError(t, svc.PutChunk(ctx, expired.Id, "U2", 0, testHash(content[:16]), bytes.NewReader(content[:16])))
+	// 模拟会话过期
+	require.NoError(t, cache.Del(ctx, expired))
+
+	old := time.Now().Add(-time.Hour)
+	for _, id := range []string{active.Id, expired.Id} {
+		require.NoError(t, os.Chtimes(filepath.Join(chunkDir, id), old, old))
+	}
+
+	removed, err := svc.CleanOrphans(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed)
+	_, err = os.Stat(filepath.Join(chunkDir, active.Id))
+	assert.NoError(t, err)
+	_, err = os.Stat(filepath.Join(chunkDir, expired.Id))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -27,20 +27,48 @@ import (
 
 // LoginRequest 登录请求
 type LoginRequest struct {
-	Username string `json:"username" binding:"required,min=3,max=50"` // 用户名
-	Password string `json:"password" binding:"required,min=6,max=50"` // 密码
+	Username    string `json:"username" binding:"required,min=3,max=50"` // 用户名
+	Password    string `json:"password" binding:"required,min=6,max=50"` // 密码
+	CaptchaId   string `json:"captchaId"`                                // 验证码id，多次失败后必填
+	CaptchaCode string `json:"captchaCode"`                              // 验证码，多次失败后必填
+}
+
+// LoginFailResponse 登录失败响应
+type LoginFailResponse struct {
+	CaptchaRequired bool   `json:"captchaRequired"`       // 下次登录是否需要验证码
+	LockedUntil     string `json:"lockedUntil,omitempty"` // 锁定截止时间
+}
+
+// CaptchaResponse 验证码响应
+type CaptchaResponse struct {
+	CaptchaId string `json:"captchaId"` // 验证码id
+	Image     string `json:"image"`     // base64图片
 }
 
 // LoginResponse 登录响应
 type LoginResponse struct {
-	Token  string            `json:"token"`  // JWT令牌
-	User   domainSystem.User `json:"user"`   // 用户信息
-	Expire int               `json:"expire"` // 过期时间(秒)
+	Token         string            `json:"token"`         // JWT令牌
+	RefreshToken  string            `json:"refreshToken"`  // 刷新令牌
+	User          domainSystem.User `json:"user"`          // 用户信息
+	Expire        int               `json:"expire"`        // 过期时间(秒)
+	RefreshExpire int               `json:"refreshExpire"` // 刷新令牌过期时间(秒)
 }
 
 // RefreshTokenRequest 刷新令牌请求
 type RefreshTokenRequest struct {
-	Token string `json:"token" binding:"required" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6Ikp..."` // 旧的JWT令牌
+	RefreshToken string `json:"refreshToken" binding:"required" example:"Q2FyZWZ1bEFkbWluUmVmcmVzaFRva2Vu..."` // 刷新令牌
+}
+
+// SessionResponse 会话信息
+type SessionResponse struct {
+	Id         string `json:"id"`         // 会话ID
+	Ip         string `json:"ip"`         // 登录IP
+	Os         string `json:"os"`         // 操作系统
+	Browser    string `json:"browser"`    // 浏览器
+	UserAgent  string `json:"userAgent"`  // 设备信息
+	LoginTime  string `json:"loginTime"`  // 登录时间
+	ExpireTime string `json:"expireTime"` // 过期时间
+	Current    bool   `json:"current"`    // 是否当前会话
 }
 
 // ChangePasswordRequest 修改密码请求
@@ -52,9 +80,13 @@ type ChangePasswordRequest struct {
 type AuthsHandler interface {
 	RegisterRoutes(router *gin.RouterGroup)
 	LoginHandler(ctx *gin.Context)
+	CaptchaHandler(ctx *gin.Context)
 	RefreshTokenHandler(ctx *gin.Context)
 	LogoutHandler(ctx *gin.Context)
 	ProfileHandler(ctx *gin.Context)
+	MenusHandler(ctx *gin.Context)
+	SessionsHandler(ctx *gin.Context)
+	RevokeSessionHandler(ctx *gin.Context)
 }
 
 type authHandler struct {
@@ -62,29 +94,40 @@ type authHandler struct {
 	userSvc      serviceSystem.UserService
 	jwtSvc       *jwt.DefaultJWTService
 	blacklistSvc *jwt.TokenBlacklist
+	refreshSvc   *jwt.RefreshTokenManager
+	menuSvc      serviceSystem.MenuService
+	guardSvc     serviceSystem.LoginGuardService
 }
 
 func NewAuthHandler(rely config.RelyConfig, svc serviceSystem.UserService,
-	jwtSvc *jwt.DefaultJWTService, blacklistSvc *jwt.TokenBlacklist) AuthsHandler {
+	jwtSvc *jwt.DefaultJWTService, blacklistSvc *jwt.TokenBlacklist, refreshSvc *jwt.RefreshTokenManager,
+	menuSvc serviceSystem.MenuService, guardSvc serviceSystem.LoginGuardService) AuthsHandler {
 	return &authHandler{
 		rely:         rely,
 		userSvc:      svc,
 		jwtSvc:       jwtSvc,
 		blacklistSvc: blacklistSvc,
+		refreshSvc:   refreshSvc,
+		menuSvc:      menuSvc,
+		guardSvc:     guardSvc,
 	}
 }
 
 // RegisterRoutes 注册路由
 func (h *authHandler) RegisterRoutes(router *gin.RouterGroup) {
 	router.POST("/login", h.LoginHandler)
+	router.GET("/captcha", h.CaptchaHandler)
 	router.POST("/refresh-token", h.RefreshTokenHandler)
 	router.POST("/logout", h.LogoutHandler)
 	router.GET("/profile", h.ProfileHandler)
+	router.GET("/menus", h.MenusHandler)
+	router.GET("/sessions", h.SessionsHandler)
+	router.DELETE("/sessions/:id", h.RevokeSessionHandler)
 }
 
 // LoginHandler
 // @Summary 账号密码登录
-// @Description 账号密码登录
+// @Description 账号密码登录，连续失败后需携带验证码，超过上限将临时锁定
 // @Tags 认证管理
 // @Accept application/json
 // @Produce application/json
@@ -99,23 +142,46 @@ func (h *authHandler) LoginHandler(ctx *gin.Context) {
 		return
 	}
 
+	// 登录防护：锁定与验证码检查
+	ip := request_utils.NormalizeIP(ctx)
+	if err := h.guardSvc.Check(ctx, req.Username, ip, req.CaptchaId, req.CaptchaCode); err != nil {
+		h.loginFailed(ctx, req.Username, domainSystem.User{}, err, true)
+		return
+	}
+
 	// 调用业务逻辑
 	domain, err := h.userSvc.Login(ctx, req.Username, req.Password)
 	if err != nil {
-		switch {
-		case errors.Is(err, serviceSystem.ErrUserInvalidCredential):
-			response.NewResponse().Error(ctx, http.StatusBadRequest, "用户名或密码错误", nil)
-			return
-		default:
-			ctx.Set("internalError", fmt.Sprintf("用户登录异常 >>> %v", err.Error()))
-			zap.S().Error("用户登录异常 >>> ", zap.Error(err))
-			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
-			return
+		h.loginFailed(ctx, req.Username, domain, err, errors.Is(err, serviceSystem.ErrUserInvalidCredential))
+		return
+	}
+
+	// 签发刷新令牌并登记会话
+	ua := request_utils.GetUserAgent(ctx)
+	family, refreshToken, err := h.refreshSvc.Issue(ctx, jwt.SessionInfo{
+		UserId:    domain.Id,
+		Username:  domain.Username,
+		Ip:        ip,
+		Os:        request_utils.GetOS(ua),
+		Browser:   request_utils.GetBrowser(ua),
+		UserAgent: ua,
+	})
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("生成刷新令牌异常 >>> %v", err.Error()))
+		zap.S().Error("生成刷新令牌异常 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	// 单会话模式：注销其他会话
+	if h.jwtSvc.Config().SingleSession {
+		if _, err := h.refreshSvc.RevokeUser(ctx, domain.Id, family.Id); err != nil {
+			zap.S().Warn("注销历史会话异常 >>> ", zap.Error(err))
 		}
 	}
 
 	// 生成JWT令牌
-	token, err := h.jwtSvc.GenerateToken(ctx, domain.Id, domain)
+	token, err := h.jwtSvc.GenerateSessionToken(ctx, domain, family.Id, time.Unix(family.AuthTime, 0))
 	if err != nil {
 		ctx.Set("internalError", fmt.Sprintf("生成令牌异常 >>> %v", err.Error()))
 		zap.S().Error("生成令牌异常 >>> ", zap.Error(err))
@@ -123,19 +189,93 @@ func (h *authHandler) LoginHandler(ctx *gin.Context) {
 		return
 	}
 
+	// 清除失败记录
+	if err := h.guardSvc.RecordSuccess(ctx, req.Username, ip); err != nil {
+		zap.S().Warn("清除登录失败记录异常 >>> ", zap.Error(err))
+	}
+
 	// 记录登录日志
-	go request_utils.SaveLoginLog(ctx, domain, h.rely.Db.Careful)
+	go request_utils.SaveLoginLog(request_utils.NewLoginLogInfo(ctx, req.Username, domain, true, ""), h.rely.LogSink)
 
 	// 返回用户信息和令牌
 	response.NewResponse().Success(ctx, "登录成功", LoginResponse{
-		Token:  token,
-		Expire: h.rely.Token.Expire * 3600,
+		Token:         token,
+		RefreshToken:  refreshToken,
+		Expire:        h.rely.Token.Load().Expire * 3600,
+		RefreshExpire: int(h.refreshSvc.RefreshExpire().Seconds()),
 	})
 }
 
+// CaptchaHandler
+// @Summary 获取登录验证码
+// @Description 获取图片验证码，多次登录失败后登录需携带验证码
+// @Tags 认证管理
+// @Accept application/json
+// @Produce application/json
+// @Success 200 {object} CaptchaResponse
+// @Failure 400 {object} response.Response
+// @Router /v1/auth/captcha [get]
+func (h *authHandler) CaptchaHandler(ctx *gin.Context) {
+	id, image, err := h.guardSvc.NewCaptcha(ctx)
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("生成验证码异常 >>> %v", err.Error()))
+		zap.S().Error("生成验证码异常 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "获取成功", CaptchaResponse{
+		CaptchaId: id,
+		Image:     image,
+	})
+}
+
+// loginFailed 处理登录失败：累计失败次数、记录登录日志并返回错误
+func (h *authHandler) loginFailed(ctx *gin.Context, username string, user domainSystem.User, err error, countFailure bool) {
+	resp := LoginFailResponse{}
+
+	var lockedErr *serviceSystem.UserLockedError
+	// 仅密码错误计入失败次数
+	if countFailure && errors.Is(err, serviceSystem.ErrUserInvalidCredential) {
+		captchaRequired, guardErr := h.guardSvc.RecordFailure(ctx, username, request_utils.NormalizeIP(ctx))
+		resp.CaptchaRequired = captchaRequired
+		if errors.As(guardErr, &lockedErr) {
+			// 本次失败触发锁定，直接返回锁定信息
+			err = lockedErr
+		} else if guardErr != nil {
+			zap.S().Warn("记录登录失败次数异常 >>> ", zap.Error(guardErr))
+		}
+	} else if required, guardErr := h.guardSvc.CaptchaRequired(ctx, username, request_utils.NormalizeIP(ctx)); guardErr == nil {
+		resp.CaptchaRequired = required
+	}
+
+	message := ""
+	switch {
+	case errors.As(err, &lockedErr):
+		message = lockedErr.Error()
+		resp.LockedUntil = lockedErr.LockedUntil.Format(time.DateTime)
+	case errors.Is(err, serviceSystem.ErrCaptchaRequired),
+		errors.Is(err, serviceSystem.ErrCaptchaInvalid),
+		errors.Is(err, serviceSystem.ErrUserHasBeen):
+		message = err.Error()
+	case errors.Is(err, serviceSystem.ErrUserInvalidCredential):
+		message = "用户名或密码错误"
+	default:
+		ctx.Set("internalError", fmt.Sprintf("用户登录异常 >>> %v", err.Error()))
+		zap.S().Error("用户登录异常 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	// 记录失败登录日志
+	go request_utils.SaveLoginLog(request_utils.NewLoginLogInfo(ctx, username, user, false, message), h.rely.LogSink)
+
+	response.NewResponse().Error(ctx, http.StatusBadRequest, message, resp)
+}
+
 // RefreshTokenHandler
 // @Summary 刷新令牌
-// @Description 使用旧的JWT令牌获取新的令牌
+// @Description 使用刷新令牌换取新的访问令牌与刷新令牌，刷新令牌仅可使用一次，重复使用将注销整个会话
 // @Tags 认证管理
 // @Accept application/json
 // @Produce application/json
@@ -151,35 +291,29 @@ func (h *authHandler) RefreshTokenHandler(ctx *gin.Context) {
 		return
 	}
 
-	// 解析旧令牌
-	claims, err := h.jwtSvc.ParseToken(req.Token)
+	// 轮换刷新令牌
+	family, refreshToken, err := h.refreshSvc.Rotate(ctx, req.RefreshToken)
 	if err != nil {
 		switch {
-		case errors.Is(err, jwt.ErrExpiredToken):
-			response.NewResponse().Error(ctx, http.StatusUnauthorized, "令牌已过期，请重新登录", nil)
-		case errors.Is(err, jwt.ErrInvalidToken):
-			response.NewResponse().Error(ctx, http.StatusUnauthorized, "无效的令牌", nil)
+		case errors.Is(err, jwt.ErrRefreshTokenReused):
+			zap.S().Warn("检测到刷新令牌重复使用，已注销会话 >>> ", zap.Error(err))
+			response.NewResponse().Error(ctx, http.StatusUnauthorized, err.Error(), nil)
+		case errors.Is(err, jwt.ErrRefreshExpired),
+			errors.Is(err, jwt.ErrRefreshTokenInvalid):
+			response.NewResponse().Error(ctx, http.StatusUnauthorized, err.Error(), nil)
 		default:
-			response.NewResponse().Error(ctx, http.StatusUnauthorized, "认证失败", nil)
+			ctx.Set("internalError", fmt.Sprintf("刷新令牌异常 >>> %v", err.Error()))
+			zap.S().Error("刷新令牌异常 >>> ", zap.Error(err))
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
 		}
 		return
 	}
 
-	// 检查token是否在黑名单中
-	isBlacklisted, err := h.blacklistSvc.IsBlacklisted(ctx, req.Token)
-	if err != nil {
-		response.NewResponse().Error(ctx, http.StatusUnauthorized, "检查令牌状态失败", nil)
-		return
-	}
-	if isBlacklisted {
-		response.NewResponse().Error(ctx, http.StatusUnauthorized, "令牌已被加入黑名单", nil)
-		return
-	}
-
 	// 获取用户信息
-	domain, err := h.userSvc.GetById(ctx, claims.UserId)
+	domain, err := h.userSvc.GetById(ctx, family.UserId)
 	if err != nil {
 		if errors.Is(err, serviceSystem.ErrUserNotFound) {
+			_ = h.refreshSvc.Revoke(ctx, family.Id)
 			response.NewResponse().Error(ctx, http.StatusUnauthorized, "用户不存在", nil)
 			return
 		}
@@ -188,9 +322,14 @@ func (h *authHandler) RefreshTokenHandler(ctx *gin.Context) {
 		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
 		return
 	}
+	if !domain.Status {
+		_ = h.refreshSvc.Revoke(ctx, family.Id)
+		response.NewResponse().Error(ctx, http.StatusUnauthorized, serviceSystem.ErrUserHasBeen.Error(), nil)
+		return
+	}
 
 	// 生成JWT令牌
-	token, err := h.jwtSvc.GenerateToken(ctx, domain.Id, domain)
+	token, err := h.jwtSvc.GenerateSessionToken(ctx, domain, family.Id, time.Unix(family.AuthTime, 0))
 	if err != nil {
 		ctx.Set("internalError", fmt.Sprintf("生成令牌异常 >>> %v", err.Error()))
 		zap.S().Error("生成令牌异常 >>> ", zap.Error(err))
@@ -199,10 +338,12 @@ func (h *authHandler) RefreshTokenHandler(ctx *gin.Context) {
 	}
 
 	// 返回用户信息和令牌
-	response.NewResponse().Success(ctx, "登录成功", LoginResponse{
-		Token:  token,
-		User:   domain,
-		Expire: h.rely.Token.Expire * 3600,
+	response.NewResponse().Success(ctx, "刷新成功", LoginResponse{
+		Token:         token,
+		RefreshToken:  refreshToken,
+		User:          domain,
+		Expire:        h.rely.Token.Load().Expire * 3600,
+		RefreshExpire: int(h.refreshSvc.RefreshExpire().Seconds()),
 	})
 }
 
@@ -258,6 +399,11 @@ func (h *authHandler) LogoutHandler(ctx *gin.Context) {
 		return
 	}
 
+	// 注销刷新令牌族
+	if err := h.refreshSvc.Revoke(ctx, jwtClaims.SessionId); err != nil {
+		zap.S().Warn("注销刷新令牌失败 >>> ", zap.Error(err))
+	}
+
 	// 将token加入黑名单
 	if err := h.blacklistSvc.Add(ctx, tokenStr, claims.UserId, remainingTime); err != nil {
 		ctx.Set("internalError", fmt.Sprintf("将token加入黑名单失败 >>> %v", err.Error()))
@@ -306,3 +452,122 @@ func (h *authHandler) ProfileHandler(ctx *gin.Context) {
 	// 返回用户信息
 	response.NewResponse().Success(ctx, "获取成功", domain)
 }
+
+// MenusHandler
+// @Summary 获取当前登录用户菜单
+// @Description 获取当前登录用户可见的菜单树及按钮权限标识，超级管理员返回全部启用菜单
+// @Tags 认证管理
+// @Accept application/json
+// @Produce application/json
+// @Success 200 {object} domainSystem.UserMenu
+// @Failure 401 {object} response.Response
+// @Router /v1/auth/menus [get]
+// @Security LoginToken
+func (h *authHandler) MenusHandler(ctx *gin.Context) {
+	// 从上下文中获取登录信息
+	claims, ok := ctx.MustGet("claims").(*jwt.Claims)
+	if !ok {
+		zap.S().Error("未找到用户认证信息 >>> ", zap.Error(errors.New(claims.UserId)))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	userMenu, err := h.menuSvc.GetUserMenu(ctx, claims.UserId)
+	if err != nil {
+		if errors.Is(err, serviceSystem.ErrUserNotFound) {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "用户不存在", nil)
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("获取用户菜单异常 >>> %v", err.Error()))
+		zap.S().Error("获取用户菜单异常 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "获取成功", userMenu)
+}
+
+// SessionsHandler
+// @Summary 获取当前用户的登录会话
+// @Description 获取当前用户全部有效的登录会话(设备、IP、浏览器、登录时间)
+// @Tags 认证管理
+// @Accept application/json
+// @Produce application/json
+// @Success 200 {object} []SessionResponse
+// @Failure 400 {object} response.Response
+// @Router /v1/auth/sessions [get]
+// @Security LoginToken
+func (h *authHandler) SessionsHandler(ctx *gin.Context) {
+	claims := ctx.MustGet("claims").(*jwt.Claims)
+
+	families, err := h.refreshSvc.ListSessions(ctx, claims.UserId)
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("获取登录会话异常 >>> %v", err.Error()))
+		zap.S().Error("获取登录会话异常 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	list := make([]SessionResponse, 0, len(families))
+	for _, f := range families {
+		list = append(list, SessionResponse{
+			Id:         f.Id,
+			Ip:         f.Ip,
+			Os:         f.Os,
+			Browser:    f.Browser,
+			UserAgent:  f.UserAgent,
+			LoginTime:  time.Unix(f.AuthTime, 0).Format(time.DateTime),
+			ExpireTime: time.Unix(f.ExpireAt, 0).Format(time.DateTime),
+			Current:    f.Id == claims.SessionId,
+		})
+	}
+
+	response.NewResponse().Success(ctx, "获取成功", list)
+}
+
+// RevokeSessionHandler
+// @Summary 注销当前用户的指定会话
+// @Description 注销当前用户的指定登录会话，对应设备需重新登录
+// @Tags 认证管理
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "会话ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/auth/sessions/{id} [delete]
+// @Security LoginToken
+func (h *authHandler) RevokeSessionHandler(ctx *gin.Context) {
+	claims := ctx.MustGet("claims").(*jwt.Claims)
+
+	id := ctx.Param("id")
+	if id == "" {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "id不能为空", nil)
+		return
+	}
+
+	// 仅允许注销自己的会话
+	family, err := h.refreshSvc.GetFamily(ctx, id)
+	if err != nil {
+		if errors.Is(err, jwt.ErrRefreshTokenInvalid) {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "会话不存在", nil)
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("获取登录会话异常 >>> %v", err.Error()))
+		zap.S().Error("获取登录会话异常 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+	if family.UserId != claims.UserId {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "会话不存在", nil)
+		return
+	}
+
+	if err := h.refreshSvc.Revoke(ctx, id); err != nil {
+		ctx.Set("internalError", fmt.Sprintf("注销登录会话异常 >>> %v", err.Error()))
+		zap.S().Error("注销登录会话异常 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "注销成功", nil)
+}
@@ -0,0 +1,311 @@
+/**
+ * Description：
+ * FileName：cache_log.go
+ * Author：CJiaの用心
+ * Create：2025/10/30 11:34:52
+ * Remark：
+ */
+
+package logger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/carefuly/careful-admin-go-gin/config"
+	domainLogger "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/logger"
+	serviceLogger "github.com/carefuly/careful-admin-go-gin/internal/service/careful/logger"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/response"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/excelutil"
+	"github.com/carefuly/careful-admin-go-gin/pkg/validate"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// CacheLogListPageResponse 列表分页响应
+type CacheLogListPageResponse struct {
+	List     []domainLogger.CacheLog `json:"list"`     // 列表
+	Total    int64                   `json:"total"`    // 总数
+	Page     int                     `json:"page"`     // 页码
+	PageSize int                     `json:"pageSize"` // 每页数量
+}
+
+type CacheLogHandler interface {
+	RegisterRoutes(router *gin.RouterGroup)
+	BatchDelete(ctx *gin.Context)
+	Clear(ctx *gin.Context)
+	GetById(ctx *gin.Context)
+	GetListPage(ctx *gin.Context)
+	Export(ctx *gin.Context)
+}
+
+type cacheLogHandler struct {
+	rely config.RelyConfig
+	svc  serviceLogger.CacheLogService
+}
+
+func NewCacheLogHandler(rely config.RelyConfig, svc serviceLogger.CacheLogService) CacheLogHandler {
+	return &cacheLogHandler{
+		rely: rely,
+		svc:  svc,
+	}
+}
+
+// RegisterRoutes 注册路由
+func (h *cacheLogHandler) RegisterRoutes(router *gin.RouterGroup) {
+	base := router.Group("/cacheLog")
+	base.POST("/delete/batchDelete", h.BatchDelete)
+	base.DELETE("/delete/clear", h.Clear)
+	base.GET("/getById/:id", h.GetById)
+	base.GET("/listPage", h.GetListPage)
+	base.GET("/export", h.Export)
+}
+
+// BatchDelete
+// @Summary 批量删除缓存日志
+// @Description 批量删除缓存日志
+// @Tags 日志管理/缓存日志
+// @Accept application/json
+// @Produce application/json
+// @Param ids body []string true "id数组"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/logger/cacheLog/delete/batchDelete [post]
+// @Security LoginToken
+func (h *cacheLogHandler) BatchDelete(ctx *gin.Context) {
+	var ids []string
+	if err := ctx.ShouldBindJSON(&ids); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	if err := h.svc.BatchDelete(ctx, ids); err != nil {
+		ctx.Set("internalError", fmt.Sprintf("批量删除缓存日志异常 >>> %v", err.Error()))
+		zap.S().Error("批量删除缓存日志异常 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "批量删除成功", nil)
+}
+
+// Clear
+// @Summary 清空缓存日志
+// @Description 清空全部缓存日志
+// @Tags 日志管理/缓存日志
+// @Accept application/json
+// @Produce application/json
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/logger/cacheLog/delete/clear [delete]
+// @Security LoginToken
+func (h *cacheLogHandler) Clear(ctx *gin.Context) {
+	if err := h.svc.Clear(ctx); err != nil {
+		ctx.Set("internalError", fmt.Sprintf("清空缓存日志异常 >>> %v", err.Error()))
+		zap.S().Error("清空缓存日志异常 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "清空成功", nil)
+}
+
+// GetById
+// @Summary 获取缓存日志详情
+// @Description 获取指定id缓存日志详情
+// @Tags 日志管理/缓存日志
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Success 200 {object} domainLogger.CacheLog
+// @Failure 400 {object} response.Response
+// @Router /v1/logger/cacheLog/getById/{id} [get]
+// @Security LoginToken
+func (h *cacheLogHandler) GetById(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" || len(id) == 0 {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "id不能为空", nil)
+		return
+	}
+
+	detail, err := h.svc.GetById(ctx, id)
+	if err != nil {
+		if errors.Is(err, serviceLogger.ErrCacheLogNotFound) {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "缓存日志不存在", nil)
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("获取缓存日志失败 >>> %v", err.Error()))
+		zap.S().Error("获取缓存日志失败 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "获取成功", detail)
+}
+
+// GetListPage
+// @Summary 获取缓存日志分页列表
+// @Description 获取缓存日志分页列表，按创建时间倒序
+// @Tags 日志管理/缓存日志
+// @Accept application/json
+// @Produce application/json
+// @Param page query int true "页码" default(1)
+// @Param pageSize query int true "每页数量" default(10)
+// @Param username query string false "缓存用户名"
+// @Param path query string false "缓存请求地址"
+// @Param method query string false "缓存请求方式"
+// @Param ip query string false "缓存者IP"
+// @Param key query string false "缓存key键"
+// @Param status query bool false "状态，不传则不限制"
+// @Param startTime query string false "开始时间，yyyy-MM-dd 或 yyyy-MM-dd HH:mm:ss"
+// @Param endTime query string false "结束时间，yyyy-MM-dd 或 yyyy-MM-dd HH:mm:ss"
+// @Success 200 {object} CacheLogListPageResponse
+// @Failure 400 {object} response.Response
+// @Router /v1/logger/cacheLog/listPage [get]
+// @Security LoginToken
+func (h *cacheLogHandler) GetListPage(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("pageSize", "10"))
+
+	filter, err := h.buildFilter(ctx)
+	if err != nil {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	filter.Pagination = filters.Pagination{
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	list, total, err := h.svc.GetListPage(ctx, filter)
+	if err != nil {
+		if errors.Is(err, filters.ErrTimeRangeInvalid) {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, err.Error(), nil)
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("获取缓存日志分页列表异常 >>> %v", err.Error()))
+		zap.S().Error("获取缓存日志分页列表异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", CacheLogListPageResponse{
+		List:     list,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// Export
+// @Summary 导出缓存日志
+// @Description 按查询条件导出缓存日志为Excel、CSV或JSON文件
+// @Tags 日志管理/缓存日志
+// @Accept application/json
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet,text/csv,application/json,application/x-ndjson
+// @Param format query string false "导出格式(xlsx/csv/json/ndjson)" default(xlsx)
+// @Param bom query bool false "CSV是否写入UTF-8 BOM，中文版Excel直接打开时需开启" default(false)
+// @Param username query string false "缓存用户名"
+// @Param path query string false "缓存请求地址"
+// @Param method query string false "缓存请求方式"
+// @Param ip query string false "缓存者IP"
+// @Param key query string false "缓存key键"
+// @Param status query bool false "状态，不传则不限制"
+// @Param startTime query string false "开始时间，yyyy-MM-dd 或 yyyy-MM-dd HH:mm:ss"
+// @Param endTime query string false "结束时间，yyyy-MM-dd 或 yyyy-MM-dd HH:mm:ss"
+// @Success 200 cache_log file "导出文件"
+// @Failure 500 {object} response.Response
+// @Router /v1/logger/cacheLog/export [get]
+// @Security LoginToken
+func (h *cacheLogHandler) Export(ctx *gin.Context) {
+	// 导出格式
+	format, err := excelutil.ParseFormat(ctx.DefaultQuery("format", ""))
+	if err != nil {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	bom, _ := strconv.ParseBool(ctx.DefaultQuery("bom", "false"))
+
+	filter, err := h.buildFilter(ctx)
+	if err != nil {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	// 按游标分批读取，避免一次性加载全部缓存日志
+	it := excelutil.CursorIterator(func(c context.Context, cursor *filters.Cursor, limit int) ([]domainLogger.CacheLog, error) {
+		return h.svc.GetBatch(c, filter, cursor, limit)
+	}, func(last domainLogger.CacheLog) filters.Cursor {
+		return filters.NewCursor(last.CacheLogger.CreateTime, last.Id)
+	}, 0)
+
+	// 准备导出配置
+	filename := fmt.Sprintf("缓存日志导出_%s", time.Now().Format("20060102150405"))
+	cfg := excelutil.ExcelExportConfig{
+		SheetName: "缓存日志",
+		FileName:  filename,
+		BOM:       bom,
+		Columns: []excelutil.ExcelColumn{
+			{Title: "缓存用户名", Field: "CacheUsername", Width: 18},
+			{Title: "请求方式", Field: "CacheMethod", Width: 10},
+			{Title: "请求地址", Field: "CachePath", Width: 40},
+			{Title: "缓存IP", Field: "CacheIp", Width: 18},
+			{Title: "缓存Key", Field: "CacheKey", Width: 40},
+			{
+				Title: "状态",
+				Field: "Status",
+				Width: 10,
+				Formatter: func(value interface{}) string {
+					if status, ok := value.(bool); ok {
+						if status {
+							return "启用"
+						}
+						return "停用"
+					}
+					return fmt.Sprintf("%v", value)
+				},
+			},
+			{Title: "错误信息", Field: "CacheError", Width: 40},
+			{Title: "记录时间", Field: "CacheTime", Width: 22},
+			{Title: "创建时间", Field: "CreateTime", Width: 22},
+		},
+	}
+
+	// 流式写入响应
+	w := response.NewAttachmentWriter(ctx, format.FileName(filename), format.ContentType())
+	if _, err := excelutil.Write(ctx, w, format, &cfg, it); err != nil {
+		if w.Written() {
+			zap.S().Error("写入缓存日志导出文件异常 >>> ", err.Error())
+			return
+		}
+		if errors.Is(err, filters.ErrTimeRangeInvalid) {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, err.Error(), nil)
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("导出缓存日志异常 >>> %v", err.Error()))
+		zap.S().Error("导出缓存日志异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+	}
+}
+
+// buildFilter 构建查询条件
+func (h *cacheLogHandler) buildFilter(ctx *gin.Context) (domainLogger.CacheLogFilter, error) {
+	timeRange, err := parseTimeRange(ctx)
+	if err != nil {
+		return domainLogger.CacheLogFilter{}, err
+	}
+
+	return domainLogger.CacheLogFilter{
+		TimeRange: timeRange,
+		Username:  ctx.DefaultQuery("username", ""),
+		Path:      ctx.DefaultQuery("path", ""),
+		Method:    ctx.DefaultQuery("method", ""),
+		Ip:        ctx.DefaultQuery("ip", ""),
+		Key:       ctx.DefaultQuery("key", ""),
+		Status:    parseStatus(ctx),
+	}, nil
+}
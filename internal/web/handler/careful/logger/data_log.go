@@ -0,0 +1,178 @@
+/**
+ * Description：
+ * FileName：data_log.go
+ * Author：CJiaの用心
+ * Create：2025/11/14 15:02:27
+ * Remark：
+ */
+
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carefuly/careful-admin-go-gin/config"
+	domainLogger "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/logger"
+	serviceLogger "github.com/carefuly/careful-admin-go-gin/internal/service/careful/logger"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/logger/data_log"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/response"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+)
+
+// DataLogListPageResponse 列表分页响应
+type DataLogListPageResponse struct {
+	List     []domainLogger.DataLog `json:"list"`     // 列表
+	Total    int64                  `json:"total"`    // 总数
+	Page     int                    `json:"page"`     // 页码
+	PageSize int                    `json:"pageSize"` // 每页数量
+}
+
+type DataLogHandler interface {
+	RegisterRoutes(router *gin.RouterGroup)
+	// RegisterHistoryRoute 在资源路由组下注册 /history/:id，查询该资源数据表的变更历史
+	RegisterHistoryRoute(router *gin.RouterGroup, table string)
+	GetListPage(ctx *gin.Context)
+	History(table string) gin.HandlerFunc
+}
+
+type dataLogHandler struct {
+	rely config.RelyConfig
+	svc  serviceLogger.DataLogService
+}
+
+func NewDataLogHandler(rely config.RelyConfig, svc serviceLogger.DataLogService) DataLogHandler {
+	return &dataLogHandler{
+		rely: rely,
+		svc:  svc,
+	}
+}
+
+// RegisterRoutes 注册路由
+func (h *dataLogHandler) RegisterRoutes(router *gin.RouterGroup) {
+	base := router.Group("/dataLog")
+	base.GET("/listPage", h.GetListPage)
+}
+
+// RegisterHistoryRoute 注册资源变更历史路由
+func (h *dataLogHandler) RegisterHistoryRoute(router *gin.RouterGroup, table string) {
+	router.GET("/history/:id", h.History(table))
+}
+
+// GetListPage
+// @Summary 获取数据变更日志分页列表
+// @Description 获取数据变更日志分页列表，按变更时间倒序
+// @Tags 日志管理/数据变更日志
+// @Accept application/json
+// @Produce application/json
+// @Param page query int true "页码" default(1)
+// @Param pageSize query int true "每页数量" default(10)
+// @Param recordTable query string false "数据表"
+// @Param recordId query string false "数据主键ID"
+// @Param action query int false "变更类型【1-新增 2-修改 3-删除】"
+// @Param operator query string false "操作人"
+// @Param requestId query string false "请求ID"
+// @Param startTime query string false "开始时间，yyyy-MM-dd 或 yyyy-MM-dd HH:mm:ss"
+// @Param endTime query string false "结束时间，yyyy-MM-dd 或 yyyy-MM-dd HH:mm:ss"
+// @Success 200 {object} DataLogListPageResponse
+// @Failure 400 {object} response.Response
+// @Router /v1/logger/dataLog/listPage [get]
+// @Security LoginToken
+func (h *dataLogHandler) GetListPage(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("pageSize", "10"))
+	action, _ := strconv.Atoi(ctx.DefaultQuery("action", "0"))
+
+	timeRange, err := parseTimeRange(ctx)
+	if err != nil {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	filter := domainLogger.DataLogFilter{
+		Pagination: filters.Pagination{
+			Page:     page,
+			PageSize: pageSize,
+		},
+		TimeRange:   timeRange,
+		RecordTable: ctx.DefaultQuery("recordTable", ""),
+		RecordId:    ctx.DefaultQuery("recordId", ""),
+		Action:      data_log.ActionConst(action),
+		Operator:    ctx.DefaultQuery("operator", ""),
+		RequestId:   ctx.DefaultQuery("requestId", ""),
+	}
+
+	list, total, err := h.svc.GetListPage(ctx, filter)
+	if err != nil {
+		if errors.Is(err, filters.ErrTimeRangeInvalid) {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, err.Error(), nil)
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("获取数据变更日志分页列表异常 >>> %v", err.Error()))
+		zap.S().Error("获取数据变更日志分页列表异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", DataLogListPageResponse{
+		List:     list,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// History
+// @Summary 获取数据变更历史
+// @Description 获取指定id数据的字段变更历史，按变更时间倒序，敏感字段已脱敏
+// @Tags 日志管理/数据变更日志
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Param page query int true "页码" default(1)
+// @Param pageSize query int true "每页数量" default(10)
+// @Success 200 {object} DataLogListPageResponse
+// @Failure 400 {object} response.Response
+// @Router /v1/system/user/history/{id} [get]
+// @Router /v1/system/dept/history/{id} [get]
+// @Router /v1/system/role/history/{id} [get]
+// @Router /v1/system/permission/history/{id} [get]
+// @Router /v1/system/menu/history/{id} [get]
+// @Router /v1/tools/dict/history/{id} [get]
+// @Router /v1/tools/dictType/history/{id} [get]
+// @Router /v1/tools/bucket/history/{id} [get]
+// @Router /v1/tools/file/history/{id} [get]
+// @Security LoginToken
+func (h *dataLogHandler) History(table string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id := ctx.Param("id")
+		if id == "" {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "ID不能为空", nil)
+			return
+		}
+
+		page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+		pageSize, _ := strconv.Atoi(ctx.DefaultQuery("pageSize", "10"))
+
+		list, total, err := h.svc.GetHistory(ctx, table, id, filters.Pagination{
+			Page:     page,
+			PageSize: pageSize,
+		})
+		if err != nil {
+			ctx.Set("internalError", fmt.Sprintf("获取数据变更历史异常 >>> %v", err.Error()))
+			zap.S().Error("获取数据变更历史异常 >>> ", err.Error())
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+			return
+		}
+
+		response.NewResponse().Success(ctx, "查询成功", DataLogListPageResponse{
+			List:     list,
+			Total:    total,
+			Page:     page,
+			PageSize: pageSize,
+		})
+	}
+}
@@ -0,0 +1,64 @@
+/**
+ * Description：
+ * FileName：filter.go
+ * Author：CJiaの用心
+ * Create：2025/10/30 10:48:15
+ * Remark：
+ */
+
+package logger
+
+import (
+	"errors"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"github.com/gin-gonic/gin"
+	"strconv"
+	"time"
+)
+
+var (
+	ErrTimeFormatInvalid = errors.New("时间格式错误，应为 yyyy-MM-dd 或 yyyy-MM-dd HH:mm:ss")
+)
+
+// parseTimeRange 解析 startTime、endTime 查询参数，仅有日期时结束时间取当天最后一刻
+func parseTimeRange(ctx *gin.Context) (filters.TimeRange, error) {
+	var (
+		timeRange filters.TimeRange
+		err       error
+	)
+	if value := ctx.Query("startTime"); value != "" {
+		if timeRange.StartTime, _, err = parseTime(value); err != nil {
+			return filters.TimeRange{}, err
+		}
+	}
+	if value := ctx.Query("endTime"); value != "" {
+		var dateOnly bool
+		if timeRange.EndTime, dateOnly, err = parseTime(value); err != nil {
+			return filters.TimeRange{}, err
+		}
+		if dateOnly {
+			timeRange.EndTime = timeRange.EndTime.Add(24*time.Hour - time.Nanosecond)
+		}
+	}
+	return timeRange, nil
+}
+
+// parseTime 解析本地时间，返回是否仅包含日期
+func parseTime(value string) (time.Time, bool, error) {
+	if t, err := time.ParseInLocation(time.DateTime, value, time.Local); err == nil {
+		return t, false, nil
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, value, time.Local); err == nil {
+		return t, true, nil
+	}
+	return time.Time{}, false, ErrTimeFormatInvalid
+}
+
+// parseStatus 解析可选的布尔状态，未传或格式错误时不限制
+func parseStatus(ctx *gin.Context) *bool {
+	status, err := strconv.ParseBool(ctx.Query("status"))
+	if err != nil {
+		return nil
+	}
+	return &status
+}
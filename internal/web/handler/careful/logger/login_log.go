@@ -0,0 +1,303 @@
+/**
+ * Description：
+ * FileName：login_log.go
+ * Author：CJiaの用心
+ * Create：2025/10/30 11:20:16
+ * Remark：
+ */
+
+package logger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/carefuly/careful-admin-go-gin/config"
+	domainLogger "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/logger"
+	serviceLogger "github.com/carefuly/careful-admin-go-gin/internal/service/careful/logger"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/response"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/excelutil"
+	"github.com/carefuly/careful-admin-go-gin/pkg/validate"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// LoginLogListPageResponse 列表分页响应
+type LoginLogListPageResponse struct {
+	List     []domainLogger.LoginLog `json:"list"`     // 列表
+	Total    int64                   `json:"total"`    // 总数
+	Page     int                     `json:"page"`     // 页码
+	PageSize int                     `json:"pageSize"` // 每页数量
+}
+
+type LoginLogHandler interface {
+	RegisterRoutes(router *gin.RouterGroup)
+	BatchDelete(ctx *gin.Context)
+	Clear(ctx *gin.Context)
+	GetById(ctx *gin.Context)
+	GetListPage(ctx *gin.Context)
+	Export(ctx *gin.Context)
+}
+
+type loginLogHandler struct {
+	rely config.RelyConfig
+	svc  serviceLogger.LoginLogService
+}
+
+func NewLoginLogHandler(rely config.RelyConfig, svc serviceLogger.LoginLogService) LoginLogHandler {
+	return &loginLogHandler{
+		rely: rely,
+		svc:  svc,
+	}
+}
+
+// RegisterRoutes 注册路由
+func (h *loginLogHandler) RegisterRoutes(router *gin.RouterGroup) {
+	base := router.Group("/loginLog")
+	base.POST("/delete/batchDelete", h.BatchDelete)
+	base.DELETE("/delete/clear", h.Clear)
+	base.GET("/getById/:id", h.GetById)
+	base.GET("/listPage", h.GetListPage)
+	base.GET("/export", h.Export)
+}
+
+// BatchDelete
+// @Summary 批量删除登录日志
+// @Description 批量删除登录日志
+// @Tags 日志管理/登录日志
+// @Accept application/json
+// @Produce application/json
+// @Param ids body []string true "id数组"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/logger/loginLog/delete/batchDelete [post]
+// @Security LoginToken
+func (h *loginLogHandler) BatchDelete(ctx *gin.Context) {
+	var ids []string
+	if err := ctx.ShouldBindJSON(&ids); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	if err := h.svc.BatchDelete(ctx, ids); err != nil {
+		ctx.Set("internalError", fmt.Sprintf("批量删除登录日志异常 >>> %v", err.Error()))
+		zap.S().Error("批量删除登录日志异常 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "批量删除成功", nil)
+}
+
+// Clear
+// @Summary 清空登录日志
+// @Description 清空全部登录日志
+// @Tags 日志管理/登录日志
+// @Accept application/json
+// @Produce application/json
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/logger/loginLog/delete/clear [delete]
+// @Security LoginToken
+func (h *loginLogHandler) Clear(ctx *gin.Context) {
+	if err := h.svc.Clear(ctx); err != nil {
+		ctx.Set("internalError", fmt.Sprintf("清空登录日志异常 >>> %v", err.Error()))
+		zap.S().Error("清空登录日志异常 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "清空成功", nil)
+}
+
+// GetById
+// @Summary 获取登录日志详情
+// @Description 获取指定id登录日志详情
+// @Tags 日志管理/登录日志
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Success 200 {object} domainLogger.LoginLog
+// @Failure 400 {object} response.Response
+// @Router /v1/logger/loginLog/getById/{id} [get]
+// @Security LoginToken
+func (h *loginLogHandler) GetById(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" || len(id) == 0 {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "id不能为空", nil)
+		return
+	}
+
+	detail, err := h.svc.GetById(ctx, id)
+	if err != nil {
+		if errors.Is(err, serviceLogger.ErrLoginLogNotFound) {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "登录日志不存在", nil)
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("获取登录日志失败 >>> %v", err.Error()))
+		zap.S().Error("获取登录日志失败 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "获取成功", detail)
+}
+
+// GetListPage
+// @Summary 获取登录日志分页列表
+// @Description 获取登录日志分页列表，按创建时间倒序
+// @Tags 日志管理/登录日志
+// @Accept application/json
+// @Produce application/json
+// @Param page query int true "页码" default(1)
+// @Param pageSize query int true "每页数量" default(10)
+// @Param username query string false "登录用户名"
+// @Param ip query string false "登录ip"
+// @Param status query bool false "登录结果【true-成功 false-失败】，不传则不限制"
+// @Param startTime query string false "开始时间，yyyy-MM-dd 或 yyyy-MM-dd HH:mm:ss"
+// @Param endTime query string false "结束时间，yyyy-MM-dd 或 yyyy-MM-dd HH:mm:ss"
+// @Success 200 {object} LoginLogListPageResponse
+// @Failure 400 {object} response.Response
+// @Router /v1/logger/loginLog/listPage [get]
+// @Security LoginToken
+func (h *loginLogHandler) GetListPage(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("pageSize", "10"))
+
+	filter, err := h.buildFilter(ctx)
+	if err != nil {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	filter.Pagination = filters.Pagination{
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	list, total, err := h.svc.GetListPage(ctx, filter)
+	if err != nil {
+		if errors.Is(err, filters.ErrTimeRangeInvalid) {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, err.Error(), nil)
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("获取登录日志分页列表异常 >>> %v", err.Error()))
+		zap.S().Error("获取登录日志分页列表异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", LoginLogListPageResponse{
+		List:     list,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// Export
+// @Summary 导出登录日志
+// @Description 按查询条件导出登录日志为Excel、CSV或JSON文件
+// @Tags 日志管理/登录日志
+// @Accept application/json
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet,text/csv,application/json,application/x-ndjson
+// @Param format query string false "导出格式(xlsx/csv/json/ndjson)" default(xlsx)
+// @Param bom query bool false "CSV是否写入UTF-8 BOM，中文版Excel直接打开时需开启" default(false)
+// @Param username query string false "登录用户名"
+// @Param ip query string false "登录ip"
+// @Param status query bool false "登录结果【true-成功 false-失败】，不传则不限制"
+// @Param startTime query string false "开始时间，yyyy-MM-dd 或 yyyy-MM-dd HH:mm:ss"
+// @Param endTime query string false "结束时间，yyyy-MM-dd 或 yyyy-MM-dd HH:mm:ss"
+// @Success 200 login_log file "导出文件"
+// @Failure 500 {object} response.Response
+// @Router /v1/logger/loginLog/export [get]
+// @Security LoginToken
+func (h *loginLogHandler) Export(ctx *gin.Context) {
+	// 导出格式
+	format, err := excelutil.ParseFormat(ctx.DefaultQuery("format", ""))
+	if err != nil {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	bom, _ := strconv.ParseBool(ctx.DefaultQuery("bom", "false"))
+
+	filter, err := h.buildFilter(ctx)
+	if err != nil {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	// 按游标分批读取，避免一次性加载全部登录日志
+	it := excelutil.CursorIterator(func(c context.Context, cursor *filters.Cursor, limit int) ([]domainLogger.LoginLog, error) {
+		return h.svc.GetBatch(c, filter, cursor, limit)
+	}, func(last domainLogger.LoginLog) filters.Cursor {
+		return filters.NewCursor(last.LoginLogger.CreateTime, last.Id)
+	}, 0)
+
+	// 准备导出配置
+	filename := fmt.Sprintf("登录日志导出_%s", time.Now().Format("20060102150405"))
+	cfg := excelutil.ExcelExportConfig{
+		SheetName: "登录日志",
+		FileName:  filename,
+		BOM:       bom,
+		Columns: []excelutil.ExcelColumn{
+			{Title: "登录用户名", Field: "LoginUsername", Width: 18},
+			{Title: "登录IP", Field: "Ip", Width: 18},
+			{
+				Title: "登录结果",
+				Field: "Status",
+				Width: 10,
+				Formatter: func(value interface{}) string {
+					if status, ok := value.(bool); ok {
+						if status {
+							return "成功"
+						}
+						return "失败"
+					}
+					return fmt.Sprintf("%v", value)
+				},
+			},
+			{Title: "浏览器", Field: "Browser", Width: 15},
+			{Title: "操作系统", Field: "Os", Width: 15},
+			{Title: "国家", Field: "Country", Width: 12},
+			{Title: "省份", Field: "Province", Width: 12},
+			{Title: "城市", Field: "City", Width: 12},
+			{Title: "运营商", Field: "Isp", Width: 12},
+			{Title: "创建时间", Field: "CreateTime", Width: 22},
+		},
+	}
+
+	// 流式写入响应
+	w := response.NewAttachmentWriter(ctx, format.FileName(filename), format.ContentType())
+	if _, err := excelutil.Write(ctx, w, format, &cfg, it); err != nil {
+		if w.Written() {
+			zap.S().Error("写入登录日志导出文件异常 >>> ", err.Error())
+			return
+		}
+		if errors.Is(err, filters.ErrTimeRangeInvalid) {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, err.Error(), nil)
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("导出登录日志异常 >>> %v", err.Error()))
+		zap.S().Error("导出登录日志异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+	}
+}
+
+// buildFilter 构建查询条件
+func (h *loginLogHandler) buildFilter(ctx *gin.Context) (domainLogger.LoginLogFilter, error) {
+	timeRange, err := parseTimeRange(ctx)
+	if err != nil {
+		return domainLogger.LoginLogFilter{}, err
+	}
+
+	return domainLogger.LoginLogFilter{
+		TimeRange: timeRange,
+		Username:  ctx.DefaultQuery("username", ""),
+		Ip:        ctx.DefaultQuery("ip", ""),
+		Status:    parseStatus(ctx),
+	}, nil
+}
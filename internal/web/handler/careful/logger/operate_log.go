@@ -0,0 +1,299 @@
+/**
+ * Description：
+ * FileName：operate_log.go
+ * Author：CJiaの用心
+ * Create：2025/10/30 11:02:39
+ * Remark：
+ */
+
+package logger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/carefuly/careful-admin-go-gin/config"
+	domainLogger "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/logger"
+	serviceLogger "github.com/carefuly/careful-admin-go-gin/internal/service/careful/logger"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/response"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/excelutil"
+	"github.com/carefuly/careful-admin-go-gin/pkg/validate"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// OperateLogListPageResponse 列表分页响应
+type OperateLogListPageResponse struct {
+	List     []domainLogger.OperateLog `json:"list"`     // 列表
+	Total    int64                     `json:"total"`    // 总数
+	Page     int                       `json:"page"`     // 页码
+	PageSize int                       `json:"pageSize"` // 每页数量
+}
+
+type OperateLogHandler interface {
+	RegisterRoutes(router *gin.RouterGroup)
+	BatchDelete(ctx *gin.Context)
+	Clear(ctx *gin.Context)
+	GetById(ctx *gin.Context)
+	GetListPage(ctx *gin.Context)
+	Export(ctx *gin.Context)
+}
+
+type operateLogHandler struct {
+	rely config.RelyConfig
+	svc  serviceLogger.OperateLogService
+}
+
+func NewOperateLogHandler(rely config.RelyConfig, svc serviceLogger.OperateLogService) OperateLogHandler {
+	return &operateLogHandler{
+		rely: rely,
+		svc:  svc,
+	}
+}
+
+// RegisterRoutes 注册路由
+func (h *operateLogHandler) RegisterRoutes(router *gin.RouterGroup) {
+	base := router.Group("/operateLog")
+	base.POST("/delete/batchDelete", h.BatchDelete)
+	base.DELETE("/delete/clear", h.Clear)
+	base.GET("/getById/:id", h.GetById)
+	base.GET("/listPage", h.GetListPage)
+	base.GET("/export", h.Export)
+}
+
+// BatchDelete
+// @Summary 批量删除操作日志
+// @Description 批量删除操作日志
+// @Tags 日志管理/操作日志
+// @Accept application/json
+// @Produce application/json
+// @Param ids body []string true "id数组"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/logger/operateLog/delete/batchDelete [post]
+// @Security LoginToken
+func (h *operateLogHandler) BatchDelete(ctx *gin.Context) {
+	var ids []string
+	if err := ctx.ShouldBindJSON(&ids); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	if err := h.svc.BatchDelete(ctx, ids); err != nil {
+		ctx.Set("internalError", fmt.Sprintf("批量删除操作日志异常 >>> %v", err.Error()))
+		zap.S().Error("批量删除操作日志异常 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "批量删除成功", nil)
+}
+
+// Clear
+// @Summary 清空操作日志
+// @Description 清空全部操作日志
+// @Tags 日志管理/操作日志
+// @Accept application/json
+// @Produce application/json
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/logger/operateLog/delete/clear [delete]
+// @Security LoginToken
+func (h *operateLogHandler) Clear(ctx *gin.Context) {
+	if err := h.svc.Clear(ctx); err != nil {
+		ctx.Set("internalError", fmt.Sprintf("清空操作日志异常 >>> %v", err.Error()))
+		zap.S().Error("清空操作日志异常 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "清空成功", nil)
+}
+
+// GetById
+// @Summary 获取操作日志详情
+// @Description 获取指定id操作日志详情
+// @Tags 日志管理/操作日志
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Success 200 {object} domainLogger.OperateLog
+// @Failure 400 {object} response.Response
+// @Router /v1/logger/operateLog/getById/{id} [get]
+// @Security LoginToken
+func (h *operateLogHandler) GetById(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" || len(id) == 0 {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "id不能为空", nil)
+		return
+	}
+
+	detail, err := h.svc.GetById(ctx, id)
+	if err != nil {
+		if errors.Is(err, serviceLogger.ErrOperateLogNotFound) {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "操作日志不存在", nil)
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("获取操作日志失败 >>> %v", err.Error()))
+		zap.S().Error("获取操作日志失败 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "获取成功", detail)
+}
+
+// GetListPage
+// @Summary 获取操作日志分页列表
+// @Description 获取操作日志分页列表，按创建时间倒序
+// @Tags 日志管理/操作日志
+// @Accept application/json
+// @Produce application/json
+// @Param page query int true "页码" default(1)
+// @Param pageSize query int true "每页数量" default(10)
+// @Param username query string false "请求用户名"
+// @Param path query string false "请求地址"
+// @Param method query string false "请求方式"
+// @Param status query int false "响应状态码"
+// @Param ip query string false "请求IP地址"
+// @Param startTime query string false "开始时间，yyyy-MM-dd 或 yyyy-MM-dd HH:mm:ss"
+// @Param endTime query string false "结束时间，yyyy-MM-dd 或 yyyy-MM-dd HH:mm:ss"
+// @Success 200 {object} OperateLogListPageResponse
+// @Failure 400 {object} response.Response
+// @Router /v1/logger/operateLog/listPage [get]
+// @Security LoginToken
+func (h *operateLogHandler) GetListPage(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("pageSize", "10"))
+
+	filter, err := h.buildFilter(ctx)
+	if err != nil {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	filter.Pagination = filters.Pagination{
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	list, total, err := h.svc.GetListPage(ctx, filter)
+	if err != nil {
+		if errors.Is(err, filters.ErrTimeRangeInvalid) {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, err.Error(), nil)
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("获取操作日志分页列表异常 >>> %v", err.Error()))
+		zap.S().Error("获取操作日志分页列表异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", OperateLogListPageResponse{
+		List:     list,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// Export
+// @Summary 导出操作日志
+// @Description 按查询条件导出操作日志为Excel、CSV或JSON文件
+// @Tags 日志管理/操作日志
+// @Accept application/json
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet,text/csv,application/json,application/x-ndjson
+// @Param format query string false "导出格式(xlsx/csv/json/ndjson)" default(xlsx)
+// @Param bom query bool false "CSV是否写入UTF-8 BOM，中文版Excel直接打开时需开启" default(false)
+// @Param username query string false "请求用户名"
+// @Param path query string false "请求地址"
+// @Param method query string false "请求方式"
+// @Param status query int false "响应状态码"
+// @Param ip query string false "请求IP地址"
+// @Param startTime query string false "开始时间，yyyy-MM-dd 或 yyyy-MM-dd HH:mm:ss"
+// @Param endTime query string false "结束时间，yyyy-MM-dd 或 yyyy-MM-dd HH:mm:ss"
+// @Success 200 operate_log file "导出文件"
+// @Failure 500 {object} response.Response
+// @Router /v1/logger/operateLog/export [get]
+// @Security LoginToken
+func (h *operateLogHandler) Export(ctx *gin.Context) {
+	// 导出格式
+	format, err := excelutil.ParseFormat(ctx.DefaultQuery("format", ""))
+	if err != nil {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	bom, _ := strconv.ParseBool(ctx.DefaultQuery("bom", "false"))
+
+	filter, err := h.buildFilter(ctx)
+	if err != nil {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	// 按游标分批读取，避免一次性加载全部操作日志
+	it := excelutil.CursorIterator(func(c context.Context, cursor *filters.Cursor, limit int) ([]domainLogger.OperateLog, error) {
+		return h.svc.GetBatch(c, filter, cursor, limit)
+	}, func(last domainLogger.OperateLog) filters.Cursor {
+		return filters.NewCursor(last.OperateLogger.CreateTime, last.Id)
+	}, 0)
+
+	// 准备导出配置
+	filename := fmt.Sprintf("操作日志导出_%s", time.Now().Format("20060102150405"))
+	cfg := excelutil.ExcelExportConfig{
+		SheetName: "操作日志",
+		FileName:  filename,
+		BOM:       bom,
+		Columns: []excelutil.ExcelColumn{
+			{Title: "请求用户名", Field: "RequestUsername", Width: 18},
+			{Title: "请求方式", Field: "RequestMethod", Width: 10},
+			{Title: "请求地址", Field: "RequestPath", Width: 40},
+			{Title: "请求IP", Field: "RequestIp", Width: 18},
+			{Title: "响应状态码", Field: "RequestStatus", Width: 12},
+			{Title: "业务状态码", Field: "RequestCode", Width: 12},
+			{Title: "请求耗时", Field: "RequestTime", Width: 15},
+			{Title: "操作系统", Field: "RequestOs", Width: 15},
+			{Title: "浏览器", Field: "RequestBrowser", Width: 15},
+			{Title: "查询参数", Field: "RequestQuery", Width: 30},
+			{Title: "系统错误", Field: "RequestInternal", Width: 40},
+			{Title: "创建时间", Field: "CreateTime", Width: 22},
+		},
+	}
+
+	// 流式写入响应
+	w := response.NewAttachmentWriter(ctx, format.FileName(filename), format.ContentType())
+	if _, err := excelutil.Write(ctx, w, format, &cfg, it); err != nil {
+		if w.Written() {
+			zap.S().Error("写入操作日志导出文件异常 >>> ", err.Error())
+			return
+		}
+		if errors.Is(err, filters.ErrTimeRangeInvalid) {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, err.Error(), nil)
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("导出操作日志异常 >>> %v", err.Error()))
+		zap.S().Error("导出操作日志异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+	}
+}
+
+// buildFilter 构建查询条件
+func (h *operateLogHandler) buildFilter(ctx *gin.Context) (domainLogger.OperateLogFilter, error) {
+	timeRange, err := parseTimeRange(ctx)
+	if err != nil {
+		return domainLogger.OperateLogFilter{}, err
+	}
+	status, _ := strconv.Atoi(ctx.DefaultQuery("status", "0"))
+
+	return domainLogger.OperateLogFilter{
+		TimeRange: timeRange,
+		Username:  ctx.DefaultQuery("username", ""),
+		Path:      ctx.DefaultQuery("path", ""),
+		Method:    ctx.DefaultQuery("method", ""),
+		Status:    status,
+		Ip:        ctx.DefaultQuery("ip", ""),
+	}, nil
+}
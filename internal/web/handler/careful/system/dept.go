@@ -0,0 +1,598 @@
+/**
+ * Description：
+ * FileName：dept.go
+ * Author：CJiaの用心
+ * Create：2025/10/26 11:26:50
+ * Remark：
+ */
+
+package system
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carefuly/careful-admin-go-gin/config"
+	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
+	modelSystem "github.com/carefuly/careful-admin-go-gin/internal/model/careful/system"
+	serviceSystem "github.com/carefuly/careful-admin-go-gin/internal/service/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/response"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/jwt"
+	"github.com/carefuly/careful-admin-go-gin/pkg/validate"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+)
+
+// CreateDeptRequest 创建
+type CreateDeptRequest struct {
+	Name     string `json:"name" binding:"required,max=50"`            // 部门名称
+	Code     string `json:"code" binding:"required,max=50"`            // 部门编码
+	Owner    string `json:"owner" binding:"omitempty,max=32"`          // 负责人
+	Phone    string `json:"phone" binding:"omitempty,max=32"`          // 联系电话
+	Email    string `json:"email" binding:"omitempty,email,max=32"`    // 邮箱
+	ParentId string `json:"parent_id" binding:"omitempty,max=100"`     // 上级部门ID
+	Sort     int    `json:"sort" binding:"omitempty" default:"1"`      // 排序
+	Status   bool   `json:"status" binding:"omitempty" default:"true"` // 状态【true-启用 false-停用】
+	Remark   string `json:"remark" binding:"omitempty,max=255"`        // 备注
+}
+
+// UpdateDeptRequest 更新
+type UpdateDeptRequest struct {
+	Id        string `json:"id" binding:"required"`                     // 主键ID
+	Name      string `json:"name" binding:"required,max=50"`            // 部门名称
+	Code      string `json:"code" binding:"required,max=50"`            // 部门编码
+	Owner     string `json:"owner" binding:"omitempty,max=32"`          // 负责人
+	Phone     string `json:"phone" binding:"omitempty,max=32"`          // 联系电话
+	Email     string `json:"email" binding:"omitempty,email,max=32"`    // 邮箱
+	Sort      int    `json:"sort" binding:"omitempty" default:"1"`      // 排序
+	Status    bool   `json:"status" binding:"omitempty" default:"true"` // 状态【true-启用 false-停用】
+	Timestamp int64  `json:"timestamp" binding:"omitempty"`             // 版本
+	Remark    string `json:"remark" binding:"omitempty,max=255"`        // 备注
+}
+
+// MoveDeptRequest 移动
+type MoveDeptRequest struct {
+	Id        string `json:"id" binding:"required"`                 // 主键ID
+	ParentId  string `json:"parent_id" binding:"omitempty,max=100"` // 新上级部门ID，为空则移动为根节点
+	Timestamp int64  `json:"timestamp" binding:"omitempty"`         // 版本
+}
+
+// DeptListPageResponse 列表分页响应
+type DeptListPageResponse struct {
+	List     []domainSystem.Dept `json:"list"`     // 列表
+	Total    int64               `json:"total"`    // 总数
+	Page     int                 `json:"page"`     // 页码
+	PageSize int                 `json:"pageSize"` // 每页数量
+}
+
+type DeptHandler interface {
+	RegisterRoutes(router *gin.RouterGroup)
+	Create(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+	Update(ctx *gin.Context)
+	Move(ctx *gin.Context)
+	GetById(ctx *gin.Context)
+	GetTree(ctx *gin.Context)
+	GetSubtree(ctx *gin.Context)
+	GetAncestors(ctx *gin.Context)
+	GetRecycleListPage(ctx *gin.Context)
+	Restore(ctx *gin.Context)
+	Purge(ctx *gin.Context)
+}
+
+type deptHandler struct {
+	rely    config.RelyConfig
+	svc     serviceSystem.DeptService
+	userSvc serviceSystem.UserService
+}
+
+func NewDeptHandler(rely config.RelyConfig, svc serviceSystem.DeptService, userSvc serviceSystem.UserService) DeptHandler {
+	return &deptHandler{
+		rely:    rely,
+		svc:     svc,
+		userSvc: userSvc,
+	}
+}
+
+// RegisterRoutes 注册路由
+func (h *deptHandler) RegisterRoutes(router *gin.RouterGroup) {
+	base := router.Group("/dept")
+	base.POST("/create", h.Create)
+	base.DELETE("/delete/:id", h.Delete)
+	base.PUT("/update", h.Update)
+	base.PUT("/move", h.Move)
+	base.GET("/getById/:id", h.GetById)
+	base.GET("/tree", h.GetTree)
+	base.GET("/subtree/:id", h.GetSubtree)
+	base.GET("/ancestors/:id", h.GetAncestors)
+	base.GET("/recycle/listPage", h.GetRecycleListPage)
+	base.PUT("/recycle/restore/:id", h.Restore)
+	base.POST("/recycle/purge", h.Purge)
+}
+
+// Create
+// @Summary 创建部门
+// @Description 创建部门
+// @Tags 系统管理/部门管理
+// @Accept application/json
+// @Produce application/json
+// @Param CreateDeptRequest body CreateDeptRequest true "请求"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/dept/create [post]
+// @Security LoginToken
+func (h *deptHandler) Create(ctx *gin.Context) {
+	// 从上下文中获取登录信息
+	claims, ok := ctx.MustGet("claims").(*jwt.Claims)
+	if !ok {
+		zap.S().Error("未找到用户认证信息 >>> ", zap.Error(errors.New(claims.UserId)))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	user, err := h.userSvc.GetById(ctx, claims.UserId)
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("获取用户信息异常 >>> %v", err.Error()))
+		zap.S().Error("获取用户信息异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	var req CreateDeptRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	// 转换为领域模型
+	domain := domainSystem.Dept{
+		Dept: modelSystem.Dept{
+			CoreModels: models.CoreModels{
+				Sort:       req.Sort,
+				Creator:    user.Id,
+				Modifier:   user.Id,
+				BelongDept: user.DeptId,
+				Remark:     req.Remark,
+			},
+			Status: req.Status,
+			Name:   req.Name,
+			Code:   req.Code,
+			Owner:  req.Owner,
+			Phone:  req.Phone,
+			Email:  req.Email,
+		},
+		ParentId: req.ParentId,
+	}
+
+	if err := h.svc.Create(ctx, domain); err != nil {
+		switch {
+		case errors.Is(err, serviceSystem.ErrDeptParentNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "上级部门不存在", nil)
+			return
+		case errors.Is(err, serviceSystem.ErrDeptDuplicate):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "同级下部门名称和编码已存在", nil)
+			return
+		default:
+			ctx.Set("internalError", fmt.Sprintf("创建部门异常 >>> %v", err.Error()))
+			zap.S().Error("创建部门异常 >>> ", zap.Error(err))
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+			return
+		}
+	}
+
+	response.NewResponse().Success(ctx, "新增成功", nil)
+}
+
+// Delete
+// @Summary 删除部门
+// @Description 删除指定id部门，存在子部门或用户时不允许删除
+// @Tags 系统管理/部门管理
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/dept/delete/{id} [delete]
+// @Security LoginToken
+func (h *deptHandler) Delete(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" || len(id) == 0 {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "ID不能为空", nil)
+		return
+	}
+
+	if err := h.svc.Delete(ctx, id); err != nil {
+		switch {
+		case errors.Is(err, serviceSystem.ErrDeptNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "部门不存在", nil)
+			return
+		case errors.Is(err, serviceSystem.ErrDeptHasChildren):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "存在子部门，不允许删除", nil)
+			return
+		case errors.Is(err, serviceSystem.ErrDeptHasUsers):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "部门下存在用户，不允许删除", nil)
+			return
+		default:
+			ctx.Set("internalError", fmt.Sprintf("删除部门失败 >>> %v", err.Error()))
+			zap.S().Error("删除部门失败 >>> ", zap.Error(err))
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+			return
+		}
+	}
+
+	response.NewResponse().Success(ctx, "删除成功", nil)
+}
+
+// Update
+// @Summary 更新部门
+// @Description 更新部门信息，调整上级请使用移动接口
+// @Tags 系统管理/部门管理
+// @Accept application/json
+// @Produce application/json
+// @Param UpdateDeptRequest body UpdateDeptRequest true "请求"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/dept/update [put]
+// @Security LoginToken
+func (h *deptHandler) Update(ctx *gin.Context) {
+	// 从上下文中获取登录信息
+	claims, ok := ctx.MustGet("claims").(*jwt.Claims)
+	if !ok {
+		zap.S().Error("未找到用户认证信息 >>> ", zap.Error(errors.New(claims.UserId)))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	var req UpdateDeptRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	// 转换为领域模型
+	domain := domainSystem.Dept{
+		Dept: modelSystem.Dept{
+			CoreModels: models.CoreModels{
+				Id:        req.Id,
+				Sort:      req.Sort,
+				Timestamp: req.Timestamp,
+				Modifier:  claims.UserId,
+				Remark:    req.Remark,
+			},
+			Status: req.Status,
+			Name:   req.Name,
+			Code:   req.Code,
+			Owner:  req.Owner,
+			Phone:  req.Phone,
+			Email:  req.Email,
+		},
+	}
+
+	if err := h.svc.Update(ctx, domain); err != nil {
+		switch {
+		case errors.Is(err, serviceSystem.ErrDeptNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "部门不存在", nil)
+			return
+		case errors.Is(err, serviceSystem.ErrDeptDuplicate):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "同级下部门名称和编码已存在", nil)
+			return
+		case errors.Is(err, serviceSystem.ErrDeptVersionInconsistency):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "数据版本不一致，取消修改，请刷新后重试", nil)
+			return
+		default:
+			ctx.Set("internalError", fmt.Sprintf("更新部门失败 >>> %v", err.Error()))
+			zap.S().Error("更新部门失败 >>> ", err.Error())
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+			return
+		}
+	}
+
+	response.NewResponse().Success(ctx, "更新成功", nil)
+}
+
+// Move
+// @Summary 移动部门
+// @Description 将部门移动到新的上级部门下，子孙部门随之移动
+// @Tags 系统管理/部门管理
+// @Accept application/json
+// @Produce application/json
+// @Param MoveDeptRequest body MoveDeptRequest true "请求"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/dept/move [put]
+// @Security LoginToken
+func (h *deptHandler) Move(ctx *gin.Context) {
+	// 从上下文中获取登录信息
+	claims, ok := ctx.MustGet("claims").(*jwt.Claims)
+	if !ok {
+		zap.S().Error("未找到用户认证信息 >>> ", zap.Error(errors.New(claims.UserId)))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	var req MoveDeptRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	if err := h.svc.Move(ctx, req.Id, req.ParentId, req.Timestamp, claims.UserId); err != nil {
+		switch {
+		case errors.Is(err, serviceSystem.ErrDeptNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "部门不存在", nil)
+			return
+		case errors.Is(err, serviceSystem.ErrDeptParentNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "上级部门不存在", nil)
+			return
+		case errors.Is(err, serviceSystem.ErrDeptMoveToDescendant):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "不能移动到自身或子部门下", nil)
+			return
+		case errors.Is(err, serviceSystem.ErrDeptDuplicate):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "同级下部门名称和编码已存在", nil)
+			return
+		case errors.Is(err, serviceSystem.ErrDeptVersionInconsistency):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "数据版本不一致，取消修改，请刷新后重试", nil)
+			return
+		default:
+			ctx.Set("internalError", fmt.Sprintf("移动部门失败 >>> %v", err.Error()))
+			zap.S().Error("移动部门失败 >>> ", err.Error())
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+			return
+		}
+	}
+
+	response.NewResponse().Success(ctx, "移动成功", nil)
+}
+
+// GetById
+// @Summary 获取部门
+// @Description 获取指定id部门信息
+// @Tags 系统管理/部门管理
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Success 200 {object} domainSystem.Dept
+// @Failure 400 {object} response.Response
+// @Router /v1/system/dept/getById/{id} [get]
+// @Security LoginToken
+func (h *deptHandler) GetById(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" || len(id) == 0 {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "id不能为空", nil)
+		return
+	}
+
+	detail, err := h.svc.GetById(ctx, id)
+	if err != nil {
+		if errors.Is(err, serviceSystem.ErrDeptNotFound) {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "部门不存在", nil)
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("获取部门失败 >>> %v", err.Error()))
+		zap.S().Error("获取部门失败 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "获取成功", detail)
+}
+
+// GetTree
+// @Summary 获取部门树
+// @Description 获取完整部门树
+// @Tags 系统管理/部门管理
+// @Accept application/json
+// @Produce application/json
+// @Param status query bool false "状态" default(true)
+// @Param name query string false "部门名称"
+// @Param code query string false "部门编码"
+// @Success 200 {array} []domainSystem.Dept
+// @Failure 400 {object} response.Response
+// @Router /v1/system/dept/tree [get]
+// @Security LoginToken
+func (h *deptHandler) GetTree(ctx *gin.Context) {
+	tree, err := h.svc.GetTree(ctx, h.buildFilter(ctx))
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("获取部门树异常 >>> %v", err.Error()))
+		zap.S().Error("获取部门树异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", tree)
+}
+
+// GetSubtree
+// @Summary 获取部门子树
+// @Description 获取指定id部门及其全部子孙部门组成的树
+// @Tags 系统管理/部门管理
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Param status query bool false "状态" default(true)
+// @Param name query string false "部门名称"
+// @Param code query string false "部门编码"
+// @Success 200 {array} []domainSystem.Dept
+// @Failure 400 {object} response.Response
+// @Router /v1/system/dept/subtree/{id} [get]
+// @Security LoginToken
+func (h *deptHandler) GetSubtree(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" || len(id) == 0 {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "id不能为空", nil)
+		return
+	}
+
+	tree, err := h.svc.GetSubtree(ctx, id, h.buildFilter(ctx))
+	if err != nil {
+		if errors.Is(err, serviceSystem.ErrDeptNotFound) {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "部门不存在", nil)
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("获取部门子树异常 >>> %v", err.Error()))
+		zap.S().Error("获取部门子树异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", tree)
+}
+
+// GetAncestors
+// @Summary 获取上级部门链
+// @Description 获取指定id部门的全部祖先部门，按层级由根到近排列
+// @Tags 系统管理/部门管理
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Success 200 {array} []domainSystem.Dept
+// @Failure 400 {object} response.Response
+// @Router /v1/system/dept/ancestors/{id} [get]
+// @Security LoginToken
+func (h *deptHandler) GetAncestors(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" || len(id) == 0 {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "id不能为空", nil)
+		return
+	}
+
+	list, err := h.svc.GetAncestors(ctx, id)
+	if err != nil {
+		if errors.Is(err, serviceSystem.ErrDeptNotFound) {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "部门不存在", nil)
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("获取上级部门异常 >>> %v", err.Error()))
+		zap.S().Error("获取上级部门异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", list)
+}
+
+// GetRecycleListPage
+// @Summary 获取部门回收站分页列表
+// @Description 获取已删除的部门分页列表，按删除时间倒序
+// @Tags 系统管理/部门管理
+// @Accept application/json
+// @Produce application/json
+// @Param page query int true "页码" default(1)
+// @Param pageSize query int true "每页数量" default(10)
+// @Param name query string false "名称"
+// @Param deletedBy query string false "删除人"
+// @Success 200 {object} DeptListPageResponse
+// @Failure 400 {object} response.Response
+// @Router /v1/system/dept/recycle/listPage [get]
+// @Security LoginToken
+func (h *deptHandler) GetRecycleListPage(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("pageSize", "10"))
+
+	filter := domainSystem.RecycleFilter{
+		Pagination: filters.Pagination{
+			Page:     page,
+			PageSize: pageSize,
+		},
+		Name:      ctx.DefaultQuery("name", ""),
+		DeletedBy: ctx.DefaultQuery("deletedBy", ""),
+	}
+
+	list, total, err := h.svc.GetRecycleListPage(ctx, filter)
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("获取部门回收站分页列表异常 >>> %v", err.Error()))
+		zap.S().Error("获取部门回收站分页列表异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", DeptListPageResponse{
+		List:     list,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// Restore
+// @Summary 恢复部门
+// @Description 从回收站恢复指定id部门，上级部门需未被删除
+// @Tags 系统管理/部门管理
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/dept/recycle/restore/{id} [put]
+// @Security LoginToken
+func (h *deptHandler) Restore(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "ID不能为空", nil)
+		return
+	}
+
+	if err := h.svc.Restore(ctx, id); err != nil {
+		switch {
+		case errors.Is(err, serviceSystem.ErrDeptNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "回收站中不存在该部门", nil)
+		case errors.Is(err, serviceSystem.ErrDeptParentNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "上级部门不存在，请先恢复上级部门", nil)
+		case errors.Is(err, serviceSystem.ErrDeptDuplicate):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "同级下部门名称和编码已存在", nil)
+		default:
+			ctx.Set("internalError", fmt.Sprintf("恢复部门异常 >>> %v", err.Error()))
+			zap.S().Error("恢复部门异常 >>> ", zap.Error(err))
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		}
+		return
+	}
+
+	response.NewResponse().Success(ctx, "恢复成功", nil)
+}
+
+// Purge
+// @Summary 彻底删除部门
+// @Description 彻底删除回收站中的部门，删除后不可恢复
+// @Tags 系统管理/部门管理
+// @Accept application/json
+// @Produce application/json
+// @Param ids body []string true "id数组"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/dept/recycle/purge [post]
+// @Security LoginToken
+func (h *deptHandler) Purge(ctx *gin.Context) {
+	var ids []string
+	if err := ctx.ShouldBindJSON(&ids); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	if err := h.svc.Purge(ctx, ids); err != nil {
+		ctx.Set("internalError", fmt.Sprintf("彻底删除部门异常 >>> %v", err.Error()))
+		zap.S().Error("彻底删除部门异常 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "彻底删除成功", nil)
+}
+
+// buildFilter 构建查询条件
+func (h *deptHandler) buildFilter(ctx *gin.Context) domainSystem.DeptFilter {
+	status, _ := strconv.ParseBool(ctx.DefaultQuery("status", "true"))
+
+	return domainSystem.DeptFilter{
+		Filters: filters.Filters{
+			Creator:  ctx.DefaultQuery("creator", ""),
+			Modifier: ctx.DefaultQuery("modifier", ""),
+		},
+		Status: status,
+		Name:   ctx.DefaultQuery("name", ""),
+		Code:   ctx.DefaultQuery("code", ""),
+	}
+}
@@ -0,0 +1,487 @@
+/**
+ * Description：
+ * FileName：menu.go
+ * Author：CJiaの用心
+ * Create：2025/10/28 11:36:20
+ * Remark：
+ */
+
+package system
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carefuly/careful-admin-go-gin/config"
+	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
+	modelSystem "github.com/carefuly/careful-admin-go-gin/internal/model/careful/system"
+	serviceSystem "github.com/carefuly/careful-admin-go-gin/internal/service/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/system/menu"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/response"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/jwt"
+	"github.com/carefuly/careful-admin-go-gin/pkg/validate"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+)
+
+// CreateMenuRequest 创建
+type CreateMenuRequest struct {
+	Type      menu.TypeConst `json:"type" binding:"required,oneof=1 2 3" default:"1"` // 菜单类型【1-目录 2-菜单 3-按钮】
+	Title     string         `json:"title" binding:"required,max=64"`                 // 菜单标题
+	Name      string         `json:"name" binding:"omitempty,max=64"`                 // 路由名称
+	Code      string         `json:"code" binding:"omitempty,max=128"`                // 权限标识，按钮必填
+	Path      string         `json:"path" binding:"omitempty,max=255"`                // 路由地址
+	Component string         `json:"component" binding:"omitempty,max=255"`           // 组件路径
+	Redirect  string         `json:"redirect" binding:"omitempty,max=255"`            // 重定向地址
+	Icon      string         `json:"icon" binding:"omitempty,max=64"`                 // 图标
+	Visible   bool           `json:"visible" binding:"omitempty" default:"true"`      // 是否显示
+	KeepAlive bool           `json:"keep_alive" binding:"omitempty"`                  // 是否缓存
+	IsLink    bool           `json:"is_link" binding:"omitempty"`                     // 是否外链
+	ParentId  string         `json:"parent_id" binding:"omitempty,max=100"`           // 上级菜单ID
+	Sort      int            `json:"sort" binding:"omitempty" default:"1"`            // 排序
+	Status    bool           `json:"status" binding:"omitempty" default:"true"`       // 状态【true-启用 false-停用】
+	Remark    string         `json:"remark" binding:"omitempty,max=255"`              // 备注
+}
+
+// UpdateMenuRequest 更新
+type UpdateMenuRequest struct {
+	Id        string         `json:"id" binding:"required"`                           // 主键ID
+	Type      menu.TypeConst `json:"type" binding:"required,oneof=1 2 3" default:"1"` // 菜单类型【1-目录 2-菜单 3-按钮】
+	Title     string         `json:"title" binding:"required,max=64"`                 // 菜单标题
+	Name      string         `json:"name" binding:"omitempty,max=64"`                 // 路由名称
+	Code      string         `json:"code" binding:"omitempty,max=128"`                // 权限标识，按钮必填
+	Path      string         `json:"path" binding:"omitempty,max=255"`                // 路由地址
+	Component string         `json:"component" binding:"omitempty,max=255"`           // 组件路径
+	Redirect  string         `json:"redirect" binding:"omitempty,max=255"`            // 重定向地址
+	Icon      string         `json:"icon" binding:"omitempty,max=64"`                 // 图标
+	Visible   bool           `json:"visible" binding:"omitempty" default:"true"`      // 是否显示
+	KeepAlive bool           `json:"keep_alive" binding:"omitempty"`                  // 是否缓存
+	IsLink    bool           `json:"is_link" binding:"omitempty"`                     // 是否外链
+	ParentId  string         `json:"parent_id" binding:"omitempty,max=100"`           // 上级菜单ID
+	Sort      int            `json:"sort" binding:"omitempty" default:"1"`            // 排序
+	Status    bool           `json:"status" binding:"omitempty" default:"true"`       // 状态【true-启用 false-停用】
+	Timestamp int64          `json:"timestamp" binding:"omitempty"`                   // 版本
+	Remark    string         `json:"remark" binding:"omitempty,max=255"`              // 备注
+}
+
+// MenuListPageResponse 列表分页响应
+type MenuListPageResponse struct {
+	List     []domainSystem.Menu `json:"list"`     // 列表
+	Total    int64               `json:"total"`    // 总数
+	Page     int                 `json:"page"`     // 页码
+	PageSize int                 `json:"pageSize"` // 每页数量
+}
+
+type MenuHandler interface {
+	RegisterRoutes(router *gin.RouterGroup)
+	Create(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+	Update(ctx *gin.Context)
+	GetById(ctx *gin.Context)
+	GetTree(ctx *gin.Context)
+	GetRecycleListPage(ctx *gin.Context)
+	Restore(ctx *gin.Context)
+	Purge(ctx *gin.Context)
+}
+
+type menuHandler struct {
+	rely    config.RelyConfig
+	svc     serviceSystem.MenuService
+	userSvc serviceSystem.UserService
+}
+
+func NewMenuHandler(rely config.RelyConfig, svc serviceSystem.MenuService, userSvc serviceSystem.UserService) MenuHandler {
+	return &menuHandler{
+		rely:    rely,
+		svc:     svc,
+		userSvc: userSvc,
+	}
+}
+
+// RegisterRoutes 注册路由
+func (h *menuHandler) RegisterRoutes(router *gin.RouterGroup) {
+	base := router.Group("/menu")
+	base.POST("/create", h.Create)
+	base.DELETE("/delete/:id", h.Delete)
+	base.PUT("/update", h.Update)
+	base.GET("/getById/:id", h.GetById)
+	base.GET("/tree", h.GetTree)
+	base.GET("/recycle/listPage", h.GetRecycleListPage)
+	base.PUT("/recycle/restore/:id", h.Restore)
+	base.POST("/recycle/purge", h.Purge)
+}
+
+// Create
+// @Summary 创建菜单
+// @Description 创建目录、菜单或按钮，按钮必须填写唯一的权限标识
+// @Tags 系统管理/菜单管理
+// @Accept application/json
+// @Produce application/json
+// @Param CreateMenuRequest body CreateMenuRequest true "请求"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/menu/create [post]
+// @Security LoginToken
+func (h *menuHandler) Create(ctx *gin.Context) {
+	// 从上下文中获取登录信息
+	claims, ok := ctx.MustGet("claims").(*jwt.Claims)
+	if !ok {
+		zap.S().Error("未找到用户认证信息 >>> ", zap.Error(errors.New(claims.UserId)))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	user, err := h.userSvc.GetById(ctx, claims.UserId)
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("获取用户信息异常 >>> %v", err.Error()))
+		zap.S().Error("获取用户信息异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	var req CreateMenuRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	// 转换为领域模型
+	domain := domainSystem.Menu{
+		Menu: modelSystem.Menu{
+			CoreModels: models.CoreModels{
+				Sort:       req.Sort,
+				Creator:    user.Id,
+				Modifier:   user.Id,
+				BelongDept: user.DeptId,
+				Remark:     req.Remark,
+			},
+			Status:    req.Status,
+			Type:      req.Type,
+			Title:     req.Title,
+			Name:      req.Name,
+			Code:      req.Code,
+			Path:      req.Path,
+			Component: req.Component,
+			Redirect:  req.Redirect,
+			Icon:      req.Icon,
+			Visible:   req.Visible,
+			KeepAlive: req.KeepAlive,
+			IsLink:    req.IsLink,
+		},
+		ParentId: req.ParentId,
+	}
+
+	if err := h.svc.Create(ctx, domain); err != nil {
+		if h.handleError(ctx, err) {
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("创建菜单异常 >>> %v", err.Error()))
+		zap.S().Error("创建菜单异常 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "新增成功", nil)
+}
+
+// Delete
+// @Summary 删除菜单
+// @Description 删除指定id菜单，存在子菜单时不允许删除，角色关联在彻底删除时解除
+// @Tags 系统管理/菜单管理
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/menu/delete/{id} [delete]
+// @Security LoginToken
+func (h *menuHandler) Delete(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" || len(id) == 0 {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "ID不能为空", nil)
+		return
+	}
+
+	if err := h.svc.Delete(ctx, id); err != nil {
+		if h.handleError(ctx, err) {
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("删除菜单失败 >>> %v", err.Error()))
+		zap.S().Error("删除菜单失败 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "删除成功", nil)
+}
+
+// Update
+// @Summary 更新菜单
+// @Description 更新菜单信息，可调整上级菜单
+// @Tags 系统管理/菜单管理
+// @Accept application/json
+// @Produce application/json
+// @Param UpdateMenuRequest body UpdateMenuRequest true "请求"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/menu/update [put]
+// @Security LoginToken
+func (h *menuHandler) Update(ctx *gin.Context) {
+	// 从上下文中获取登录信息
+	claims, ok := ctx.MustGet("claims").(*jwt.Claims)
+	if !ok {
+		zap.S().Error("未找到用户认证信息 >>> ", zap.Error(errors.New(claims.UserId)))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	var req UpdateMenuRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	// 转换为领域模型
+	domain := domainSystem.Menu{
+		Menu: modelSystem.Menu{
+			CoreModels: models.CoreModels{
+				Id:        req.Id,
+				Sort:      req.Sort,
+				Timestamp: req.Timestamp,
+				Modifier:  claims.UserId,
+				Remark:    req.Remark,
+			},
+			Status:    req.Status,
+			Type:      req.Type,
+			Title:     req.Title,
+			Name:      req.Name,
+			Code:      req.Code,
+			Path:      req.Path,
+			Component: req.Component,
+			Redirect:  req.Redirect,
+			Icon:      req.Icon,
+			Visible:   req.Visible,
+			KeepAlive: req.KeepAlive,
+			IsLink:    req.IsLink,
+		},
+		ParentId: req.ParentId,
+	}
+
+	if err := h.svc.Update(ctx, domain); err != nil {
+		if h.handleError(ctx, err) {
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("更新菜单失败 >>> %v", err.Error()))
+		zap.S().Error("更新菜单失败 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "更新成功", nil)
+}
+
+// GetById
+// @Summary 获取菜单详情
+// @Description 获取指定id菜单详情
+// @Tags 系统管理/菜单管理
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Success 200 {object} domainSystem.Menu
+// @Failure 400 {object} response.Response
+// @Router /v1/system/menu/getById/{id} [get]
+// @Security LoginToken
+func (h *menuHandler) GetById(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" || len(id) == 0 {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "id不能为空", nil)
+		return
+	}
+
+	detail, err := h.svc.GetById(ctx, id)
+	if err != nil {
+		if errors.Is(err, serviceSystem.ErrMenuNotFound) {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "菜单不存在", nil)
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("获取菜单失败 >>> %v", err.Error()))
+		zap.S().Error("获取菜单失败 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "获取成功", detail)
+}
+
+// GetTree
+// @Summary 获取菜单树
+// @Description 获取完整菜单树，包含按钮
+// @Tags 系统管理/菜单管理
+// @Accept application/json
+// @Produce application/json
+// @Param status query bool false "状态" default(true)
+// @Param title query string false "菜单标题"
+// @Param type query int false "菜单类型【1-目录 2-菜单 3-按钮】"
+// @Success 200 {array} []domainSystem.Menu
+// @Failure 400 {object} response.Response
+// @Router /v1/system/menu/tree [get]
+// @Security LoginToken
+func (h *menuHandler) GetTree(ctx *gin.Context) {
+	tree, err := h.svc.GetTree(ctx, h.buildFilter(ctx))
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("获取菜单树异常 >>> %v", err.Error()))
+		zap.S().Error("获取菜单树异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", tree)
+}
+
+// GetRecycleListPage
+// @Summary 获取菜单回收站分页列表
+// @Description 获取已删除的菜单分页列表，按删除时间倒序
+// @Tags 系统管理/菜单管理
+// @Accept application/json
+// @Produce application/json
+// @Param page query int true "页码" default(1)
+// @Param pageSize query int true "每页数量" default(10)
+// @Param name query string false "菜单标题"
+// @Param deletedBy query string false "删除人"
+// @Success 200 {object} MenuListPageResponse
+// @Failure 400 {object} response.Response
+// @Router /v1/system/menu/recycle/listPage [get]
+// @Security LoginToken
+func (h *menuHandler) GetRecycleListPage(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("pageSize", "10"))
+
+	filter := domainSystem.RecycleFilter{
+		Pagination: filters.Pagination{
+			Page:     page,
+			PageSize: pageSize,
+		},
+		Name:      ctx.DefaultQuery("name", ""),
+		DeletedBy: ctx.DefaultQuery("deletedBy", ""),
+	}
+
+	list, total, err := h.svc.GetRecycleListPage(ctx, filter)
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("获取菜单回收站分页列表异常 >>> %v", err.Error()))
+		zap.S().Error("获取菜单回收站分页列表异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", MenuListPageResponse{
+		List:     list,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// Restore
+// @Summary 恢复菜单
+// @Description 从回收站恢复指定id菜单，上级菜单需未被删除
+// @Tags 系统管理/菜单管理
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/menu/recycle/restore/{id} [put]
+// @Security LoginToken
+func (h *menuHandler) Restore(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "ID不能为空", nil)
+		return
+	}
+
+	if err := h.svc.Restore(ctx, id); err != nil {
+		if errors.Is(err, serviceSystem.ErrMenuNotFound) {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "回收站中不存在该菜单", nil)
+			return
+		}
+		if h.handleError(ctx, err) {
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("恢复菜单异常 >>> %v", err.Error()))
+		zap.S().Error("恢复菜单异常 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "恢复成功", nil)
+}
+
+// Purge
+// @Summary 彻底删除菜单
+// @Description 彻底删除回收站中的菜单，删除后不可恢复
+// @Tags 系统管理/菜单管理
+// @Accept application/json
+// @Produce application/json
+// @Param ids body []string true "id数组"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/menu/recycle/purge [post]
+// @Security LoginToken
+func (h *menuHandler) Purge(ctx *gin.Context) {
+	var ids []string
+	if err := ctx.ShouldBindJSON(&ids); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	if err := h.svc.Purge(ctx, ids); err != nil {
+		ctx.Set("internalError", fmt.Sprintf("彻底删除菜单异常 >>> %v", err.Error()))
+		zap.S().Error("彻底删除菜单异常 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "彻底删除成功", nil)
+}
+
+// handleError 处理业务错误，已响应返回true
+func (h *menuHandler) handleError(ctx *gin.Context, err error) bool {
+	switch {
+	case errors.Is(err, serviceSystem.ErrMenuNotFound):
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "菜单不存在", nil)
+	case errors.Is(err, serviceSystem.ErrMenuDuplicate):
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "权限标识已存在", nil)
+	case errors.Is(err, serviceSystem.ErrMenuCodeRequired):
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "按钮权限标识不能为空", nil)
+	case errors.Is(err, serviceSystem.ErrMenuParentNotFound):
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "上级菜单不存在", nil)
+	case errors.Is(err, serviceSystem.ErrMenuParentInvalid):
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "上级菜单不能是按钮、自身或子菜单", nil)
+	case errors.Is(err, serviceSystem.ErrMenuHasChildren):
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "存在子菜单，不允许删除", nil)
+	case errors.Is(err, serviceSystem.ErrMenuVersionInconsistency):
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "数据版本不一致，取消修改，请刷新后重试", nil)
+	default:
+		return false
+	}
+	return true
+}
+
+// buildFilter 构建查询条件
+func (h *menuHandler) buildFilter(ctx *gin.Context) domainSystem.MenuFilter {
+	status, _ := strconv.ParseBool(ctx.DefaultQuery("status", "true"))
+	menuType, _ := strconv.Atoi(ctx.DefaultQuery("type", "0"))
+
+	return domainSystem.MenuFilter{
+		Filters: filters.Filters{
+			Creator:  ctx.DefaultQuery("creator", ""),
+			Modifier: ctx.DefaultQuery("modifier", ""),
+		},
+		Status: status,
+		Title:  ctx.DefaultQuery("title", ""),
+		Type:   menu.TypeConst(menuType),
+	}
+}
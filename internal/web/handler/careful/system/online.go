@@ -0,0 +1,187 @@
+/**
+ * Description：
+ * FileName：online.go
+ * Author：CJiaの用心
+ * Create：2025/11/1 15:36:48
+ * Remark：
+ */
+
+package system
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carefuly/careful-admin-go-gin/config"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/response"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/jwt"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// OnlineSession 在线会话
+type OnlineSession struct {
+	Id         string `json:"id"`         // 会话ID
+	UserId     string `json:"userId"`     // 用户ID
+	Username   string `json:"username"`   // 用户名
+	Ip         string `json:"ip"`         // 登录IP
+	Os         string `json:"os"`         // 操作系统
+	Browser    string `json:"browser"`    // 浏览器
+	UserAgent  string `json:"userAgent"`  // 设备信息
+	LoginTime  string `json:"loginTime"`  // 登录时间
+	ExpireTime string `json:"expireTime"` // 过期时间
+}
+
+// OnlineListPageResponse 列表分页响应
+type OnlineListPageResponse struct {
+	List     []OnlineSession `json:"list"`     // 列表
+	Total    int64           `json:"total"`    // 总数
+	Page     int             `json:"page"`     // 页码
+	PageSize int             `json:"pageSize"` // 每页数量
+}
+
+type OnlineHandler interface {
+	RegisterRoutes(router *gin.RouterGroup)
+	ForceLogout(ctx *gin.Context)
+	ForceLogoutUser(ctx *gin.Context)
+	GetListPage(ctx *gin.Context)
+}
+
+type onlineHandler struct {
+	rely       config.RelyConfig
+	refreshSvc *jwt.RefreshTokenManager
+}
+
+func NewOnlineHandler(rely config.RelyConfig, refreshSvc *jwt.RefreshTokenManager) OnlineHandler {
+	return &onlineHandler{
+		rely:       rely,
+		refreshSvc: refreshSvc,
+	}
+}
+
+// RegisterRoutes 注册路由
+func (h *onlineHandler) RegisterRoutes(router *gin.RouterGroup) {
+	base := router.Group("/online")
+	base.DELETE("/delete/:id", h.ForceLogout)
+	base.DELETE("/deleteUser/:userId", h.ForceLogoutUser)
+	base.GET("/listPage", h.GetListPage)
+}
+
+// ForceLogout
+// @Summary 强制会话下线
+// @Description 强制指定会话下线，对应设备需重新登录
+// @Tags 系统管理/在线用户
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "会话ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/online/delete/{id} [delete]
+// @Security LoginToken
+func (h *onlineHandler) ForceLogout(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "id不能为空", nil)
+		return
+	}
+
+	if _, err := h.refreshSvc.GetFamily(ctx, id); err != nil {
+		if errors.Is(err, jwt.ErrRefreshTokenInvalid) {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "会话不存在", nil)
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("获取在线会话异常 >>> %v", err.Error()))
+		zap.S().Error("获取在线会话异常 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	if err := h.refreshSvc.Revoke(ctx, id); err != nil {
+		ctx.Set("internalError", fmt.Sprintf("强制下线异常 >>> %v", err.Error()))
+		zap.S().Error("强制下线异常 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "强制下线成功", nil)
+}
+
+// ForceLogoutUser
+// @Summary 强制用户下线
+// @Description 强制指定用户的全部会话下线
+// @Tags 系统管理/在线用户
+// @Accept application/json
+// @Produce application/json
+// @Param userId path string true "用户ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/online/deleteUser/{userId} [delete]
+// @Security LoginToken
+func (h *onlineHandler) ForceLogoutUser(ctx *gin.Context) {
+	userId := ctx.Param("userId")
+	if userId == "" {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "用户id不能为空", nil)
+		return
+	}
+
+	count, err := h.refreshSvc.RevokeUser(ctx, userId)
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("强制用户下线异常 >>> %v", err.Error()))
+		zap.S().Error("强制用户下线异常 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "强制下线成功", gin.H{"count": count})
+}
+
+// GetListPage
+// @Summary 获取在线用户分页列表
+// @Description 获取在线会话分页列表，按登录时间倒序
+// @Tags 系统管理/在线用户
+// @Accept application/json
+// @Produce application/json
+// @Param page query int true "页码" default(1)
+// @Param pageSize query int true "每页数量" default(10)
+// @Param username query string false "用户名"
+// @Success 200 {object} OnlineListPageResponse
+// @Failure 400 {object} response.Response
+// @Router /v1/system/online/listPage [get]
+// @Security LoginToken
+func (h *onlineHandler) GetListPage(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("pageSize", "10"))
+	username := ctx.DefaultQuery("username", "")
+
+	families, total, err := h.refreshSvc.ListOnline(ctx, username, page, pageSize)
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("获取在线用户分页列表异常 >>> %v", err.Error()))
+		zap.S().Error("获取在线用户分页列表异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	list := make([]OnlineSession, 0, len(families))
+	for _, f := range families {
+		list = append(list, OnlineSession{
+			Id:         f.Id,
+			UserId:     f.UserId,
+			Username:   f.Username,
+			Ip:         f.Ip,
+			Os:         f.Os,
+			Browser:    f.Browser,
+			UserAgent:  f.UserAgent,
+			LoginTime:  time.Unix(f.AuthTime, 0).Format(time.DateTime),
+			ExpireTime: time.Unix(f.ExpireAt, 0).Format(time.DateTime),
+		})
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", OnlineListPageResponse{
+		List:     list,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
@@ -0,0 +1,528 @@
+/**
+ * Description：
+ * FileName：permission.go
+ * Author：CJiaの用心
+ * Create：2025/10/27 15:40:19
+ * Remark：
+ */
+
+package system
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carefuly/careful-admin-go-gin/config"
+	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
+	modelSystem "github.com/carefuly/careful-admin-go-gin/internal/model/careful/system"
+	serviceSystem "github.com/carefuly/careful-admin-go-gin/internal/service/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/response"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/jwt"
+	"github.com/carefuly/careful-admin-go-gin/pkg/validate"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+)
+
+// CreatePermissionRequest 创建
+type CreatePermissionRequest struct {
+	Name   string `json:"name" binding:"required,max=64"`            // 权限名称
+	Code   string `json:"code" binding:"required,max=128"`           // 权限编码
+	Method string `json:"method" binding:"required,max=10"`          // 请求方式，*表示全部
+	Path   string `json:"path" binding:"required,max=255"`           // 接口路径，如/v1/system/user/getById/:id
+	Sort   int    `json:"sort" binding:"omitempty" default:"1"`      // 排序
+	Status bool   `json:"status" binding:"omitempty" default:"true"` // 状态【true-启用 false-停用】
+	Remark string `json:"remark" binding:"omitempty,max=255"`        // 备注
+}
+
+// UpdatePermissionRequest 更新
+type UpdatePermissionRequest struct {
+	Id        string `json:"id" binding:"required"`                     // 主键ID
+	Name      string `json:"name" binding:"required,max=64"`            // 权限名称
+	Code      string `json:"code" binding:"required,max=128"`           // 权限编码
+	Method    string `json:"method" binding:"required,max=10"`          // 请求方式，*表示全部
+	Path      string `json:"path" binding:"required,max=255"`           // 接口路径，如/v1/system/user/getById/:id
+	Sort      int    `json:"sort" binding:"omitempty" default:"1"`      // 排序
+	Status    bool   `json:"status" binding:"omitempty" default:"true"` // 状态【true-启用 false-停用】
+	Timestamp int64  `json:"timestamp" binding:"omitempty"`             // 版本
+	Remark    string `json:"remark" binding:"omitempty,max=255"`        // 备注
+}
+
+// PermissionListPageResponse 列表分页响应
+type PermissionListPageResponse struct {
+	List     []domainSystem.Permission `json:"list"`     // 列表
+	Total    int64                     `json:"total"`    // 总数
+	Page     int                       `json:"page"`     // 页码
+	PageSize int                       `json:"pageSize"` // 每页数量
+}
+
+type PermissionHandler interface {
+	RegisterRoutes(router *gin.RouterGroup)
+	Create(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+	BatchDelete(ctx *gin.Context)
+	Update(ctx *gin.Context)
+	GetById(ctx *gin.Context)
+	GetListPage(ctx *gin.Context)
+	GetListAll(ctx *gin.Context)
+	GetRecycleListPage(ctx *gin.Context)
+	Restore(ctx *gin.Context)
+	Purge(ctx *gin.Context)
+}
+
+type permissionHandler struct {
+	rely    config.RelyConfig
+	svc     serviceSystem.PermissionService
+	userSvc serviceSystem.UserService
+}
+
+func NewPermissionHandler(rely config.RelyConfig, svc serviceSystem.PermissionService, userSvc serviceSystem.UserService) PermissionHandler {
+	return &permissionHandler{
+		rely:    rely,
+		svc:     svc,
+		userSvc: userSvc,
+	}
+}
+
+// RegisterRoutes 注册路由
+func (h *permissionHandler) RegisterRoutes(router *gin.RouterGroup) {
+	base := router.Group("/permission")
+	base.POST("/create", h.Create)
+	base.DELETE("/delete/:id", h.Delete)
+	base.POST("/delete/batchDelete", h.BatchDelete)
+	base.PUT("/update", h.Update)
+	base.GET("/getById/:id", h.GetById)
+	base.GET("/listPage", h.GetListPage)
+	base.GET("/listAll", h.GetListAll)
+	base.GET("/recycle/listPage", h.GetRecycleListPage)
+	base.PUT("/recycle/restore/:id", h.Restore)
+	base.POST("/recycle/purge", h.Purge)
+}
+
+// Create
+// @Summary 创建接口权限
+// @Description 创建接口权限，路径需与路由定义一致（不含接口前缀）
+// @Tags 系统管理/接口权限管理
+// @Accept application/json
+// @Produce application/json
+// @Param CreatePermissionRequest body CreatePermissionRequest true "请求"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/permission/create [post]
+// @Security LoginToken
+func (h *permissionHandler) Create(ctx *gin.Context) {
+	// 从上下文中获取登录信息
+	claims, ok := ctx.MustGet("claims").(*jwt.Claims)
+	if !ok {
+		zap.S().Error("未找到用户认证信息 >>> ", zap.Error(errors.New(claims.UserId)))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	user, err := h.userSvc.GetById(ctx, claims.UserId)
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("获取用户信息异常 >>> %v", err.Error()))
+		zap.S().Error("获取用户信息异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	var req CreatePermissionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	// 转换为领域模型
+	domain := domainSystem.Permission{
+		Permission: modelSystem.Permission{
+			CoreModels: models.CoreModels{
+				Sort:       req.Sort,
+				Creator:    user.Id,
+				Modifier:   user.Id,
+				BelongDept: user.DeptId,
+				Remark:     req.Remark,
+			},
+			Status: req.Status,
+			Name:   req.Name,
+			Code:   req.Code,
+			Method: req.Method,
+			Path:   req.Path,
+		},
+	}
+
+	if err := h.svc.Create(ctx, domain); err != nil {
+		switch {
+		case errors.Is(err, serviceSystem.ErrPermissionMethodInvalid):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "请求方式不合法", nil)
+			return
+		case errors.Is(err, serviceSystem.ErrPermissionDuplicate):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "权限编码或接口已存在", nil)
+			return
+		default:
+			ctx.Set("internalError", fmt.Sprintf("创建接口权限异常 >>> %v", err.Error()))
+			zap.S().Error("创建接口权限异常 >>> ", zap.Error(err))
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+			return
+		}
+	}
+
+	response.NewResponse().Success(ctx, "新增成功", nil)
+}
+
+// Delete
+// @Summary 删除接口权限
+// @Description 删除指定id接口权限，移入回收站，角色关联在彻底删除时解除
+// @Tags 系统管理/接口权限管理
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/permission/delete/{id} [delete]
+// @Security LoginToken
+func (h *permissionHandler) Delete(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" || len(id) == 0 {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "ID不能为空", nil)
+		return
+	}
+
+	if err := h.svc.Delete(ctx, id); err != nil {
+		ctx.Set("internalError", fmt.Sprintf("删除接口权限失败 >>> %v", err.Error()))
+		zap.S().Error("删除接口权限失败 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "删除成功", nil)
+}
+
+// BatchDelete
+// @Summary 批量删除接口权限
+// @Description 批量删除接口权限
+// @Tags 系统管理/接口权限管理
+// @Accept application/json
+// @Produce application/json
+// @Param ids body []string true "id数组"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/permission/delete/batchDelete [post]
+// @Security LoginToken
+func (h *permissionHandler) BatchDelete(ctx *gin.Context) {
+	var ids []string
+	if err := ctx.ShouldBindJSON(&ids); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	if err := h.svc.BatchDelete(ctx, ids); err != nil {
+		ctx.Set("internalError", fmt.Sprintf("批量删除接口权限异常 >>> %v", err.Error()))
+		zap.S().Error("批量删除接口权限异常 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "批量删除成功", nil)
+}
+
+// Update
+// @Summary 更新接口权限
+// @Description 更新接口权限信息
+// @Tags 系统管理/接口权限管理
+// @Accept application/json
+// @Produce application/json
+// @Param UpdatePermissionRequest body UpdatePermissionRequest true "请求"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/permission/update [put]
+// @Security LoginToken
+func (h *permissionHandler) Update(ctx *gin.Context) {
+	// 从上下文中获取登录信息
+	claims, ok := ctx.MustGet("claims").(*jwt.Claims)
+	if !ok {
+		zap.S().Error("未找到用户认证信息 >>> ", zap.Error(errors.New(claims.UserId)))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	var req UpdatePermissionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	// 转换为领域模型
+	domain := domainSystem.Permission{
+		Permission: modelSystem.Permission{
+			CoreModels: models.CoreModels{
+				Id:        req.Id,
+				Sort:      req.Sort,
+				Timestamp: req.Timestamp,
+				Modifier:  claims.UserId,
+				Remark:    req.Remark,
+			},
+			Status: req.Status,
+			Name:   req.Name,
+			Code:   req.Code,
+			Method: req.Method,
+			Path:   req.Path,
+		},
+	}
+
+	if err := h.svc.Update(ctx, domain); err != nil {
+		switch {
+		case errors.Is(err, serviceSystem.ErrPermissionNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "接口权限不存在", nil)
+			return
+		case errors.Is(err, serviceSystem.ErrPermissionMethodInvalid):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "请求方式不合法", nil)
+			return
+		case errors.Is(err, serviceSystem.ErrPermissionDuplicate):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "权限编码或接口已存在", nil)
+			return
+		case errors.Is(err, serviceSystem.ErrPermissionVersionInconsistency):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "数据版本不一致，取消修改，请刷新后重试", nil)
+			return
+		default:
+			ctx.Set("internalError", fmt.Sprintf("更新接口权限失败 >>> %v", err.Error()))
+			zap.S().Error("更新接口权限失败 >>> ", err.Error())
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+			return
+		}
+	}
+
+	response.NewResponse().Success(ctx, "更新成功", nil)
+}
+
+// GetById
+// @Summary 获取接口权限
+// @Description 获取指定id接口权限信息
+// @Tags 系统管理/接口权限管理
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Success 200 {object} domainSystem.Permission
+// @Failure 400 {object} response.Response
+// @Router /v1/system/permission/getById/{id} [get]
+// @Security LoginToken
+func (h *permissionHandler) GetById(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" || len(id) == 0 {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "id不能为空", nil)
+		return
+	}
+
+	detail, err := h.svc.GetById(ctx, id)
+	if err != nil {
+		if errors.Is(err, serviceSystem.ErrPermissionNotFound) {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "接口权限不存在", nil)
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("获取接口权限失败 >>> %v", err.Error()))
+		zap.S().Error("获取接口权限失败 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "获取成功", detail)
+}
+
+// GetListPage
+// @Summary 获取接口权限分页列表
+// @Description 获取接口权限分页列表
+// @Tags 系统管理/接口权限管理
+// @Accept application/json
+// @Produce application/json
+// @Param page query int true "页码" default(1)
+// @Param pageSize query int true "每页数量" default(10)
+// @Param creator query string false "创建人"
+// @Param modifier query string false "修改人"
+// @Param status query bool false "状态" default(true)
+// @Param name query string false "权限名称"
+// @Param code query string false "权限编码"
+// @Param method query string false "请求方式"
+// @Param path query string false "接口路径"
+// @Success 200 {object} PermissionListPageResponse
+// @Failure 400 {object} response.Response
+// @Router /v1/system/permission/listPage [get]
+// @Security LoginToken
+func (h *permissionHandler) GetListPage(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("pageSize", "10"))
+
+	filter := h.buildFilter(ctx)
+	filter.Pagination = filters.Pagination{
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	list, total, err := h.svc.GetListPage(ctx, filter)
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("获取接口权限分页列表异常 >>> %v", err.Error()))
+		zap.S().Error("获取接口权限分页列表异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", PermissionListPageResponse{
+		List:     list,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// GetListAll
+// @Summary 获取所有接口权限
+// @Description 获取所有接口权限列表
+// @Tags 系统管理/接口权限管理
+// @Accept application/json
+// @Produce application/json
+// @Param creator query string false "创建人"
+// @Param modifier query string false "修改人"
+// @Param status query bool false "状态" default(true)
+// @Param name query string false "权限名称"
+// @Param code query string false "权限编码"
+// @Param method query string false "请求方式"
+// @Param path query string false "接口路径"
+// @Success 200 {array} []domainSystem.Permission
+// @Failure 400 {object} response.Response
+// @Router /v1/system/permission/listAll [get]
+// @Security LoginToken
+func (h *permissionHandler) GetListAll(ctx *gin.Context) {
+	list, err := h.svc.GetListAll(ctx, h.buildFilter(ctx))
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("获取接口权限列表异常 >>> %v", err.Error()))
+		zap.S().Error("获取接口权限列表异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", list)
+}
+
+// GetRecycleListPage
+// @Summary 获取接口权限回收站分页列表
+// @Description 获取已删除的接口权限分页列表，按删除时间倒序
+// @Tags 系统管理/接口权限管理
+// @Accept application/json
+// @Produce application/json
+// @Param page query int true "页码" default(1)
+// @Param pageSize query int true "每页数量" default(10)
+// @Param name query string false "名称"
+// @Param deletedBy query string false "删除人"
+// @Success 200 {object} PermissionListPageResponse
+// @Failure 400 {object} response.Response
+// @Router /v1/system/permission/recycle/listPage [get]
+// @Security LoginToken
+func (h *permissionHandler) GetRecycleListPage(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("pageSize", "10"))
+
+	filter := domainSystem.RecycleFilter{
+		Pagination: filters.Pagination{
+			Page:     page,
+			PageSize: pageSize,
+		},
+		Name:      ctx.DefaultQuery("name", ""),
+		DeletedBy: ctx.DefaultQuery("deletedBy", ""),
+	}
+
+	list, total, err := h.svc.GetRecycleListPage(ctx, filter)
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("获取接口权限回收站分页列表异常 >>> %v", err.Error()))
+		zap.S().Error("获取接口权限回收站分页列表异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", PermissionListPageResponse{
+		List:     list,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// Restore
+// @Summary 恢复接口权限
+// @Description 从回收站恢复指定id接口权限，恢复前重新校验唯一性
+// @Tags 系统管理/接口权限管理
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/permission/recycle/restore/{id} [put]
+// @Security LoginToken
+func (h *permissionHandler) Restore(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "ID不能为空", nil)
+		return
+	}
+
+	if err := h.svc.Restore(ctx, id); err != nil {
+		switch {
+		case errors.Is(err, serviceSystem.ErrPermissionNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "回收站中不存在该接口权限", nil)
+		case errors.Is(err, serviceSystem.ErrPermissionDuplicate):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "权限编码或接口已存在", nil)
+		default:
+			ctx.Set("internalError", fmt.Sprintf("恢复接口权限异常 >>> %v", err.Error()))
+			zap.S().Error("恢复接口权限异常 >>> ", zap.Error(err))
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		}
+		return
+	}
+
+	response.NewResponse().Success(ctx, "恢复成功", nil)
+}
+
+// Purge
+// @Summary 彻底删除接口权限
+// @Description 彻底删除回收站中的接口权限，删除后不可恢复
+// @Tags 系统管理/接口权限管理
+// @Accept application/json
+// @Produce application/json
+// @Param ids body []string true "id数组"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/permission/recycle/purge [post]
+// @Security LoginToken
+func (h *permissionHandler) Purge(ctx *gin.Context) {
+	var ids []string
+	if err := ctx.ShouldBindJSON(&ids); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	if err := h.svc.Purge(ctx, ids); err != nil {
+		ctx.Set("internalError", fmt.Sprintf("彻底删除接口权限异常 >>> %v", err.Error()))
+		zap.S().Error("彻底删除接口权限异常 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "彻底删除成功", nil)
+}
+
+// buildFilter 构建查询条件
+func (h *permissionHandler) buildFilter(ctx *gin.Context) domainSystem.PermissionFilter {
+	status, _ := strconv.ParseBool(ctx.DefaultQuery("status", "true"))
+
+	return domainSystem.PermissionFilter{
+		Filters: filters.Filters{
+			Creator:  ctx.DefaultQuery("creator", ""),
+			Modifier: ctx.DefaultQuery("modifier", ""),
+		},
+		Status: status,
+		Name:   ctx.DefaultQuery("name", ""),
+		Code:   ctx.DefaultQuery("code", ""),
+		Method: ctx.DefaultQuery("method", ""),
+		Path:   ctx.DefaultQuery("path", ""),
+	}
+}
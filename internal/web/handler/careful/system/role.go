@@ -0,0 +1,825 @@
+/**
+ * Description：
+ * FileName：role.go
+ * Author：CJiaの用心
+ * Create：2025/10/27 15:02:37
+ * Remark：
+ */
+
+package system
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carefuly/careful-admin-go-gin/config"
+	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
+	modelSystem "github.com/carefuly/careful-admin-go-gin/internal/model/careful/system"
+	serviceSystem "github.com/carefuly/careful-admin-go-gin/internal/service/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/system/role"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/response"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/jwt"
+	"github.com/carefuly/careful-admin-go-gin/pkg/validate"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+)
+
+// CreateRoleRequest 创建
+type CreateRoleRequest struct {
+	Name      string              `json:"name" binding:"required,max=64"`                             // 角色名称
+	Code      string              `json:"code" binding:"required,max=64"`                             // 角色编码
+	DataScope role.DataScopeConst `json:"data_scope" binding:"omitempty,oneof=1 2 3 4 5" default:"1"` // 数据范围【1-全部 2-本部门 3-本部门及以下 4-自定义 5-仅本人】
+	Sort      int                 `json:"sort" binding:"omitempty" default:"1"`                       // 排序
+	Status    bool                `json:"status" binding:"omitempty" default:"true"`                  // 状态【true-启用 false-停用】
+	Remark    string              `json:"remark" binding:"omitempty,max=255"`                         // 备注
+}
+
+// UpdateRoleRequest 更新
+type UpdateRoleRequest struct {
+	Id        string              `json:"id" binding:"required"`                                      // 主键ID
+	Name      string              `json:"name" binding:"required,max=64"`                             // 角色名称
+	Code      string              `json:"code" binding:"required,max=64"`                             // 角色编码
+	DataScope role.DataScopeConst `json:"data_scope" binding:"omitempty,oneof=1 2 3 4 5" default:"1"` // 数据范围【1-全部 2-本部门 3-本部门及以下 4-自定义 5-仅本人】
+	Sort      int                 `json:"sort" binding:"omitempty" default:"1"`                       // 排序
+	Status    bool                `json:"status" binding:"omitempty" default:"true"`                  // 状态【true-启用 false-停用】
+	Timestamp int64               `json:"timestamp" binding:"omitempty"`                              // 版本
+	Remark    string              `json:"remark" binding:"omitempty,max=255"`                         // 备注
+}
+
+// AssignPermissionsRequest 分配角色接口权限
+type AssignPermissionsRequest struct {
+	RoleId        string   `json:"role_id" binding:"required"`         // 角色ID
+	PermissionIds []string `json:"permission_ids" binding:"omitempty"` // 接口权限ID，为空则清空
+}
+
+// AssignUserRolesRequest 分配用户角色
+type AssignUserRolesRequest struct {
+	UserId  string   `json:"user_id" binding:"required"`   // 用户ID
+	RoleIds []string `json:"role_ids" binding:"omitempty"` // 角色ID，为空则清空
+}
+
+// AssignMenusRequest 分配角色菜单
+type AssignMenusRequest struct {
+	RoleId  string   `json:"role_id" binding:"required"`   // 角色ID
+	MenuIds []string `json:"menu_ids" binding:"omitempty"` // 菜单ID，为空则清空
+}
+
+// AssignDeptsRequest 分配角色自定义数据范围部门
+type AssignDeptsRequest struct {
+	RoleId  string   `json:"role_id" binding:"required"`   // 角色ID
+	DeptIds []string `json:"dept_ids" binding:"omitempty"` // 部门ID，为空则清空
+}
+
+// RoleListPageResponse 列表分页响应
+type RoleListPageResponse struct {
+	List     []domainSystem.Role `json:"list"`     // 列表
+	Total    int64               `json:"total"`    // 总数
+	Page     int                 `json:"page"`     // 页码
+	PageSize int                 `json:"pageSize"` // 每页数量
+}
+
+type RoleHandler interface {
+	RegisterRoutes(router *gin.RouterGroup)
+	Create(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+	BatchDelete(ctx *gin.Context)
+	Update(ctx *gin.Context)
+	AssignPermissions(ctx *gin.Context)
+	AssignUserRoles(ctx *gin.Context)
+	AssignMenus(ctx *gin.Context)
+	AssignDepts(ctx *gin.Context)
+	GetById(ctx *gin.Context)
+	GetListPage(ctx *gin.Context)
+	GetListAll(ctx *gin.Context)
+	GetPermissionIds(ctx *gin.Context)
+	GetMenuIds(ctx *gin.Context)
+	GetDeptIds(ctx *gin.Context)
+	GetByUserId(ctx *gin.Context)
+	GetRecycleListPage(ctx *gin.Context)
+	Restore(ctx *gin.Context)
+	Purge(ctx *gin.Context)
+}
+
+type roleHandler struct {
+	rely    config.RelyConfig
+	svc     serviceSystem.RoleService
+	userSvc serviceSystem.UserService
+}
+
+func NewRoleHandler(rely config.RelyConfig, svc serviceSystem.RoleService, userSvc serviceSystem.UserService) RoleHandler {
+	return &roleHandler{
+		rely:    rely,
+		svc:     svc,
+		userSvc: userSvc,
+	}
+}
+
+// RegisterRoutes 注册路由
+func (h *roleHandler) RegisterRoutes(router *gin.RouterGroup) {
+	base := router.Group("/role")
+	base.POST("/create", h.Create)
+	base.DELETE("/delete/:id", h.Delete)
+	base.POST("/delete/batchDelete", h.BatchDelete)
+	base.PUT("/update", h.Update)
+	base.PUT("/assignPermissions", h.AssignPermissions)
+	base.PUT("/assignUserRoles", h.AssignUserRoles)
+	base.PUT("/assignMenus", h.AssignMenus)
+	base.PUT("/assignDepts", h.AssignDepts)
+	base.GET("/getById/:id", h.GetById)
+	base.GET("/listPage", h.GetListPage)
+	base.GET("/listAll", h.GetListAll)
+	base.GET("/getPermissionIds/:id", h.GetPermissionIds)
+	base.GET("/getMenuIds/:id", h.GetMenuIds)
+	base.GET("/getDeptIds/:id", h.GetDeptIds)
+	base.GET("/getByUserId/:userId", h.GetByUserId)
+	base.GET("/recycle/listPage", h.GetRecycleListPage)
+	base.PUT("/recycle/restore/:id", h.Restore)
+	base.POST("/recycle/purge", h.Purge)
+}
+
+// Create
+// @Summary 创建角色
+// @Description 创建角色
+// @Tags 系统管理/角色管理
+// @Accept application/json
+// @Produce application/json
+// @Param CreateRoleRequest body CreateRoleRequest true "请求"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/role/create [post]
+// @Security LoginToken
+func (h *roleHandler) Create(ctx *gin.Context) {
+	// 从上下文中获取登录信息
+	claims, ok := ctx.MustGet("claims").(*jwt.Claims)
+	if !ok {
+		zap.S().Error("未找到用户认证信息 >>> ", zap.Error(errors.New(claims.UserId)))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	user, err := h.userSvc.GetById(ctx, claims.UserId)
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("获取用户信息异常 >>> %v", err.Error()))
+		zap.S().Error("获取用户信息异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	var req CreateRoleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	// 转换为领域模型
+	domain := domainSystem.Role{
+		Role: modelSystem.Role{
+			CoreModels: models.CoreModels{
+				Sort:       req.Sort,
+				Creator:    user.Id,
+				Modifier:   user.Id,
+				BelongDept: user.DeptId,
+				Remark:     req.Remark,
+			},
+			Status:    req.Status,
+			Name:      req.Name,
+			Code:      req.Code,
+			DataScope: req.DataScope,
+		},
+	}
+
+	if err := h.svc.Create(ctx, domain); err != nil {
+		switch {
+		case errors.Is(err, serviceSystem.ErrRoleNameDuplicate):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "角色名称已存在", nil)
+			return
+		case errors.Is(err, serviceSystem.ErrRoleCodeDuplicate):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "角色编码已存在", nil)
+			return
+		default:
+			ctx.Set("internalError", fmt.Sprintf("创建角色异常 >>> %v", err.Error()))
+			zap.S().Error("创建角色异常 >>> ", zap.Error(err))
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+			return
+		}
+	}
+
+	response.NewResponse().Success(ctx, "新增成功", nil)
+}
+
+// Delete
+// @Summary 删除角色
+// @Description 删除指定id角色，移入回收站，用户及权限关联在彻底删除时解除
+// @Tags 系统管理/角色管理
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/role/delete/{id} [delete]
+// @Security LoginToken
+func (h *roleHandler) Delete(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" || len(id) == 0 {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "ID不能为空", nil)
+		return
+	}
+
+	if err := h.svc.Delete(ctx, id); err != nil {
+		ctx.Set("internalError", fmt.Sprintf("删除角色失败 >>> %v", err.Error()))
+		zap.S().Error("删除角色失败 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "删除成功", nil)
+}
+
+// BatchDelete
+// @Summary 批量删除角色
+// @Description 批量删除角色
+// @Tags 系统管理/角色管理
+// @Accept application/json
+// @Produce application/json
+// @Param ids body []string true "id数组"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/role/delete/batchDelete [post]
+// @Security LoginToken
+func (h *roleHandler) BatchDelete(ctx *gin.Context) {
+	var ids []string
+	if err := ctx.ShouldBindJSON(&ids); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	if err := h.svc.BatchDelete(ctx, ids); err != nil {
+		ctx.Set("internalError", fmt.Sprintf("批量删除角色异常 >>> %v", err.Error()))
+		zap.S().Error("批量删除角色异常 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "批量删除成功", nil)
+}
+
+// Update
+// @Summary 更新角色
+// @Description 更新角色信息
+// @Tags 系统管理/角色管理
+// @Accept application/json
+// @Produce application/json
+// @Param UpdateRoleRequest body UpdateRoleRequest true "请求"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/role/update [put]
+// @Security LoginToken
+func (h *roleHandler) Update(ctx *gin.Context) {
+	// 从上下文中获取登录信息
+	claims, ok := ctx.MustGet("claims").(*jwt.Claims)
+	if !ok {
+		zap.S().Error("未找到用户认证信息 >>> ", zap.Error(errors.New(claims.UserId)))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	var req UpdateRoleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	// 转换为领域模型
+	domain := domainSystem.Role{
+		Role: modelSystem.Role{
+			CoreModels: models.CoreModels{
+				Id:        req.Id,
+				Sort:      req.Sort,
+				Timestamp: req.Timestamp,
+				Modifier:  claims.UserId,
+				Remark:    req.Remark,
+			},
+			Status:    req.Status,
+			Name:      req.Name,
+			Code:      req.Code,
+			DataScope: req.DataScope,
+		},
+	}
+
+	if err := h.svc.Update(ctx, domain); err != nil {
+		switch {
+		case errors.Is(err, serviceSystem.ErrRoleNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "角色不存在", nil)
+			return
+		case errors.Is(err, serviceSystem.ErrRoleNameDuplicate):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "角色名称已存在", nil)
+			return
+		case errors.Is(err, serviceSystem.ErrRoleCodeDuplicate):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "角色编码已存在", nil)
+			return
+		case errors.Is(err, serviceSystem.ErrRoleVersionInconsistency):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "数据版本不一致，取消修改，请刷新后重试", nil)
+			return
+		default:
+			ctx.Set("internalError", fmt.Sprintf("更新角色失败 >>> %v", err.Error()))
+			zap.S().Error("更新角色失败 >>> ", err.Error())
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+			return
+		}
+	}
+
+	response.NewResponse().Success(ctx, "更新成功", nil)
+}
+
+// AssignPermissions
+// @Summary 分配角色接口权限
+// @Description 覆盖设置角色的接口权限，拥有该角色的用户权限缓存随之失效
+// @Tags 系统管理/角色管理
+// @Accept application/json
+// @Produce application/json
+// @Param AssignPermissionsRequest body AssignPermissionsRequest true "请求"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/role/assignPermissions [put]
+// @Security LoginToken
+func (h *roleHandler) AssignPermissions(ctx *gin.Context) {
+	var req AssignPermissionsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	if err := h.svc.AssignPermissions(ctx, req.RoleId, req.PermissionIds); err != nil {
+		switch {
+		case errors.Is(err, serviceSystem.ErrRoleNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "角色不存在", nil)
+			return
+		case errors.Is(err, serviceSystem.ErrRolePermissionNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "接口权限不存在", nil)
+			return
+		default:
+			ctx.Set("internalError", fmt.Sprintf("分配角色接口权限失败 >>> %v", err.Error()))
+			zap.S().Error("分配角色接口权限失败 >>> ", err.Error())
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+			return
+		}
+	}
+
+	response.NewResponse().Success(ctx, "分配成功", nil)
+}
+
+// AssignUserRoles
+// @Summary 分配用户角色
+// @Description 覆盖设置用户的角色，用户权限缓存随之失效
+// @Tags 系统管理/角色管理
+// @Accept application/json
+// @Produce application/json
+// @Param AssignUserRolesRequest body AssignUserRolesRequest true "请求"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/role/assignUserRoles [put]
+// @Security LoginToken
+func (h *roleHandler) AssignUserRoles(ctx *gin.Context) {
+	var req AssignUserRolesRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	if err := h.svc.AssignUserRoles(ctx, req.UserId, req.RoleIds); err != nil {
+		switch {
+		case errors.Is(err, serviceSystem.ErrRoleUserNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "用户不存在", nil)
+			return
+		case errors.Is(err, serviceSystem.ErrRoleNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "角色不存在", nil)
+			return
+		default:
+			ctx.Set("internalError", fmt.Sprintf("分配用户角色失败 >>> %v", err.Error()))
+			zap.S().Error("分配用户角色失败 >>> ", err.Error())
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+			return
+		}
+	}
+
+	response.NewResponse().Success(ctx, "分配成功", nil)
+}
+
+// AssignMenus
+// @Summary 分配角色菜单
+// @Description 覆盖设置角色可见的目录、菜单与按钮，需包含授权节点本身，上级目录会自动补全
+// @Tags 系统管理/角色管理
+// @Accept application/json
+// @Produce application/json
+// @Param AssignMenusRequest body AssignMenusRequest true "请求"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/role/assignMenus [put]
+// @Security LoginToken
+func (h *roleHandler) AssignMenus(ctx *gin.Context) {
+	var req AssignMenusRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	if err := h.svc.AssignMenus(ctx, req.RoleId, req.MenuIds); err != nil {
+		switch {
+		case errors.Is(err, serviceSystem.ErrRoleNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "角色不存在", nil)
+			return
+		case errors.Is(err, serviceSystem.ErrRoleMenuNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "菜单不存在", nil)
+			return
+		default:
+			ctx.Set("internalError", fmt.Sprintf("分配角色菜单失败 >>> %v", err.Error()))
+			zap.S().Error("分配角色菜单失败 >>> ", err.Error())
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+			return
+		}
+	}
+
+	response.NewResponse().Success(ctx, "分配成功", nil)
+}
+
+// AssignDepts
+// @Summary 分配角色数据范围部门
+// @Description 覆盖设置角色自定义数据范围的部门，仅数据范围为自定义时生效
+// @Tags 系统管理/角色管理
+// @Accept application/json
+// @Produce application/json
+// @Param AssignDeptsRequest body AssignDeptsRequest true "请求"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/role/assignDepts [put]
+// @Security LoginToken
+func (h *roleHandler) AssignDepts(ctx *gin.Context) {
+	var req AssignDeptsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	if err := h.svc.AssignDepts(ctx, req.RoleId, req.DeptIds); err != nil {
+		switch {
+		case errors.Is(err, serviceSystem.ErrRoleNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "角色不存在", nil)
+			return
+		case errors.Is(err, serviceSystem.ErrRoleDeptNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "部门不存在", nil)
+			return
+		default:
+			ctx.Set("internalError", fmt.Sprintf("分配角色数据范围失败 >>> %v", err.Error()))
+			zap.S().Error("分配角色数据范围失败 >>> ", err.Error())
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+			return
+		}
+	}
+
+	response.NewResponse().Success(ctx, "分配成功", nil)
+}
+
+// GetById
+// @Summary 获取角色
+// @Description 获取指定id角色信息
+// @Tags 系统管理/角色管理
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Success 200 {object} domainSystem.Role
+// @Failure 400 {object} response.Response
+// @Router /v1/system/role/getById/{id} [get]
+// @Security LoginToken
+func (h *roleHandler) GetById(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" || len(id) == 0 {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "id不能为空", nil)
+		return
+	}
+
+	detail, err := h.svc.GetById(ctx, id)
+	if err != nil {
+		if errors.Is(err, serviceSystem.ErrRoleNotFound) {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "角色不存在", nil)
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("获取角色失败 >>> %v", err.Error()))
+		zap.S().Error("获取角色失败 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "获取成功", detail)
+}
+
+// GetListPage
+// @Summary 获取角色分页列表
+// @Description 获取角色分页列表
+// @Tags 系统管理/角色管理
+// @Accept application/json
+// @Produce application/json
+// @Param page query int true "页码" default(1)
+// @Param pageSize query int true "每页数量" default(10)
+// @Param creator query string false "创建人"
+// @Param modifier query string false "修改人"
+// @Param status query bool false "状态" default(true)
+// @Param name query string false "角色名称"
+// @Param code query string false "角色编码"
+// @Success 200 {object} RoleListPageResponse
+// @Failure 400 {object} response.Response
+// @Router /v1/system/role/listPage [get]
+// @Security LoginToken
+func (h *roleHandler) GetListPage(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("pageSize", "10"))
+
+	filter := h.buildFilter(ctx)
+	filter.Pagination = filters.Pagination{
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	list, total, err := h.svc.GetListPage(ctx, filter)
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("获取角色分页列表异常 >>> %v", err.Error()))
+		zap.S().Error("获取角色分页列表异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", RoleListPageResponse{
+		List:     list,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// GetListAll
+// @Summary 获取所有角色
+// @Description 获取所有角色列表
+// @Tags 系统管理/角色管理
+// @Accept application/json
+// @Produce application/json
+// @Param creator query string false "创建人"
+// @Param modifier query string false "修改人"
+// @Param status query bool false "状态" default(true)
+// @Param name query string false "角色名称"
+// @Param code query string false "角色编码"
+// @Success 200 {array} []domainSystem.Role
+// @Failure 400 {object} response.Response
+// @Router /v1/system/role/listAll [get]
+// @Security LoginToken
+func (h *roleHandler) GetListAll(ctx *gin.Context) {
+	list, err := h.svc.GetListAll(ctx, h.buildFilter(ctx))
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("获取角色列表异常 >>> %v", err.Error()))
+		zap.S().Error("获取角色列表异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", list)
+}
+
+// GetPermissionIds
+// @Summary 获取角色接口权限
+// @Description 获取指定id角色已分配的接口权限ID
+// @Tags 系统管理/角色管理
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Success 200 {array} string
+// @Failure 400 {object} response.Response
+// @Router /v1/system/role/getPermissionIds/{id} [get]
+// @Security LoginToken
+func (h *roleHandler) GetPermissionIds(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" || len(id) == 0 {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "id不能为空", nil)
+		return
+	}
+
+	ids, err := h.svc.GetPermissionIds(ctx, id)
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("获取角色接口权限异常 >>> %v", err.Error()))
+		zap.S().Error("获取角色接口权限异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", ids)
+}
+
+// GetMenuIds
+// @Summary 获取角色菜单
+// @Description 获取指定id角色已分配的菜单ID
+// @Tags 系统管理/角色管理
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Success 200 {array} string
+// @Failure 400 {object} response.Response
+// @Router /v1/system/role/getMenuIds/{id} [get]
+// @Security LoginToken
+func (h *roleHandler) GetMenuIds(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" || len(id) == 0 {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "id不能为空", nil)
+		return
+	}
+
+	ids, err := h.svc.GetMenuIds(ctx, id)
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("获取角色菜单异常 >>> %v", err.Error()))
+		zap.S().Error("获取角色菜单异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", ids)
+}
+
+// GetDeptIds
+// @Summary 获取角色数据范围部门
+// @Description 获取指定id角色自定义数据范围的部门ID
+// @Tags 系统管理/角色管理
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Success 200 {array} string
+// @Failure 400 {object} response.Response
+// @Router /v1/system/role/getDeptIds/{id} [get]
+// @Security LoginToken
+func (h *roleHandler) GetDeptIds(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" || len(id) == 0 {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "id不能为空", nil)
+		return
+	}
+
+	ids, err := h.svc.GetDeptIds(ctx, id)
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("获取角色数据范围异常 >>> %v", err.Error()))
+		zap.S().Error("获取角色数据范围异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", ids)
+}
+
+// GetByUserId
+// @Summary 获取用户角色
+// @Description 获取指定用户已分配的角色
+// @Tags 系统管理/角色管理
+// @Accept application/json
+// @Produce application/json
+// @Param userId path string true "用户ID"
+// @Success 200 {array} []domainSystem.Role
+// @Failure 400 {object} response.Response
+// @Router /v1/system/role/getByUserId/{userId} [get]
+// @Security LoginToken
+func (h *roleHandler) GetByUserId(ctx *gin.Context) {
+	userId := ctx.Param("userId")
+	if userId == "" || len(userId) == 0 {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "用户ID不能为空", nil)
+		return
+	}
+
+	list, err := h.svc.GetByUserId(ctx, userId)
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("获取用户角色异常 >>> %v", err.Error()))
+		zap.S().Error("获取用户角色异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", list)
+}
+
+// GetRecycleListPage
+// @Summary 获取角色回收站分页列表
+// @Description 获取已删除的角色分页列表，按删除时间倒序
+// @Tags 系统管理/角色管理
+// @Accept application/json
+// @Produce application/json
+// @Param page query int true "页码" default(1)
+// @Param pageSize query int true "每页数量" default(10)
+// @Param name query string false "名称"
+// @Param deletedBy query string false "删除人"
+// @Success 200 {object} RoleListPageResponse
+// @Failure 400 {object} response.Response
+// @Router /v1/system/role/recycle/listPage [get]
+// @Security LoginToken
+func (h *roleHandler) GetRecycleListPage(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("pageSize", "10"))
+
+	filter := domainSystem.RecycleFilter{
+		Pagination: filters.Pagination{
+			Page:     page,
+			PageSize: pageSize,
+		},
+		Name:      ctx.DefaultQuery("name", ""),
+		DeletedBy: ctx.DefaultQuery("deletedBy", ""),
+	}
+
+	list, total, err := h.svc.GetRecycleListPage(ctx, filter)
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("获取角色回收站分页列表异常 >>> %v", err.Error()))
+		zap.S().Error("获取角色回收站分页列表异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", RoleListPageResponse{
+		List:     list,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// Restore
+// @Summary 恢复角色
+// @Description 从回收站恢复指定id角色及其权限、菜单与数据范围的分配，恢复前重新校验唯一性
+// @Tags 系统管理/角色管理
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/role/recycle/restore/{id} [put]
+// @Security LoginToken
+func (h *roleHandler) Restore(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "ID不能为空", nil)
+		return
+	}
+
+	if err := h.svc.Restore(ctx, id); err != nil {
+		switch {
+		case errors.Is(err, serviceSystem.ErrRoleNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "回收站中不存在该角色", nil)
+		case errors.Is(err, serviceSystem.ErrRoleNameDuplicate):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "角色名称已存在", nil)
+		case errors.Is(err, serviceSystem.ErrRoleCodeDuplicate):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "角色编码已存在", nil)
+		default:
+			ctx.Set("internalError", fmt.Sprintf("恢复角色异常 >>> %v", err.Error()))
+			zap.S().Error("恢复角色异常 >>> ", zap.Error(err))
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		}
+		return
+	}
+
+	response.NewResponse().Success(ctx, "恢复成功", nil)
+}
+
+// Purge
+// @Summary 彻底删除角色
+// @Description 彻底删除回收站中的角色，删除后不可恢复
+// @Tags 系统管理/角色管理
+// @Accept application/json
+// @Produce application/json
+// @Param ids body []string true "id数组"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/role/recycle/purge [post]
+// @Security LoginToken
+func (h *roleHandler) Purge(ctx *gin.Context) {
+	var ids []string
+	if err := ctx.ShouldBindJSON(&ids); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	if err := h.svc.Purge(ctx, ids); err != nil {
+		ctx.Set("internalError", fmt.Sprintf("彻底删除角色异常 >>> %v", err.Error()))
+		zap.S().Error("彻底删除角色异常 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "彻底删除成功", nil)
+}
+
+// buildFilter 构建查询条件
+func (h *roleHandler) buildFilter(ctx *gin.Context) domainSystem.RoleFilter {
+	status, _ := strconv.ParseBool(ctx.DefaultQuery("status", "true"))
+
+	return domainSystem.RoleFilter{
+		Filters: filters.Filters{
+			Creator:  ctx.DefaultQuery("creator", ""),
+			Modifier: ctx.DefaultQuery("modifier", ""),
+		},
+		Status: status,
+		Name:   ctx.DefaultQuery("name", ""),
+		Code:   ctx.DefaultQuery("code", ""),
+	}
+}
@@ -0,0 +1,114 @@
+/**
+ * Description：
+ * FileName：signing_key.go
+ * Author：CJiaの用心
+ * Create：2025/11/3 14:42:18
+ * Remark：
+ */
+
+package system
+
+import (
+	"fmt"
+	"github.com/carefuly/careful-admin-go-gin/config"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/response"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/jwt/keymanager"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+// SigningKeyResponse 令牌签名密钥信息
+type SigningKeyResponse struct {
+	Algorithm string   `json:"algorithm"` // 签名算法
+	ActiveKid string   `json:"activeKid"` // 当前签名密钥ID
+	Kids      []string `json:"kids"`      // 已加载的密钥ID
+}
+
+type SigningKeyHandler interface {
+	RegisterRoutes(router *gin.RouterGroup)
+	GetInfo(ctx *gin.Context)
+	Rotate(ctx *gin.Context)
+}
+
+type signingKeyHandler struct {
+	rely config.RelyConfig
+}
+
+func NewSigningKeyHandler(rely config.RelyConfig) SigningKeyHandler {
+	return &signingKeyHandler{
+		rely: rely,
+	}
+}
+
+// RegisterRoutes 注册路由
+func (h *signingKeyHandler) RegisterRoutes(router *gin.RouterGroup) {
+	base := router.Group("/signingKey")
+	base.GET("/info", h.GetInfo)
+	base.POST("/rotate", h.Rotate)
+}
+
+// GetInfo
+// @Summary 获取令牌签名密钥信息
+// @Description 获取当前签名算法、签名密钥ID及已加载的验签密钥ID
+// @Tags 系统管理/签名密钥
+// @Accept application/json
+// @Produce application/json
+// @Success 200 {object} SigningKeyResponse
+// @Failure 400 {object} response.Response
+// @Router /v1/system/signingKey/info [get]
+// @Security LoginToken
+func (h *signingKeyHandler) GetInfo(ctx *gin.Context) {
+	rotator, ok := h.rely.Keys.(keymanager.Rotator)
+	if !ok {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "未启用签名密钥管理", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", h.info(rotator))
+}
+
+// Rotate
+// @Summary 轮换令牌签名密钥
+// @Description 生成新密钥并设为当前签名密钥，旧密钥保留用于验签直至移除，仅支持RS256/EdDSA
+// @Tags 系统管理/签名密钥
+// @Accept application/json
+// @Produce application/json
+// @Success 200 {object} SigningKeyResponse
+// @Failure 400 {object} response.Response
+// @Router /v1/system/signingKey/rotate [post]
+// @Security LoginToken
+func (h *signingKeyHandler) Rotate(ctx *gin.Context) {
+	rotator, ok := h.rely.Keys.(keymanager.Rotator)
+	if !ok {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "未启用签名密钥管理", nil)
+		return
+	}
+	if rotator.Algorithm() == keymanager.AlgorithmHS512 {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "HS512 共享密钥不支持轮换，请修改配置中的密钥", nil)
+		return
+	}
+
+	key, err := rotator.Rotate()
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("轮换签名密钥异常 >>> %v", err.Error()))
+		zap.S().Error("轮换签名密钥异常 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+	zap.L().Info("令牌签名密钥已轮换", zap.String("kid", key.Kid), zap.String("operator", ctx.GetString("username")))
+
+	response.NewResponse().Success(ctx, "轮换成功", h.info(rotator))
+}
+
+// info 组装密钥信息
+func (h *signingKeyHandler) info(rotator keymanager.Rotator) SigningKeyResponse {
+	resp := SigningKeyResponse{
+		Algorithm: rotator.Algorithm(),
+		Kids:      rotator.Kids(),
+	}
+	if key, err := rotator.SigningKey(); err == nil {
+		resp.ActiveKid = key.Kid
+	}
+	return resp
+}
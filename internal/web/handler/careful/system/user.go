@@ -0,0 +1,686 @@
+/**
+ * Description：
+ * FileName：user.go
+ * Author：CJiaの用心
+ * Create：2025/10/25 10:12:36
+ * Remark：
+ */
+
+package system
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carefuly/careful-admin-go-gin/config"
+	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
+	modelSystem "github.com/carefuly/careful-admin-go-gin/internal/model/careful/system"
+	serviceSystem "github.com/carefuly/careful-admin-go-gin/internal/service/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/system/user"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/response"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/jwt"
+	"github.com/carefuly/careful-admin-go-gin/pkg/validate"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+)
+
+// CreateUserRequest 创建
+type CreateUserRequest struct {
+	Username string           `json:"username" binding:"required,min=4,max=50"`  // 用户名
+	Password string           `json:"password" binding:"required,min=6,max=64"`  // 密码
+	Name     string           `json:"name" binding:"omitempty,max=50"`           // 姓名
+	Gender   user.GenderConst `json:"gender" binding:"omitempty,oneof=1 2 3"`    // 性别
+	Email    string           `json:"email" binding:"omitempty,email,max=50"`    // 邮箱
+	Mobile   string           `json:"mobile" binding:"omitempty,max=20"`         // 电话
+	Avatar   string           `json:"avatar" binding:"omitempty"`                // 头像
+	DeptId   string           `json:"dept_id" binding:"omitempty,max=100"`       // 部门ID
+	Sort     int              `json:"sort" binding:"omitempty" default:"1"`      // 排序
+	Status   bool             `json:"status" binding:"omitempty" default:"true"` // 状态【true-启用 false-停用】
+	Remark   string           `json:"remark" binding:"omitempty,max=255"`        // 备注
+}
+
+// UpdateUserRequest 更新
+type UpdateUserRequest struct {
+	Id        string           `json:"id" binding:"required"`                     // 主键ID
+	Name      string           `json:"name" binding:"omitempty,max=50"`           // 姓名
+	Gender    user.GenderConst `json:"gender" binding:"omitempty,oneof=1 2 3"`    // 性别
+	Email     string           `json:"email" binding:"omitempty,email,max=50"`    // 邮箱
+	Mobile    string           `json:"mobile" binding:"omitempty,max=20"`         // 电话
+	Avatar    string           `json:"avatar" binding:"omitempty"`                // 头像
+	DeptId    string           `json:"dept_id" binding:"omitempty,max=100"`       // 部门ID
+	Sort      int              `json:"sort" binding:"omitempty" default:"1"`      // 排序
+	Status    bool             `json:"status" binding:"omitempty" default:"true"` // 状态【true-启用 false-停用】
+	Timestamp int64            `json:"timestamp" binding:"omitempty"`             // 版本
+	Remark    string           `json:"remark" binding:"omitempty,max=255"`        // 备注
+}
+
+// UpdateUserStatusRequest 启用/停用
+type UpdateUserStatusRequest struct {
+	Id     string `json:"id" binding:"required"`      // 主键ID
+	Status bool   `json:"status" binding:"omitempty"` // 状态【true-启用 false-停用】
+}
+
+// ResetUserPasswordRequest 重置密码
+type ResetUserPasswordRequest struct {
+	Id       string `json:"id" binding:"required"`                    // 主键ID
+	Password string `json:"password" binding:"required,min=6,max=64"` // 新密码
+}
+
+// UserListPageResponse 列表分页响应
+type UserListPageResponse struct {
+	List     []domainSystem.User `json:"list"`     // 列表
+	Total    int64               `json:"total"`    // 总数
+	Page     int                 `json:"page"`     // 页码
+	PageSize int                 `json:"pageSize"` // 每页数量
+}
+
+type UserHandler interface {
+	RegisterRoutes(router *gin.RouterGroup)
+	Create(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+	BatchDelete(ctx *gin.Context)
+	Update(ctx *gin.Context)
+	UpdateStatus(ctx *gin.Context)
+	ResetPassword(ctx *gin.Context)
+	GetById(ctx *gin.Context)
+	GetListPage(ctx *gin.Context)
+	GetListAll(ctx *gin.Context)
+	GetRecycleListPage(ctx *gin.Context)
+	Restore(ctx *gin.Context)
+	Purge(ctx *gin.Context)
+}
+
+type userHandler struct {
+	rely config.RelyConfig
+	svc  serviceSystem.UserService
+}
+
+func NewUserHandler(rely config.RelyConfig, svc serviceSystem.UserService) UserHandler {
+	return &userHandler{
+		rely: rely,
+		svc:  svc,
+	}
+}
+
+// RegisterRoutes 注册路由
+func (h *userHandler) RegisterRoutes(router *gin.RouterGroup) {
+	base := router.Group("/user")
+	base.POST("/create", h.Create)
+	base.DELETE("/delete/:id", h.Delete)
+	base.POST("/delete/batchDelete", h.BatchDelete)
+	base.PUT("/update", h.Update)
+	base.PUT("/updateStatus", h.UpdateStatus)
+	base.PUT("/resetPassword", h.ResetPassword)
+	base.GET("/getById/:id", h.GetById)
+	base.GET("/listPage", h.GetListPage)
+	base.GET("/listAll", h.GetListAll)
+	base.GET("/recycle/listPage", h.GetRecycleListPage)
+	base.PUT("/recycle/restore/:id", h.Restore)
+	base.POST("/recycle/purge", h.Purge)
+}
+
+// Create
+// @Summary 创建用户
+// @Description 创建用户
+// @Tags 系统管理/用户管理
+// @Accept application/json
+// @Produce application/json
+// @Param CreateUserRequest body CreateUserRequest true "请求"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/user/create [post]
+// @Security LoginToken
+func (h *userHandler) Create(ctx *gin.Context) {
+	// 从上下文中获取登录信息
+	claims, ok := ctx.MustGet("claims").(*jwt.Claims)
+	if !ok {
+		zap.S().Error("未找到用户认证信息 >>> ", zap.Error(errors.New(claims.UserId)))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	operator, err := h.svc.GetById(ctx, claims.UserId)
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("获取用户信息异常 >>> %v", err.Error()))
+		zap.S().Error("获取用户信息异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	var req CreateUserRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	if req.Gender == 0 {
+		req.Gender = user.GenderConstMale
+	}
+
+	// 转换为领域模型
+	domain := domainSystem.User{
+		User: modelSystem.User{
+			CoreModels: models.CoreModels{
+				Sort:       req.Sort,
+				Creator:    operator.Id,
+				Modifier:   operator.Id,
+				BelongDept: operator.DeptId,
+				Remark:     req.Remark,
+			},
+			Status:   req.Status,
+			Username: req.Username,
+			Password: req.Password,
+			Name:     req.Name,
+			Gender:   req.Gender,
+			Email:    req.Email,
+			Mobile:   req.Mobile,
+			Avatar:   req.Avatar,
+		},
+		DeptId: req.DeptId,
+	}
+
+	if err := h.svc.Create(ctx, domain); err != nil {
+		switch {
+		case errors.Is(err, serviceSystem.ErrUserUsernameDuplicate):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "用户名已存在", nil)
+			return
+		case errors.Is(err, serviceSystem.ErrUserDeptNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "部门不存在", nil)
+			return
+		default:
+			ctx.Set("internalError", fmt.Sprintf("创建用户异常 >>> %v", err.Error()))
+			zap.S().Error("创建用户异常 >>> ", zap.Error(err))
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+			return
+		}
+	}
+
+	response.NewResponse().Success(ctx, "新增成功", nil)
+}
+
+// Delete
+// @Summary 删除用户
+// @Description 删除指定id用户
+// @Tags 系统管理/用户管理
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/user/delete/{id} [delete]
+// @Security LoginToken
+func (h *userHandler) Delete(ctx *gin.Context) {
+	// 从上下文中获取登录信息
+	claims, ok := ctx.MustGet("claims").(*jwt.Claims)
+	if !ok {
+		zap.S().Error("未找到用户认证信息 >>> ", zap.Error(errors.New(claims.UserId)))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	id := ctx.Param("id")
+	if id == "" || len(id) == 0 {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "ID不能为空", nil)
+		return
+	}
+
+	if err := h.svc.Delete(ctx, claims.UserId, id); err != nil {
+		switch {
+		case errors.Is(err, serviceSystem.ErrUserNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "用户不存在", nil)
+			return
+		case errors.Is(err, serviceSystem.ErrUserOperateSelf):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "不能删除当前登录用户", nil)
+			return
+		default:
+			ctx.Set("internalError", fmt.Sprintf("删除用户失败 >>> %v", err.Error()))
+			zap.S().Error("删除用户失败 >>> ", zap.Error(err))
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+			return
+		}
+	}
+
+	response.NewResponse().Success(ctx, "删除成功", nil)
+}
+
+// BatchDelete
+// @Summary 批量删除用户
+// @Description 批量删除用户
+// @Tags 系统管理/用户管理
+// @Accept application/json
+// @Produce application/json
+// @Param ids body []string true "id数组"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/user/delete/batchDelete [post]
+// @Security LoginToken
+func (h *userHandler) BatchDelete(ctx *gin.Context) {
+	// 从上下文中获取登录信息
+	claims, ok := ctx.MustGet("claims").(*jwt.Claims)
+	if !ok {
+		zap.S().Error("未找到用户认证信息 >>> ", zap.Error(errors.New(claims.UserId)))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	var ids []string
+	if err := ctx.ShouldBindJSON(&ids); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	if err := h.svc.BatchDelete(ctx, claims.UserId, ids); err != nil {
+		if errors.Is(err, serviceSystem.ErrUserOperateSelf) {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "不能删除当前登录用户", nil)
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("批量删除用户异常 >>> %v", err.Error()))
+		zap.S().Error("批量删除用户异常 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "批量删除成功", nil)
+}
+
+// Update
+// @Summary 更新用户
+// @Description 更新用户信息
+// @Tags 系统管理/用户管理
+// @Accept application/json
+// @Produce application/json
+// @Param UpdateUserRequest body UpdateUserRequest true "请求"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/user/update [put]
+// @Security LoginToken
+func (h *userHandler) Update(ctx *gin.Context) {
+	// 从上下文中获取登录信息
+	claims, ok := ctx.MustGet("claims").(*jwt.Claims)
+	if !ok {
+		zap.S().Error("未找到用户认证信息 >>> ", zap.Error(errors.New(claims.UserId)))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	var req UpdateUserRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	if req.Id == claims.UserId && !req.Status {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "不能停用当前登录用户", nil)
+		return
+	}
+
+	if req.Gender == 0 {
+		req.Gender = user.GenderConstMale
+	}
+
+	// 转换为领域模型
+	domain := domainSystem.User{
+		User: modelSystem.User{
+			CoreModels: models.CoreModels{
+				Id:        req.Id,
+				Sort:      req.Sort,
+				Timestamp: req.Timestamp,
+				Modifier:  claims.UserId,
+				Remark:    req.Remark,
+			},
+			Status: req.Status,
+			Name:   req.Name,
+			Gender: req.Gender,
+			Email:  req.Email,
+			Mobile: req.Mobile,
+			Avatar: req.Avatar,
+		},
+		DeptId: req.DeptId,
+	}
+
+	if err := h.svc.Update(ctx, domain); err != nil {
+		switch {
+		case errors.Is(err, serviceSystem.ErrUserNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "用户不存在", nil)
+			return
+		case errors.Is(err, serviceSystem.ErrUserDeptNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "部门不存在", nil)
+			return
+		case errors.Is(err, serviceSystem.ErrUserVersionInconsistency):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "数据版本不一致，取消修改，请刷新后重试", nil)
+			return
+		default:
+			ctx.Set("internalError", fmt.Sprintf("更新用户失败 >>> %v", err.Error()))
+			zap.S().Error("更新用户失败 >>> ", err.Error())
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+			return
+		}
+	}
+
+	response.NewResponse().Success(ctx, "更新成功", nil)
+}
+
+// UpdateStatus
+// @Summary 启用/停用用户
+// @Description 启用或停用指定用户
+// @Tags 系统管理/用户管理
+// @Accept application/json
+// @Produce application/json
+// @Param UpdateUserStatusRequest body UpdateUserStatusRequest true "请求"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/user/updateStatus [put]
+// @Security LoginToken
+func (h *userHandler) UpdateStatus(ctx *gin.Context) {
+	// 从上下文中获取登录信息
+	claims, ok := ctx.MustGet("claims").(*jwt.Claims)
+	if !ok {
+		zap.S().Error("未找到用户认证信息 >>> ", zap.Error(errors.New(claims.UserId)))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	var req UpdateUserStatusRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	if err := h.svc.UpdateStatus(ctx, claims.UserId, req.Id, req.Status); err != nil {
+		switch {
+		case errors.Is(err, serviceSystem.ErrUserNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "用户不存在", nil)
+			return
+		case errors.Is(err, serviceSystem.ErrUserOperateSelf):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "不能停用当前登录用户", nil)
+			return
+		default:
+			ctx.Set("internalError", fmt.Sprintf("更新用户状态失败 >>> %v", err.Error()))
+			zap.S().Error("更新用户状态失败 >>> ", err.Error())
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+			return
+		}
+	}
+
+	response.NewResponse().Success(ctx, "更新成功", nil)
+}
+
+// ResetPassword
+// @Summary 重置用户密码
+// @Description 管理员重置指定用户密码
+// @Tags 系统管理/用户管理
+// @Accept application/json
+// @Produce application/json
+// @Param ResetUserPasswordRequest body ResetUserPasswordRequest true "请求"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/user/resetPassword [put]
+// @Security LoginToken
+func (h *userHandler) ResetPassword(ctx *gin.Context) {
+	// 从上下文中获取登录信息
+	claims, ok := ctx.MustGet("claims").(*jwt.Claims)
+	if !ok {
+		zap.S().Error("未找到用户认证信息 >>> ", zap.Error(errors.New(claims.UserId)))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	var req ResetUserPasswordRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	if err := h.svc.ResetPassword(ctx, claims.UserId, req.Id, req.Password); err != nil {
+		if errors.Is(err, serviceSystem.ErrUserNotFound) {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "用户不存在", nil)
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("重置用户密码失败 >>> %v", err.Error()))
+		zap.S().Error("重置用户密码失败 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "重置成功", nil)
+}
+
+// GetById
+// @Summary 获取用户
+// @Description 获取指定id用户信息
+// @Tags 系统管理/用户管理
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Success 200 {object} domainSystem.User
+// @Failure 400 {object} response.Response
+// @Router /v1/system/user/getById/{id} [get]
+// @Security LoginToken
+func (h *userHandler) GetById(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" || len(id) == 0 {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "id不能为空", nil)
+		return
+	}
+
+	detail, err := h.svc.GetById(ctx, id)
+	if err != nil {
+		if errors.Is(err, serviceSystem.ErrUserNotFound) {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "用户不存在", nil)
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("获取用户失败 >>> %v", err.Error()))
+		zap.S().Error("获取用户失败 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "获取成功", detail)
+}
+
+// GetListPage
+// @Summary 获取用户分页列表
+// @Description 获取用户分页列表
+// @Tags 系统管理/用户管理
+// @Accept application/json
+// @Produce application/json
+// @Param page query int true "页码" default(1)
+// @Param pageSize query int true "每页数量" default(10)
+// @Param creator query string false "创建人"
+// @Param modifier query string false "修改人"
+// @Param status query bool false "状态" default(true)
+// @Param username query string false "用户名"
+// @Param name query string false "姓名"
+// @Param gender query int false "性别" default(0)
+// @Param email query string false "邮箱"
+// @Param mobile query string false "电话"
+// @Param dept_id query string false "部门ID"
+// @Success 200 {object} UserListPageResponse
+// @Failure 400 {object} response.Response
+// @Router /v1/system/user/listPage [get]
+// @Security LoginToken
+func (h *userHandler) GetListPage(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("pageSize", "10"))
+
+	filter := h.buildFilter(ctx)
+	filter.Pagination = filters.Pagination{
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	list, total, err := h.svc.GetListPage(ctx, filter)
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("获取用户分页列表异常 >>> %v", err.Error()))
+		zap.S().Error("获取用户分页列表异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", UserListPageResponse{
+		List:     list,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// GetListAll
+// @Summary 获取所有用户
+// @Description 获取所有用户列表
+// @Tags 系统管理/用户管理
+// @Accept application/json
+// @Produce application/json
+// @Param creator query string false "创建人"
+// @Param modifier query string false "修改人"
+// @Param status query bool false "状态" default(true)
+// @Param username query string false "用户名"
+// @Param name query string false "姓名"
+// @Param gender query int false "性别" default(0)
+// @Param email query string false "邮箱"
+// @Param mobile query string false "电话"
+// @Param dept_id query string false "部门ID"
+// @Success 200 {array} []domainSystem.User
+// @Failure 400 {object} response.Response
+// @Router /v1/system/user/listAll [get]
+// @Security LoginToken
+func (h *userHandler) GetListAll(ctx *gin.Context) {
+	list, err := h.svc.GetListAll(ctx, h.buildFilter(ctx))
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("获取用户列表异常 >>> %v", err.Error()))
+		zap.S().Error("获取用户列表异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", list)
+}
+
+// GetRecycleListPage
+// @Summary 获取用户回收站分页列表
+// @Description 获取已删除的用户分页列表，按删除时间倒序
+// @Tags 系统管理/用户管理
+// @Accept application/json
+// @Produce application/json
+// @Param page query int true "页码" default(1)
+// @Param pageSize query int true "每页数量" default(10)
+// @Param name query string false "用户名或姓名"
+// @Param deletedBy query string false "删除人"
+// @Success 200 {object} UserListPageResponse
+// @Failure 400 {object} response.Response
+// @Router /v1/system/user/recycle/listPage [get]
+// @Security LoginToken
+func (h *userHandler) GetRecycleListPage(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("pageSize", "10"))
+
+	filter := domainSystem.RecycleFilter{
+		Pagination: filters.Pagination{
+			Page:     page,
+			PageSize: pageSize,
+		},
+		Name:      ctx.DefaultQuery("name", ""),
+		DeletedBy: ctx.DefaultQuery("deletedBy", ""),
+	}
+
+	list, total, err := h.svc.GetRecycleListPage(ctx, filter)
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("获取用户回收站分页列表异常 >>> %v", err.Error()))
+		zap.S().Error("获取用户回收站分页列表异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", UserListPageResponse{
+		List:     list,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// Restore
+// @Summary 恢复用户
+// @Description 从回收站恢复指定id用户，用户名不能与现有用户重复，原部门已删除时不再关联部门
+// @Tags 系统管理/用户管理
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/user/recycle/restore/{id} [put]
+// @Security LoginToken
+func (h *userHandler) Restore(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "ID不能为空", nil)
+		return
+	}
+
+	if err := h.svc.Restore(ctx, id); err != nil {
+		switch {
+		case errors.Is(err, serviceSystem.ErrUserNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "回收站中不存在该用户", nil)
+		case errors.Is(err, serviceSystem.ErrUserUsernameDuplicate):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "用户名已存在", nil)
+		default:
+			ctx.Set("internalError", fmt.Sprintf("恢复用户异常 >>> %v", err.Error()))
+			zap.S().Error("恢复用户异常 >>> ", zap.Error(err))
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		}
+		return
+	}
+
+	response.NewResponse().Success(ctx, "恢复成功", nil)
+}
+
+// Purge
+// @Summary 彻底删除用户
+// @Description 彻底删除回收站中的用户，删除后不可恢复
+// @Tags 系统管理/用户管理
+// @Accept application/json
+// @Produce application/json
+// @Param ids body []string true "id数组"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/system/user/recycle/purge [post]
+// @Security LoginToken
+func (h *userHandler) Purge(ctx *gin.Context) {
+	var ids []string
+	if err := ctx.ShouldBindJSON(&ids); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	if err := h.svc.Purge(ctx, ids); err != nil {
+		ctx.Set("internalError", fmt.Sprintf("彻底删除用户异常 >>> %v", err.Error()))
+		zap.S().Error("彻底删除用户异常 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "彻底删除成功", nil)
+}
+
+// buildFilter 构建查询条件
+func (h *userHandler) buildFilter(ctx *gin.Context) domainSystem.UserFilter {
+	status, _ := strconv.ParseBool(ctx.DefaultQuery("status", "true"))
+	gender, _ := strconv.Atoi(ctx.DefaultQuery("gender", "0"))
+
+	return domainSystem.UserFilter{
+		Filters: filters.Filters{
+			Creator:  ctx.DefaultQuery("creator", ""),
+			Modifier: ctx.DefaultQuery("modifier", ""),
+		},
+		Status:   status,
+		Username: ctx.DefaultQuery("username", ""),
+		Name:     ctx.DefaultQuery("name", ""),
+		Gender:   user.GenderConst(gender),
+		Email:    ctx.DefaultQuery("email", ""),
+		Mobile:   ctx.DefaultQuery("mobile", ""),
+		DeptId:   ctx.DefaultQuery("dept_id", ""),
+	}
+}
@@ -0,0 +1,448 @@
+/**
+ * Description：
+ * FileName：bucket.go
+ * Author：CJiaの用心
+ * Create：2025/11/11 18:40:19
+ * Remark：
+ */
+
+package tools
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carefuly/careful-admin-go-gin/config"
+	domainTools "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/tools"
+	modelTools "github.com/carefuly/careful-admin-go-gin/internal/model/careful/tools"
+	serviceTools "github.com/carefuly/careful-admin-go-gin/internal/service/careful/tools"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/response"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	"github.com/carefuly/careful-admin-go-gin/pkg/validate"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+)
+
+// CreateBucketRequest 创建
+type CreateBucketRequest struct {
+	Name         string `json:"name" binding:"required,max=100"`           // 存储桶名称
+	Code         string `json:"code" binding:"required,max=64"`            // 存储桶编码(文件存储路径前缀，创建后不可修改)
+	MaxSize      int64  `json:"maxSize" binding:"omitempty,min=0"`         // 单个文件大小上限(字节)，0表示不限制
+	AllowedMimes string `json:"allowedMimes" binding:"omitempty,max=512"`  // 允许的文件类型，逗号分隔，支持 image/* 通配
+	Sort         int    `json:"sort" binding:"omitempty" default:"1"`      // 排序
+	Status       bool   `json:"status" binding:"omitempty" default:"true"` // 状态【true-启用 false-停用】
+	Remark       string `json:"remark" binding:"omitempty,max=255"`        // 备注
+}
+
+// UpdateBucketRequest 更新
+type UpdateBucketRequest struct {
+	Id           string `json:"id" binding:"required"`                     // 主键ID
+	Name         string `json:"name" binding:"required,max=100"`           // 存储桶名称
+	MaxSize      int64  `json:"maxSize" binding:"omitempty,min=0"`         // 单个文件大小上限(字节)，0表示不限制
+	AllowedMimes string `json:"allowedMimes" binding:"omitempty,max=512"`  // 允许的文件类型，逗号分隔，支持 image/* 通配
+	Sort         int    `json:"sort" binding:"omitempty" default:"1"`      // 排序
+	Status       bool   `json:"status" binding:"omitempty" default:"true"` // 状态【true-启用 false-停用】
+	Timestamp    int64  `json:"timestamp" binding:"omitempty"`             // 版本
+	Remark       string `json:"remark" binding:"omitempty,max=255"`        // 备注
+}
+
+// BucketListPageResponse 列表分页响应
+type BucketListPageResponse struct {
+	List     []domainTools.Bucket `json:"list"`     // 列表
+	Total    int64                `json:"total"`    // 总数
+	Page     int                  `json:"page"`     // 页码
+	PageSize int                  `json:"pageSize"` // 每页数量
+}
+
+type BucketHandler interface {
+	RegisterRoutes(router *gin.RouterGroup)
+	Create(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+	Update(ctx *gin.Context)
+	GetById(ctx *gin.Context)
+	GetListPage(ctx *gin.Context)
+	GetListAll(ctx *gin.Context)
+	GetRecycleListPage(ctx *gin.Context)
+	Restore(ctx *gin.Context)
+	Purge(ctx *gin.Context)
+}
+
+type bucketHandler struct {
+	rely config.RelyConfig
+	svc  serviceTools.BucketService
+}
+
+func NewBucketHandler(rely config.RelyConfig, svc serviceTools.BucketService) BucketHandler {
+	return &bucketHandler{
+		rely: rely,
+		svc:  svc,
+	}
+}
+
+// RegisterRoutes 注册路由
+func (h *bucketHandler) RegisterRoutes(router *gin.RouterGroup) {
+	base := router.Group("/bucket")
+	base.POST("/create", h.Create)
+	base.DELETE("/delete/:id", h.Delete)
+	base.PUT("/update", h.Update)
+	base.GET("/getById/:id", h.GetById)
+	base.GET("/listPage", h.GetListPage)
+	base.GET("/listAll", h.GetListAll)
+	base.GET("/recycle/listPage", h.GetRecycleListPage)
+	base.PUT("/recycle/restore/:id", h.Restore)
+	base.POST("/recycle/purge", h.Purge)
+}
+
+// Create
+// @Summary 创建存储桶
+// @Description 创建存储桶，编码作为文件存储路径前缀
+// @Tags 系统工具/文件存储
+// @Accept application/json
+// @Produce application/json
+// @Param CreateBucketRequest body CreateBucketRequest true "请求"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/tools/bucket/create [post]
+// @Security LoginToken
+func (h *bucketHandler) Create(ctx *gin.Context) {
+	var req CreateBucketRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	// 转换为领域模型
+	domain := domainTools.Bucket{
+		Bucket: modelTools.Bucket{
+			CoreModels: models.CoreModels{
+				Sort:       req.Sort,
+				Creator:    ctx.GetString("userId"),
+				Modifier:   ctx.GetString("userId"),
+				BelongDept: ctx.GetString("deptId"),
+				Remark:     req.Remark,
+			},
+			Status:       req.Status,
+			Name:         req.Name,
+			Code:         req.Code,
+			MaxSize:      req.MaxSize,
+			AllowedMimes: req.AllowedMimes,
+		},
+	}
+
+	if err := h.svc.Create(ctx, domain); err != nil {
+		switch {
+		case errors.Is(err, serviceTools.ErrBucketNameDuplicate),
+			errors.Is(err, serviceTools.ErrBucketCodeDuplicate),
+			errors.Is(err, serviceTools.ErrBucketCodeInvalid):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, err.Error(), nil)
+		default:
+			ctx.Set("internalError", fmt.Sprintf("创建存储桶异常 >>> %v", err.Error()))
+			zap.S().Error("创建存储桶异常 >>> ", zap.Error(err))
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		}
+		return
+	}
+
+	response.NewResponse().Success(ctx, "新增成功", nil)
+}
+
+// Delete
+// @Summary 删除存储桶
+// @Description 删除指定id存储桶，桶内仍有文件时不允许删除
+// @Tags 系统工具/文件存储
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/tools/bucket/delete/{id} [delete]
+// @Security LoginToken
+func (h *bucketHandler) Delete(ctx *gin.Context) {
+	if err := h.svc.Delete(ctx, ctx.Param("id")); err != nil {
+		switch {
+		case errors.Is(err, serviceTools.ErrBucketNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "存储桶不存在", nil)
+		case errors.Is(err, serviceTools.ErrBucketNotEmpty):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, err.Error(), nil)
+		default:
+			ctx.Set("internalError", fmt.Sprintf("删除存储桶失败 >>> %v", err.Error()))
+			zap.S().Error("删除存储桶失败 >>> ", zap.Error(err))
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		}
+		return
+	}
+
+	response.NewResponse().Success(ctx, "删除成功", nil)
+}
+
+// Update
+// @Summary 更新存储桶
+// @Description 更新存储桶名称、大小与类型限制，编码不可修改
+// @Tags 系统工具/文件存储
+// @Accept application/json
+// @Produce application/json
+// @Param UpdateBucketRequest body UpdateBucketRequest true "请求"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/tools/bucket/update [put]
+// @Security LoginToken
+func (h *bucketHandler) Update(ctx *gin.Context) {
+	var req UpdateBucketRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	// 转换为领域模型
+	domain := domainTools.Bucket{
+		Bucket: modelTools.Bucket{
+			CoreModels: models.CoreModels{
+				Id:        req.Id,
+				Sort:      req.Sort,
+				Timestamp: req.Timestamp,
+				Modifier:  ctx.GetString("userId"),
+				Remark:    req.Remark,
+			},
+			Status:       req.Status,
+			Name:         req.Name,
+			MaxSize:      req.MaxSize,
+			AllowedMimes: req.AllowedMimes,
+		},
+	}
+
+	if err := h.svc.Update(ctx, domain); err != nil {
+		switch {
+		case errors.Is(err, serviceTools.ErrBucketNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "存储桶不存在", nil)
+		case errors.Is(err, serviceTools.ErrBucketNameDuplicate):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, err.Error(), nil)
+		case errors.Is(err, serviceTools.ErrBucketVersionInconsistency):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "数据版本不一致，取消修改，请刷新后重试", nil)
+		default:
+			ctx.Set("internalError", fmt.Sprintf("更新存储桶失败 >>> %v", err.Error()))
+			zap.S().Error("更新存储桶失败 >>> ", err.Error())
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		}
+		return
+	}
+
+	response.NewResponse().Success(ctx, "更新成功", nil)
+}
+
+// GetById
+// @Summary 获取存储桶
+// @Description 获取指定id存储桶信息
+// @Tags 系统工具/文件存储
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Success 200 {object} domainTools.Bucket
+// @Failure 400 {object} response.Response
+// @Router /v1/tools/bucket/getById/{id} [get]
+// @Security LoginToken
+func (h *bucketHandler) GetById(ctx *gin.Context) {
+	detail, err := h.svc.GetById(ctx, ctx.Param("id"))
+	if err != nil {
+		if errors.Is(err, serviceTools.ErrBucketNotFound) {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "存储桶不存在", nil)
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("获取存储桶失败 >>> %v", err.Error()))
+		zap.S().Error("获取存储桶失败 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "获取成功", detail)
+}
+
+// GetListPage
+// @Summary 获取存储桶分页列表
+// @Description 获取存储桶分页列表
+// @Tags 系统工具/文件存储
+// @Accept application/json
+// @Produce application/json
+// @Param page query int true "页码" default(1)
+// @Param pageSize query int true "每页数量" default(10)
+// @Param status query bool false "状态"
+// @Param name query string false "存储桶名称"
+// @Param code query string false "存储桶编码"
+// @Success 200 {object} BucketListPageResponse
+// @Failure 400 {object} response.Response
+// @Router /v1/tools/bucket/listPage [get]
+// @Security LoginToken
+func (h *bucketHandler) GetListPage(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("pageSize", "10"))
+
+	filter := h.filter(ctx)
+	filter.Pagination = filters.Pagination{
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	list, total, err := h.svc.GetListPage(ctx, filter)
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("获取存储桶分页列表异常 >>> %v", err.Error()))
+		zap.S().Error("获取存储桶分页列表异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", BucketListPageResponse{
+		List:     list,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// GetListAll
+// @Summary 获取所有存储桶
+// @Description 获取所有存储桶列表
+// @Tags 系统工具/文件存储
+// @Accept application/json
+// @Produce application/json
+// @Param status query bool false "状态"
+// @Param name query string false "存储桶名称"
+// @Param code query string false "存储桶编码"
+// @Success 200 {array} domainTools.Bucket
+// @Failure 400 {object} response.Response
+// @Router /v1/tools/bucket/listAll [get]
+// @Security LoginToken
+func (h *bucketHandler) GetListAll(ctx *gin.Context) {
+	list, err := h.svc.GetListAll(ctx, h.filter(ctx))
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("获取所有存储桶异常 >>> %v", err.Error()))
+		zap.S().Error("获取所有存储桶异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", list)
+}
+
+// GetRecycleListPage
+// @Summary 获取存储桶回收站分页列表
+// @Description 获取已删除的存储桶分页列表，按删除时间倒序
+// @Tags 系统工具/文件存储
+// @Accept application/json
+// @Produce application/json
+// @Param page query int true "页码" default(1)
+// @Param pageSize query int true "每页数量" default(10)
+// @Param name query string false "名称"
+// @Param deletedBy query string false "删除人"
+// @Success 200 {object} BucketListPageResponse
+// @Failure 400 {object} response.Response
+// @Router /v1/tools/bucket/recycle/listPage [get]
+// @Security LoginToken
+func (h *bucketHandler) GetRecycleListPage(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("pageSize", "10"))
+
+	filter := domainTools.RecycleFilter{
+		Pagination: filters.Pagination{
+			Page:     page,
+			PageSize: pageSize,
+		},
+		Name:      ctx.DefaultQuery("name", ""),
+		DeletedBy: ctx.DefaultQuery("deletedBy", ""),
+	}
+
+	list, total, err := h.svc.GetRecycleListPage(ctx, filter)
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("获取存储桶回收站分页列表异常 >>> %v", err.Error()))
+		zap.S().Error("获取存储桶回收站分页列表异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", BucketListPageResponse{
+		List:     list,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// Restore
+// @Summary 恢复存储桶
+// @Description 从回收站恢复指定id存储桶，恢复前重新校验唯一性
+// @Tags 系统工具/文件存储
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/tools/bucket/recycle/restore/{id} [put]
+// @Security LoginToken
+func (h *bucketHandler) Restore(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "ID不能为空", nil)
+		return
+	}
+
+	if err := h.svc.Restore(ctx, id); err != nil {
+		switch {
+		case errors.Is(err, serviceTools.ErrBucketNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "回收站中不存在该存储桶", nil)
+		case errors.Is(err, serviceTools.ErrBucketNameDuplicate), errors.Is(err, serviceTools.ErrBucketCodeDuplicate):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, err.Error(), nil)
+		default:
+			ctx.Set("internalError", fmt.Sprintf("恢复存储桶异常 >>> %v", err.Error()))
+			zap.S().Error("恢复存储桶异常 >>> ", zap.Error(err))
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		}
+		return
+	}
+
+	response.NewResponse().Success(ctx, "恢复成功", nil)
+}
+
+// Purge
+// @Summary 彻底删除存储桶
+// @Description 彻底删除回收站中的存储桶，回收站中仍有其文件时不允许删除
+// @Tags 系统工具/文件存储
+// @Accept application/json
+// @Produce application/json
+// @Param ids body []string true "id数组"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/tools/bucket/recycle/purge [post]
+// @Security LoginToken
+func (h *bucketHandler) Purge(ctx *gin.Context) {
+	var ids []string
+	if err := ctx.ShouldBindJSON(&ids); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	if err := h.svc.Purge(ctx, ids); err != nil {
+		switch {
+		case errors.Is(err, serviceTools.ErrBucketHasDeletedFiles):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, err.Error(), nil)
+		default:
+			ctx.Set("internalError", fmt.Sprintf("彻底删除存储桶异常 >>> %v", err.Error()))
+			zap.S().Error("彻底删除存储桶异常 >>> ", zap.Error(err))
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		}
+		return
+	}
+
+	response.NewResponse().Success(ctx, "彻底删除成功", nil)
+}
+
+// filter 查询条件，未传状态时不按状态过滤
+func (h *bucketHandler) filter(ctx *gin.Context) domainTools.BucketFilter {
+	filter := domainTools.BucketFilter{
+		Name: ctx.DefaultQuery("name", ""),
+		Code: ctx.DefaultQuery("code", ""),
+	}
+	if status, err := strconv.ParseBool(ctx.Query("status")); err == nil {
+		filter.Status = &status
+	}
+	return filter
+}
@@ -9,17 +9,21 @@
 package tools
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/carefuly/careful-admin-go-gin/config"
+	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
 	domainTools "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/tools"
 	modelTools "github.com/carefuly/careful-admin-go-gin/internal/model/careful/tools"
 	serviceSystem "github.com/carefuly/careful-admin-go-gin/internal/service/careful/system"
 	serviceTools "github.com/carefuly/careful-admin-go-gin/internal/service/careful/tools"
 	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/tools/dict"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/tools/job"
 	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
 	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/response"
 	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	_import "github.com/carefuly/careful-admin-go-gin/pkg/utils/common/import"
 	"github.com/carefuly/careful-admin-go-gin/pkg/utils/enumconv"
 	"github.com/carefuly/careful-admin-go-gin/pkg/utils/excelutil"
 	"github.com/carefuly/careful-admin-go-gin/pkg/utils/jwt"
@@ -29,6 +33,7 @@ import (
 	"go.uber.org/zap"
 	"mime/multipart"
 	"net/http"
+	"os"
 	"strconv"
 	"time"
 )
@@ -46,7 +51,10 @@ type CreateDictRequest struct {
 
 // ImportDictRequest 导入
 type ImportDictRequest struct {
-	File *multipart.FileHeader `form:"file" binding:"required"`
+	File     *multipart.FileHeader `form:"file" binding:"required_without=UploadId"`
+	UploadId string                `form:"uploadId" binding:"omitempty,max=32"`               // 分片上传会话ID，与 file 二选一
+	Mode     string                `form:"mode" binding:"omitempty,oneof=partial all dryRun"` // 导入模式【partial-部分导入 all-全部成功才写入 dryRun-仅预览】
+	Async    bool                  `form:"async" binding:"omitempty"`                         // 是否提交为后台任务
 }
 
 // UpdateDictRequest 更新
@@ -78,19 +86,26 @@ type DictHandler interface {
 	GetListPage(ctx *gin.Context)
 	GetListAll(ctx *gin.Context)
 	Export(ctx *gin.Context)
+	GetRecycleListPage(ctx *gin.Context)
+	Restore(ctx *gin.Context)
+	Purge(ctx *gin.Context)
 }
 
 type dictHandler struct {
-	rely    config.RelyConfig
-	svc     serviceTools.DictService
-	userSvc serviceSystem.UserService
+	rely      config.RelyConfig
+	svc       serviceTools.DictService
+	userSvc   serviceSystem.UserService
+	jobSvc    serviceTools.JobService
+	uploadSvc serviceTools.UploadService
 }
 
-func NewDictHandler(rely config.RelyConfig, svc serviceTools.DictService, userSvc serviceSystem.UserService) DictHandler {
+func NewDictHandler(rely config.RelyConfig, svc serviceTools.DictService, userSvc serviceSystem.UserService, jobSvc serviceTools.JobService, uploadSvc serviceTools.UploadService) DictHandler {
 	return &dictHandler{
-		rely:    rely,
-		svc:     svc,
-		userSvc: userSvc,
+		rely:      rely,
+		svc:       svc,
+		userSvc:   userSvc,
+		jobSvc:    jobSvc,
+		uploadSvc: uploadSvc,
 	}
 }
 
@@ -106,6 +121,9 @@ func (h *dictHandler) RegisterRoutes(router *gin.RouterGroup) {
 	base.GET("/listPage", h.GetListPage)
 	base.GET("/listAll", h.GetListAll)
 	base.GET("/export", h.Export)
+	base.GET("/recycle/listPage", h.GetRecycleListPage)
+	base.PUT("/recycle/restore/:id", h.Restore)
+	base.POST("/recycle/purge", h.Purge)
 }
 
 // Create
@@ -204,7 +222,10 @@ func (h *dictHandler) Create(ctx *gin.Context) {
 // @Tags 系统工具/字典管理
 // @Accept multipart/form-data
 // @Produce application/json
-// @Param file formData file true "文件(支持xlsx/csv格式)"
+// @Param file formData file false "文件(支持xlsx/csv格式)，与 uploadId 二选一"
+// @Param uploadId formData string false "分片上传会话ID，大文件先通过 /v1/tools/upload 分片上传"
+// @Param mode formData string false "导入模式(partial/all/dryRun)，默认 partial"
+// @Param async formData bool false "是否提交为后台任务，提交后通过 /v1/jobs/{id} 查询进度"
 // @Success 200 {object} response.Response
 // @Failure 400 {object} response.Response
 // @Router /v1/tools/dict/import [post]
@@ -233,26 +254,105 @@ func (h *dictHandler) Import(ctx *gin.Context) {
 	}
 
 	// 保存导入的文件信息
-	format := time.Now().Format("2006-01-02")
-	filePath := "./uploads/" + format + "/" + req.File.Filename
-	if err := ctx.SaveUploadedFile(req.File, filePath); err != nil {
-		response.NewResponse().Error(ctx, http.StatusBadRequest, "保存文件失败", nil)
+	filePath, ok := saveImportFile(ctx, h.uploadSvc, user.Id, req.File, req.UploadId)
+	if !ok {
 		return
 	}
 
-	// 读取Excel文件
-	read, err := xlsx.NewXlsxFile(filePath).ReadSheetByName("字典模板")
+	if req.Async {
+		created, err := h.jobSvc.Submit(ctx, domainTools.Job{
+			Job: modelTools.Job{
+				CoreModels: models.CoreModels{
+					Creator:    user.Id,
+					Modifier:   user.Id,
+					BelongDept: user.DeptId,
+				},
+				Name: "数据字典导入",
+				Type: job.TypeConstImport,
+			},
+		}, func(jobCtx context.Context, jc serviceTools.JobContext) (domainTools.JobOutcome, error) {
+			jobCtx = _import.WithProgress(jobCtx, jc.Report)
+			result, msg, err := h.importFile(jobCtx, user, filePath, _import.ParseMode(req.Mode))
+			if err != nil {
+				return domainTools.JobOutcome{}, err
+			}
+			return domainTools.JobOutcome{
+				JobProgress: domainTools.JobProgress{
+					Total:        int64(result.ValidCount + result.FailCount),
+					Processed:    int64(result.ValidCount + result.FailCount),
+					SuccessCount: int64(result.SuccessCount),
+					FailCount:    int64(result.FailCount),
+				},
+				Message: msg,
+				Result:  result,
+			}, nil
+		})
+		if err != nil {
+			removeImportFile(filePath)
+			if errors.Is(err, serviceTools.ErrJobBusy) {
+				response.NewResponse().Error(ctx, http.StatusTooManyRequests, err.Error(), nil)
+				return
+			}
+			ctx.Set("internalError", fmt.Sprintf("提交数据字典导入任务异常 >>> %v", err.Error()))
+			zap.S().Error("提交数据字典导入任务异常 >>> ", zap.Error(err))
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+			return
+		}
+
+		response.NewResponse().Success(ctx, "导入任务已提交", created)
+		return
+	}
+
+	result, msg, err := h.importFile(ctx, user, filePath, _import.ParseMode(req.Mode))
 	if err != nil {
 		response.NewResponse().Error(ctx, http.StatusBadRequest, err.Error(), nil)
 		return
 	}
 
-	result := h.svc.Import(ctx, user, read)
-	msg := fmt.Sprintf("导入成功【成功导入【%d】条数据, 失败【%d】条数据】", result.SuccessCount, result.FailCount)
-
 	response.NewResponse().Success(ctx, msg, result)
 }
 
+// importFile 读取上传的文件并导入，完成后删除文件
+func (h *dictHandler) importFile(ctx context.Context, user domainSystem.User, filePath string, mode _import.Mode) (_import.ImportResult, string, error) {
+	// 导入完成后删除上传的临时文件
+	defer removeImportFile(filePath)
+
+	// 读取Excel文件
+	file := xlsx.NewXlsxFile(filePath)
+	defer func() {
+		if err := file.Close(); err != nil {
+			zap.S().Warn("关闭导入文件失败 >>> ", zap.Error(err))
+		}
+	}()
+	read, err := file.ReadSheetByName("字典模板")
+	if err != nil {
+		return _import.ImportResult{}, "", err
+	}
+	headers, err := file.ReadHeaders("字典模板")
+	if err != nil {
+		return _import.ImportResult{}, "", err
+	}
+
+	result := h.svc.Import(ctx, user, read, mode)
+
+	// 生成错误数据工作簿，失败行标红并附错误信息
+	if err := result.SaveErrorWorkbook(headers, read, "字典导入错误数据"); err != nil {
+		zap.S().Error("生成导入错误数据文件失败 >>> ", zap.Error(err))
+	}
+
+	var msg string
+	switch {
+	case result.DryRun:
+		msg = fmt.Sprintf("校验完成【校验通过【%d】条数据, 失败【%d】条数据】", result.ValidCount, result.FailCount)
+	case result.Mode == _import.ModeAllOrNothing && result.FailCount > 0:
+		msg = fmt.Sprintf("导入失败【存在【%d】条错误数据, 未写入任何数据】", result.FailCount)
+	default:
+		msg = fmt.Sprintf("导入成功【成功导入【%d】条数据, 失败【%d】条数据】", result.SuccessCount, result.FailCount)
+	}
+
+	return result, msg, nil
+}
+
 // Delete
 // @Summary 删除字典
 // @Description 删除指定id字典
@@ -571,10 +671,12 @@ func (h *dictHandler) GetListAll(ctx *gin.Context) {
 
 // Export
 // @Summary 导出字典数据
-// @Description 导出字典数据到Excel文件
+// @Description 导出字典数据为Excel、CSV或JSON文件
 // @Tags 系统工具/字典管理
 // @Accept application/json
-// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet,text/csv,application/json,application/x-ndjson
+// @Param format query string false "导出格式(xlsx/csv/json/ndjson)" default(xlsx)
+// @Param bom query bool false "CSV是否写入UTF-8 BOM，中文版Excel直接打开时需开启" default(false)
 // @Param creator query string false "创建人"
 // @Param modifier query string false "修改人"
 // @Param status query bool false "状态" default(true)
@@ -582,11 +684,20 @@ func (h *dictHandler) GetListAll(ctx *gin.Context) {
 // @Param code query string false "字典编码"
 // @Param type query int true "字典分类" default(1)
 // @Param valueType query int true "字典值类型" default(1)
-// @Success 200 {file} file "Excel文件"
+// @Param async query bool false "是否提交为后台任务，完成后通过 /v1/jobs/{id}/download 下载" default(false)
+// @Success 200 {file} file "导出文件"
 // @Failure 500 {object} response.Response
 // @Router /v1/tools/dict/export [get]
 // @Security LoginToken
 func (h *dictHandler) Export(ctx *gin.Context) {
+	// 导出格式
+	format, err := excelutil.ParseFormat(ctx.DefaultQuery("format", ""))
+	if err != nil {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	bom, _ := strconv.ParseBool(ctx.DefaultQuery("bom", "false"))
+
 	// 从上下文中获取登录信息
 	claims, ok := ctx.MustGet("claims").(*jwt.Claims)
 	if !ok {
@@ -625,6 +736,37 @@ func (h *dictHandler) Export(ctx *gin.Context) {
 		ValueType: dict.ValueTypeConst(valueType),
 	}
 
+	filename := fmt.Sprintf("数据字典导出_%s", time.Now().Format("20060102150405"))
+
+	if async, _ := strconv.ParseBool(ctx.DefaultQuery("async", "false")); async {
+		created, err := h.jobSvc.Submit(ctx, domainTools.Job{
+			Job: modelTools.Job{
+				CoreModels: models.CoreModels{
+					Creator:    user.Id,
+					Modifier:   user.Id,
+					BelongDept: user.DeptId,
+				},
+				Name: "数据字典导出",
+				Type: job.TypeConstExport,
+			},
+		}, func(jobCtx context.Context, jc serviceTools.JobContext) (domainTools.JobOutcome, error) {
+			return h.exportFile(jobCtx, jc, filter, format, filename, bom)
+		})
+		if err != nil {
+			if errors.Is(err, serviceTools.ErrJobBusy) {
+				response.NewResponse().Error(ctx, http.StatusTooManyRequests, err.Error(), nil)
+				return
+			}
+			ctx.Set("internalError", fmt.Sprintf("提交数据字典导出任务异常 >>> %v", err.Error()))
+			zap.S().Error("提交数据字典导出任务异常 >>> ", zap.Error(err))
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+			return
+		}
+
+		response.NewResponse().Success(ctx, "导出任务已提交", created)
+		return
+	}
+
 	list, err := h.svc.GetListAll(ctx, filter)
 	if err != nil {
 		ctx.Set("internalError", fmt.Sprintf("获取数据字典列表异常 >>> %v", err.Error()))
@@ -634,76 +776,177 @@ func (h *dictHandler) Export(ctx *gin.Context) {
 	}
 
 	// 准备导出配置
-	filename := fmt.Sprintf("数据字典导出_%s.xlsx", time.Now().Format("20060102150405"))
-	cfg := excelutil.ExcelExportConfig{
-		SheetName:  "数据字典",
-		FileName:   filename,
-		StreamMode: true,
-		Columns: []excelutil.ExcelColumn{
-			{Title: "字典名称", Field: "Name", Width: 22},
-			{Title: "字典编码", Field: "Code", Width: 17},
-			{
-				Title: "字典编码",
-				Field: "Type",
-				Width: 15,
-				Formatter: func(value interface{}) string {
-					typeValidValues := []string{"普通字典", "系统字典", "枚举字典"}
-					converter := enumconv.NewEnumConverter(dict.TypeMapping, dict.TypeImportMapping, typeValidValues, "字典分类")
-					str, _ := converter.FromEnum(value.(dict.TypeConst))
-					return str
-				},
-			},
-			{
-				Title: "数据类型",
-				Field: "ValueType",
-				Width: 15,
-				Formatter: func(value interface{}) string {
-					typeValueValidValues := []string{"字符串", "整型", "布尔"}
-					typeValueConverter := enumconv.NewEnumConverter(dict.TypeValueMapping, dict.TypeValueImportMapping, typeValueValidValues, "数据类型")
-					str, _ := typeValueConverter.FromEnum(value.(dict.ValueTypeConst))
-					return str
-				},
-			},
-			{
-				Title: "状态",
-				Field: "Status",
-				Width: 10,
-				Formatter: func(value interface{}) string {
-					if status, ok := value.(bool); ok {
-						if status {
-							return "启用"
-						}
-						return "停用"
-					}
-					return fmt.Sprintf("%v", value)
-				},
-			},
-			{Title: "排序", Field: "Sort", Width: 8},
-			{Title: "创建时间", Field: "CreateTime", Width: 22},
-			{Title: "更新时间", Field: "UpdateTime", Width: 22},
-			{Title: "备注", Field: "Remark", Width: 40},
+	cfg := h.exportConfig(filename, bom)
+
+	// 流式写入响应
+	w := response.NewAttachmentWriter(ctx, format.FileName(filename), format.ContentType())
+	if _, err := excelutil.Write(ctx, w, format, &cfg, excelutil.SliceIterator(list)); err != nil {
+		if w.Written() {
+			zap.S().Error("写入数据字典导出文件异常 >>> ", err.Error())
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("导出数据字典异常 >>> %v", err.Error()))
+		zap.S().Error("导出数据字典异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+	}
+}
+
+// exportFile 后台任务：导出数据字典到结果文件
+func (h *dictHandler) exportFile(ctx context.Context, jc serviceTools.JobContext, filter domainTools.DictFilter, format excelutil.Format, filename string, bom bool) (domainTools.JobOutcome, error) {
+	list, err := h.svc.GetListAll(ctx, filter)
+	if err != nil {
+		return domainTools.JobOutcome{}, err
+	}
+	total := int64(len(list))
+	jc.Report(0, total)
+
+	path, err := jc.ArtifactPath(string(format))
+	if err != nil {
+		return domainTools.JobOutcome{}, err
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return domainTools.JobOutcome{}, err
+	}
+	defer file.Close()
+
+	cfg := h.exportConfig(filename, bom)
+	if _, err := excelutil.Write(ctx, file, format, &cfg, excelutil.SliceIterator(list)); err != nil {
+		return domainTools.JobOutcome{}, err
+	}
+	if err := file.Close(); err != nil {
+		return domainTools.JobOutcome{}, err
+	}
+	jc.Report(total, total)
+
+	return domainTools.JobOutcome{
+		JobProgress: domainTools.JobProgress{
+			Total:        total,
+			Processed:    total,
+			SuccessCount: total,
+		},
+		Message:      fmt.Sprintf("导出成功【共导出【%d】条数据】", total),
+		Artifact:     path,
+		ArtifactName: format.FileName(filename),
+	}, nil
+}
+
+// exportConfig 数据字典导出配置，列由 excel 标签声明，与导入模板一致
+func (h *dictHandler) exportConfig(filename string, bom bool) excelutil.ExcelExportConfig {
+	return excelutil.ExcelExportConfig{
+		SheetName: "数据字典",
+		FileName:  filename,
+		BOM:       bom,
+		Columns:   excelutil.Columns[domainTools.Dict](),
+	}
+}
+
+// GetRecycleListPage
+// @Summary 获取字典回收站分页列表
+// @Description 获取已删除的字典分页列表，按删除时间倒序
+// @Tags 系统工具/字典管理
+// @Accept application/json
+// @Produce application/json
+// @Param page query int true "页码" default(1)
+// @Param pageSize query int true "每页数量" default(10)
+// @Param name query string false "名称"
+// @Param deletedBy query string false "删除人"
+// @Success 200 {object} DictListPageResponse
+// @Failure 400 {object} response.Response
+// @Router /v1/tools/dict/recycle/listPage [get]
+// @Security LoginToken
+func (h *dictHandler) GetRecycleListPage(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("pageSize", "10"))
+
+	filter := domainTools.RecycleFilter{
+		Pagination: filters.Pagination{
+			Page:     page,
+			PageSize: pageSize,
 		},
-		Data: list,
+		Name:      ctx.DefaultQuery("name", ""),
+		DeletedBy: ctx.DefaultQuery("deletedBy", ""),
 	}
 
-	// 创建并执行导出器
-	exporter := excelutil.NewExcelExporter(&cfg)
-	f, err := exporter.Export()
+	list, total, err := h.svc.GetRecycleListPage(ctx, filter)
 	if err != nil {
-		ctx.Set("internalError", fmt.Sprintf("导出数据字典异常 >>> %v", err.Error()))
-		zap.S().Error("导出数据字典异常 >>> ", err.Error())
+		ctx.Set("internalError", fmt.Sprintf("获取字典回收站分页列表异常 >>> %v", err.Error()))
+		zap.S().Error("获取字典回收站分页列表异常 >>> ", err.Error())
 		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
 		return
 	}
 
-	// 设置响应头
-	ctx.Header("Content-Type", "application/octet-stream")
-	ctx.Header("Content-Disposition", "attachment; filename=export.xlsx")
-	ctx.Header("Pragma", "no-cache")
-	ctx.Header("Cache-Control", "no-store")
+	response.NewResponse().Success(ctx, "查询成功", DictListPageResponse{
+		List:     list,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
 
-	// 流式写入响应
-	if _, err := f.WriteTo(ctx.Writer); err != nil {
-		response.NewResponse().Error(ctx, http.StatusInternalServerError, "生成Excel失败", nil)
+// Restore
+// @Summary 恢复字典
+// @Description 从回收站恢复指定id字典，恢复前重新校验唯一性
+// @Tags 系统工具/字典管理
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/tools/dict/recycle/restore/{id} [put]
+// @Security LoginToken
+func (h *dictHandler) Restore(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "ID不能为空", nil)
+		return
+	}
+
+	if err := h.svc.Restore(ctx, id); err != nil {
+		switch {
+		case errors.Is(err, serviceTools.ErrDictNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "回收站中不存在该字典", nil)
+		case errors.Is(err, serviceTools.ErrDictNameDuplicate):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "字典名称已存在", nil)
+		case errors.Is(err, serviceTools.ErrDictCodeDuplicate):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "字典编码已存在", nil)
+		case errors.Is(err, serviceTools.ErrDictDuplicate):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "数据字典已存在", nil)
+		default:
+			ctx.Set("internalError", fmt.Sprintf("恢复字典异常 >>> %v", err.Error()))
+			zap.S().Error("恢复字典异常 >>> ", zap.Error(err))
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		}
+		return
+	}
+
+	response.NewResponse().Success(ctx, "恢复成功", nil)
+}
+
+// Purge
+// @Summary 彻底删除字典
+// @Description 彻底删除回收站中的字典，删除后不可恢复
+// @Tags 系统工具/字典管理
+// @Accept application/json
+// @Produce application/json
+// @Param ids body []string true "id数组"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/tools/dict/recycle/purge [post]
+// @Security LoginToken
+func (h *dictHandler) Purge(ctx *gin.Context) {
+	var ids []string
+	if err := ctx.ShouldBindJSON(&ids); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
 	}
+
+	if err := h.svc.Purge(ctx, ids); err != nil {
+		ctx.Set("internalError", fmt.Sprintf("彻底删除字典异常 >>> %v", err.Error()))
+		zap.S().Error("彻底删除字典异常 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "彻底删除成功", nil)
 }
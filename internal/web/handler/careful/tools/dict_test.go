@@ -228,7 +228,7 @@ func Test_dictHandler_Create(t *testing.T) {
 			})
 			router := server.Group("/dev-api/v1")
 			service, userService := tc.mock(ctrl)
-			h := NewDictHandler(c, service, userService)
+			h := NewDictHandler(c, service, userService, nil, nil)
 			h.RegisterRoutes(router)
 
 			req, err := http.NewRequest(http.MethodPost,
@@ -310,7 +310,7 @@ func Test_dictHandler_Delete(t *testing.T) {
 			})
 			router := server.Group("/dev-api/v1")
 			service := tc.mock(ctrl)
-			h := NewDictHandler(c, service, nil)
+			h := NewDictHandler(c, service, nil, nil, nil)
 			h.RegisterRoutes(router)
 
 			req, err := http.NewRequest(http.MethodDelete,
@@ -332,6 +332,80 @@ func Test_dictHandler_Delete(t *testing.T) {
 	}
 }
 
+func Test_dictHandler_Restore(t *testing.T) {
+	c := config.RelyConfig{}
+
+	testCases := []struct {
+		name     string
+		mock     func(ctrl *gomock.Controller) serviceTools.DictService
+		id       string
+		wantCode int
+		wantMsg  string
+	}{
+		{
+			name: "恢复成功",
+			mock: func(ctrl *gomock.Controller) serviceTools.DictService {
+				dictService := svcmocks.NewMockDictService(ctrl)
+				dictService.EXPECT().Restore(gomock.Any(), "1").Return(nil)
+				return dictService
+			},
+			id:       "1",
+			wantCode: http.StatusOK,
+			wantMsg:  "恢复成功",
+		},
+		{
+			name: "回收站中不存在",
+			mock: func(ctrl *gomock.Controller) serviceTools.DictService {
+				dictService := svcmocks.NewMockDictService(ctrl)
+				dictService.EXPECT().Restore(gomock.Any(), "1").
+					Return(serviceTools.ErrDictNotFound)
+				return dictService
+			},
+			id:       "1",
+			wantCode: http.StatusBadRequest,
+			wantMsg:  "回收站中不存在该字典",
+		},
+		{
+			name: "字典名称已存在",
+			mock: func(ctrl *gomock.Controller) serviceTools.DictService {
+				dictService := svcmocks.NewMockDictService(ctrl)
+				dictService.EXPECT().Restore(gomock.Any(), "1").
+					Return(serviceTools.ErrDictNameDuplicate)
+				return dictService
+			},
+			id:       "1",
+			wantCode: http.StatusBadRequest,
+			wantMsg:  "字典名称已存在",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			server := gin.Default()
+			router := server.Group("/dev-api/v1")
+			h := NewDictHandler(c, tc.mock(ctrl), nil, nil, nil)
+			h.RegisterRoutes(router)
+
+			req, err := http.NewRequest(http.MethodPut,
+				"/dev-api/v1/dict/recycle/restore/"+tc.id,
+				bytes.NewBuffer([]byte("")))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			resp := httptest.NewRecorder()
+			server.ServeHTTP(resp, req)
+
+			var res response.Response
+			err = json.Unmarshal(resp.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantCode, resp.Code)
+			assert.Equal(t, tc.wantMsg, res.Message)
+		})
+	}
+}
+
 func Test_dictHandler_Update(t *testing.T) {
 	c := config.RelyConfig{}
 
@@ -511,7 +585,7 @@ func Test_dictHandler_Update(t *testing.T) {
 			})
 			router := server.Group("/dev-api/v1")
 			service, userService := tc.mock(ctrl)
-			h := NewDictHandler(c, service, userService)
+			h := NewDictHandler(c, service, userService, nil, nil)
 			h.RegisterRoutes(router)
 
 			req, err := http.NewRequest(http.MethodPut,
@@ -599,7 +673,7 @@ func Test_dictHandler_GetById(t *testing.T) {
 			})
 			router := server.Group("/dev-api/v1")
 			service := tc.mock(ctrl)
-			h := NewDictHandler(c, service, nil)
+			h := NewDictHandler(c, service, nil, nil, nil)
 			h.RegisterRoutes(router)
 
 			req, err := http.NewRequest(http.MethodGet,
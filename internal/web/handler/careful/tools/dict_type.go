@@ -9,6 +9,7 @@
 package tools
 
 import (
+	"database/sql"
 	"errors"
 	"fmt"
 	"github.com/carefuly/careful-admin-go-gin/config"
@@ -20,14 +21,20 @@ import (
 	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
 	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/response"
 	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	_import "github.com/carefuly/careful-admin-go-gin/pkg/utils/common/import"
 	"github.com/carefuly/careful-admin-go-gin/pkg/utils/enumconv"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/excelutil"
 	"github.com/carefuly/careful-admin-go-gin/pkg/utils/jwt"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/xlsx"
 	"github.com/carefuly/careful-admin-go-gin/pkg/validate"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
 	"mime/multipart"
 	"net/http"
+	"os"
+	"slices"
 	"strconv"
+	"time"
 )
 
 // CreateDictTypeRequest 创建
@@ -46,7 +53,9 @@ type CreateDictTypeRequest struct {
 
 // ImportDictTypeRequest 导入
 type ImportDictTypeRequest struct {
-	File *multipart.FileHeader `form:"file" binding:"required"`
+	File     *multipart.FileHeader `form:"file" binding:"required_without=UploadId"`
+	UploadId string                `form:"uploadId" binding:"omitempty,max=32"`               // 分片上传会话ID，与 file 二选一
+	Mode     string                `form:"mode" binding:"omitempty,oneof=partial all dryRun"` // 导入模式【partial-部分导入 all-全部成功才写入 dryRun-仅预览】
 }
 
 // UpdateDictTypeRequest 更新
@@ -63,7 +72,7 @@ type UpdateDictTypeRequest struct {
 }
 
 type ListByDictNamesRequest struct {
-	DictNames []string `json:"dictNames"` // 数组参数格式: ?dictNames=性别&dictNames=计量单位
+	DictNames []string `form:"dictNames" binding:"required"` // 数组参数格式: ?dictNames=性别&dictNames=计量单位
 }
 
 // DictTypeListPageResponse 列表分页响应
@@ -86,19 +95,24 @@ type DictTypeHandler interface {
 	GetListPage(ctx *gin.Context)
 	GetListAll(ctx *gin.Context)
 	Export(ctx *gin.Context)
+	GetRecycleListPage(ctx *gin.Context)
+	Restore(ctx *gin.Context)
+	Purge(ctx *gin.Context)
 }
 
 type dictTypeHandler struct {
-	rely    config.RelyConfig
-	svc     serviceTools.DictTypeService
-	userSvc serviceSystem.UserService
+	rely      config.RelyConfig
+	svc       serviceTools.DictTypeService
+	userSvc   serviceSystem.UserService
+	uploadSvc serviceTools.UploadService
 }
 
-func NewDictTypeHandler(rely config.RelyConfig, svc serviceTools.DictTypeService, userSvc serviceSystem.UserService) DictTypeHandler {
+func NewDictTypeHandler(rely config.RelyConfig, svc serviceTools.DictTypeService, userSvc serviceSystem.UserService, uploadSvc serviceTools.UploadService) DictTypeHandler {
 	return &dictTypeHandler{
-		rely:    rely,
-		svc:     svc,
-		userSvc: userSvc,
+		rely:      rely,
+		svc:       svc,
+		userSvc:   userSvc,
+		uploadSvc: uploadSvc,
 	}
 }
 
@@ -110,10 +124,13 @@ func (h *dictTypeHandler) RegisterRoutes(router *gin.RouterGroup) {
 	base.POST("/delete/batchDelete", h.BatchDelete)
 	base.PUT("/update", h.Update)
 	base.GET("/getById/:id", h.GetById)
-	base.POST("/listByDictNames", h.GetListByDictNames)
+	base.GET("/listByDictNames", h.GetListByDictNames)
 	base.GET("/listPage", h.GetListPage)
 	base.GET("/listAll", h.GetListAll)
 	base.GET("/export", h.Export)
+	base.GET("/recycle/listPage", h.GetRecycleListPage)
+	base.PUT("/recycle/restore/:id", h.Restore)
+	base.POST("/recycle/purge", h.Purge)
 }
 
 // Create
@@ -200,17 +217,89 @@ func (h *dictTypeHandler) Create(ctx *gin.Context) {
 
 // Import
 // @Summary 导入字典信息
-// @Description 导入字典信息
+// @Description 导入字典信息，模板：/static/templates/import/字典信息导入模板.xlsx
 // @Tags 系统工具/字典信息管理
 // @Accept multipart/form-data
 // @Produce application/json
-// @Param file formData file true "文件(支持xlsx/csv格式)"
+// @Param file formData file false "文件(支持xlsx/csv格式)，与 uploadId 二选一"
+// @Param uploadId formData string false "分片上传会话ID，大文件先通过 /v1/tools/upload 分片上传"
+// @Param mode formData string false "导入模式(partial/all/dryRun)，默认 partial"
 // @Success 200 {object} response.Response
 // @Failure 400 {object} response.Response
 // @Router /v1/tools/dictType/import [post]
 // @Security LoginToken
 func (h *dictTypeHandler) Import(ctx *gin.Context) {
+	// 从上下文中获取登录信息
+	claims, ok := ctx.MustGet("claims").(*jwt.Claims)
+	if !ok {
+		zap.S().Error("未找到用户认证信息 >>> ", zap.Error(errors.New(claims.UserId)))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	user, err := h.userSvc.GetById(ctx, claims.UserId)
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("获取用户信息异常 >>> %v", err.Error()))
+		zap.S().Error("获取用户信息异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	var req ImportDictTypeRequest
+	if err := ctx.ShouldBind(&req); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	// 保存导入的文件信息
+	filePath, ok := saveImportFile(ctx, h.uploadSvc, user.Id, req.File, req.UploadId)
+	if !ok {
+		return
+	}
+
+	// 导入完成后删除上传的临时文件
+	defer func() {
+		if err := os.Remove(filePath); err != nil {
+			zap.S().Warn("删除导入临时文件失败 >>> ", zap.Error(err))
+		}
+	}()
+
+	// 读取Excel文件
+	file := xlsx.NewXlsxFile(filePath)
+	defer func() {
+		if err := file.Close(); err != nil {
+			zap.S().Warn("关闭导入文件失败 >>> ", zap.Error(err))
+		}
+	}()
+	read, err := file.ReadSheetByName("字典信息模板")
+	if err != nil {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	headers, err := file.ReadHeaders("字典信息模板")
+	if err != nil {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
 
+	result := h.svc.Import(ctx, user, read, _import.ParseMode(req.Mode))
+
+	// 生成错误数据工作簿，失败行标红并附错误信息
+	if err := result.SaveErrorWorkbook(headers, read, "字典信息导入错误数据"); err != nil {
+		zap.S().Error("生成导入错误数据文件失败 >>> ", zap.Error(err))
+	}
+
+	var msg string
+	switch {
+	case result.DryRun:
+		msg = fmt.Sprintf("校验完成【校验通过【%d】条数据, 失败【%d】条数据】", result.ValidCount, result.FailCount)
+	case result.Mode == _import.ModeAllOrNothing && result.FailCount > 0:
+		msg = fmt.Sprintf("导入失败【存在【%d】条错误数据, 未写入任何数据】", result.FailCount)
+	default:
+		msg = fmt.Sprintf("导入成功【成功导入【%d】条数据, 失败【%d】条数据】", result.SuccessCount, result.FailCount)
+	}
+
+	response.NewResponse().Success(ctx, msg, result)
 }
 
 // Delete
@@ -380,30 +469,35 @@ func (h *dictTypeHandler) GetById(ctx *gin.Context) {
 
 // GetListByDictNames
 // @Summary 根据字典名称批量查询字典项
-// @Description 返回分层结构的字典项映射
+// @Description 按字典名称返回字典选项映射，值的类型由字典的数据类型决定
 // @Tags 系统工具/字典信息管理
 // @Accept application/json
 // @Produce application/json
-// @Param dictNames body []string true "字典名称数组"
-// @Success 200 {object} map[string][]domainTools.DictType
+// @Param dictNames query []string true "字典名称数组" collectionFormat(multi)
+// @Success 200 {object} map[string][]domainTools.DictOption
 // @Failure 400 {object} response.Response
-// @Router /v1/tools/dictType/listByDictNames [post]
+// @Router /v1/tools/dictType/listByDictNames [get]
 // @Security LoginToken
 func (h *dictTypeHandler) GetListByDictNames(ctx *gin.Context) {
-	// var req []string
-	// if err := ctx.ShouldBindJSON(&req); err != nil {
-	// 	validate.NewValidatorError(h.rely.Trans).HandleValidatorError(ctx, err)
-	// 	return
-	// }
-	//
-	// list, err := h.svc.GetByDictNames(ctx, req)
-	// if err != nil {
-	// 	zap.L().Error("获取字典名称批量查询字典项异常", zap.Error(err))
-	// 	response.NewResponse().ErrorResponse(ctx, http.StatusInternalServerError, "服务器异常", nil)
-	// 	return
-	// }
-	//
-	// response.NewResponse().SuccessResponse(ctx, "查询成功", list)
+	var req ListByDictNamesRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	options, err := h.svc.GetByDictNames(ctx, req.DictNames)
+	if err != nil {
+		if errors.Is(err, serviceTools.ErrDictNamesTooMany) {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, err.Error(), nil)
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("根据字典名称批量查询字典项异常 >>> %v", err.Error()))
+		zap.S().Error("根据字典名称批量查询字典项异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", options)
 }
 
 // GetListPage
@@ -561,10 +655,12 @@ func (h *dictTypeHandler) GetListAll(ctx *gin.Context) {
 
 // Export
 // @Summary 导出字典信息
-// @Description 导出字典信息到Excel文件
+// @Description 导出字典信息为Excel、CSV或JSON文件
 // @Tags 系统工具/字典信息管理
 // @Accept application/json
-// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet,text/csv,application/json,application/x-ndjson
+// @Param format query string false "导出格式(xlsx/csv/json/ndjson)" default(xlsx)
+// @Param bom query bool false "CSV是否写入UTF-8 BOM，中文版Excel直接打开时需开启" default(false)
 // @Param creator query string false "创建人"
 // @Param modifier query string false "修改人"
 // @Param status query bool false "状态" default(true)
@@ -573,11 +669,19 @@ func (h *dictTypeHandler) GetListAll(ctx *gin.Context) {
 // @Param dictName query string false "数据字典名称"
 // @Param valueType query int true "数据类型" default(1)
 // @Param dict_id query string false "数据字典id"
-// @Success 200 {file} file "Excel文件"
+// @Success 200 {file} file "导出文件"
 // @Failure 500 {object} response.Response
 // @Router /v1/tools/dictType/export [get]
 // @Security LoginToken
 func (h *dictTypeHandler) Export(ctx *gin.Context) {
+	// 导出格式
+	format, err := excelutil.ParseFormat(ctx.DefaultQuery("format", ""))
+	if err != nil {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	bom, _ := strconv.ParseBool(ctx.DefaultQuery("bom", "false"))
+
 	// 从上下文中获取登录信息
 	claims, ok := ctx.MustGet("claims").(*jwt.Claims)
 	if !ok {
@@ -626,5 +730,173 @@ func (h *dictTypeHandler) Export(ctx *gin.Context) {
 		return
 	}
 
-	fmt.Println(list)
+	// 准备导出配置，列与导入模板保持一致，导出文件可直接修改后导入
+	// 字典值列取决于所属字典的数据类型，插入在字典信息名称之后
+	columns := slices.Insert(excelutil.Columns[domainTools.DictType](), 1,
+		excelutil.ExcelColumn{
+			Title: "字符串值",
+			Field: "DictType.StrValue",
+			Width: 17,
+			Key:   "strValue",
+			Formatter: func(value interface{}) string {
+				if v, ok := value.(sql.NullString); ok && v.Valid {
+					return v.String
+				}
+				return ""
+			},
+		},
+		excelutil.ExcelColumn{
+			Title: "整型值",
+			Field: "DictType.IntValue",
+			Width: 10,
+			Key:   "intValue",
+			Formatter: func(value interface{}) string {
+				if v, ok := value.(sql.NullInt64); ok && v.Valid {
+					return strconv.FormatInt(v.Int64, 10)
+				}
+				return ""
+			},
+		},
+		excelutil.ExcelColumn{
+			Title: "布尔值",
+			Field: "DictType.BoolValue",
+			Width: 10,
+			Key:   "boolValue",
+			Formatter: func(value interface{}) string {
+				if v, ok := value.(sql.NullBool); ok && v.Valid {
+					return dict_type.BoolValueMapping[v.Bool]
+				}
+				return ""
+			},
+		},
+	)
+	filename := fmt.Sprintf("字典信息导出_%s", time.Now().Format("20060102150405"))
+	cfg := excelutil.ExcelExportConfig{
+		SheetName: "字典信息",
+		FileName:  filename,
+		BOM:       bom,
+		Columns:   columns,
+	}
+
+	// 流式写入响应
+	w := response.NewAttachmentWriter(ctx, format.FileName(filename), format.ContentType())
+	if _, err := excelutil.Write(ctx, w, format, &cfg, excelutil.SliceIterator(list)); err != nil {
+		if w.Written() {
+			zap.S().Error("写入字典信息导出文件异常 >>> ", err.Error())
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("导出字典信息异常 >>> %v", err.Error()))
+		zap.S().Error("导出字典信息异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+	}
+}
+
+// GetRecycleListPage
+// @Summary 获取字典信息回收站分页列表
+// @Description 获取已删除的字典信息分页列表，按删除时间倒序
+// @Tags 系统工具/字典信息管理
+// @Accept application/json
+// @Produce application/json
+// @Param page query int true "页码" default(1)
+// @Param pageSize query int true "每页数量" default(10)
+// @Param name query string false "名称"
+// @Param deletedBy query string false "删除人"
+// @Success 200 {object} DictTypeListPageResponse
+// @Failure 400 {object} response.Response
+// @Router /v1/tools/dictType/recycle/listPage [get]
+// @Security LoginToken
+func (h *dictTypeHandler) GetRecycleListPage(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("pageSize", "10"))
+
+	filter := domainTools.RecycleFilter{
+		Pagination: filters.Pagination{
+			Page:     page,
+			PageSize: pageSize,
+		},
+		Name:      ctx.DefaultQuery("name", ""),
+		DeletedBy: ctx.DefaultQuery("deletedBy", ""),
+	}
+
+	list, total, err := h.svc.GetRecycleListPage(ctx, filter)
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("获取字典信息回收站分页列表异常 >>> %v", err.Error()))
+		zap.S().Error("获取字典信息回收站分页列表异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", DictTypeListPageResponse{
+		List:     list,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// Restore
+// @Summary 恢复字典信息
+// @Description 从回收站恢复指定id字典信息，恢复前重新校验唯一性
+// @Tags 系统工具/字典信息管理
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/tools/dictType/recycle/restore/{id} [put]
+// @Security LoginToken
+func (h *dictTypeHandler) Restore(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "ID不能为空", nil)
+		return
+	}
+
+	if err := h.svc.Restore(ctx, id); err != nil {
+		switch {
+		case errors.Is(err, serviceTools.ErrDictTypeNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "回收站中不存在该字典信息", nil)
+		case errors.Is(err, serviceTools.ErrDictTypeDictDeleted),
+			errors.Is(err, serviceTools.ErrDictTypeNameDuplicate),
+			errors.Is(err, serviceTools.ErrDictTypeValueDuplicate):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, err.Error(), nil)
+		case errors.Is(err, serviceTools.ErrDictTypeDuplicate):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "字典信息已存在", nil)
+		default:
+			ctx.Set("internalError", fmt.Sprintf("恢复字典信息异常 >>> %v", err.Error()))
+			zap.S().Error("恢复字典信息异常 >>> ", zap.Error(err))
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		}
+		return
+	}
+
+	response.NewResponse().Success(ctx, "恢复成功", nil)
+}
+
+// Purge
+// @Summary 彻底删除字典信息
+// @Description 彻底删除回收站中的字典信息，删除后不可恢复
+// @Tags 系统工具/字典信息管理
+// @Accept application/json
+// @Produce application/json
+// @Param ids body []string true "id数组"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/tools/dictType/recycle/purge [post]
+// @Security LoginToken
+func (h *dictTypeHandler) Purge(ctx *gin.Context) {
+	var ids []string
+	if err := ctx.ShouldBindJSON(&ids); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	if err := h.svc.Purge(ctx, ids); err != nil {
+		ctx.Set("internalError", fmt.Sprintf("彻底删除字典信息异常 >>> %v", err.Error()))
+		zap.S().Error("彻底删除字典信息异常 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "彻底删除成功", nil)
 }
@@ -204,7 +204,7 @@ func Test_dictTypeHandler_Create(t *testing.T) {
 			})
 			router := server.Group("/dev-api/v1")
 			service, userService := tc.mock(ctrl)
-			h := NewDictTypeHandler(c, service, userService)
+			h := NewDictTypeHandler(c, service, userService, nil)
 			h.RegisterRoutes(router)
 
 			req, err := http.NewRequest(http.MethodPost,
@@ -287,7 +287,7 @@ func Test_dictTypeHandler_Delete(t *testing.T) {
 			})
 			router := server.Group("/dev-api/v1")
 			service := tc.mock(ctrl)
-			h := NewDictTypeHandler(c, service, nil)
+			h := NewDictTypeHandler(c, service, nil, nil)
 			h.RegisterRoutes(router)
 
 			req, err := http.NewRequest(http.MethodDelete,
@@ -478,7 +478,7 @@ func Test_dictTypeHandler_Update(t *testing.T) {
 			})
 			router := server.Group("/dev-api/v1")
 			service, userService := tc.mock(ctrl)
-			h := NewDictTypeHandler(c, service, userService)
+			h := NewDictTypeHandler(c, service, userService, nil)
 			h.RegisterRoutes(router)
 
 			req, err := http.NewRequest(http.MethodPut,
@@ -566,7 +566,7 @@ func Test_dictTypeHandler_GetById(t *testing.T) {
 			})
 			router := server.Group("/dev-api/v1")
 			service := tc.mock(ctrl)
-			h := NewDictTypeHandler(c, service, nil)
+			h := NewDictTypeHandler(c, service, nil, nil)
 			h.RegisterRoutes(router)
 
 			req, err := http.NewRequest(http.MethodGet,
@@ -587,3 +587,81 @@ func Test_dictTypeHandler_GetById(t *testing.T) {
 		})
 	}
 }
+
+func Test_dictTypeHandler_GetListByDictNames(t *testing.T) {
+	c := config.RelyConfig{}
+
+	testCases := []struct {
+		name     string
+		mock     func(ctrl *gomock.Controller) serviceTools.DictTypeService
+		query    string
+		wantCode int
+		wantMsg  string
+	}{
+		{
+			name: "查询成功",
+			mock: func(ctrl *gomock.Controller) serviceTools.DictTypeService {
+				dictTypeService := svcmocks.NewMockDictTypeService(ctrl)
+				dictTypeService.EXPECT().GetByDictNames(gomock.Any(), []string{"性别", "计量单位"}).
+					Return(map[string][]domainTools.DictOption{
+						"性别":   {{Label: "男", Value: int64(1), Tag: "primary"}},
+						"计量单位": {},
+					}, nil)
+				return dictTypeService
+			},
+			query:    "dictNames=性别&dictNames=计量单位",
+			wantCode: http.StatusOK,
+			wantMsg:  "查询成功",
+		},
+		{
+			name: "超过查询上限",
+			mock: func(ctrl *gomock.Controller) serviceTools.DictTypeService {
+				dictTypeService := svcmocks.NewMockDictTypeService(ctrl)
+				dictTypeService.EXPECT().GetByDictNames(gomock.Any(), gomock.Any()).
+					Return(nil, serviceTools.ErrDictNamesTooMany)
+				return dictTypeService
+			},
+			query:    "dictNames=性别",
+			wantCode: http.StatusBadRequest,
+			wantMsg:  serviceTools.ErrDictNamesTooMany.Error(),
+		},
+		{
+			name: "服务器异常",
+			mock: func(ctrl *gomock.Controller) serviceTools.DictTypeService {
+				dictTypeService := svcmocks.NewMockDictTypeService(ctrl)
+				dictTypeService.EXPECT().GetByDictNames(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("服务器异常"))
+				return dictTypeService
+			},
+			query:    "dictNames=性别",
+			wantCode: http.StatusInternalServerError,
+			wantMsg:  "服务器异常",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			server := gin.Default()
+			router := server.Group("/dev-api/v1")
+			service := tc.mock(ctrl)
+			h := NewDictTypeHandler(c, service, nil, nil)
+			h.RegisterRoutes(router)
+
+			req, err := http.NewRequest(http.MethodGet,
+				"/dev-api/v1/dictType/listByDictNames?"+tc.query, nil)
+			require.NoError(t, err)
+			resp := httptest.NewRecorder()
+			// 响应写回到 resp 里
+			server.ServeHTTP(resp, req)
+
+			var res response.Response
+			err = json.Unmarshal(resp.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantCode, resp.Code)
+			assert.Equal(t, tc.wantMsg, res.Message)
+		})
+	}
+}
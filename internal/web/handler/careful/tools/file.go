@@ -0,0 +1,497 @@
+/**
+ * Description：
+ * FileName：file.go
+ * Author：CJiaの用心
+ * Create：2025/11/11 19:02:57
+ * Remark：
+ */
+
+package tools
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carefuly/careful-admin-go-gin/config"
+	domainTools "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/tools"
+	modelTools "github.com/carefuly/careful-admin-go-gin/internal/model/careful/tools"
+	serviceTools "github.com/carefuly/careful-admin-go-gin/internal/service/careful/tools"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/response"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/storage"
+	"github.com/carefuly/careful-admin-go-gin/pkg/validate"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// UploadFileRequest 上传
+type UploadFileRequest struct {
+	File   *multipart.FileHeader `form:"file" binding:"required"`            // 文件
+	Bucket string                `form:"bucket" binding:"required,max=64"`   // 存储桶编码
+	Remark string                `form:"remark" binding:"omitempty,max=255"` // 备注
+}
+
+// PresignFileResponse 预签名下载地址响应
+type PresignFileResponse struct {
+	Url       string `json:"url"`       // 下载地址
+	ExpiresIn int64  `json:"expiresIn"` // 有效期(秒)
+}
+
+// FileListPageResponse 列表分页响应
+type FileListPageResponse struct {
+	List     []domainTools.File `json:"list"`     // 列表
+	Total    int64              `json:"total"`    // 总数
+	Page     int                `json:"page"`     // 页码
+	PageSize int                `json:"pageSize"` // 每页数量
+}
+
+type FileHandler interface {
+	RegisterRoutes(router *gin.RouterGroup)
+	RegisterPublicRoutes(router *gin.RouterGroup)
+	Upload(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+	GetById(ctx *gin.Context)
+	GetListPage(ctx *gin.Context)
+	Download(ctx *gin.Context)
+	Presign(ctx *gin.Context)
+	Object(ctx *gin.Context)
+	GetRecycleListPage(ctx *gin.Context)
+	Restore(ctx *gin.Context)
+	Purge(ctx *gin.Context)
+}
+
+type fileHandler struct {
+	rely config.RelyConfig
+	svc  serviceTools.FileService
+}
+
+func NewFileHandler(rely config.RelyConfig, svc serviceTools.FileService) FileHandler {
+	return &fileHandler{
+		rely: rely,
+		svc:  svc,
+	}
+}
+
+// RegisterRoutes 注册路由
+func (h *fileHandler) RegisterRoutes(router *gin.RouterGroup) {
+	base := router.Group("/file")
+	base.POST("/upload", h.Upload)
+	base.DELETE("/delete/:id", h.Delete)
+	base.GET("/getById/:id", h.GetById)
+	base.GET("/listPage", h.GetListPage)
+	base.GET("/download/:id", h.Download)
+	base.GET("/presign/:id", h.Presign)
+	base.GET("/recycle/listPage", h.GetRecycleListPage)
+	base.PUT("/recycle/restore/:id", h.Restore)
+	base.POST("/recycle/purge", h.Purge)
+}
+
+// RegisterPublicRoutes 注册免登录路由，凭预签名参数下载
+func (h *fileHandler) RegisterPublicRoutes(router *gin.RouterGroup) {
+	base := router.Group("/storage")
+	base.GET("/object", h.Object)
+}
+
+// Upload
+// @Summary 上传文件
+// @Description 上传文件到指定存储桶，文件类型以内容识别为准，同一存储桶内内容相同的文件共用存储
+// @Tags 系统工具/文件存储
+// @Accept multipart/form-data
+// @Produce application/json
+// @Param file formData file true "文件"
+// @Param bucket formData string true "存储桶编码"
+// @Param remark formData string false "备注"
+// @Success 200 {object} domainTools.File
+// @Failure 400 {object} response.Response
+// @Router /v1/tools/file/upload [post]
+// @Security LoginToken
+func (h *fileHandler) Upload(ctx *gin.Context) {
+	var req UploadFileRequest
+	if err := ctx.ShouldBind(&req); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	src, err := req.File.Open()
+	if err != nil {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "读取上传文件失败", nil)
+		return
+	}
+	defer src.Close()
+
+	// 转换为领域模型
+	domain := domainTools.File{
+		File: modelTools.File{
+			CoreModels: models.CoreModels{
+				Creator:    ctx.GetString("userId"),
+				Modifier:   ctx.GetString("userId"),
+				BelongDept: ctx.GetString("deptId"),
+				Remark:     req.Remark,
+			},
+			Name: req.File.Filename,
+			Size: req.File.Size,
+		},
+	}
+
+	created, err := h.svc.Upload(ctx, req.Bucket, domain, src)
+	if err != nil {
+		switch {
+		case errors.Is(err, serviceTools.ErrBucketNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "存储桶不存在", nil)
+		case errors.Is(err, serviceTools.ErrBucketDisabled),
+			errors.Is(err, serviceTools.ErrFileEmpty),
+			errors.Is(err, serviceTools.ErrFileMimeNotAllowed):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, err.Error(), nil)
+		case errors.Is(err, serviceTools.ErrFileTooLarge):
+			response.NewResponse().Error(ctx, http.StatusRequestEntityTooLarge, err.Error(), nil)
+		default:
+			ctx.Set("internalError", fmt.Sprintf("上传文件异常 >>> %v", err.Error()))
+			zap.S().Error("上传文件异常 >>> ", zap.Error(err))
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		}
+		return
+	}
+
+	response.NewResponse().Success(ctx, "上传成功", created)
+}
+
+// Delete
+// @Summary 删除文件
+// @Description 删除指定id文件，移入回收站，存储内容在彻底删除后释放
+// @Tags 系统工具/文件存储
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/tools/file/delete/{id} [delete]
+// @Security LoginToken
+func (h *fileHandler) Delete(ctx *gin.Context) {
+	if err := h.svc.Delete(ctx, ctx.Param("id")); err != nil {
+		if errors.Is(err, serviceTools.ErrFileNotFound) {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "文件不存在", nil)
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("删除文件失败 >>> %v", err.Error()))
+		zap.S().Error("删除文件失败 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "删除成功", nil)
+}
+
+// GetById
+// @Summary 获取文件
+// @Description 获取指定id文件信息
+// @Tags 系统工具/文件存储
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Success 200 {object} domainTools.File
+// @Failure 400 {object} response.Response
+// @Router /v1/tools/file/getById/{id} [get]
+// @Security LoginToken
+func (h *fileHandler) GetById(ctx *gin.Context) {
+	detail, err := h.svc.GetById(ctx, ctx.Param("id"))
+	if err != nil {
+		if errors.Is(err, serviceTools.ErrFileNotFound) {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "文件不存在", nil)
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("获取文件失败 >>> %v", err.Error()))
+		zap.S().Error("获取文件失败 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "获取成功", detail)
+}
+
+// GetListPage
+// @Summary 获取文件分页列表
+// @Description 获取文件分页列表，按数据权限过滤
+// @Tags 系统工具/文件存储
+// @Accept application/json
+// @Produce application/json
+// @Param page query int true "页码" default(1)
+// @Param pageSize query int true "每页数量" default(10)
+// @Param bucketId query string false "存储桶ID"
+// @Param name query string false "文件名称"
+// @Param mimeType query string false "文件类型(前缀匹配，如 image/)"
+// @Param hash query string false "内容哈希"
+// @Param startTime query string false "开始时间(2006-01-02 15:04:05)"
+// @Param endTime query string false "结束时间(2006-01-02 15:04:05)"
+// @Success 200 {object} FileListPageResponse
+// @Failure 400 {object} response.Response
+// @Router /v1/tools/file/listPage [get]
+// @Security LoginToken
+func (h *fileHandler) GetListPage(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("pageSize", "10"))
+
+	var timeRange filters.TimeRange
+	for key, target := range map[string]*time.Time{"startTime": &timeRange.StartTime, "endTime": &timeRange.EndTime} {
+		value := ctx.Query(key)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.ParseInLocation(time.DateTime, value, time.Local)
+		if err != nil {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "时间格式错误，示例：2006-01-02 15:04:05", nil)
+			return
+		}
+		*target = parsed
+	}
+	if !timeRange.Valid() {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, filters.ErrTimeRangeInvalid.Error(), nil)
+		return
+	}
+
+	filter := domainTools.FileFilter{
+		Pagination: filters.Pagination{
+			Page:     page,
+			PageSize: pageSize,
+		},
+		TimeRange: timeRange,
+		BucketId:  ctx.DefaultQuery("bucketId", ""),
+		Name:      ctx.DefaultQuery("name", ""),
+		MimeType:  ctx.DefaultQuery("mimeType", ""),
+		Hash:      ctx.DefaultQuery("hash", ""),
+	}
+
+	list, total, err := h.svc.GetListPage(ctx, filter)
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("获取文件分页列表异常 >>> %v", err.Error()))
+		zap.S().Error("获取文件分页列表异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", FileListPageResponse{
+		List:     list,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// Download
+// @Summary 下载文件
+// @Description 下载指定id文件
+// @Tags 系统工具/文件存储
+// @Produce application/octet-stream
+// @Param id path string true "id"
+// @Success 200 {file} file
+// @Failure 404 {object} response.Response
+// @Router /v1/tools/file/download/{id} [get]
+// @Security LoginToken
+func (h *fileHandler) Download(ctx *gin.Context) {
+	file, reader, err := h.svc.Open(ctx, ctx.Param("id"))
+	if err != nil {
+		switch {
+		case errors.Is(err, serviceTools.ErrFileNotFound), errors.Is(err, serviceTools.ErrObjectNotFound):
+			response.NewResponse().Error(ctx, http.StatusNotFound, "文件不存在", nil)
+		default:
+			ctx.Set("internalError", fmt.Sprintf("下载文件失败 >>> %v", err.Error()))
+			zap.S().Error("下载文件失败 >>> ", err.Error())
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		}
+		return
+	}
+	defer reader.Close()
+
+	h.write(ctx, reader, file.Name, file.MimeType)
+}
+
+// Presign
+// @Summary 获取文件下载地址
+// @Description 生成限时有效的文件下载地址，无需登录即可下载
+// @Tags 系统工具/文件存储
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Success 200 {object} PresignFileResponse
+// @Failure 400 {object} response.Response
+// @Router /v1/tools/file/presign/{id} [get]
+// @Security LoginToken
+func (h *fileHandler) Presign(ctx *gin.Context) {
+	url, err := h.svc.Presign(ctx, ctx.Param("id"))
+	if err != nil {
+		if errors.Is(err, serviceTools.ErrFileNotFound) {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "文件不存在", nil)
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("生成文件下载地址失败 >>> %v", err.Error()))
+		zap.S().Error("生成文件下载地址失败 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "获取成功", PresignFileResponse{
+		Url:       url,
+		ExpiresIn: int64(h.rely.Presign / time.Second),
+	})
+}
+
+// Object
+// @Summary 预签名下载
+// @Description 凭预签名参数下载本地存储的文件，地址由获取文件下载地址接口生成
+// @Tags 系统工具/文件存储
+// @Produce application/octet-stream
+// @Param key query string true "存储对象键"
+// @Param name query string false "下载文件名"
+// @Param expires query int true "过期时间戳(秒)"
+// @Param signature query string true "签名"
+// @Success 200 {file} file
+// @Failure 403 {object} response.Response
+// @Router /v1/storage/object [get]
+func (h *fileHandler) Object(ctx *gin.Context) {
+	key, name := ctx.Query("key"), ctx.Query("name")
+	expires, _ := strconv.ParseInt(ctx.Query("expires"), 10, 64)
+
+	reader, err := h.svc.OpenObject(ctx, key, name, expires, ctx.Query("signature"))
+	if err != nil {
+		switch {
+		case errors.Is(err, serviceTools.ErrInvalidSignature), errors.Is(err, storage.ErrInvalidKey):
+			response.NewResponse().Error(ctx, http.StatusForbidden, serviceTools.ErrInvalidSignature.Error(), nil)
+		case errors.Is(err, serviceTools.ErrObjectNotFound):
+			response.NewResponse().Error(ctx, http.StatusNotFound, "文件不存在", nil)
+		default:
+			ctx.Set("internalError", fmt.Sprintf("下载文件失败 >>> %v", err.Error()))
+			zap.S().Error("下载文件失败 >>> ", err.Error())
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		}
+		return
+	}
+	defer reader.Close()
+
+	h.write(ctx, reader, name, "application/octet-stream")
+}
+
+// GetRecycleListPage
+// @Summary 获取文件回收站分页列表
+// @Description 获取已删除的文件分页列表，按删除时间倒序
+// @Tags 系统工具/文件存储
+// @Accept application/json
+// @Produce application/json
+// @Param page query int true "页码" default(1)
+// @Param pageSize query int true "每页数量" default(10)
+// @Param name query string false "文件名"
+// @Param deletedBy query string false "删除人"
+// @Success 200 {object} FileListPageResponse
+// @Failure 400 {object} response.Response
+// @Router /v1/tools/file/recycle/listPage [get]
+// @Security LoginToken
+func (h *fileHandler) GetRecycleListPage(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("pageSize", "10"))
+
+	filter := domainTools.RecycleFilter{
+		Pagination: filters.Pagination{
+			Page:     page,
+			PageSize: pageSize,
+		},
+		Name:      ctx.DefaultQuery("name", ""),
+		DeletedBy: ctx.DefaultQuery("deletedBy", ""),
+	}
+
+	list, total, err := h.svc.GetRecycleListPage(ctx, filter)
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("获取文件回收站分页列表异常 >>> %v", err.Error()))
+		zap.S().Error("获取文件回收站分页列表异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", FileListPageResponse{
+		List:     list,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// Restore
+// @Summary 恢复文件
+// @Description 从回收站恢复指定id文件，所属存储桶需未被删除
+// @Tags 系统工具/文件存储
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/tools/file/recycle/restore/{id} [put]
+// @Security LoginToken
+func (h *fileHandler) Restore(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "ID不能为空", nil)
+		return
+	}
+
+	if err := h.svc.Restore(ctx, id); err != nil {
+		switch {
+		case errors.Is(err, serviceTools.ErrFileNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "回收站中不存在该文件", nil)
+		case errors.Is(err, serviceTools.ErrFileBucketNotFound):
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "文件所属存储桶不存在，请先恢复存储桶", nil)
+		default:
+			ctx.Set("internalError", fmt.Sprintf("恢复文件异常 >>> %v", err.Error()))
+			zap.S().Error("恢复文件异常 >>> ", zap.Error(err))
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		}
+		return
+	}
+
+	response.NewResponse().Success(ctx, "恢复成功", nil)
+}
+
+// Purge
+// @Summary 彻底删除文件
+// @Description 彻底删除回收站中的文件，存储对象不再被引用时一并删除，删除后不可恢复
+// @Tags 系统工具/文件存储
+// @Accept application/json
+// @Produce application/json
+// @Param ids body []string true "id数组"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/tools/file/recycle/purge [post]
+// @Security LoginToken
+func (h *fileHandler) Purge(ctx *gin.Context) {
+	var ids []string
+	if err := ctx.ShouldBindJSON(&ids); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	if err := h.svc.Purge(ctx, ids); err != nil {
+		ctx.Set("internalError", fmt.Sprintf("彻底删除文件异常 >>> %v", err.Error()))
+		zap.S().Error("彻底删除文件异常 >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "彻底删除成功", nil)
+}
+
+// write 以附件形式输出文件内容
+func (h *fileHandler) write(ctx *gin.Context, reader io.Reader, name, contentType string) {
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	ctx.Header("X-Content-Type-Options", "nosniff")
+
+	w := response.NewAttachmentWriter(ctx, name, contentType)
+	if _, err := io.Copy(w, reader); err != nil {
+		zap.S().Warn("输出文件内容失败 >>> ", zap.Error(err))
+		if !w.Written() {
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+/**
+ * Description：
+ * FileName：import_error.go
+ * Author：CJiaの用心
+ * Create：2025/11/6 15:21:07
+ * Remark：
+ */
+
+package tools
+
+import (
+	"github.com/carefuly/careful-admin-go-gin/config"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/response"
+	_import "github.com/carefuly/careful-admin-go-gin/pkg/utils/common/import"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"os"
+)
+
+type ImportErrorHandler interface {
+	RegisterRoutes(router *gin.RouterGroup)
+	Download(ctx *gin.Context)
+}
+
+type importErrorHandler struct {
+	rely config.RelyConfig
+}
+
+func NewImportErrorHandler(rely config.RelyConfig) ImportErrorHandler {
+	return &importErrorHandler{
+		rely: rely,
+	}
+}
+
+// RegisterRoutes 注册路由
+func (h *importErrorHandler) RegisterRoutes(router *gin.RouterGroup) {
+	base := router.Group("/import")
+	base.GET("/errorFile/:token", h.Download)
+}
+
+// Download
+// @Summary 下载导入错误数据
+// @Description 下载导入结果中的错误数据工作簿，令牌取自导入结果的 ErrorFile，过期后不可下载
+// @Tags 系统工具/导入
+// @Produce application/octet-stream
+// @Param token path string true "下载令牌"
+// @Success 200 {file} file
+// @Failure 404 {object} response.Response
+// @Router /v1/tools/import/errorFile/{token} [get]
+// @Security LoginToken
+func (h *importErrorHandler) Download(ctx *gin.Context) {
+	path, name, err := _import.OpenErrorFile(ctx.Param("token"))
+	if err != nil {
+		response.NewResponse().Error(ctx, http.StatusNotFound, err.Error(), nil)
+		return
+	}
+
+	ctx.FileAttachment(path, name)
+}
+
+// removeImportFile 删除导入上传的临时文件
+func removeImportFile(path string) {
+	if err := os.Remove(path); err != nil {
+		zap.S().Warn("删除导入临时文件失败 >>> ", zap.Error(err))
+	}
+}
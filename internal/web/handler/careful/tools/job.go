@@ -0,0 +1,225 @@
+/**
+ * Description：
+ * FileName：job.go
+ * Author：CJiaの用心
+ * Create：2025/11/7 15:40:26
+ * Remark：
+ */
+
+package tools
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carefuly/careful-admin-go-gin/config"
+	domainTools "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/tools"
+	serviceTools "github.com/carefuly/careful-admin-go-gin/internal/service/careful/tools"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/tools/job"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/response"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// jobEventInterval 任务进度推送间隔
+const jobEventInterval = time.Second
+
+// JobListPageResponse 列表分页响应
+type JobListPageResponse struct {
+	List     []domainTools.Job `json:"list"`     // 列表
+	Total    int64             `json:"total"`    // 总数
+	Page     int               `json:"page"`     // 页码
+	PageSize int               `json:"pageSize"` // 每页数量
+}
+
+type JobHandler interface {
+	RegisterRoutes(router *gin.RouterGroup)
+	GetById(ctx *gin.Context)
+	GetListPage(ctx *gin.Context)
+	Events(ctx *gin.Context)
+	Download(ctx *gin.Context)
+}
+
+type jobHandler struct {
+	rely config.RelyConfig
+	svc  serviceTools.JobService
+}
+
+func NewJobHandler(rely config.RelyConfig, svc serviceTools.JobService) JobHandler {
+	return &jobHandler{
+		rely: rely,
+		svc:  svc,
+	}
+}
+
+// RegisterRoutes 注册路由
+func (h *jobHandler) RegisterRoutes(router *gin.RouterGroup) {
+	base := router.Group("/jobs")
+	base.GET("/listPage", h.GetListPage)
+	base.GET("/:id", h.GetById)
+	base.GET("/:id/events", h.Events)
+	base.GET("/:id/download", h.Download)
+}
+
+// GetById
+// @Summary 获取后台任务
+// @Description 获取本人提交的后台任务状态与执行进度
+// @Tags 系统工具/后台任务
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "id"
+// @Success 200 {object} domainTools.Job
+// @Failure 400 {object} response.Response
+// @Router /v1/jobs/{id} [get]
+// @Security LoginToken
+func (h *jobHandler) GetById(ctx *gin.Context) {
+	detail, err := h.svc.GetById(ctx, ctx.Param("id"), ctx.GetString("userId"))
+	if err != nil {
+		if errors.Is(err, serviceTools.ErrJobNotFound) {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "任务不存在", nil)
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("获取后台任务失败 >>> %v", err.Error()))
+		zap.S().Error("获取后台任务失败 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "获取成功", detail)
+}
+
+// GetListPage
+// @Summary 获取后台任务分页列表
+// @Description 获取本人提交的后台任务分页列表
+// @Tags 系统工具/后台任务
+// @Accept application/json
+// @Produce application/json
+// @Param page query int true "页码" default(1)
+// @Param pageSize query int true "每页数量" default(10)
+// @Param name query string false "任务名称"
+// @Param type query int false "任务类型(1-导入 2-导出)" default(0)
+// @Param status query int false "任务状态(1-排队中 2-执行中 3-已完成 4-失败 5-已过期)" default(0)
+// @Success 200 {object} JobListPageResponse
+// @Failure 400 {object} response.Response
+// @Router /v1/jobs/listPage [get]
+// @Security LoginToken
+func (h *jobHandler) GetListPage(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("pageSize", "10"))
+	name := ctx.DefaultQuery("name", "")
+	jobType, _ := strconv.Atoi(ctx.DefaultQuery("type", "0"))
+	status, _ := strconv.Atoi(ctx.DefaultQuery("status", "0"))
+
+	filter := domainTools.JobFilter{
+		Pagination: filters.Pagination{
+			Page:     page,
+			PageSize: pageSize,
+		},
+		Filters: filters.Filters{
+			Creator: ctx.GetString("userId"),
+		},
+		Name:   name,
+		Type:   job.TypeConst(jobType),
+		Status: job.StatusConst(status),
+	}
+
+	list, total, err := h.svc.GetListPage(ctx, filter)
+	if err != nil {
+		ctx.Set("internalError", fmt.Sprintf("获取后台任务分页列表异常 >>> %v", err.Error()))
+		zap.S().Error("获取后台任务分页列表异常 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	response.NewResponse().Success(ctx, "查询成功", JobListPageResponse{
+		List:     list,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// Events
+// @Summary 订阅后台任务进度
+// @Description 以SSE推送任务状态(event: progress)，任务结束后推送最终状态并关闭连接
+// @Tags 系统工具/后台任务
+// @Produce text/event-stream
+// @Param id path string true "id"
+// @Success 200 {object} domainTools.Job
+// @Failure 400 {object} response.Response
+// @Router /v1/jobs/{id}/events [get]
+// @Security LoginToken
+func (h *jobHandler) Events(ctx *gin.Context) {
+	id, userId := ctx.Param("id"), ctx.GetString("userId")
+
+	detail, err := h.svc.GetById(ctx, id, userId)
+	if err != nil {
+		if errors.Is(err, serviceTools.ErrJobNotFound) {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "任务不存在", nil)
+			return
+		}
+		ctx.Set("internalError", fmt.Sprintf("获取后台任务失败 >>> %v", err.Error()))
+		zap.S().Error("获取后台任务失败 >>> ", err.Error())
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		return
+	}
+
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("X-Accel-Buffering", "no")
+
+	ticker := time.NewTicker(jobEventInterval)
+	defer ticker.Stop()
+
+	ctx.Stream(func(w io.Writer) bool {
+		ctx.SSEvent("progress", detail)
+		if detail.Status.Finished() {
+			return false
+		}
+
+		select {
+		case <-ctx.Request.Context().Done():
+			return false
+		case <-ticker.C:
+		}
+
+		if detail, err = h.svc.GetById(ctx, id, userId); err != nil {
+			zap.S().Warn("推送后台任务进度失败 >>> ", zap.Error(err))
+			ctx.SSEvent("error", "获取任务状态失败")
+			return false
+		}
+		return true
+	})
+}
+
+// Download
+// @Summary 下载后台任务结果
+// @Description 下载已完成任务的结果文件，结果文件过期清理后不可下载
+// @Tags 系统工具/后台任务
+// @Produce application/octet-stream
+// @Param id path string true "id"
+// @Success 200 {file} file
+// @Failure 404 {object} response.Response
+// @Router /v1/jobs/{id}/download [get]
+// @Security LoginToken
+func (h *jobHandler) Download(ctx *gin.Context) {
+	path, name, err := h.svc.Artifact(ctx, ctx.Param("id"), ctx.GetString("userId"))
+	if err != nil {
+		switch {
+		case errors.Is(err, serviceTools.ErrJobNotFound):
+			response.NewResponse().Error(ctx, http.StatusNotFound, "任务不存在", nil)
+		case errors.Is(err, serviceTools.ErrJobArtifactNotReady):
+			response.NewResponse().Error(ctx, http.StatusNotFound, err.Error(), nil)
+		default:
+			ctx.Set("internalError", fmt.Sprintf("下载后台任务结果失败 >>> %v", err.Error()))
+			zap.S().Error("下载后台任务结果失败 >>> ", err.Error())
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+		}
+		return
+	}
+
+	ctx.FileAttachment(path, name)
+}
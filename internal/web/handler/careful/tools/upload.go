@@ -0,0 +1,245 @@
+/**
+ * Description：
+ * FileName：upload.go
+ * Author：CJiaの用心
+ * Create：2025/11/12 14:03:19
+ * Remark：
+ */
+
+package tools
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carefuly/careful-admin-go-gin/config"
+	domainTools "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/tools"
+	serviceTools "github.com/carefuly/careful-admin-go-gin/internal/service/careful/tools"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/response"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/request_utils"
+	"github.com/carefuly/careful-admin-go-gin/pkg/validate"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// ChunkChecksumHeader 分片校验和请求头，值为分片内容的 SHA-256 十六进制串
+const ChunkChecksumHeader = "X-Chunk-Checksum"
+
+// InitUploadRequest 创建上传会话
+type InitUploadRequest struct {
+	Bucket string `json:"bucket" binding:"omitempty,max=64"`          // 存储桶编码，为空时仅可用于导入
+	Name   string `json:"name" binding:"required,max=255"`            // 文件名称
+	Size   int64  `json:"size" binding:"required,min=1"`              // 文件大小(字节)
+	Hash   string `json:"hash" binding:"required,len=64,hexadecimal"` // 文件内容哈希(SHA-256)
+}
+
+type UploadHandler interface {
+	RegisterRoutes(router *gin.RouterGroup)
+	Init(ctx *gin.Context)
+	Get(ctx *gin.Context)
+	PutChunk(ctx *gin.Context)
+	Complete(ctx *gin.Context)
+	Abort(ctx *gin.Context)
+}
+
+type uploadHandler struct {
+	rely config.RelyConfig
+	svc  serviceTools.UploadService
+}
+
+func NewUploadHandler(rely config.RelyConfig, svc serviceTools.UploadService) UploadHandler {
+	return &uploadHandler{
+		rely: rely,
+		svc:  svc,
+	}
+}
+
+// RegisterRoutes 注册路由
+func (h *uploadHandler) RegisterRoutes(router *gin.RouterGroup) {
+	base := router.Group("/upload")
+	base.POST("/init", h.Init)
+	base.GET("/:id", h.Get)
+	base.PUT("/:id/chunks/:index", h.PutChunk)
+	base.POST("/:id/complete", h.Complete)
+	base.DELETE("/:id", h.Abort)
+}
+
+// Init
+// @Summary 创建分片上传会话
+// @Description 创建分片上传会话并返回会话ID与分片大小，同一用户上传同一文件时返回未完成的会话及已上传分片用于断点续传
+// @Tags 系统工具/分片上传
+// @Accept application/json
+// @Produce application/json
+// @Param InitUploadRequest body InitUploadRequest true "参数"
+// @Success 200 {object} domainTools.UploadSession
+// @Failure 400 {object} response.Response
+// @Router /v1/tools/upload/init [post]
+// @Security LoginToken
+func (h *uploadHandler) Init(ctx *gin.Context) {
+	var req InitUploadRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validate.NewValidatorErrorHandler(h.rely.Trans).Handle(ctx, err)
+		return
+	}
+
+	session, err := h.svc.Init(ctx, domainTools.UploadSession{
+		UserId: ctx.GetString("userId"),
+		DeptId: ctx.GetString("deptId"),
+		Bucket: req.Bucket,
+		Name:   req.Name,
+		Size:   req.Size,
+		Hash:   req.Hash,
+	})
+	if err != nil {
+		handleUploadError(ctx, err, "创建上传会话异常")
+		return
+	}
+
+	response.NewResponse().Success(ctx, "创建成功", session)
+}
+
+// Get
+// @Summary 获取分片上传会话
+// @Description 获取本人的分片上传会话及已上传的分片序号
+// @Tags 系统工具/分片上传
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "上传会话ID"
+// @Success 200 {object} domainTools.UploadSession
+// @Failure 400 {object} response.Response
+// @Router /v1/tools/upload/{id} [get]
+// @Security LoginToken
+func (h *uploadHandler) Get(ctx *gin.Context) {
+	session, err := h.svc.Get(ctx, ctx.Param("id"), ctx.GetString("userId"))
+	if err != nil {
+		handleUploadError(ctx, err, "获取上传会话异常")
+		return
+	}
+
+	response.NewResponse().Success(ctx, "获取成功", session)
+}
+
+// PutChunk
+// @Summary 上传分片
+// @Description 请求体为分片原始内容，除最后一个分片外长度须等于分片大小，重复上传同一分片会覆盖
+// @Tags 系统工具/分片上传
+// @Accept application/octet-stream
+// @Produce application/json
+// @Param id path string true "上传会话ID"
+// @Param index path int true "分片序号(从0开始)"
+// @Param X-Chunk-Checksum header string true "分片内容的SHA-256十六进制串"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/tools/upload/{id}/chunks/{index} [put]
+// @Security LoginToken
+func (h *uploadHandler) PutChunk(ctx *gin.Context) {
+	index, err := strconv.Atoi(ctx.Param("index"))
+	if err != nil {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, serviceTools.ErrUploadChunkIndex.Error(), nil)
+		return
+	}
+	checksum := ctx.GetHeader(ChunkChecksumHeader)
+	if checksum == "" {
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "缺少分片校验和请求头 "+ChunkChecksumHeader, nil)
+		return
+	}
+
+	if err := h.svc.PutChunk(ctx, ctx.Param("id"), ctx.GetString("userId"), index, checksum, ctx.Request.Body); err != nil {
+		handleUploadError(ctx, err, "上传分片异常")
+		return
+	}
+
+	response.NewResponse().Success(ctx, "上传成功", nil)
+}
+
+// Complete
+// @Summary 完成分片上传
+// @Description 合并全部分片并校验文件哈希，通过后写入会话指定的存储桶
+// @Tags 系统工具/分片上传
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "上传会话ID"
+// @Success 200 {object} domainTools.File
+// @Failure 400 {object} response.Response
+// @Router /v1/tools/upload/{id}/complete [post]
+// @Security LoginToken
+func (h *uploadHandler) Complete(ctx *gin.Context) {
+	file, err := h.svc.Complete(ctx, ctx.Param("id"), ctx.GetString("userId"))
+	if err != nil {
+		handleUploadError(ctx, err, "完成分片上传异常")
+		return
+	}
+
+	response.NewResponse().Success(ctx, "上传成功", file)
+}
+
+// Abort
+// @Summary 取消分片上传
+// @Description 取消分片上传会话并删除已上传分片
+// @Tags 系统工具/分片上传
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "上传会话ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /v1/tools/upload/{id} [delete]
+// @Security LoginToken
+func (h *uploadHandler) Abort(ctx *gin.Context) {
+	if err := h.svc.Abort(ctx, ctx.Param("id"), ctx.GetString("userId")); err != nil {
+		handleUploadError(ctx, err, "取消分片上传异常")
+		return
+	}
+
+	response.NewResponse().Success(ctx, "取消成功", nil)
+}
+
+// handleUploadError 分片上传错误响应
+func handleUploadError(ctx *gin.Context, err error, msg string) {
+	switch {
+	case errors.Is(err, serviceTools.ErrBucketNotFound):
+		response.NewResponse().Error(ctx, http.StatusBadRequest, "存储桶不存在", nil)
+	case errors.Is(err, serviceTools.ErrUploadSessionNotFound),
+		errors.Is(err, serviceTools.ErrUploadHashInvalid),
+		errors.Is(err, serviceTools.ErrUploadChunkIndex),
+		errors.Is(err, serviceTools.ErrUploadChunkSize),
+		errors.Is(err, serviceTools.ErrUploadChunkChecksum),
+		errors.Is(err, serviceTools.ErrUploadIncomplete),
+		errors.Is(err, serviceTools.ErrUploadHashMismatch),
+		errors.Is(err, serviceTools.ErrUploadBucketRequired),
+		errors.Is(err, serviceTools.ErrBucketDisabled),
+		errors.Is(err, serviceTools.ErrFileEmpty),
+		errors.Is(err, serviceTools.ErrFileMimeNotAllowed):
+		response.NewResponse().Error(ctx, http.StatusBadRequest, err.Error(), nil)
+	case errors.Is(err, serviceTools.ErrFileTooLarge), errors.Is(err, serviceTools.ErrUploadTooLarge):
+		response.NewResponse().Error(ctx, http.StatusRequestEntityTooLarge, err.Error(), nil)
+	default:
+		ctx.Set("internalError", fmt.Sprintf("%s >>> %v", msg, err.Error()))
+		zap.S().Error(msg+" >>> ", zap.Error(err))
+		response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器异常", nil)
+	}
+}
+
+// saveImportFile 保存导入文件，指定上传会话时合并其分片，否则保存表单上传的文件
+// 失败时已写入错误响应，返回 false
+func saveImportFile(ctx *gin.Context, uploadSvc serviceTools.UploadService, userId string, file *multipart.FileHeader, uploadId string) (string, bool) {
+	if file != nil {
+		filePath, err := request_utils.SaveUploadedFile(ctx, file, "./uploads")
+		if err != nil {
+			response.NewResponse().Error(ctx, http.StatusBadRequest, "保存文件失败", nil)
+			return "", false
+		}
+		return filePath, true
+	}
+
+	dir := filepath.Join("./uploads", time.Now().Format("2006-01-02"))
+	filePath, err := uploadSvc.Assemble(ctx, uploadId, userId, dir)
+	if err != nil {
+		handleUploadError(ctx, err, "合并导入文件异常")
+		return "", false
+	}
+	return filePath, true
+}
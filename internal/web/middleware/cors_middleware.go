@@ -27,9 +27,9 @@ func (c *CorsMiddlewareBuilder) Build() gin.HandlerFunc {
 	return cors.New(cors.Config{
 		AllowOrigins: []string{"*"},
 		AllowMethods: []string{"POST", "DELETE", "PUT", "GET", "OPTIONS", "UPDATE"},
-		AllowHeaders: []string{"Origin", "Accept", "Content-Type", "Authorization", "X-Requested-Id", "X-Requested-Sign"},
+		AllowHeaders: []string{"Origin", "Accept", "Content-Type", "Authorization", "X-Requested-Id", "X-Requested-Sign", RequestIdHeader},
 		// 响应头
-		ExposeHeaders: []string{"Content-Length", "Access-Control-Allow-Origin", "Access-Control-Allow-Headers", "x-jwt-token"},
+		ExposeHeaders: []string{"Content-Length", "Access-Control-Allow-Origin", "Access-Control-Allow-Headers", "x-jwt-token", RefreshedTokenHeader, RequestIdHeader},
 		// 是否允许带 cookie 之类的东西
 		AllowCredentials: true,
 		AllowOriginFunc: func(origin string) bool {
@@ -0,0 +1,95 @@
+/**
+ * Description：
+ * FileName：data_scope_middleware.go
+ * Author：CJiaの用心
+ * Create：2025/10/29 11:36:02
+ * Remark：
+ */
+
+package middleware
+
+import (
+	"github.com/carefuly/careful-admin-go-gin/config"
+	cacheSystem "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/careful/system"
+	cacheDecoratorSystem "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/decorator/careful/system"
+	cacheRecord "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/decorator/record"
+	daoSystem "github.com/carefuly/careful-admin-go-gin/internal/repository/dao/careful/system"
+	repositorySystem "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/system"
+	serviceSystem "github.com/carefuly/careful-admin-go-gin/internal/service/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/filters"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/response"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"strings"
+)
+
+// DataScopeMiddlewareBuilder 解析当前用户的数据权限并写入上下文，
+// 由 filters.Filters.QueryFilter 自动应用到列表查询，需在JWT中间件之后使用
+type DataScopeMiddlewareBuilder struct {
+	rely           config.RelyConfig
+	ignorePrefixes []string
+	svc            serviceSystem.DataScopeService
+}
+
+// NewDataScopeMiddlewareBuilder 创建数据权限中间件
+func NewDataScopeMiddlewareBuilder(rely config.RelyConfig) *DataScopeMiddlewareBuilder {
+	userCache := cacheSystem.NewRedisUserCache(rely.Redis)
+	userCacheLogger := cacheRecord.NewCacheLogger(rely.LogSink)
+	userCacheLoggingDecorator := cacheDecoratorSystem.NewUserCacheLoggingDecorator(userCache, userCacheLogger)
+	userRepository := repositorySystem.NewUserRepository(daoSystem.NewGORMUserDAO(rely.Db.Careful), userCacheLoggingDecorator)
+
+	permissionCache := cacheSystem.NewRedisUserPermissionCache(rely.Redis)
+	permissionCacheLogger := cacheRecord.NewCacheLogger(rely.LogSink)
+	permissionCacheLoggingDecorator := cacheDecoratorSystem.NewUserPermissionCacheLoggingDecorator(permissionCache, permissionCacheLogger)
+	dataScopeCache := cacheSystem.NewRedisDataScopeCache(rely.Redis)
+	roleRepository := repositorySystem.NewRoleRepository(daoSystem.NewGORMRoleDAO(rely.Db.Careful), permissionCacheLoggingDecorator, dataScopeCache)
+
+	deptCache := cacheSystem.NewRedisDeptCache(rely.Redis)
+	deptCacheLogger := cacheRecord.NewCacheLogger(rely.LogSink)
+	deptCacheLoggingDecorator := cacheDecoratorSystem.NewDeptCacheLoggingDecorator(deptCache, deptCacheLogger)
+	deptRepository := repositorySystem.NewDeptRepository(daoSystem.NewGORMDeptDAO(rely.Db.Careful), deptCacheLoggingDecorator)
+
+	return &DataScopeMiddlewareBuilder{
+		rely: rely,
+		svc:  serviceSystem.NewDataScopeService(userRepository, roleRepository, deptRepository, dataScopeCache),
+	}
+}
+
+// IgnorePrefix 添加忽略的路由前缀（不含接口前缀），如 /v1/auth/
+func (d *DataScopeMiddlewareBuilder) IgnorePrefix(prefix string) *DataScopeMiddlewareBuilder {
+	d.ignorePrefixes = append(d.ignorePrefixes, prefix)
+	return d
+}
+
+// Build 数据权限中间件
+func (d *DataScopeMiddlewareBuilder) Build() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		fullPath := ctx.FullPath()
+		idx := strings.Index(fullPath, "/v1/")
+		if idx < 0 {
+			return
+		}
+		for _, prefix := range d.ignorePrefixes {
+			if strings.HasPrefix(fullPath[idx:], prefix) {
+				return
+			}
+		}
+
+		userId := ctx.GetString("userId")
+		if userId == "" {
+			return
+		}
+
+		scope, err := d.svc.GetUserDataScope(ctx, userId)
+		if err != nil {
+			zap.L().Error("数据权限解析失败", zap.String("userId", userId), zap.Error(err))
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器内部错误", nil)
+			ctx.Abort()
+			return
+		}
+		ctx.Set(filters.DataScopeKey, scope)
+
+		ctx.Next()
+	}
+}
@@ -85,8 +85,14 @@ func (l *Storage) Build() gin.HandlerFunc {
 			responseBody := crw.Body.String()
 			responseJson := crw.Format(responseBody)
 
+			// 记录操作人及其部门，日志列表按数据权限过滤时依赖这两个字段
+			userId := c.GetString("userId")
 			model := loggerModel.OperateLogger{
-				CoreModels:      models.CoreModels{},
+				CoreModels: models.CoreModels{
+					Creator:    userId,
+					Modifier:   userId,
+					BelongDept: c.GetString("deptId"),
+				},
 				RequestUsername: request_utils.GetRequestUser(c),
 				RequestTime:     fmt.Sprintf("%v", requestTime),
 				RequestStatus:   c.Writer.Status(),
@@ -103,8 +109,9 @@ func (l *Storage) Build() gin.HandlerFunc {
 				RequestInternal: l.GetResValue(c, "internalError"),
 			}
 
-			// 记录日志
-			model.Insert(c, l.rely.Db.Careful, model)
+			// 记录日志（异步批量落库，传入副本避免与后续读取产生竞争）
+			entity := model
+			l.rely.LogSink.WriteOperate(&entity)
 
 			// GET请求不持久化日志
 			if requestMethod != "GET" {
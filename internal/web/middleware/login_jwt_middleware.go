@@ -32,26 +32,26 @@ type LoginJWTMiddlewareBuilder struct {
 	ignorePaths    []string
 	jwtService     *jwt.DefaultJWTService
 	tokenBlacklist *jwt.TokenBlacklist
+	refreshManager *jwt.RefreshTokenManager
 }
 
+// RefreshedTokenHeader 滑动续期后返回新访问令牌的响应头
+const RefreshedTokenHeader = "X-Refreshed-Token"
+
 // NewLoginJWTMiddlewareBuilder 创建JWT中间件
 func NewLoginJWTMiddlewareBuilder(rely config.RelyConfig) *LoginJWTMiddlewareBuilder {
 	// 创建JWT服务
-	jwtConfig := jwt.TokenConfig{
-		Secret:      rely.Token.Secret,
-		ExpireHours: rely.Token.Expire,
-		Issuer:      "careful@用心",
-		Audience:    []string{"careful-admin"},
-		MaxRefresh:  24 * time.Hour, // 允许在24小时内刷新
-	}
+	tokenSource := jwt.LiveTokenSource(rely.Token, rely.Keys)
 
-	jwtService := jwt.NewJWTService(jwtConfig)
+	jwtService := jwt.NewJWTServiceFromSource(tokenSource)
 	tokenBlacklist := jwt.NewTokenBlacklist(rely.Redis)
+	refreshManager := jwt.NewRefreshTokenManagerFromSource(rely.Redis, tokenSource)
 
 	return &LoginJWTMiddlewareBuilder{
 		rely:           rely,
 		jwtService:     jwtService,
 		tokenBlacklist: tokenBlacklist,
+		refreshManager: refreshManager,
 	}
 }
 
@@ -141,6 +141,26 @@ func (l *LoginJWTMiddlewareBuilder) Build() gin.HandlerFunc {
 			return
 		}
 
+		// 检查会话是否有效(已退出、被强制下线或刷新令牌被重复使用的会话)
+		// 未归属会话的令牌无法注销，一律拒绝
+		if claims.SessionId == "" {
+			response.NewResponse().Error(ctx, http.StatusUnauthorized, "会话已失效，请重新登录", nil)
+			ctx.Abort()
+			return
+		}
+		active, err := l.refreshManager.IsActive(ctx, claims.SessionId)
+		if err != nil {
+			zap.L().Error("检查会话状态失败", zap.Error(err))
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器内部错误", nil)
+			ctx.Abort()
+			return
+		}
+		if !active {
+			response.NewResponse().Error(ctx, http.StatusUnauthorized, "会话已失效，请重新登录", nil)
+			ctx.Abort()
+			return
+		}
+
 		// gin.Context.Set() 方法将数据存储到上下文，可以在后续的中间件或处理程序中访问。
 		// 通过 gin.Context.Get() 方法获取存储在上下文中的数据。
 		// 通过 gin.Context.Set() 方法存储数据时，需要指定一个键，以便在后续的中间件或处理程序中访问该数据。
@@ -155,7 +175,6 @@ func (l *LoginJWTMiddlewareBuilder) Build() gin.HandlerFunc {
 		ctx.Set("username", claims.UserInfo["username"])
 		ctx.Set("deptId", claims.UserInfo["deptId"])
 		ctx.Set("userInfo", claims.UserInfo)
-		ctx.Set("X-Request-ID", claims.UserId)
 
 		// 刷新token(如果接近过期)
 		l.maybeRefreshToken(ctx, claims, tokenStr)
@@ -176,14 +195,22 @@ func (l *LoginJWTMiddlewareBuilder) containsAnySubstring(str string, subs []stri
 
 // maybeRefreshToken 如果token接近过期，则刷新它
 func (l *LoginJWTMiddlewareBuilder) maybeRefreshToken(ctx *gin.Context, claims *jwt.Claims, currentToken string) {
-	// 如果token在30分钟内过期，则刷新它
-	// if time.Until(claims.ExpiresAt.Time) < 30*time.Minute {
-	// 	newToken, err := l.jwtService.RefreshToken(ctx, currentToken)
-	// 	if err == nil {
-	// 		// 将新token设置在响应头中
-	// 		ctx.Header("X-Refreshed-Token", newToken)
-	// 	}
-	// }
+	window := l.jwtService.Config().RenewWindow
+	if window <= 0 || claims.ExpiresAt == nil {
+		return
+	}
+	// 剩余有效期充足或已超过最大刷新时间
+	if time.Until(claims.ExpiresAt.Time) >= window || !l.jwtService.CanRenew(claims) {
+		return
+	}
+	newToken, err := l.jwtService.RenewToken(ctx, claims)
+	if err != nil {
+		zap.L().Warn("令牌续期失败", zap.String("userId", claims.UserId), zap.Error(err))
+		return
+	}
+
+	// 将新token设置在响应头中
+	ctx.Header(RefreshedTokenHeader, newToken)
 }
 
 // GetUserIDFromContext 从上下文中获取用户ID
@@ -0,0 +1,109 @@
+/**
+ * Description：
+ * FileName：permission_middleware.go
+ * Author：CJiaの用心
+ * Create：2025/10/27 14:20:41
+ * Remark：
+ */
+
+package middleware
+
+import (
+	"github.com/carefuly/careful-admin-go-gin/config"
+	cacheSystem "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/careful/system"
+	cacheDecoratorSystem "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/decorator/careful/system"
+	cacheRecord "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/decorator/record"
+	daoSystem "github.com/carefuly/careful-admin-go-gin/internal/repository/dao/careful/system"
+	repositorySystem "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/system"
+	serviceSystem "github.com/carefuly/careful-admin-go-gin/internal/service/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/response"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"strings"
+)
+
+var (
+	ForbiddenPermission = "无权限访问该接口"
+)
+
+// PermissionMiddlewareBuilder 接口权限校验，需在JWT中间件之后使用
+type PermissionMiddlewareBuilder struct {
+	rely           config.RelyConfig
+	ignorePrefixes []string
+	svc            serviceSystem.PermissionService
+}
+
+// NewPermissionMiddlewareBuilder 创建接口权限中间件
+func NewPermissionMiddlewareBuilder(rely config.RelyConfig) *PermissionMiddlewareBuilder {
+	cache := cacheSystem.NewRedisUserPermissionCache(rely.Redis)
+	cacheLogger := cacheRecord.NewCacheLogger(rely.LogSink)
+	cacheLoggingDecorator := cacheDecoratorSystem.NewUserPermissionCacheLoggingDecorator(cache, cacheLogger)
+	repo := repositorySystem.NewPermissionRepository(
+		daoSystem.NewGORMPermissionDAO(rely.Db.Careful),
+		daoSystem.NewGORMUserDAO(rely.Db.Careful),
+		cacheLoggingDecorator,
+	)
+
+	return &PermissionMiddlewareBuilder{
+		rely: rely,
+		svc:  serviceSystem.NewPermissionService(repo),
+	}
+}
+
+// IgnorePrefix 添加忽略的路由前缀（不含接口前缀），如 /v1/auth/
+func (p *PermissionMiddlewareBuilder) IgnorePrefix(prefix string) *PermissionMiddlewareBuilder {
+	p.ignorePrefixes = append(p.ignorePrefixes, prefix)
+	return p
+}
+
+// Build 接口权限中间件
+func (p *PermissionMiddlewareBuilder) Build() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		// 未匹配的路由交由404处理
+		path := p.routePath(ctx.FullPath())
+		if path == "" {
+			return
+		}
+		for _, prefix := range p.ignorePrefixes {
+			if strings.HasPrefix(path, prefix) {
+				return
+			}
+		}
+
+		// 无需登录的接口需通过 IgnorePrefix 忽略，其余接口缺少登录用户时一律拒绝
+		userId := ctx.GetString("userId")
+		if userId == "" {
+			response.NewResponse().Error(ctx, http.StatusUnauthorized, UnauthorizedNotFound, nil)
+			ctx.Abort()
+			return
+		}
+
+		allowed, err := p.svc.CheckPermission(ctx, userId, ctx.Request.Method, path)
+		if err != nil {
+			zap.L().Error("接口权限校验失败", zap.String("userId", userId), zap.Error(err))
+			response.NewResponse().Error(ctx, http.StatusInternalServerError, "服务器内部错误", nil)
+			ctx.Abort()
+			return
+		}
+		if !allowed {
+			response.NewResponse().Error(ctx, http.StatusForbidden, ForbiddenPermission, nil)
+			ctx.Abort()
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+// routePath 去除 /dev-api、/pro-api 等接口前缀，返回以 /v1 开头的路由定义
+func (p *PermissionMiddlewareBuilder) routePath(fullPath string) string {
+	if fullPath == "" {
+		return ""
+	}
+	if idx := strings.Index(fullPath, "/v1/"); idx >= 0 {
+		return fullPath[idx:]
+	}
+	// 非业务接口（健康检查、静态资源等）
+	return ""
+}
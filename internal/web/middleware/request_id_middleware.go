@@ -0,0 +1,44 @@
+/**
+ * Description：
+ * FileName：request_id_middleware.go
+ * Author：CJiaの用心
+ * Create：2025/11/14 14:02:45
+ * Remark：
+ */
+
+package middleware
+
+import (
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	// RequestIdHeader 请求ID请求头与响应头
+	RequestIdHeader = "X-Request-ID"
+	// maxRequestIdLength 客户端传入请求ID的最大长度，超出时重新生成
+	maxRequestIdLength = 64
+)
+
+// RequestIdMiddlewareBuilder 请求ID中间件
+// 沿用客户端传入的请求ID或生成新的请求ID，写入上下文与响应头，用于关联响应、日志与数据变更记录
+type RequestIdMiddlewareBuilder struct {
+}
+
+func NewRequestIdMiddlewareBuilder() *RequestIdMiddlewareBuilder {
+	return &RequestIdMiddlewareBuilder{}
+}
+
+func (r *RequestIdMiddlewareBuilder) Build() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestId := ctx.GetHeader(RequestIdHeader)
+		if requestId == "" || len(requestId) > maxRequestIdLength {
+			requestId = uuid.NewString()
+		}
+
+		ctx.Set(models.RequestIdKey, requestId)
+		ctx.Header(RequestIdHeader, requestId)
+		ctx.Next()
+	}
+}
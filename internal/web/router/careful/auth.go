@@ -38,22 +38,32 @@ func (r *AuthRouter) RegisterRouter() {
 	baseRouter := r.router.Group("/auth")
 
 	userCache := cacheSystem.NewRedisUserCache(r.rely.Redis)
-	userCacheLogger := cacheRecord.NewCacheLogger(r.rely.Db.Careful)
+	userCacheLogger := cacheRecord.NewCacheLogger(r.rely.LogSink)
 	userCacheLoggingDecorator := cacheDecoratorSystem.NewUserCacheLoggingDecorator(userCache, userCacheLogger)
 	userDAO := daoSystem.NewGORMUserDAO(r.rely.Db.Careful)
 	userRepository := repositorySystem.NewUserRepository(userDAO, userCacheLoggingDecorator)
-	userService := serviceSystem.NewUserService(userRepository)
 	// jwt配置
-	jwtConfig := jwt.TokenConfig{
-		Secret:      r.rely.Token.Secret,
-		ExpireHours: r.rely.Token.Expire,
-		Issuer:      "careful@用心",
-		Audience:    []string{"careful-admin"},
-		MaxRefresh:  24 * time.Hour, // 允许在24小时内刷新
-	}
-	jwtService := jwt.NewJWTService(jwtConfig)
+	tokenSource := jwt.LiveTokenSource(r.rely.Token, r.rely.Keys)
+	jwtService := jwt.NewJWTServiceFromSource(tokenSource)
 	// 黑名单配置
 	blacklistService := jwt.NewTokenBlacklist(r.rely.Redis)
-	authHandler := authSystem.NewAuthHandler(r.rely, userService, jwtService, blacklistService)
+	// 刷新令牌
+	refreshService := jwt.NewRefreshTokenManagerFromSource(r.rely.Redis, tokenSource)
+	userService := serviceSystem.NewUserService(userRepository, refreshService)
+	// 菜单
+	menuDAO := daoSystem.NewGORMMenuDAO(r.rely.Db.Careful)
+	menuRepository := repositorySystem.NewMenuRepository(menuDAO)
+	menuService := serviceSystem.NewMenuService(menuRepository, userRepository)
+	// 登录防护，阈值在服务启动时读取
+	token := r.rely.Token.Load()
+	loginGuardCache := cacheSystem.NewRedisLoginGuardCache(r.rely.Redis)
+	loginGuardService := serviceSystem.NewLoginGuardService(loginGuardCache, serviceSystem.LoginGuardConfig{
+		MaxUserFailures:  int64(token.LoginMaxUserFailures),
+		MaxIpFailures:    int64(token.LoginMaxIpFailures),
+		FailureWindow:    time.Duration(token.LoginFailureWindow) * time.Minute,
+		LockDuration:     time.Duration(token.LoginLockDuration) * time.Minute,
+		CaptchaThreshold: int64(token.LoginCaptchaThreshold),
+	})
+	authHandler := authSystem.NewAuthHandler(r.rely, userService, jwtService, blacklistService, refreshService, menuService, loginGuardService)
 	authHandler.RegisterRoutes(baseRouter)
 }
@@ -28,6 +28,12 @@ func NewRouter(rely config.RelyConfig, router *gin.RouterGroup) *Router {
 func (r *Router) RegisterRoutes() {
 	// 认证管理
 	NewAuthRouter(r.rely, r.router).RegisterRouter()
+	// 系统管理
+	NewSystemRouter(r.rely, r.router).RegisterRouter()
 	// 系统工具
 	NewToolsRouter(r.rely, r.router).RegisterRouter()
+	// 后台任务
+	NewJobRouter(r.rely, r.router).RegisterRouter()
+	// 日志管理
+	NewLoggerRouter(r.rely, r.router).RegisterRouter()
 }
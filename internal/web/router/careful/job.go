@@ -0,0 +1,42 @@
+/**
+ * Description：
+ * FileName：job.go
+ * Author：CJiaの用心
+ * Create：2025/11/7 16:05:43
+ * Remark：
+ */
+
+package careful
+
+import (
+	"github.com/carefuly/careful-admin-go-gin/config"
+	daoTools "github.com/carefuly/careful-admin-go-gin/internal/repository/dao/careful/tools"
+	repositoryTools "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/tools"
+	serviceTools "github.com/carefuly/careful-admin-go-gin/internal/service/careful/tools"
+	handlerTools "github.com/carefuly/careful-admin-go-gin/internal/web/handler/careful/tools"
+	"github.com/gin-gonic/gin"
+)
+
+type JobRouter struct {
+	rely   config.RelyConfig
+	router *gin.RouterGroup
+}
+
+func NewJobRouter(rely config.RelyConfig, router *gin.RouterGroup) *JobRouter {
+	return &JobRouter{
+		rely:   rely,
+		router: router,
+	}
+}
+
+func (r *JobRouter) RegisterRouter() {
+	jobHandler := handlerTools.NewJobHandler(r.rely, newJobService(r.rely))
+	jobHandler.RegisterRoutes(r.router)
+}
+
+// newJobService 后台任务服务，提交任务的模块与任务查询共用同一任务池
+func newJobService(rely config.RelyConfig) serviceTools.JobService {
+	jobDAO := daoTools.NewGORMJobDAO(rely.Db.Careful)
+	jobRepository := repositoryTools.NewJobRepository(jobDAO)
+	return serviceTools.NewJobService(jobRepository, rely.Jobs, serviceTools.DefaultJobConfig())
+}
@@ -0,0 +1,66 @@
+/**
+ * Description：
+ * FileName：logger.go
+ * Author：CJiaの用心
+ * Create：2025/10/30 11:48:21
+ * Remark：
+ */
+
+package careful
+
+import (
+	"github.com/carefuly/careful-admin-go-gin/config"
+	daoLogger "github.com/carefuly/careful-admin-go-gin/internal/repository/dao/careful/logger"
+	repositoryLogger "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/logger"
+	serviceLogger "github.com/carefuly/careful-admin-go-gin/internal/service/careful/logger"
+	handlerLogger "github.com/carefuly/careful-admin-go-gin/internal/web/handler/careful/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type LoggerRouter struct {
+	rely   config.RelyConfig
+	router *gin.RouterGroup
+}
+
+func NewLoggerRouter(rely config.RelyConfig, router *gin.RouterGroup) *LoggerRouter {
+	return &LoggerRouter{
+		rely:   rely,
+		router: router,
+	}
+}
+
+func (r *LoggerRouter) RegisterRouter() {
+	baseRouter := r.router.Group("/logger")
+
+	// 操作日志
+	operateLogDAO := daoLogger.NewGORMOperateLogDAO(r.rely.Db.Careful)
+	operateLogRepository := repositoryLogger.NewOperateLogRepository(operateLogDAO)
+	operateLogService := serviceLogger.NewOperateLogService(operateLogRepository)
+	operateLogHandler := handlerLogger.NewOperateLogHandler(r.rely, operateLogService)
+	operateLogHandler.RegisterRoutes(baseRouter)
+
+	// 登录日志
+	loginLogDAO := daoLogger.NewGORMLoginLogDAO(r.rely.Db.Careful)
+	loginLogRepository := repositoryLogger.NewLoginLogRepository(loginLogDAO)
+	loginLogService := serviceLogger.NewLoginLogService(loginLogRepository)
+	loginLogHandler := handlerLogger.NewLoginLogHandler(r.rely, loginLogService)
+	loginLogHandler.RegisterRoutes(baseRouter)
+
+	// 缓存日志
+	cacheLogDAO := daoLogger.NewGORMCacheLogDAO(r.rely.Db.Careful)
+	cacheLogRepository := repositoryLogger.NewCacheLogRepository(cacheLogDAO)
+	cacheLogService := serviceLogger.NewCacheLogService(cacheLogRepository)
+	cacheLogHandler := handlerLogger.NewCacheLogHandler(r.rely, cacheLogService)
+	cacheLogHandler.RegisterRoutes(baseRouter)
+
+	// 数据变更日志
+	newDataLogHandler(r.rely).RegisterRoutes(baseRouter)
+}
+
+// newDataLogHandler 数据变更日志处理器，各资源通过其注册变更历史路由
+func newDataLogHandler(rely config.RelyConfig) handlerLogger.DataLogHandler {
+	dataLogDAO := daoLogger.NewGORMDataLogDAO(rely.Db.Careful)
+	dataLogRepository := repositoryLogger.NewDataLogRepository(dataLogDAO)
+	dataLogService := serviceLogger.NewDataLogService(dataLogRepository)
+	return handlerLogger.NewDataLogHandler(rely, dataLogService)
+}
@@ -0,0 +1,100 @@
+/**
+ * Description：
+ * FileName：system.go
+ * Author：CJiaの用心
+ * Create：2025/10/25 10:40:18
+ * Remark：
+ */
+
+package careful
+
+import (
+	"github.com/carefuly/careful-admin-go-gin/config"
+	modelSystem "github.com/carefuly/careful-admin-go-gin/internal/model/careful/system"
+	cacheSystem "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/careful/system"
+	cacheDecoratorSystem "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/decorator/careful/system"
+	cacheRecord "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/decorator/record"
+	daoSystem "github.com/carefuly/careful-admin-go-gin/internal/repository/dao/careful/system"
+	repositorySystem "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/system"
+	serviceSystem "github.com/carefuly/careful-admin-go-gin/internal/service/careful/system"
+	handlerSystem "github.com/carefuly/careful-admin-go-gin/internal/web/handler/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/jwt"
+	"github.com/gin-gonic/gin"
+)
+
+type SystemRouter struct {
+	rely   config.RelyConfig
+	router *gin.RouterGroup
+}
+
+func NewSystemRouter(rely config.RelyConfig, router *gin.RouterGroup) *SystemRouter {
+	return &SystemRouter{
+		rely:   rely,
+		router: router,
+	}
+}
+
+func (r *SystemRouter) RegisterRouter() {
+	baseRouter := r.router.Group("/system")
+	// 数据变更历史
+	dataLogHandler := newDataLogHandler(r.rely)
+
+	// 用户
+	userCache := cacheSystem.NewRedisUserCache(r.rely.Redis)
+	userCacheLogger := cacheRecord.NewCacheLogger(r.rely.LogSink)
+	userCacheLoggingDecorator := cacheDecoratorSystem.NewUserCacheLoggingDecorator(userCache, userCacheLogger)
+	userDAO := daoSystem.NewGORMUserDAO(r.rely.Db.Careful)
+	userRepository := repositorySystem.NewUserRepository(userDAO, userCacheLoggingDecorator)
+	refreshService := jwt.NewRefreshTokenManagerFromSource(r.rely.Redis, jwt.LiveTokenSource(r.rely.Token))
+	userService := serviceSystem.NewUserService(userRepository, refreshService)
+	userHandler := handlerSystem.NewUserHandler(r.rely, userService)
+	userHandler.RegisterRoutes(baseRouter)
+	dataLogHandler.RegisterHistoryRoute(baseRouter.Group("/user"), modelSystem.NewUser().TableName())
+
+	// 部门
+	deptCache := cacheSystem.NewRedisDeptCache(r.rely.Redis)
+	deptCacheLogger := cacheRecord.NewCacheLogger(r.rely.LogSink)
+	deptCacheLoggingDecorator := cacheDecoratorSystem.NewDeptCacheLoggingDecorator(deptCache, deptCacheLogger)
+	deptDAO := daoSystem.NewGORMDeptDAO(r.rely.Db.Careful)
+	deptRepository := repositorySystem.NewDeptRepository(deptDAO, deptCacheLoggingDecorator)
+	deptService := serviceSystem.NewDeptService(deptRepository)
+	deptHandler := handlerSystem.NewDeptHandler(r.rely, deptService, userService)
+	deptHandler.RegisterRoutes(baseRouter)
+	dataLogHandler.RegisterHistoryRoute(baseRouter.Group("/dept"), modelSystem.NewDept().TableName())
+
+	// 角色
+	permissionCache := cacheSystem.NewRedisUserPermissionCache(r.rely.Redis)
+	permissionCacheLogger := cacheRecord.NewCacheLogger(r.rely.LogSink)
+	permissionCacheLoggingDecorator := cacheDecoratorSystem.NewUserPermissionCacheLoggingDecorator(permissionCache, permissionCacheLogger)
+	roleDAO := daoSystem.NewGORMRoleDAO(r.rely.Db.Careful)
+	dataScopeCache := cacheSystem.NewRedisDataScopeCache(r.rely.Redis)
+	roleRepository := repositorySystem.NewRoleRepository(roleDAO, permissionCacheLoggingDecorator, dataScopeCache)
+	roleService := serviceSystem.NewRoleService(roleRepository)
+	roleHandler := handlerSystem.NewRoleHandler(r.rely, roleService, userService)
+	roleHandler.RegisterRoutes(baseRouter)
+	dataLogHandler.RegisterHistoryRoute(baseRouter.Group("/role"), modelSystem.NewRole().TableName())
+
+	// 接口权限
+	permissionDAO := daoSystem.NewGORMPermissionDAO(r.rely.Db.Careful)
+	permissionRepository := repositorySystem.NewPermissionRepository(permissionDAO, userDAO, permissionCacheLoggingDecorator)
+	permissionService := serviceSystem.NewPermissionService(permissionRepository)
+	permissionHandler := handlerSystem.NewPermissionHandler(r.rely, permissionService, userService)
+	permissionHandler.RegisterRoutes(baseRouter)
+	dataLogHandler.RegisterHistoryRoute(baseRouter.Group("/permission"), modelSystem.NewPermission().TableName())
+
+	// 菜单
+	menuDAO := daoSystem.NewGORMMenuDAO(r.rely.Db.Careful)
+	menuRepository := repositorySystem.NewMenuRepository(menuDAO)
+	menuService := serviceSystem.NewMenuService(menuRepository, userRepository)
+	menuHandler := handlerSystem.NewMenuHandler(r.rely, menuService, userService)
+	menuHandler.RegisterRoutes(baseRouter)
+	dataLogHandler.RegisterHistoryRoute(baseRouter.Group("/menu"), modelSystem.NewMenu().TableName())
+
+	// 在线用户
+	onlineHandler := handlerSystem.NewOnlineHandler(r.rely, refreshService)
+	onlineHandler.RegisterRoutes(baseRouter)
+
+	// 令牌签名密钥
+	signingKeyHandler := handlerSystem.NewSigningKeyHandler(r.rely)
+	signingKeyHandler.RegisterRoutes(baseRouter)
+}
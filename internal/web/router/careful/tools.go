@@ -10,6 +10,7 @@ package careful
 
 import (
 	"github.com/carefuly/careful-admin-go-gin/config"
+	modelTools "github.com/carefuly/careful-admin-go-gin/internal/model/careful/tools"
 	cacheSystem "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/careful/system"
 	cacheTools "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/careful/tools"
 	cacheDecoratorSystem "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/decorator/careful/system"
@@ -22,6 +23,7 @@ import (
 	serviceSystem "github.com/carefuly/careful-admin-go-gin/internal/service/careful/system"
 	serviceTools "github.com/carefuly/careful-admin-go-gin/internal/service/careful/tools"
 	handlerTools "github.com/carefuly/careful-admin-go-gin/internal/web/handler/careful/tools"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/jwt"
 	"github.com/gin-gonic/gin"
 )
 
@@ -39,32 +41,66 @@ func NewToolsRouter(rely config.RelyConfig, router *gin.RouterGroup) *ToolsRoute
 
 func (r *ToolsRouter) RegisterRouter() {
 	baseRouter := r.router.Group("/tools")
+	// 数据变更历史
+	dataLogHandler := newDataLogHandler(r.rely)
 
 	// 用户
 	userCache := cacheSystem.NewRedisUserCache(r.rely.Redis)
-	userCacheLogger := cacheRecord.NewCacheLogger(r.rely.Db.Careful)
+	userCacheLogger := cacheRecord.NewCacheLogger(r.rely.LogSink)
 	userCacheLoggingDecorator := cacheDecoratorSystem.NewUserCacheLoggingDecorator(userCache, userCacheLogger)
 	userDAO := daoSystem.NewGORMUserDAO(r.rely.Db.Careful)
 	userRepository := repositorySystem.NewUserRepository(userDAO, userCacheLoggingDecorator)
-	userService := serviceSystem.NewUserService(userRepository)
+	refreshService := jwt.NewRefreshTokenManagerFromSource(r.rely.Redis, jwt.LiveTokenSource(r.rely.Token))
+	userService := serviceSystem.NewUserService(userRepository, refreshService)
+
+	// 文件存储，分片上传完成后写入存储桶或用于导入
+	bucketDAO := daoTools.NewGORMBucketDAO(r.rely.Db.Careful)
+	bucketRepository := repositoryTools.NewBucketRepository(bucketDAO)
+	fileDAO := daoTools.NewGORMFileDAO(r.rely.Db.Careful)
+	fileRepository := repositoryTools.NewFileRepository(fileDAO)
+	bucketService := serviceTools.NewBucketService(bucketRepository, fileRepository)
+	fileService := serviceTools.NewFileService(fileRepository, bucketRepository, r.rely.Storage, r.rely.Presign)
+	uploadCache := cacheTools.NewRedisUploadCache(r.rely.Redis)
+	uploadService := serviceTools.NewUploadService(uploadCache, bucketRepository, fileService, serviceTools.DefaultUploadConfig())
 
 	// 数据字典
 	dictCache := cacheTools.NewRedisDictCache(r.rely.Redis)
-	dictCacheLogger := cacheRecord.NewCacheLogger(r.rely.Db.Careful)
+	dictCacheLogger := cacheRecord.NewCacheLogger(r.rely.LogSink)
 	dictDAO := daoTools.NewGORMDictDAO(r.rely.Db.Careful)
 	dictCacheLoggingDecorator := cacheDecoratorTools.NewDictCacheLoggingDecorator(dictCache, dictCacheLogger)
 	dictRepository := repositoryTools.NewDictRepository(dictDAO, dictCacheLoggingDecorator)
-	dictService := serviceTools.NewDictService(dictRepository)
-	dictHandler := handlerTools.NewDictHandler(r.rely, dictService, userService)
-	dictHandler.RegisterRoutes(baseRouter)
-
-	// 字典项
+	// 字典项仓储，字典变更时需清理其字典选项缓存
 	dictTypeCache := cacheTools.NewRedisDictTypeCache(r.rely.Redis)
-	dictTypeCacheLogger := cacheRecord.NewCacheLogger(r.rely.Db.Careful)
+	dictTypeCacheLogger := cacheRecord.NewCacheLogger(r.rely.LogSink)
 	dictTypeDAO := daoTools.NewGORMDictTypeDAO(r.rely.Db.Careful)
 	dictTypeCacheLoggingDecorator := cacheDecoratorTools.NewDictTypeCacheLoggingDecorator(dictTypeCache, dictTypeCacheLogger)
 	dictTypeRepository := repositoryTools.NewDictTypeRepository(dictTypeDAO, dictTypeCacheLoggingDecorator)
+	dictService := serviceTools.NewDictService(dictRepository, dictTypeRepository)
+	dictHandler := handlerTools.NewDictHandler(r.rely, dictService, userService, newJobService(r.rely), uploadService)
+	dictHandler.RegisterRoutes(baseRouter)
+	dataLogHandler.RegisterHistoryRoute(baseRouter.Group("/dict"), modelTools.NewDict().TableName())
+
+	// 字典项
 	dictTypeService := serviceTools.NewDictTypeService(dictTypeRepository, dictRepository)
-	dictTypeHandler := handlerTools.NewDictTypeHandler(r.rely, dictTypeService, userService)
+	dictTypeHandler := handlerTools.NewDictTypeHandler(r.rely, dictTypeService, userService, uploadService)
 	dictTypeHandler.RegisterRoutes(baseRouter)
+	dataLogHandler.RegisterHistoryRoute(baseRouter.Group("/dictType"), modelTools.NewDictType().TableName())
+
+	// 导入错误数据下载
+	importErrorHandler := handlerTools.NewImportErrorHandler(r.rely)
+	importErrorHandler.RegisterRoutes(baseRouter)
+
+	// 文件存储
+	bucketHandler := handlerTools.NewBucketHandler(r.rely, bucketService)
+	bucketHandler.RegisterRoutes(baseRouter)
+	dataLogHandler.RegisterHistoryRoute(baseRouter.Group("/bucket"), modelTools.NewBucket().TableName())
+	fileHandler := handlerTools.NewFileHandler(r.rely, fileService)
+	fileHandler.RegisterRoutes(baseRouter)
+	dataLogHandler.RegisterHistoryRoute(baseRouter.Group("/file"), modelTools.NewFile().TableName())
+	// 预签名下载免登录，挂载在 /v1/storage 下
+	fileHandler.RegisterPublicRoutes(r.router)
+
+	// 分片上传
+	uploadHandler := handlerTools.NewUploadHandler(r.rely, uploadService)
+	uploadHandler.RegisterRoutes(baseRouter)
 }
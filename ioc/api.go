@@ -13,12 +13,16 @@ import (
 	"github.com/carefuly/careful-admin-go-gin/docs"
 	"github.com/carefuly/careful-admin-go-gin/internal/web/router"
 	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/response"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/jwt/keymanager"
 	"github.com/gin-gonic/gin"
 	swaggerfiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 	"net/http"
 )
 
+// JWKSPath 公钥集合地址
+const JWKSPath = "/.well-known/jwks.json"
+
 type RouterRegistrar interface {
 	RegisterRoutes(group *gin.RouterGroup)
 }
@@ -37,6 +41,16 @@ func RegisterRoutes(debug bool, engine *gin.Engine, rely config.RelyConfig) {
 	// 注册Swagger文档
 	engine.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerfiles.Handler))
 
+	// 公开令牌验签公钥，供网关及其他服务本地校验令牌
+	engine.GET(JWKSPath, func(c *gin.Context) {
+		set := keymanager.JWKS{Keys: []keymanager.JWK{}}
+		if rely.Keys != nil {
+			set = rely.Keys.JWKS()
+		}
+		c.Header("Cache-Control", "public, max-age=300")
+		c.JSON(http.StatusOK, set)
+	})
+
 	// 创建API路由组
 	apiGroup := engine.Group(apiPrefix)
 	{
@@ -11,7 +11,7 @@ package ioc
 import (
 	"fmt"
 	"github.com/carefuly/careful-admin-go-gin/config"
-	carefulAutoMigrate "github.com/carefuly/careful-admin-go-gin/internal/model/careful/autoMigrate"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/datalog"
 	"go.uber.org/zap"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
@@ -86,9 +86,10 @@ func initDatabase(database config.DatabaseDetail) (*gorm.DB, error) {
 			return nil, fmt.Errorf("数据库连接失败: %w", err)
 		}
 
-		// 实际迁移操作应该在此处调用
-		// 迁移系统表
-		carefulAutoMigrate.AutoMigrate(db)
+		// 数据变更日志
+		if err := db.Use(datalog.NewPlugin()); err != nil {
+			return nil, fmt.Errorf("数据变更日志插件注册失败: %w", err)
+		}
 
 		return db, nil
 	default:
@@ -123,3 +124,24 @@ func configureConnectionPool(db *gorm.DB, cfg config.DatabaseDetail) {
 		sqlDB.SetConnMaxLifetime(30 * time.Minute) // 默认值
 	}
 }
+
+// ApplyPoolConfig 数据库配置变更回调，即时调整连接池大小
+func (p *DbPool) ApplyPoolConfig(_, latest *config.RemoteConfig) {
+	for name, detail := range latest.DatabaseConfig {
+		var db *gorm.DB
+		switch name {
+		case "careful":
+			db = p.CarefulDB
+		case "table":
+			db = p.TableDB
+		}
+		if db == nil {
+			continue
+		}
+		configureConnectionPool(db, detail)
+		zap.L().Info("数据库连接池配置已更新",
+			zap.String("name", name),
+			zap.Int("maxIdleConn", detail.MaxIdleConn),
+			zap.Int("maxOpenConn", detail.MaxOpenConn))
+	}
+}
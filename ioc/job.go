@@ -0,0 +1,84 @@
+/**
+ * Description：
+ * FileName：job.go
+ * Author：CJiaの用心
+ * Create：2025/11/7 16:21:09
+ * Remark：
+ */
+
+package ioc
+
+import (
+	"context"
+	"github.com/carefuly/careful-admin-go-gin/config"
+	daoTools "github.com/carefuly/careful-admin-go-gin/internal/repository/dao/careful/tools"
+	repositoryTools "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/tools"
+	serviceTools "github.com/carefuly/careful-admin-go-gin/internal/service/careful/tools"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/workerpool"
+	"go.uber.org/zap"
+	"time"
+)
+
+// jobCleanInterval 过期任务结果清理间隔
+const jobCleanInterval = 30 * time.Minute
+
+// InitJobPool 初始化后台任务协程池
+func InitJobPool(opts ...workerpool.Config) *workerpool.Pool {
+	// 合并配置
+	opt := workerpool.DefaultConfig()
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	opt.Name = "job"
+
+	pool := workerpool.New(opt)
+
+	zap.L().Info("后台任务协程池已启动",
+		zap.Int("workers", opt.Workers),
+		zap.Int("queueSize", opt.QueueSize))
+
+	return pool
+}
+
+// InitJobCleaner 回收服务重启前未结束的任务，并定时清理过期的任务结果文件
+// 返回的 stop 用于停止定时清理
+func InitJobCleaner(rely config.RelyConfig) func() {
+	jobDAO := daoTools.NewGORMJobDAO(rely.Db.Careful)
+	jobRepository := repositoryTools.NewJobRepository(jobDAO)
+	svc := serviceTools.NewJobService(jobRepository, rely.Jobs, serviceTools.DefaultJobConfig())
+
+	if count, err := svc.RecoverUnfinished(context.Background()); err != nil {
+		zap.L().Error("回收未结束的后台任务失败", zap.Error(err))
+	} else if count > 0 {
+		zap.L().Warn("服务重启前未结束的后台任务已标记为失败", zap.Int64("count", count))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(jobCleanInterval)
+		defer ticker.Stop()
+
+		for {
+			clean(ctx, svc)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return cancel
+}
+
+// clean 清理过期的任务结果文件
+func clean(ctx context.Context, svc serviceTools.JobService) {
+	removed, err := svc.CleanExpired(ctx)
+	if err != nil {
+		zap.L().Error("清理过期的后台任务结果失败", zap.Error(err))
+		return
+	}
+	if removed > 0 {
+		zap.L().Info("已清理过期的后台任务结果文件", zap.Int("count", removed))
+	}
+}
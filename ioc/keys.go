@@ -0,0 +1,61 @@
+/**
+ * Description：
+ * FileName：keys.go
+ * Author：CJiaの用心
+ * Create：2025/11/2 11:20:36
+ * Remark：
+ */
+
+package ioc
+
+import (
+	"github.com/carefuly/careful-admin-go-gin/config"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/jwt/keymanager"
+	"go.uber.org/zap"
+	"time"
+)
+
+// InitKeyManager 初始化令牌签名密钥
+// 返回的 stop 用于停止密钥目录扫描
+func InitKeyManager(token config.Token) (keymanager.KeyManager, func()) {
+	manager, err := keymanager.New(keymanager.Options{
+		Algorithm: token.Algorithm,
+		Secret:    token.Secret,
+		KeyDir:    token.KeyDir,
+		ActiveKid: token.ActiveKid,
+	})
+	if err != nil {
+		zap.L().Fatal("令牌签名密钥初始化失败", zap.Error(err))
+	}
+
+	stop := func() {}
+	if token.KeyReload > 0 && manager.Algorithm() != keymanager.AlgorithmHS512 {
+		stop = manager.StartReload(time.Duration(token.KeyReload)*time.Second, func(err error) {
+			zap.L().Error("令牌签名密钥重新加载失败", zap.Error(err))
+		})
+	}
+
+	zap.L().Info("令牌签名密钥已加载",
+		zap.String("algorithm", manager.Algorithm()),
+		zap.Strings("kids", manager.Kids()))
+
+	return manager, stop
+}
+
+// ApplyTokenConfig 令牌配置变更回调，签名密钥相关配置需重启服务后生效，有效期、续期、单会话等配置即时生效
+func ApplyTokenConfig(live *config.Live[config.Token]) func(old, latest *config.RemoteConfig) {
+	return func(_, latest *config.RemoteConfig) {
+		current := live.Load()
+		token := latest.TokenConfig
+		if token.Secret != current.Secret || token.Algorithm != current.Algorithm || token.KeyDir != current.KeyDir ||
+			token.ActiveKid != current.ActiveKid || token.KeyReload != current.KeyReload {
+			zap.L().Warn("令牌签名密钥配置变更需重启服务后生效")
+			token.Secret, token.Algorithm, token.KeyDir = current.Secret, current.Algorithm, current.KeyDir
+			token.ActiveKid, token.KeyReload = current.ActiveKid, current.KeyReload
+		}
+		if token != current {
+			live.Store(token)
+			zap.L().Info("令牌配置已更新")
+		}
+	}
+}
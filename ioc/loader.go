@@ -9,11 +9,13 @@
 package ioc
 
 import (
+	"fmt"
 	"github.com/carefuly/careful-admin-go-gin/config"
 	"github.com/fsnotify/fsnotify"
 	"go.uber.org/zap"
 	"gopkg.in/yaml.v3"
 	"os"
+	"reflect"
 	"sync"
 )
 
@@ -24,6 +26,7 @@ type ConfigManager struct {
 	ConfigFile string
 	Watcher    *fsnotify.Watcher
 	Mutex      sync.RWMutex
+	listeners  []func(old, latest *config.LocalConfig)
 }
 
 func InitConfig(configFile string) *ConfigManager {
@@ -61,6 +64,9 @@ func (cm *ConfigManager) loadConfig() error {
 	if err := yaml.Unmarshal(data, newConfig); err != nil {
 		return err
 	}
+	if err := newConfig.Validate(); err != nil {
+		return fmt.Errorf("本地配置校验失败:\n%w", err)
+	}
 
 	cm.Config = newConfig
 	zap.S().Debugf("配置文件已重新加载: %s", cm.ConfigFile)
@@ -77,6 +83,37 @@ func (cm *ConfigManager) GetConfig() *config.LocalConfig {
 	return &configCopy
 }
 
+// OnChange 注册配置文件重新加载后的回调
+func (cm *ConfigManager) OnChange(fn func(old, latest *config.LocalConfig)) {
+	cm.Mutex.Lock()
+	defer cm.Mutex.Unlock()
+	cm.listeners = append(cm.listeners, fn)
+}
+
+// reload 重新加载配置文件并通知使用方，服务地址与配置来源变更需重启服务后生效
+func (cm *ConfigManager) reload() error {
+	old := cm.GetConfig()
+	if err := cm.loadConfig(); err != nil {
+		return err
+	}
+	latest := cm.GetConfig()
+
+	if !reflect.DeepEqual(old.Server, latest.Server) {
+		zap.L().Warn("服务地址与可信代理配置变更需重启服务后生效")
+	}
+	if old.NaCos != latest.NaCos || old.Remote != latest.Remote {
+		zap.L().Warn("远程配置来源变更需重启服务后生效")
+	}
+
+	cm.Mutex.RLock()
+	listeners := append([]func(old, latest *config.LocalConfig){}, cm.listeners...)
+	cm.Mutex.RUnlock()
+	for _, fn := range listeners {
+		fn(old, latest)
+	}
+	return nil
+}
+
 // StartWatching 开始监听配置文件变化
 func (cm *ConfigManager) StartWatching() error {
 	watcher, err := fsnotify.NewWatcher()
@@ -111,7 +148,7 @@ func (cm *ConfigManager) watchConfigFile() {
 			// 检查是否是写入事件
 			if event.Op&fsnotify.Write == fsnotify.Write {
 				zap.S().Debug("检测到配置文件变化", event.Name)
-				if err := cm.loadConfig(); err != nil {
+				if err := cm.reload(); err != nil {
 					zap.S().Errorw("重新加载配置文件失败", "error", err)
 				} else {
 					zap.S().Debugf("配置文件重新加载成功")
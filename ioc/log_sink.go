@@ -0,0 +1,59 @@
+/**
+ * Description：
+ * FileName：log_sink.go
+ * Author：CJiaの用心
+ * Create：2025/10/31 10:52:16
+ * Remark：
+ */
+
+package ioc
+
+import (
+	"context"
+	modelLogger "github.com/carefuly/careful-admin-go-gin/internal/model/careful/logger"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/batchwriter"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/logsink"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// InitLogSink 初始化审计日志异步写入器
+func InitLogSink(db *gorm.DB, opts ...batchwriter.Config) *logsink.LogSink {
+	// 合并配置
+	opt := batchwriter.DefaultConfig()
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+
+	// 会话级静默日志，避免SQL日志刷屏且不影响共享连接的全局配置
+	silent := db.Session(&gorm.Session{Logger: logger.Default.LogMode(logger.Silent)})
+
+	sink := logsink.NewLogSink(logsink.Flushers{
+		Operate: batchInsert[*modelLogger.OperateLogger](silent),
+		Login:   batchInsertAll[*modelLogger.LoginLogger](silent),
+		Cache:   batchInsert[*modelLogger.CacheLogger](silent),
+	}, opt)
+
+	zap.L().Info("审计日志写入器已启动",
+		zap.Int("queueSize", opt.QueueSize),
+		zap.Int("workers", opt.Workers),
+		zap.Int("batchSize", opt.BatchSize),
+		zap.Duration("flushInterval", opt.FlushInterval))
+
+	return sink
+}
+
+// batchInsert 批量插入
+func batchInsert[T any](db *gorm.DB) batchwriter.FlushFunc[T] {
+	return func(ctx context.Context, items []T) error {
+		return db.WithContext(ctx).CreateInBatches(items, len(items)).Error
+	}
+}
+
+// batchInsertAll 批量插入全部字段，避免登录失败(Status=false)等零值被数据库默认值覆盖
+func batchInsertAll[T any](db *gorm.DB) batchwriter.FlushFunc[T] {
+	return func(ctx context.Context, items []T) error {
+		return db.WithContext(ctx).Select("*").CreateInBatches(items, len(items)).Error
+	}
+}
@@ -9,6 +9,7 @@
 package ioc
 
 import (
+	"github.com/carefuly/careful-admin-go-gin/config"
 	"github.com/carefuly/careful-admin-go-gin/pkg/ginx/logger"
 	"go.uber.org/zap"
 )
@@ -16,6 +17,7 @@ import (
 // LoggerManager 日志管理器
 type LoggerManager struct {
 	logger *zap.Logger
+	custom *logger.Logger
 }
 
 // InitLogger 初始化日志系统 - 创建带彩色输出的控制台日志记录器
@@ -49,6 +51,7 @@ func InitLogger() *LoggerManager {
 
 	return &LoggerManager{
 		logger: zapLogger,
+		custom: customLogger,
 	}
 }
 
@@ -81,6 +84,7 @@ func InitFileLogger(filePath string) *LoggerManager {
 
 	return &LoggerManager{
 		logger: zapLogger,
+		custom: customLogger,
 	}
 }
 
@@ -89,6 +93,23 @@ func (lm *LoggerManager) GetLogger() *zap.Logger {
 	return lm.logger
 }
 
+// SetDebug 调试模式输出调试级别日志，否则输出信息级别及以上日志
+func (lm *LoggerManager) SetDebug(debug bool) {
+	if debug {
+		lm.custom.SetLevel(logger.DebugLevel)
+	} else {
+		lm.custom.SetLevel(logger.InfoLevel)
+	}
+}
+
+// ApplyLocalConfig 本地配置变更回调，application.debug 即时生效
+func (lm *LoggerManager) ApplyLocalConfig(old, latest *config.LocalConfig) {
+	if old.Application.Debug != latest.Application.Debug {
+		lm.SetDebug(latest.Application.Debug)
+		zap.L().Info("日志级别已更新", zap.Bool("debug", latest.Application.Debug))
+	}
+}
+
 // Close 关闭日志器，确保所有日志都被写入
 func (lm *LoggerManager) Close() error {
 	if lm.logger != nil {
@@ -0,0 +1,158 @@
+/**
+ * Description：
+ * FileName：migrate.go
+ * Author：CJiaの用心
+ * Create：2025/11/14 17:18:46
+ * Remark：
+ */
+
+package ioc
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/migrations"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/migrate"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"os"
+	"text/tabwriter"
+	"time"
+)
+
+const migrateUsage = `用法:
+  migrate up [-steps N]            执行未执行的迁移，默认全部
+  migrate down [-steps N]          回滚最近执行的迁移，默认1个版本
+  migrate status                   查看迁移版本状态
+  migrate create [-dir 目录] <名称>  创建一对空的升级、回滚迁移文件`
+
+// InitMigrate 服务启动时执行未执行的数据库迁移，失败时终止启动
+// 多个实例同时启动时通过迁移锁串行执行
+func InitMigrate(db *gorm.DB) {
+	migrator, err := newMigrator(db)
+	if err != nil {
+		zap.L().Fatal("加载数据库迁移文件失败", zap.Error(err))
+	}
+
+	executed, err := migrator.Up(context.Background(), 0)
+	for _, migration := range executed {
+		zap.L().Info("已执行数据库迁移", zap.Int64("version", migration.Version), zap.String("name", migration.Name))
+	}
+	if err != nil {
+		zap.L().Fatal("数据库迁移失败", zap.Error(err))
+	}
+}
+
+// RunMigrateCommand 执行数据库迁移子命令 migrate up|down|status|create
+func RunMigrateCommand(configFile string, args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+	command := args[0]
+
+	flags := flag.NewFlagSet("migrate "+command, flag.ContinueOnError)
+	steps := flags.Int("steps", 0, "执行或回滚的版本数")
+	dir := flags.String("dir", migrations.Dir, "迁移文件目录，仅 create 使用")
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	switch command {
+	case "create":
+		if flags.NArg() != 1 {
+			return errors.New(migrateUsage)
+		}
+		upPath, downPath, err := migrate.Create(*dir, flags.Arg(0), time.Now())
+		if err != nil {
+			return err
+		}
+		fmt.Printf("已创建迁移文件:\n  %s\n  %s\n", upPath, downPath)
+		return nil
+	case "up", "down", "status":
+	default:
+		return fmt.Errorf("未知的迁移命令: %s\n%s", command, migrateUsage)
+	}
+
+	db, err := openCommandDB(configFile)
+	if err != nil {
+		return err
+	}
+	migrator, err := newMigrator(db)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	switch command {
+	case "up":
+		executed, err := migrator.Up(ctx, *steps)
+		printMigrations("已执行", executed)
+		return err
+	case "down":
+		reverted, err := migrator.Down(ctx, *steps)
+		printMigrations("已回滚", reverted)
+		return err
+	default:
+		list, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		printStatus(list)
+		return nil
+	}
+}
+
+func newMigrator(db *gorm.DB) (*migrate.Migrator, error) {
+	list, err := migrate.Load(migrations.FS)
+	if err != nil {
+		return nil, err
+	}
+	return migrate.New(db, list, migrate.DefaultConfig()), nil
+}
+
+// openCommandDB 子命令按服务相同的配置连接业务库，仅输出警告级别以上的 SQL 日志
+func openCommandDB(configFile string) (*gorm.DB, error) {
+	InitLogger()
+	configManager := InitConfig(configFile)
+	remoteConfig := InitRemoteConfig(configManager.Config).Config()
+	dbPool := NewDbPool(remoteConfig.DatabaseConfig)
+	if dbPool.CarefulDB == nil {
+		return nil, errors.New("未配置 careful 数据库")
+	}
+	return dbPool.CarefulDB.Session(&gorm.Session{Logger: dbPool.CarefulDB.Logger.LogMode(logger.Warn)}), nil
+}
+
+func printMigrations(action string, list []migrate.Migration) {
+	if len(list) == 0 {
+		fmt.Println("没有需要处理的迁移")
+		return
+	}
+	for _, migration := range list {
+		fmt.Printf("%s: %d_%s\n", action, migration.Version, migration.Name)
+	}
+}
+
+func printStatus(list []migrate.Status) {
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(writer, "版本\t名称\t状态\t执行时间")
+	for _, status := range list {
+		state := "未执行"
+		switch {
+		case status.Dirty:
+			state = "执行中断"
+		case status.Missing:
+			state = "文件缺失"
+		case status.Applied:
+			state = "已执行"
+		}
+		appliedAt := "-"
+		if status.AppliedAt != nil {
+			appliedAt = status.AppliedAt.Format(time.DateTime)
+		}
+		_, _ = fmt.Fprintf(writer, "%d\t%s\t%s\t%s\n", status.Version, status.Name, state, appliedAt)
+	}
+	_ = writer.Flush()
+}
@@ -0,0 +1,93 @@
+/**
+ * Description：
+ * FileName：recycle.go
+ * Author：CJiaの用心
+ * Create：2025/11/13 16:42:08
+ * Remark：
+ */
+
+package ioc
+
+import (
+	"context"
+	"github.com/carefuly/careful-admin-go-gin/config"
+	cacheSystem "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/careful/system"
+	cacheTools "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/careful/tools"
+	cacheDecoratorSystem "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/decorator/careful/system"
+	cacheDecoratorTools "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/decorator/careful/tools"
+	cacheRecord "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/decorator/record"
+	daoSystem "github.com/carefuly/careful-admin-go-gin/internal/repository/dao/careful/system"
+	daoTools "github.com/carefuly/careful-admin-go-gin/internal/repository/dao/careful/tools"
+	repositorySystem "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/system"
+	repositoryTools "github.com/carefuly/careful-admin-go-gin/internal/repository/repository/careful/tools"
+	serviceTools "github.com/carefuly/careful-admin-go-gin/internal/service/careful/tools"
+	"go.uber.org/zap"
+	"time"
+)
+
+// recyclePurgeInterval 回收站过期数据清理间隔
+const recyclePurgeInterval = 24 * time.Hour
+
+// InitRecycleCleaner 定时彻底删除回收站中超过保留时长的数据
+// 返回的 stop 用于停止定时清理
+func InitRecycleCleaner(rely config.RelyConfig) func() {
+	dictCache := cacheDecoratorTools.NewDictCacheLoggingDecorator(
+		cacheTools.NewRedisDictCache(rely.Redis), cacheRecord.NewCacheLogger(rely.LogSink))
+	dictRepository := repositoryTools.NewDictRepository(daoTools.NewGORMDictDAO(rely.Db.Careful), dictCache)
+
+	dictTypeCache := cacheDecoratorTools.NewDictTypeCacheLoggingDecorator(
+		cacheTools.NewRedisDictTypeCache(rely.Redis), cacheRecord.NewCacheLogger(rely.LogSink))
+	dictTypeRepository := repositoryTools.NewDictTypeRepository(daoTools.NewGORMDictTypeDAO(rely.Db.Careful), dictTypeCache)
+
+	// 文件通过服务清理，以便释放不再被引用的存储对象
+	bucketRepository := repositoryTools.NewBucketRepository(daoTools.NewGORMBucketDAO(rely.Db.Careful))
+	fileRepository := repositoryTools.NewFileRepository(daoTools.NewGORMFileDAO(rely.Db.Careful))
+	fileService := serviceTools.NewFileService(fileRepository, bucketRepository, rely.Storage, rely.Presign)
+	bucketService := serviceTools.NewBucketService(bucketRepository, fileRepository)
+
+	userDAO := daoSystem.NewGORMUserDAO(rely.Db.Careful)
+	userCache := cacheDecoratorSystem.NewUserCacheLoggingDecorator(
+		cacheSystem.NewRedisUserCache(rely.Redis), cacheRecord.NewCacheLogger(rely.LogSink))
+	userRepository := repositorySystem.NewUserRepository(userDAO, userCache)
+
+	permissionCache := cacheDecoratorSystem.NewUserPermissionCacheLoggingDecorator(
+		cacheSystem.NewRedisUserPermissionCache(rely.Redis), cacheRecord.NewCacheLogger(rely.LogSink))
+	roleRepository := repositorySystem.NewRoleRepository(
+		daoSystem.NewGORMRoleDAO(rely.Db.Careful), permissionCache, cacheSystem.NewRedisDataScopeCache(rely.Redis))
+	permissionRepository := repositorySystem.NewPermissionRepository(daoSystem.NewGORMPermissionDAO(rely.Db.Careful), userDAO, permissionCache)
+	menuRepository := repositorySystem.NewMenuRepository(daoSystem.NewGORMMenuDAO(rely.Db.Careful))
+
+	deptCache := cacheDecoratorSystem.NewDeptCacheLoggingDecorator(
+		cacheSystem.NewRedisDeptCache(rely.Redis), cacheRecord.NewCacheLogger(rely.LogSink))
+	deptRepository := repositorySystem.NewDeptRepository(daoSystem.NewGORMDeptDAO(rely.Db.Careful), deptCache)
+
+	// 字典的字典项由外键级联删除；文件先于存储桶，存储桶仍被文件引用时保留
+	svc := serviceTools.NewRecycleService(serviceTools.DefaultRecycleConfig(),
+		dictRepository, dictTypeRepository,
+		fileService, bucketService,
+		userRepository, roleRepository, permissionRepository, menuRepository, deptRepository,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(recyclePurgeInterval)
+		defer ticker.Stop()
+
+		for {
+			purged, err := svc.PurgeExpired(ctx)
+			if err != nil {
+				zap.L().Error("清理回收站过期数据失败", zap.Error(err))
+			} else if purged > 0 {
+				zap.L().Info("已清理回收站过期数据", zap.Int64("count", purged))
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return cancel
+}
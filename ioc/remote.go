@@ -0,0 +1,168 @@
+/**
+ * Description：
+ * FileName：remote.go
+ * Author：CJiaの用心
+ * Create：2025/11/16 14:08:52
+ * Remark：
+ */
+
+package ioc
+
+import (
+	"fmt"
+	"github.com/carefuly/careful-admin-go-gin/config"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/configsource"
+	"go.uber.org/zap"
+	"reflect"
+	"sync"
+)
+
+// RemoteConfigManager 远程配置管理器，按 本地文件 < Nacos < 环境变量 的优先级叠加业务配置
+// 任一来源变更时重新加载并校验，校验通过后通知使用方，未通过时继续使用当前配置
+type RemoteConfigManager struct {
+	source    *configsource.Layered
+	current   *config.RemoteConfig
+	listeners []func(old, latest *config.RemoteConfig)
+	stop      func()
+	mutex     sync.RWMutex
+	reloading sync.Mutex
+}
+
+// InitRemoteConfig 初始化远程配置，所有来源均不可用或配置不合法时终止启动
+func InitRemoteConfig(localConf *config.LocalConfig) *RemoteConfigManager {
+	manager, err := NewRemoteConfigManager(remoteProviders(localConf)...)
+	if err != nil {
+		zap.L().Fatal("加载远程配置失败", zap.Error(err))
+	}
+	zap.S().Debugf("远程配置加载成功: %+v", manager.Config())
+	return manager
+}
+
+// NewRemoteConfigManager 按给定来源创建远程配置管理器并完成首次加载，providers 按优先级从低到高排列
+func NewRemoteConfigManager(providers ...configsource.Provider) (*RemoteConfigManager, error) {
+	manager := &RemoteConfigManager{source: configsource.NewLayered(providers...)}
+	current, err := manager.load()
+	if err != nil {
+		return nil, err
+	}
+	manager.current = current
+	return manager, nil
+}
+
+// remoteProviders 根据本地配置创建配置来源，未配置 Nacos 地址或客户端创建失败时仅使用本地文件与环境变量
+func remoteProviders(localConf *config.LocalConfig) []configsource.Provider {
+	file := localConf.Remote.File
+	if file == "" {
+		file = config.DefaultRemoteFile
+	}
+	prefix := localConf.Remote.EnvPrefix
+	if prefix == "" {
+		prefix = config.DefaultEnvPrefix
+	}
+
+	providers := []configsource.Provider{configsource.NewFile(file)}
+	if localConf.NaCos.Host != "" {
+		client, err := newNacosClient(localConf.NaCos)
+		if err != nil {
+			zap.L().Warn("创建Nacos客户端失败，使用本地配置", zap.Error(err))
+		} else {
+			providers = append(providers, configsource.NewNacos(client, localConf.NaCos.DataId, localConf.NaCos.Group))
+		}
+	}
+	return append(providers, configsource.NewEnv(prefix, config.RemoteConfig{}))
+}
+
+func (m *RemoteConfigManager) load() (*config.RemoteConfig, error) {
+	remoteConfig := new(config.RemoteConfig)
+	if err := m.source.Load(remoteConfig); err != nil {
+		return nil, err
+	}
+	if err := remoteConfig.Validate(); err != nil {
+		return nil, fmt.Errorf("远程配置校验失败:\n%w", err)
+	}
+	return remoteConfig, nil
+}
+
+// Config 获取当前配置，返回值不可修改
+func (m *RemoteConfigManager) Config() *config.RemoteConfig {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return m.current
+}
+
+// OnChange 注册配置变更回调，回调按注册顺序串行执行
+func (m *RemoteConfigManager) OnChange(fn func(old, latest *config.RemoteConfig)) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.listeners = append(m.listeners, fn)
+}
+
+// StartWatching 开始监听配置来源变更
+func (m *RemoteConfigManager) StartWatching() error {
+	stop, err := m.source.Watch(m.reload)
+	if err != nil {
+		return err
+	}
+	m.stop = stop
+	return nil
+}
+
+// StopWatching 停止监听配置来源变更
+func (m *RemoteConfigManager) StopWatching() {
+	if m.stop != nil {
+		m.stop()
+		zap.L().Info("已停止监听远程配置变更")
+	}
+}
+
+// reload 重新加载配置并通知使用方，多个来源同时变更时串行处理
+func (m *RemoteConfigManager) reload() {
+	m.reloading.Lock()
+	defer m.reloading.Unlock()
+
+	latest, err := m.load()
+	if err != nil {
+		zap.L().Error("重新加载远程配置失败，继续使用当前配置", zap.Error(err))
+		return
+	}
+
+	m.mutex.Lock()
+	old := m.current
+	m.current = latest
+	listeners := append([]func(old, latest *config.RemoteConfig){}, m.listeners...)
+	m.mutex.Unlock()
+
+	if reflect.DeepEqual(old, latest) {
+		return
+	}
+	zap.L().Info("远程配置已更新")
+	warnRestartRequired(old, latest)
+	for _, fn := range listeners {
+		fn(old, latest)
+	}
+}
+
+// warnRestartRequired 提示需重启服务后生效的配置变更
+func warnRestartRequired(old, latest *config.RemoteConfig) {
+	if len(old.DatabaseConfig) != len(latest.DatabaseConfig) {
+		zap.L().Warn("数据库配置增减需重启服务后生效")
+	}
+	for name, detail := range latest.DatabaseConfig {
+		previous, ok := old.DatabaseConfig[name]
+		if ok && connectionOf(previous) != connectionOf(detail) {
+			zap.L().Warn("数据库连接配置变更需重启服务后生效，连接池大小已即时生效", zap.String("name", name))
+		}
+	}
+	if old.CacheConfig != latest.CacheConfig {
+		zap.L().Warn("缓存配置变更需重启服务后生效")
+	}
+	if old.StorageConfig != latest.StorageConfig {
+		zap.L().Warn("文件存储配置变更需重启服务后生效")
+	}
+}
+
+// connectionOf 去掉连接池参数后的数据库连接配置
+func connectionOf(detail config.DatabaseDetail) config.DatabaseDetail {
+	detail.MaxIdleConn, detail.MaxOpenConn, detail.ConnMaxLifetime = 0, 0, nil
+	return detail
+}
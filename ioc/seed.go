@@ -0,0 +1,67 @@
+/**
+ * Description：
+ * FileName：seed.go
+ * Author：CJiaの用心
+ * Create：2025/11/15 11:20:37
+ * Remark：
+ */
+
+package ioc
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/seeds"
+	"io/fs"
+	"os"
+)
+
+// RunSeedCommand 执行种子数据导入子命令 seed [-env 环境] [-dir 目录]，无交互，可用于 CI 与容器启动脚本
+// 导入前先执行未执行的数据库迁移，默认使用编译进程序的种子数据与 application.environment 对应的环境
+func RunSeedCommand(configFile string, args []string) error {
+	flags := flag.NewFlagSet("seed", flag.ContinueOnError)
+	env := flags.String("env", "", "种子数据环境，默认 application.environment")
+	dir := flags.String("dir", "", "种子数据目录，目录结构与内置种子数据一致，默认使用内置种子数据")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	var fsys fs.FS = seeds.FS
+	if *dir != "" {
+		fsys = os.DirFS(*dir)
+	}
+	if *env == "" {
+		*env = InitConfig(configFile).Config.Application.Environment
+	}
+	fixture, err := seeds.LoadFixture(fsys, *env)
+	if err != nil {
+		return err
+	}
+
+	db, err := openCommandDB(configFile)
+	if err != nil {
+		return err
+	}
+	InitMigrate(db)
+
+	result, err := seeds.Apply(context.Background(), db, fixture)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("种子数据导入完成(环境: %s)\n", *env)
+	for _, item := range []struct {
+		name string
+		stat seeds.Stat
+	}{
+		{"部门", result.Depts},
+		{"用户", result.Users},
+		{"菜单", result.Menus},
+		{"字典", result.Dicts},
+		{"字典项", result.DictItems},
+	} {
+		fmt.Printf("  %s: 新增 %d，更新 %d，无变化 %d\n", item.name, item.stat.Created, item.stat.Updated, item.stat.Unchanged)
+	}
+	return nil
+}
@@ -39,6 +39,7 @@ type Server struct {
 	staticPath   string
 	Translator   ut.Translator
 	routerEngine *gin.Engine
+	shutdowns    []func(ctx context.Context) error
 }
 
 func NewServer(rely config.RelyConfig, locale string) *Server {
@@ -119,18 +120,31 @@ func (s *Server) InitGinMiddlewares(rely config.RelyConfig) []gin.HandlerFunc {
 
 	return []gin.HandlerFunc{
 		middleware.NewCorsMiddlewareBuilder().Build(),                 // 跨域支持
+		middleware.NewRequestIdMiddlewareBuilder().Build(),            // 请求ID
 		middleware.NewProductionRecoveryMiddleware().Build(),          // 异常恢复
 		middleware.NewRequestTimeoutWithConfig(timeOutConfig).Build(), // 请求超时控制
 		middleware.NewLoginJWTMiddlewareBuilder(rely).
 			IgnorePaths("/dev-api/v1/auth/login").
+			IgnorePaths("/dev-api/v1/auth/captcha").
 			IgnorePaths("/dev-api/v1/auth/refresh-token").
+			IgnorePaths(JWKSPath).
+			IgnorePaths("/dev-api/v1/storage/object").
 			Build(), // 认证中间件
+		middleware.NewPermissionMiddlewareBuilder(rely).
+			IgnorePrefix("/v1/auth/").
+			IgnorePrefix("/v1/jobs/").
+			IgnorePrefix("/v1/storage/").
+			Build(), // 接口权限中间件（后台任务由接口校验提交人，预签名下载由接口校验签名）
+		middleware.NewDataScopeMiddlewareBuilder(rely).
+			IgnorePrefix("/v1/auth/").
+			IgnorePrefix("/v1/storage/").
+			Build(), // 数据权限中间件
 		middleware.NewLogger(rely.Logger).Build(), // 请求日志
 		middleware.NewStorage(rely).Build(),       // 本地化日志
 	}
 }
 
-func (s *Server) InitWebServer(middlewares []gin.HandlerFunc, debug bool) *gin.Engine {
+func (s *Server) InitWebServer(middlewares []gin.HandlerFunc, debug bool, trustedProxies []string) *gin.Engine {
 	if debug {
 		gin.SetMode(gin.DebugMode) // 开发模式
 	} else {
@@ -138,6 +152,10 @@ func (s *Server) InitWebServer(middlewares []gin.HandlerFunc, debug bool) *gin.E
 	}
 
 	engine := gin.Default()
+	// 默认信任所有代理会导致 X-Forwarded-For 可被伪造，仅信任配置的代理
+	if err := engine.SetTrustedProxies(trustedProxies); err != nil {
+		zap.L().Fatal("可信代理配置不合法", zap.Error(err))
+	}
 	engine.Use(middlewares...)
 
 	// 注册静态资源路由
@@ -163,6 +181,11 @@ func (s *Server) InitWebServer(middlewares []gin.HandlerFunc, debug bool) *gin.E
 	return engine
 }
 
+// OnShutdown 注册关闭钩子，在HTTP服务停止后按注册顺序执行
+func (s *Server) OnShutdown(fn func(ctx context.Context) error) {
+	s.shutdowns = append(s.shutdowns, fn)
+}
+
 // Run 优雅启动应用
 func (s *Server) Run(host string, port int) error {
 	if s.routerEngine == nil {
@@ -203,6 +226,13 @@ func (s *Server) Run(host string, port int) error {
 		return fmt.Errorf("强制关闭服务: %w", err)
 	}
 
+	// 执行关闭钩子（如刷新审计日志）
+	for _, fn := range s.shutdowns {
+		if err := fn(ctx); err != nil {
+			zap.L().Error("关闭钩子执行失败", zap.Error(err))
+		}
+	}
+
 	zap.L().Info("服务已停止")
 	return nil
 }
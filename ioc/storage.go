@@ -0,0 +1,68 @@
+/**
+ * Description：
+ * FileName：storage.go
+ * Author：CJiaの用心
+ * Create：2025/11/11 15:08:42
+ * Remark：
+ */
+
+package ioc
+
+import (
+	"github.com/carefuly/careful-admin-go-gin/config"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/storage"
+	"go.uber.org/zap"
+	"time"
+)
+
+const (
+	defaultStorageRoot   = "./uploads/storage"
+	defaultStorageURL    = "/dev-api/v1/storage/object"
+	defaultPresignExpire = 900 // 秒
+)
+
+// InitStorage 初始化文件存储驱动，返回驱动及预签名下载地址有效期
+func InitStorage(cfg config.Storage, token config.Token) (storage.Driver, time.Duration) {
+	expire := cfg.PresignExpire
+	if expire <= 0 {
+		expire = defaultPresignExpire
+	}
+
+	var (
+		driver storage.Driver
+		err    error
+	)
+	switch cfg.Driver {
+	case "", storage.DriverLocal:
+		root := cfg.LocalRoot
+		if root == "" {
+			root = defaultStorageRoot
+		}
+		url := cfg.LocalURL
+		if url == "" {
+			url = defaultStorageURL
+		}
+		secret := cfg.Secret
+		if secret == "" {
+			secret = token.Secret
+		}
+		driver, err = storage.NewLocalDriver(storage.LocalConfig{Root: root, URL: url, Secret: secret})
+	case storage.DriverS3:
+		driver, err = storage.NewS3Driver(storage.S3Config{
+			Endpoint:  cfg.Endpoint,
+			Region:    cfg.Region,
+			Bucket:    cfg.Bucket,
+			AccessKey: cfg.AccessKey,
+			SecretKey: cfg.SecretKey,
+			PathStyle: cfg.PathStyle,
+		})
+	default:
+		zap.L().Fatal("不支持的文件存储驱动", zap.String("driver", cfg.Driver))
+	}
+	if err != nil {
+		zap.L().Fatal("文件存储初始化失败", zap.Error(err))
+	}
+
+	zap.L().Info("文件存储已初始化", zap.String("driver", driver.Name()))
+	return driver, time.Duration(expire) * time.Second
+}
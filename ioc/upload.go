@@ -0,0 +1,51 @@
+/**
+ * Description：
+ * FileName：upload.go
+ * Author：CJiaの用心
+ * Create：2025/11/12 15:10:44
+ * Remark：
+ */
+
+package ioc
+
+import (
+	"context"
+	"github.com/carefuly/careful-admin-go-gin/config"
+	cacheTools "github.com/carefuly/careful-admin-go-gin/internal/repository/cache/careful/tools"
+	serviceTools "github.com/carefuly/careful-admin-go-gin/internal/service/careful/tools"
+	"go.uber.org/zap"
+	"time"
+)
+
+// uploadCleanInterval 过期上传分片清理间隔
+const uploadCleanInterval = time.Hour
+
+// InitUploadCleaner 定时清理会话已过期的上传分片
+// 返回的 stop 用于停止定时清理
+func InitUploadCleaner(rely config.RelyConfig) func() {
+	uploadCache := cacheTools.NewRedisUploadCache(rely.Redis)
+	svc := serviceTools.NewUploadService(uploadCache, nil, nil, serviceTools.DefaultUploadConfig())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(uploadCleanInterval)
+		defer ticker.Stop()
+
+		for {
+			removed, err := svc.CleanOrphans(ctx)
+			if err != nil {
+				zap.L().Error("清理过期的上传分片失败", zap.Error(err))
+			} else if removed > 0 {
+				zap.L().Info("已清理过期的上传分片", zap.Int("count", removed))
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return cancel
+}
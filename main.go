@@ -9,9 +9,11 @@
 package main
 
 import (
+	"fmt"
 	"github.com/carefuly/careful-admin-go-gin/config"
 	"github.com/carefuly/careful-admin-go-gin/ioc"
 	"go.uber.org/zap"
+	"os"
 )
 
 // @title CarefulAdmin 后台管理系统 API
@@ -38,28 +40,76 @@ import (
 // @externalDocs.description    开源代码库
 // @externalDocs.url            https://github.com/carefuly/carefuly-admin-go-gin
 func main() {
+	// 数据库迁移子命令
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := ioc.RunMigrateCommand("./application.yaml", os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+	// 种子数据导入子命令
+	if len(os.Args) > 1 && os.Args[1] == "seed" {
+		if err := ioc.RunSeedCommand("./application.yaml", os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	// 初始化日志
 	loggerManager := ioc.InitLogger()
 	// 初始化配置管理器
 	configManager := ioc.InitConfig("./application.yaml")
 	configManager.RelyConfig.Logger = loggerManager.GetLogger()
+	// 日志级别随 application.debug 即时生效
+	loggerManager.SetDebug(configManager.Config.Application.Debug)
+	configManager.OnChange(loggerManager.ApplyLocalConfig)
 	// 启动配置文件监听
 	if err := configManager.StartWatching(); err != nil {
 		zap.S().Fatal("启动配置文件监听失败", err)
 	}
 	defer configManager.StopWatching()
 	// 初始化远程配置
-	remoteConfig := ioc.InitLoadNacosConfig(configManager.Config)
+	remoteManager := ioc.InitRemoteConfig(configManager.Config)
+	remoteConfig := remoteManager.Config()
 	// 初始化数据库池
 	dbPool := ioc.NewDbPool(remoteConfig.DatabaseConfig)
+	// 执行数据库迁移
+	ioc.InitMigrate(dbPool.CarefulDB)
 	configManager.RelyConfig.Db = config.Database{
 		Careful: dbPool.CarefulDB,
 		// Table:   dbPool.TableDB,
 	}
 	// 初始化缓存
 	configManager.RelyConfig.Redis = ioc.InitCache(remoteConfig.CacheConfig)
-	// Token密钥
-	configManager.RelyConfig.Token = remoteConfig.TokenConfig
+	// Token配置
+	configManager.RelyConfig.Token = config.NewLive(remoteConfig.TokenConfig)
+	// Token签名密钥
+	keys, stopKeyReload := ioc.InitKeyManager(remoteConfig.TokenConfig)
+	defer stopKeyReload()
+	configManager.RelyConfig.Keys = keys
+	// 审计日志写入器
+	configManager.RelyConfig.LogSink = ioc.InitLogSink(dbPool.CarefulDB)
+	// 后台任务协程池与过期结果清理
+	configManager.RelyConfig.Jobs = ioc.InitJobPool()
+	stopJobCleaner := ioc.InitJobCleaner(configManager.RelyConfig)
+	defer stopJobCleaner()
+	// 文件存储驱动
+	configManager.RelyConfig.Storage, configManager.RelyConfig.Presign = ioc.InitStorage(remoteConfig.StorageConfig, remoteConfig.TokenConfig)
+	// 过期上传分片清理
+	stopUploadCleaner := ioc.InitUploadCleaner(configManager.RelyConfig)
+	defer stopUploadCleaner()
+	// 回收站过期数据清理
+	stopRecycleCleaner := ioc.InitRecycleCleaner(configManager.RelyConfig)
+	defer stopRecycleCleaner()
+	// 远程配置变更时令牌配置与连接池大小即时生效
+	remoteManager.OnChange(ioc.ApplyTokenConfig(configManager.RelyConfig.Token))
+	remoteManager.OnChange(dbPool.ApplyPoolConfig)
+	if err := remoteManager.StartWatching(); err != nil {
+		zap.L().Error("启动远程配置监听失败，配置变更需重启服务后生效", zap.Error(err))
+	}
+	defer remoteManager.StopWatching()
 
 	server := ioc.NewServer(configManager.RelyConfig, "zh")
 	// 初始化翻译器
@@ -67,10 +117,13 @@ func main() {
 		zap.L().Fatal("翻译器初始化失败", zap.Error(err))
 	}
 	configManager.RelyConfig.Trans = server.Translator
+	// 服务关闭时等待后台任务结束，再刷新剩余审计日志
+	server.OnShutdown(configManager.RelyConfig.Jobs.Close)
+	server.OnShutdown(configManager.RelyConfig.LogSink.Close)
 	// 初始化中间件
 	middlewares := server.InitGinMiddlewares(configManager.RelyConfig)
 	// 初始化Web服务器
-	engine := server.InitWebServer(middlewares, configManager.Config.Application.Debug)
+	engine := server.InitWebServer(middlewares, configManager.Config.Application.Debug, configManager.Config.Server.TrustedProxies)
 	// 注册API路由
 	ioc.RegisterRoutes(true, engine, configManager.RelyConfig)
 	// 启动服务
@@ -0,0 +1,24 @@
+/**
+ * Description：
+ * FileName：const.go
+ * Author：CJiaの用心
+ * Create：2025/11/14 09:18:26
+ * Remark：
+ */
+
+package data_log
+
+type ActionConst int // 变更类型
+
+const (
+	ActionConstCreate ActionConst = iota + 1 // 新增
+	ActionConstUpdate                        // 修改
+	ActionConstDelete                        // 删除
+)
+
+// ActionMapping 变更类型映射
+var ActionMapping = map[ActionConst]string{
+	ActionConstCreate: "新增",
+	ActionConstUpdate: "修改",
+	ActionConstDelete: "删除",
+}
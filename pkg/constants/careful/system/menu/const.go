@@ -0,0 +1,17 @@
+/**
+ * Description：
+ * FileName：const.go
+ * Author：CJiaの用心
+ * Create：2025/10/28 09:12:40
+ * Remark：
+ */
+
+package menu
+
+type TypeConst int
+
+const (
+	TypeConstDirectory TypeConst = iota + 1 // 目录
+	TypeConstPage                           // 菜单页面
+	TypeConstButton                         // 按钮
+)
@@ -0,0 +1,19 @@
+/**
+ * Description：
+ * FileName：const.go
+ * Author：CJiaの用心
+ * Create：2025/10/29 09:05:16
+ * Remark：
+ */
+
+package role
+
+type DataScopeConst int
+
+const (
+	DataScopeConstAll          DataScopeConst = iota + 1 // 全部数据
+	DataScopeConstDept                                   // 本部门数据
+	DataScopeConstDeptAndChild                           // 本部门及以下数据
+	DataScopeConstCustom                                 // 自定义部门数据
+	DataScopeConstSelf                                   // 仅本人数据
+)
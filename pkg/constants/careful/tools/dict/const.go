@@ -8,6 +8,8 @@
 
 package dict
 
+import "github.com/carefuly/careful-admin-go-gin/pkg/utils/enumconv"
+
 type TypeConst int // 字典类型
 
 const (
@@ -51,3 +53,22 @@ var TypeValueImportMapping = map[string]ValueTypeConst{
 	"整型":  ValueTypeConstInt,
 	"布尔":  ValueTypeConstBool,
 }
+
+// StatusMapping 状态映射
+var StatusMapping = map[bool]string{
+	true:  "启用",
+	false: "停用",
+}
+
+// StatusImportMapping 状态映射
+var StatusImportMapping = map[string]bool{
+	"启用": true,
+	"停用": false,
+}
+
+// 注册枚举转换器，供 excel 标签按名称引用
+func init() {
+	enumconv.Register("dict.TypeMapping", enumconv.NewEnumConverter(TypeMapping, TypeImportMapping, []string{"普通字典", "系统字典", "枚举字典"}, "字典类型"))
+	enumconv.Register("dict.TypeValueMapping", enumconv.NewEnumConverter(TypeValueMapping, TypeValueImportMapping, []string{"字符串", "整型", "布尔"}, "数据类型"))
+	enumconv.Register("dict.StatusMapping", enumconv.NewEnumConverter(StatusMapping, StatusImportMapping, []string{"启用", "停用"}, "状态"))
+}
@@ -8,6 +8,8 @@
 
 package dict_type
 
+import "github.com/carefuly/careful-admin-go-gin/pkg/utils/enumconv"
+
 type DictTagConst string // 标签类型
 
 const (
@@ -36,8 +38,20 @@ var DictTagImportMapping = map[string]DictTagConst{
 	"info":    DictTagConstInfo,
 }
 
+// BoolValueMapping 布尔值类型映射
+var BoolValueMapping = map[bool]string{
+	true:  "是",
+	false: "否",
+}
+
 // BoolValueImportMapping 布尔值类型映射
 var BoolValueImportMapping = map[string]bool{
 	"是": true,
 	"否": false,
 }
+
+// 注册枚举转换器，供 excel 标签按名称引用
+func init() {
+	enumconv.Register("dict_type.DictTagMapping", enumconv.NewEnumConverter(DictTagMapping, DictTagImportMapping, []string{"primary", "success", "warning", "danger", "info"}, "标签类型"))
+	enumconv.Register("dict_type.BoolValueMapping", enumconv.NewEnumConverter(BoolValueMapping, BoolValueImportMapping, []string{"是", "否"}, "布尔值"))
+}
@@ -0,0 +1,76 @@
+/**
+ * Description：
+ * FileName：const.go
+ * Author：CJiaの用心
+ * Create：2025/11/7 10:12:35
+ * Remark：
+ */
+
+package job
+
+type TypeConst int // 任务类型
+
+const (
+	TypeConstImport TypeConst = iota + 1 // 导入
+	TypeConstExport                      // 导出
+)
+
+// TypeMapping 任务类型映射
+var TypeMapping = map[TypeConst]string{
+	TypeConstImport: "导入",
+	TypeConstExport: "导出",
+}
+
+type StatusConst int // 任务状态
+
+const (
+	StatusConstQueued  StatusConst = iota + 1 // 排队中
+	StatusConstRunning                        // 执行中
+	StatusConstDone                           // 已完成
+	StatusConstFailed                         // 失败
+	StatusConstExpired                        // 已过期(结果文件已清理)
+)
+
+// StatusMapping 任务状态映射
+var StatusMapping = map[StatusConst]string{
+	StatusConstQueued:  "排队中",
+	StatusConstRunning: "执行中",
+	StatusConstDone:    "已完成",
+	StatusConstFailed:  "失败",
+	StatusConstExpired: "已过期",
+}
+
+// transitions 任务状态机：排队中 -> 执行中 -> 已完成/失败，已完成 -> 已过期
+var transitions = map[StatusConst][]StatusConst{
+	StatusConstQueued:  {StatusConstRunning, StatusConstFailed},
+	StatusConstRunning: {StatusConstDone, StatusConstFailed},
+	StatusConstDone:    {StatusConstExpired},
+}
+
+// CanTransition 是否允许从当前状态流转到目标状态
+func (s StatusConst) CanTransition(to StatusConst) bool {
+	for _, next := range transitions[s] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Finished 任务是否已结束，结束后状态不再随执行进度变化
+func (s StatusConst) Finished() bool {
+	return s == StatusConstDone || s == StatusConstFailed || s == StatusConstExpired
+}
+
+// Sources 可流转到目标状态的来源状态
+func Sources(to StatusConst) []StatusConst {
+	sources := make([]StatusConst, 0, 2)
+	for from, nexts := range transitions {
+		for _, next := range nexts {
+			if next == to {
+				sources = append(sources, from)
+			}
+		}
+	}
+	return sources
+}
@@ -10,9 +10,19 @@ package filters
 
 import (
 	"context"
+	"errors"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
 )
 
+var (
+	ErrTimeRangeInvalid = errors.New("开始时间不能晚于结束时间")
+)
+
+// DataScopeKey 数据权限在请求上下文中的键，由数据权限中间件写入
+const DataScopeKey = "dataScope"
+
 // QueryFiltersBuilder 查询构建器接口
 type QueryFiltersBuilder interface {
 	QueryFilter(ctx context.Context, query *gorm.DB) *gorm.DB
@@ -24,6 +34,50 @@ type Pagination struct {
 	PageSize int `json:"pageSize"` // 每页显示的条数
 }
 
+// TimeRange 创建时间范围查询构建器，零值表示不限制
+type TimeRange struct {
+	StartTime time.Time `json:"startTime"` // 开始时间
+	EndTime   time.Time `json:"endTime"`   // 结束时间
+}
+
+// Valid 开始时间不能晚于结束时间
+func (t *TimeRange) Valid() bool {
+	return t.StartTime.IsZero() || t.EndTime.IsZero() || !t.StartTime.After(t.EndTime)
+}
+
+func (t *TimeRange) QueryFilter(ctx context.Context, query *gorm.DB) *gorm.DB {
+	if !t.StartTime.IsZero() {
+		query = query.Where("create_time >= ?", t.StartTime)
+	}
+	if !t.EndTime.IsZero() {
+		query = query.Where("create_time <= ?", t.EndTime)
+	}
+	return query
+}
+
+// Cursor 游标分页构建器，按创建时间倒序、主键倒序遍历，避免大数据量导出时深分页
+type Cursor struct {
+	CreateTime time.Time `json:"createTime"` // 上一批最后一条数据的创建时间
+	Id         string    `json:"id"`         // 上一批最后一条数据的主键
+}
+
+// NewCursor 以数据的创建时间与主键作为游标
+func NewCursor(createTime *time.Time, id string) Cursor {
+	cursor := Cursor{Id: id}
+	if createTime != nil {
+		cursor.CreateTime = *createTime
+	}
+	return cursor
+}
+
+// QueryFilter 查询游标之后的数据，需在 create_time DESC 排序之后调用
+func (c *Cursor) QueryFilter(ctx context.Context, query *gorm.DB) *gorm.DB {
+	if c != nil && c.Id != "" {
+		query = query.Where("create_time < ? OR (create_time = ? AND id < ?)", c.CreateTime, c.CreateTime, c.Id)
+	}
+	return query.Order("id DESC")
+}
+
 // Filters 基础查询构建器
 type Filters struct {
 	Creator    string `json:"creator"`    // 创建人
@@ -31,6 +85,22 @@ type Filters struct {
 	BelongDept string `json:"belongDept"` // 数据归属部门
 }
 
+// DataScope 当前用户的数据权限，多个角色取并集
+type DataScope struct {
+	All     bool     `json:"all"`     // 全部数据
+	DeptIds []string `json:"deptIds"` // 可访问的数据归属部门
+	UserId  string   `json:"userId"`  // 不为空时可访问本人创建的数据
+}
+
+// DataScopeFromContext 获取上下文中的数据权限，未设置时返回false
+func DataScopeFromContext(ctx context.Context) (*DataScope, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+	scope, ok := ctx.Value(DataScopeKey).(*DataScope)
+	return scope, ok && scope != nil
+}
+
 func (f *Filters) QueryFilter(ctx context.Context, query *gorm.DB) *gorm.DB {
 	// 进入后先查询权限
 	// if f.Creator != "" {
@@ -39,5 +109,40 @@ func (f *Filters) QueryFilter(ctx context.Context, query *gorm.DB) *gorm.DB {
 	// if f.Modifier != "" {
 	// 	query = query.Where("modifier LIKE ?", "%"+f.Modifier+"%")
 	// }
-	return query
-}
\ No newline at end of file
+	scope, ok := DataScopeFromContext(ctx)
+	if !ok {
+		return query
+	}
+	return scope.QueryFilter(ctx, query)
+}
+
+// QueryFilter 按数据归属部门和创建人限制查询范围
+func (s *DataScope) QueryFilter(ctx context.Context, query *gorm.DB) *gorm.DB {
+	if s.All {
+		return query
+	}
+
+	conditions := make([]clause.Expression, 0, 2)
+	if len(s.DeptIds) > 0 {
+		values := make([]any, 0, len(s.DeptIds))
+		for _, id := range s.DeptIds {
+			values = append(values, id)
+		}
+		conditions = append(conditions, clause.IN{
+			Column: clause.Column{Table: clause.CurrentTable, Name: "belong_dept"},
+			Values: values,
+		})
+	}
+	if s.UserId != "" {
+		conditions = append(conditions, clause.Eq{
+			Column: clause.Column{Table: clause.CurrentTable, Name: "creator"},
+			Value:  s.UserId,
+		})
+	}
+
+	// 没有任何可访问范围时不返回数据
+	if len(conditions) == 0 {
+		return query.Where("1 = 0")
+	}
+	return query.Where(clause.Or(conditions...))
+}
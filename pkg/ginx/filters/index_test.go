@@ -0,0 +1,117 @@
+/**
+ * Description：
+ * FileName：index_test.go
+ * Author：CJiaの用心
+ * Create：2025/10/29 10:42:37
+ * Remark：
+ */
+
+package filters
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"testing"
+	"time"
+)
+
+type scopedModel struct {
+	Id         string
+	Creator    string
+	BelongDept string
+}
+
+func (scopedModel) TableName() string {
+	return "careful_scoped"
+}
+
+func TestFilters_QueryFilter(t *testing.T) {
+	db, err := gorm.Open(mysql.New(mysql.Config{SkipInitializeWithVersion: true}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		scope    *DataScope
+		wantSQL  string
+		wantVars []any
+	}{
+		{
+			name:    "未设置数据权限",
+			wantSQL: "SELECT * FROM `careful_scoped`",
+		},
+		{
+			name:    "全部数据",
+			scope:   &DataScope{All: true, UserId: "u1"},
+			wantSQL: "SELECT * FROM `careful_scoped`",
+		},
+		{
+			name:     "部门数据",
+			scope:    &DataScope{DeptIds: []string{"d1", "d2"}},
+			wantSQL:  "SELECT * FROM `careful_scoped` WHERE `careful_scoped`.`belong_dept` IN (?,?)",
+			wantVars: []any{"d1", "d2"},
+		},
+		{
+			name:     "部门数据及本人数据",
+			scope:    &DataScope{DeptIds: []string{"d1"}, UserId: "u1"},
+			wantSQL:  "SELECT * FROM `careful_scoped` WHERE (`careful_scoped`.`belong_dept` = ? OR `careful_scoped`.`creator` = ?)",
+			wantVars: []any{"d1", "u1"},
+		},
+		{
+			name:    "无可访问范围",
+			scope:   &DataScope{},
+			wantSQL: "SELECT * FROM `careful_scoped` WHERE 1 = 0",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := &gin.Context{}
+			if tc.scope != nil {
+				ctx.Set(DataScopeKey, tc.scope)
+			}
+
+			filter := &Filters{}
+			stmt := filter.QueryFilter(ctx, db.Model(&scopedModel{})).Find(&[]scopedModel{}).Statement
+			assert.Equal(t, tc.wantSQL, stmt.SQL.String())
+			if tc.wantVars != nil {
+				assert.Equal(t, tc.wantVars, stmt.Vars)
+			}
+		})
+	}
+}
+
+func TestDataScopeFromContext(t *testing.T) {
+	_, ok := DataScopeFromContext(context.Background())
+	assert.False(t, ok)
+
+	ctx := &gin.Context{}
+	ctx.Set(DataScopeKey, &DataScope{All: true})
+	scope, ok := DataScopeFromContext(ctx)
+	assert.True(t, ok)
+	assert.True(t, scope.All)
+}
+
+func TestCursor_QueryFilter(t *testing.T) {
+	db, err := gorm.Open(mysql.New(mysql.Config{SkipInitializeWithVersion: true}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+	})
+	require.NoError(t, err)
+
+	query := db.Model(&scopedModel{}).Where("creator = ?", "u1").Order("create_time DESC")
+	stmt := (*Cursor)(nil).QueryFilter(context.Background(), query).Find(&[]scopedModel{}).Statement
+	assert.Equal(t, "SELECT * FROM `careful_scoped` WHERE creator = ? ORDER BY create_time DESC,id DESC", stmt.SQL.String())
+
+	last := time.Date(2025, 11, 8, 10, 0, 0, 0, time.Local)
+	query = db.Model(&scopedModel{}).Where("creator = ?", "u1").Order("create_time DESC")
+	stmt = (&Cursor{CreateTime: last, Id: "ID9"}).QueryFilter(context.Background(), query).Find(&[]scopedModel{}).Statement
+	assert.Equal(t, "SELECT * FROM `careful_scoped` WHERE creator = ? AND (create_time < ? OR (create_time = ? AND id < ?)) ORDER BY create_time DESC,id DESC", stmt.SQL.String())
+	assert.Equal(t, []any{"u1", last, last, "ID9"}, stmt.Vars)
+}
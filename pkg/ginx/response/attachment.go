@@ -0,0 +1,50 @@
+/**
+ * Description：
+ * FileName：attachment.go
+ * Author：CJiaの用心
+ * Create：2025/11/8 11:20:37
+ * Remark：
+ */
+
+package response
+
+import (
+	"github.com/gin-gonic/gin"
+	"mime"
+)
+
+// AttachmentWriter 文件下载响应写入器
+// 首次写入时才设置下载响应头，写入前发生错误时仍可返回JSON错误响应
+type AttachmentWriter struct {
+	ctx         *gin.Context
+	filename    string
+	contentType string
+	written     bool
+}
+
+// NewAttachmentWriter 创建文件下载响应写入器
+func NewAttachmentWriter(ctx *gin.Context, filename, contentType string) *AttachmentWriter {
+	return &AttachmentWriter{
+		ctx:         ctx,
+		filename:    filename,
+		contentType: contentType,
+	}
+}
+
+func (w *AttachmentWriter) Write(p []byte) (int, error) {
+	if !w.written {
+		w.written = true
+		header := w.ctx.Writer.Header()
+		header.Set("Content-Type", w.contentType)
+		// 中文文件名按 RFC 2231 编码
+		header.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": w.filename}))
+		header.Set("Pragma", "no-cache")
+		header.Set("Cache-Control", "no-store")
+	}
+	return w.ctx.Writer.Write(p)
+}
+
+// Written 是否已开始写入文件内容，开始写入后无法再返回错误响应
+func (w *AttachmentWriter) Written() bool {
+	return w.written
+}
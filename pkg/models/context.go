@@ -0,0 +1,47 @@
+/**
+ * Description：
+ * FileName：context.go
+ * Author：CJiaの用心
+ * Create：2025/11/14 09:41:52
+ * Remark：
+ */
+
+package models
+
+import "context"
+
+const (
+	// OperatorKey 操作人在请求上下文中的键，由登录中间件写入
+	OperatorKey = "userId"
+	// RequestIdKey 请求ID在请求上下文中的键，由请求ID中间件写入
+	RequestIdKey = "X-Request-ID"
+)
+
+// operatorKey 非请求上下文中显式设置的操作人
+type operatorKey struct{}
+
+// WithOperator 设置上下文中的操作人，用于后台任务等非请求上下文
+func WithOperator(ctx context.Context, userId string) context.Context {
+	return context.WithValue(ctx, operatorKey{}, userId)
+}
+
+// Operator 获取上下文中的操作人，未设置时返回空
+func Operator(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	if userId, ok := ctx.Value(operatorKey{}).(string); ok {
+		return userId
+	}
+	userId, _ := ctx.Value(OperatorKey).(string)
+	return userId
+}
+
+// RequestId 获取上下文中的请求ID，非请求上下文返回空
+func RequestId(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	requestId, _ := ctx.Value(RequestIdKey).(string)
+	return requestId
+}
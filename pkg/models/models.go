@@ -19,16 +19,17 @@ import (
 // CoreModels 公共模型
 // 核心标准抽象模型,可直接继承使用
 // 增加审计字段, 覆盖字段时, 字段名称请勿修改, 必须统一审计字段名称
+// 版本号与创建、修改时间不计入数据变更日志的字段差异，版本号单独记录
 type CoreModels struct {
-	Id         string     `gorm:"type:varchar(110);primaryKey;column:id;comment:主键ID" json:"id"`               // 主键ID(自增)
-	Sort       int        `gorm:"type:bigint;default:1;index;column:sort;comment:显示排序" json:"sort"`            // 显示排序
-	Timestamp  int64      `gorm:"type:bigint;column:timestamp;comment:版本号(时间戳)" json:"timestamp"`              // 版本号(时间戳)
-	Creator    string     `gorm:"type:varchar(100);index;column:creator;comment:创建人" json:"creator"`           // 创建人
-	Modifier   string     `gorm:"type:varchar(100);index;column:modifier;comment:修改人" json:"modifier"`         // 修改人
-	BelongDept string     `gorm:"type:varchar(100);index;column:belong_dept;comment:数据归属部门" json:"belongDept"` // 数据归属部门
-	CreateTime *time.Time `gorm:"autoCreateTime;index;column:create_time;comment:创建时间" json:"-"`               // 创建时间
-	UpdateTime *time.Time `gorm:"autoUpdateTime;index;column:update_time;comment:修改时间" json:"-"`               // 修改时间
-	Remark     string     `gorm:"type:varchar(512);column:remark;comment:备注" json:"remark"`                    // 备注
+	Id         string     `gorm:"type:varchar(110);primaryKey;column:id;comment:主键ID" json:"id"`                                                 // 主键ID(自增)
+	Sort       int        `gorm:"type:bigint;default:1;index;column:sort;comment:显示排序" json:"sort" excel:"title=排序;width=8;default=1;order=100"` // 显示排序
+	Timestamp  int64      `gorm:"type:bigint;column:timestamp;comment:版本号(时间戳)" json:"timestamp" datalog:"-"`                                    // 版本号(时间戳)
+	Creator    string     `gorm:"type:varchar(100);index;column:creator;comment:创建人" json:"creator"`                                             // 创建人
+	Modifier   string     `gorm:"type:varchar(100);index;column:modifier;comment:修改人" json:"modifier"`                                           // 修改人
+	BelongDept string     `gorm:"type:varchar(100);index;column:belong_dept;comment:数据归属部门" json:"belongDept"`                                   // 数据归属部门
+	CreateTime *time.Time `gorm:"autoCreateTime;index;column:create_time;comment:创建时间" json:"-" datalog:"-"`                                     // 创建时间
+	UpdateTime *time.Time `gorm:"autoUpdateTime;index;column:update_time;comment:修改时间" json:"-" datalog:"-"`                                     // 修改时间
+	Remark     string     `gorm:"type:varchar(512);column:remark;comment:备注" json:"remark" excel:"title=备注;width=40;multiline;order=110"`        // 备注
 }
 
 // BeforeCreate 创建前钩子
@@ -0,0 +1,185 @@
+/**
+ * Description：
+ * FileName：soft_delete.go
+ * Author：CJiaの用心
+ * Create：2025/11/13 09:32:15
+ * Remark：
+ */
+
+package models
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+	"reflect"
+	"time"
+)
+
+// DeletedAt 软删除标记，值为删除时间(毫秒时间戳)，0 表示未删除
+// 使用非空整型而非 NULL 时间，唯一索引追加该列后已删除的数据不再占用唯一值
+// 查询与更新自动追加 deleted_at = 0 条件，Delete 改为更新删除时间与删除人，Unscoped 可查询或物理删除已删除的数据
+type DeletedAt int64
+
+// SoftDelete 软删除字段，需要回收站的模型嵌入后启用软删除
+type SoftDelete struct {
+	DeletedAt DeletedAt `gorm:"type:bigint;not null;default:0;index;column:deleted_at;comment:删除时间(毫秒时间戳，0-未删除)" json:"deletedAt,omitempty"` // 删除时间
+	DeletedBy string    `gorm:"type:varchar(100);column:deleted_by;comment:删除人" json:"deletedBy,omitempty"`                                  // 删除人
+}
+
+// NewSoftDelete 以当前时间与上下文中的操作人生成删除标记
+func NewSoftDelete(ctx context.Context) SoftDelete {
+	return SoftDelete{
+		DeletedAt: DeletedAt(time.Now().UnixMilli()),
+		DeletedBy: Operator(ctx),
+	}
+}
+
+// Columns 删除标记对应的更新列，用于同一事务内以相同删除时间级联删除
+func (s SoftDelete) Columns() map[string]any {
+	return map[string]any{
+		"deleted_at": s.DeletedAt,
+		"deleted_by": s.DeletedBy,
+	}
+}
+
+// RestoreColumns 恢复数据时清空删除标记的更新列
+func RestoreColumns() map[string]any {
+	return map[string]any{
+		"deleted_at": DeletedAt(0),
+		"deleted_by": "",
+	}
+}
+
+// Time 删除时间，未删除时返回零值
+func (d DeletedAt) Time() time.Time {
+	if d == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(int64(d))
+}
+
+func (DeletedAt) QueryClauses(f *schema.Field) []clause.Interface {
+	return []clause.Interface{softDeleteQueryClause{Field: f}}
+}
+
+func (DeletedAt) UpdateClauses(f *schema.Field) []clause.Interface {
+	return []clause.Interface{softDeleteUpdateClause{Field: f}}
+}
+
+func (DeletedAt) DeleteClauses(f *schema.Field) []clause.Interface {
+	return []clause.Interface{softDeleteDeleteClause{Field: f}}
+}
+
+// softDeleteEnabled 标记语句已追加软删除条件，避免重复追加
+const softDeleteEnabled = "careful:soft_delete_enabled"
+
+type softDeleteQueryClause struct {
+	Field *schema.Field
+}
+
+func (sd softDeleteQueryClause) Name() string {
+	return ""
+}
+
+func (sd softDeleteQueryClause) Build(clause.Builder) {
+}
+
+func (sd softDeleteQueryClause) MergeClause(*clause.Clause) {
+}
+
+// ModifyStatement 追加 deleted_at = 0 条件
+func (sd softDeleteQueryClause) ModifyStatement(stmt *gorm.Statement) {
+	if _, ok := stmt.Clauses[softDeleteEnabled]; ok || stmt.Unscoped {
+		return
+	}
+
+	// 仅有一个 OR 条件时先整体加括号，避免与软删除条件的优先级错乱
+	if c, ok := stmt.Clauses["WHERE"]; ok {
+		if where, ok := c.Expression.(clause.Where); ok && len(where.Exprs) >= 1 {
+			for _, expr := range where.Exprs {
+				if orCond, ok := expr.(clause.OrConditions); ok && len(orCond.Exprs) == 1 {
+					where.Exprs = []clause.Expression{clause.And(where.Exprs...)}
+					c.Expression = where
+					stmt.Clauses["WHERE"] = c
+					break
+				}
+			}
+		}
+	}
+
+	stmt.AddClause(clause.Where{Exprs: []clause.Expression{
+		clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: sd.Field.DBName}, Value: DeletedAt(0)},
+	}})
+	stmt.Clauses[softDeleteEnabled] = clause.Clause{}
+}
+
+type softDeleteUpdateClause struct {
+	Field *schema.Field
+}
+
+func (sd softDeleteUpdateClause) Name() string {
+	return ""
+}
+
+func (sd softDeleteUpdateClause) Build(clause.Builder) {
+}
+
+func (sd softDeleteUpdateClause) MergeClause(*clause.Clause) {
+}
+
+// ModifyStatement 更新时仅作用于未删除的数据
+func (sd softDeleteUpdateClause) ModifyStatement(stmt *gorm.Statement) {
+	if stmt.SQL.Len() == 0 && !stmt.Unscoped {
+		softDeleteQueryClause(sd).ModifyStatement(stmt)
+	}
+}
+
+type softDeleteDeleteClause struct {
+	Field *schema.Field
+}
+
+func (sd softDeleteDeleteClause) Name() string {
+	return ""
+}
+
+func (sd softDeleteDeleteClause) Build(clause.Builder) {
+}
+
+func (sd softDeleteDeleteClause) MergeClause(*clause.Clause) {
+}
+
+// ModifyStatement 将 DELETE 改写为更新删除时间与删除人
+func (sd softDeleteDeleteClause) ModifyStatement(stmt *gorm.Statement) {
+	if stmt.SQL.Len() > 0 || stmt.Unscoped || stmt.Schema == nil {
+		return
+	}
+
+	deleted := NewSoftDelete(stmt.Context)
+	set := clause.Set{{Column: clause.Column{Name: sd.Field.DBName}, Value: deleted.DeletedAt}}
+	stmt.SetColumn(sd.Field.DBName, deleted.DeletedAt, true)
+	if field := stmt.Schema.LookUpField("deleted_by"); field != nil {
+		set = append(set, clause.Assignment{Column: clause.Column{Name: field.DBName}, Value: deleted.DeletedBy})
+		stmt.SetColumn(field.DBName, deleted.DeletedBy, true)
+	}
+	stmt.AddClause(set)
+
+	// 按传入模型的主键限定删除范围，与 gorm 物理删除的行为一致
+	_, queryValues := schema.GetIdentityFieldValuesMap(stmt.Context, stmt.ReflectValue, stmt.Schema.PrimaryFields)
+	column, values := schema.ToQueryValues(stmt.Table, stmt.Schema.PrimaryFieldDBNames, queryValues)
+	if len(values) > 0 {
+		stmt.AddClause(clause.Where{Exprs: []clause.Expression{clause.IN{Column: column, Values: values}}})
+	}
+	if stmt.ReflectValue.CanAddr() && stmt.Dest != stmt.Model && stmt.Model != nil {
+		_, queryValues = schema.GetIdentityFieldValuesMap(stmt.Context, reflect.ValueOf(stmt.Model), stmt.Schema.PrimaryFields)
+		column, values = schema.ToQueryValues(stmt.Table, stmt.Schema.PrimaryFieldDBNames, queryValues)
+		if len(values) > 0 {
+			stmt.AddClause(clause.Where{Exprs: []clause.Expression{clause.IN{Column: column, Values: values}}})
+		}
+	}
+
+	softDeleteQueryClause(sd).ModifyStatement(stmt)
+	stmt.AddClauseIfNotExists(clause.Update{})
+	stmt.Build(stmt.DB.Callback().Update().Clauses...)
+}
@@ -0,0 +1,109 @@
+/**
+ * Description：
+ * FileName：soft_delete_test.go
+ * Author：CJiaの用心
+ * Create：2025/11/13 10:26:41
+ * Remark：
+ */
+
+package models
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"testing"
+)
+
+type softDeleteModel struct {
+	Id   string
+	Name string
+	SoftDelete
+}
+
+func (softDeleteModel) TableName() string {
+	return "careful_soft_delete"
+}
+
+func newDryRunDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(mysql.New(mysql.Config{SkipInitializeWithVersion: true}), &gorm.Config{
+		DryRun:                 true,
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+	require.NoError(t, err)
+	return db
+}
+
+func TestDeletedAt_Clauses(t *testing.T) {
+	db := newDryRunDB(t)
+
+	testCases := []struct {
+		name    string
+		run     func(tx *gorm.DB) *gorm.DB
+		wantSQL string
+	}{
+		{
+			name: "查询排除已删除",
+			run: func(tx *gorm.DB) *gorm.DB {
+				return tx.Where("name = ?", "a").Or("name = ?", "b").Find(&[]softDeleteModel{})
+			},
+			wantSQL: "SELECT * FROM `careful_soft_delete` WHERE (name = ? OR name = ?) AND `careful_soft_delete`.`deleted_at` = ?",
+		},
+		{
+			name: "Unscoped 查询包含已删除",
+			run: func(tx *gorm.DB) *gorm.DB {
+				return tx.Unscoped().Where("deleted_at <> ?", 0).Find(&[]softDeleteModel{})
+			},
+			wantSQL: "SELECT * FROM `careful_soft_delete` WHERE deleted_at <> ?",
+		},
+		{
+			name: "更新仅作用于未删除",
+			run: func(tx *gorm.DB) *gorm.DB {
+				return tx.Model(&softDeleteModel{}).Where("id = ?", "1").Update("name", "a")
+			},
+			wantSQL: "UPDATE `careful_soft_delete` SET `name`=? WHERE id = ? AND `careful_soft_delete`.`deleted_at` = ?",
+		},
+		{
+			name: "删除改为标记删除时间与删除人",
+			run: func(tx *gorm.DB) *gorm.DB {
+				return tx.Where("id IN ?", []string{"1", "2"}).Delete(&softDeleteModel{})
+			},
+			wantSQL: "UPDATE `careful_soft_delete` SET `deleted_at`=?,`deleted_by`=? WHERE id IN (?,?) AND `careful_soft_delete`.`deleted_at` = ?",
+		},
+		{
+			name: "Unscoped 物理删除",
+			run: func(tx *gorm.DB) *gorm.DB {
+				return tx.Unscoped().Where("id = ?", "1").Delete(&softDeleteModel{})
+			},
+			wantSQL: "DELETE FROM `careful_soft_delete` WHERE id = ?",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			stmt := tc.run(db.Session(&gorm.Session{})).Statement
+			assert.Equal(t, tc.wantSQL, stmt.SQL.String())
+		})
+	}
+}
+
+func TestDeletedAt_DeletedBy(t *testing.T) {
+	db := newDryRunDB(t)
+
+	// 请求上下文中由登录中间件写入的用户
+	c, _ := gin.CreateTestContext(nil)
+	c.Set(OperatorKey, "U1")
+	stmt := db.WithContext(c).Where("id = ?", "1").Delete(&softDeleteModel{}).Statement
+	require.Len(t, stmt.Vars, 4)
+	assert.Positive(t, stmt.Vars[0])
+	assert.Equal(t, "U1", stmt.Vars[1])
+
+	// 显式设置的操作人优先
+	ctx := WithOperator(context.Background(), "U2")
+	stmt = db.WithContext(ctx).Where("id = ?", "1").Delete(&softDeleteModel{}).Statement
+	assert.Equal(t, "U2", stmt.Vars[1])
+}
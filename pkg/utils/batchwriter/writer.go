@@ -0,0 +1,217 @@
+/**
+ * Description：
+ * FileName：writer.go
+ * Author：CJiaの用心
+ * Create：2025/10/31 09:12:27
+ * Remark：
+ */
+
+package batchwriter
+
+import (
+	"context"
+	"errors"
+	"go.uber.org/zap"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var ErrWriterClosed = errors.New("写入器已关闭")
+
+// DropPolicy 队列已满时的处理策略
+type DropPolicy int
+
+const (
+	DropPolicyDropNewest DropPolicy = iota // 丢弃新写入的数据，不阻塞调用方
+	DropPolicyBlock                        // 阻塞等待队列空闲，超过 BlockTimeout 后丢弃
+)
+
+// FlushFunc 批量落库函数
+type FlushFunc[T any] func(ctx context.Context, items []T) error
+
+// Config 写入器配置
+type Config struct {
+	Name          string        // 名称，用于日志输出
+	QueueSize     int           // 队列容量 (默认: 4096)
+	Workers       int           // 后台协程数 (默认: 2)
+	BatchSize     int           // 单批次最大条数 (默认: 100)
+	FlushInterval time.Duration // 最长刷新间隔 (默认: 1秒)
+	FlushTimeout  time.Duration // 单次刷新超时 (默认: 5秒)
+	DropPolicy    DropPolicy    // 队列满时的处理策略 (默认: DropPolicyDropNewest)
+	BlockTimeout  time.Duration // DropPolicyBlock 下的最长等待时间 (默认: 100毫秒)
+}
+
+// DefaultConfig 默认配置
+func DefaultConfig() Config {
+	return Config{
+		QueueSize:     4096,
+		Workers:       2,
+		BatchSize:     100,
+		FlushInterval: time.Second,
+		FlushTimeout:  5 * time.Second,
+		DropPolicy:    DropPolicyDropNewest,
+		BlockTimeout:  100 * time.Millisecond,
+	}
+}
+
+// Writer 有界队列 + 后台批量刷新的异步写入器
+type Writer[T any] struct {
+	cfg     Config
+	flush   FlushFunc[T]
+	queue   chan T
+	mu      sync.RWMutex
+	closed  bool
+	wg      sync.WaitGroup
+	dropped atomic.Int64
+}
+
+// New 创建并启动写入器
+func New[T any](cfg Config, flush FlushFunc[T]) *Writer[T] {
+	def := DefaultConfig()
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = def.QueueSize
+	}
+	if cfg.Workers <= 0 {
+		cfg.Workers = def.Workers
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = def.BatchSize
+	}
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = def.FlushInterval
+	}
+	if cfg.FlushTimeout <= 0 {
+		cfg.FlushTimeout = def.FlushTimeout
+	}
+	if cfg.BlockTimeout <= 0 {
+		cfg.BlockTimeout = def.BlockTimeout
+	}
+
+	w := &Writer[T]{
+		cfg:   cfg,
+		flush: flush,
+		queue: make(chan T, cfg.QueueSize),
+	}
+	for i := 0; i < cfg.Workers; i++ {
+		w.wg.Add(1)
+		go w.run()
+	}
+	return w
+}
+
+// Write 写入一条数据，返回是否成功入队
+func (w *Writer[T]) Write(item T) bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	if w.closed {
+		w.dropped.Add(1)
+		return false
+	}
+
+	select {
+	case w.queue <- item:
+		return true
+	default:
+	}
+
+	if w.cfg.DropPolicy == DropPolicyBlock {
+		timer := time.NewTimer(w.cfg.BlockTimeout)
+		defer timer.Stop()
+		select {
+		case w.queue <- item:
+			return true
+		case <-timer.C:
+		}
+	}
+
+	w.dropped.Add(1)
+	return false
+}
+
+// Dropped 累计丢弃条数
+func (w *Writer[T]) Dropped() int64 {
+	return w.dropped.Load()
+}
+
+// Close 停止接收数据并刷新队列中剩余数据，ctx 到期后不再等待
+func (w *Writer[T]) Close(ctx context.Context) error {
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		return ErrWriterClosed
+	}
+	w.closed = true
+	close(w.queue)
+	w.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		w.wg.Wait()
+		close(done)
+	}()
+
+	if dropped := w.Dropped(); dropped > 0 {
+		zap.L().Warn("异步写入器存在丢弃数据", zap.String("name", w.cfg.Name), zap.Int64("dropped", dropped))
+	}
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// run 后台协程：按条数或时间间隔批量刷新
+func (w *Writer[T]) run() {
+	defer w.wg.Done()
+
+	ticker := time.NewTicker(w.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]T, 0, w.cfg.BatchSize)
+	for {
+		select {
+		case item, ok := <-w.queue:
+			if !ok {
+				w.doFlush(batch)
+				return
+			}
+			batch = append(batch, item)
+			if len(batch) >= w.cfg.BatchSize {
+				w.doFlush(batch)
+				batch = make([]T, 0, w.cfg.BatchSize)
+			}
+		case <-ticker.C:
+			if len(batch) > 0 {
+				w.doFlush(batch)
+				batch = make([]T, 0, w.cfg.BatchSize)
+			}
+		}
+	}
+}
+
+// doFlush 执行一次批量刷新
+func (w *Writer[T]) doFlush(batch []T) {
+	if len(batch) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), w.cfg.FlushTimeout)
+	defer cancel()
+
+	defer func() {
+		if r := recover(); r != nil {
+			zap.L().Error("异步批量写入异常", zap.String("name", w.cfg.Name), zap.Any("panic", r))
+		}
+	}()
+
+	if err := w.flush(ctx, batch); err != nil {
+		zap.L().Error("异步批量写入失败",
+			zap.String("name", w.cfg.Name),
+			zap.Int("size", len(batch)),
+			zap.Error(err))
+	}
+}
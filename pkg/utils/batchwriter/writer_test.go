@@ -0,0 +1,113 @@
+/**
+ * Description：
+ * FileName：writer_test.go
+ * Author：CJiaの用心
+ * Create：2025/10/31 09:48:05
+ * Remark：
+ */
+
+package batchwriter
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+type recorder struct {
+	mu      sync.Mutex
+	batches [][]int
+}
+
+func (r *recorder) flush(_ context.Context, items []int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.batches = append(r.batches, append([]int(nil), items...))
+	return nil
+}
+
+func (r *recorder) total() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n := 0
+	for _, b := range r.batches {
+		n += len(b)
+	}
+	return n
+}
+
+func TestWriter_FlushBySize(t *testing.T) {
+	rec := &recorder{}
+	w := New[int](Config{Workers: 1, BatchSize: 3, FlushInterval: time.Hour}, rec.flush)
+
+	for i := 0; i < 6; i++ {
+		assert.True(t, w.Write(i))
+	}
+	assert.Eventually(t, func() bool { return rec.total() == 6 }, time.Second, 10*time.Millisecond)
+	assert.NoError(t, w.Close(context.Background()))
+	assert.Len(t, rec.batches, 2)
+}
+
+func TestWriter_FlushByInterval(t *testing.T) {
+	rec := &recorder{}
+	w := New[int](Config{Workers: 1, BatchSize: 100, FlushInterval: 20 * time.Millisecond}, rec.flush)
+	defer w.Close(context.Background())
+
+	w.Write(1)
+	assert.Eventually(t, func() bool { return rec.total() == 1 }, time.Second, 10*time.Millisecond)
+}
+
+func TestWriter_CloseFlushesRemaining(t *testing.T) {
+	rec := &recorder{}
+	w := New[int](Config{Workers: 2, BatchSize: 100, FlushInterval: time.Hour}, rec.flush)
+
+	for i := 0; i < 10; i++ {
+		w.Write(i)
+	}
+	assert.NoError(t, w.Close(context.Background()))
+	assert.Equal(t, 10, rec.total())
+	assert.False(t, w.Write(11))
+	assert.ErrorIs(t, w.Close(context.Background()), ErrWriterClosed)
+}
+
+func TestWriter_DropWhenFull(t *testing.T) {
+	release := make(chan struct{})
+	block := func(ctx context.Context, items []int) error {
+		<-release
+		return nil
+	}
+
+	testCases := []struct {
+		name   string
+		policy DropPolicy
+	}{
+		{name: "丢弃新数据", policy: DropPolicyDropNewest},
+		{name: "阻塞超时后丢弃", policy: DropPolicyBlock},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := New[int](Config{
+				Workers:       1,
+				QueueSize:     1,
+				BatchSize:     1,
+				FlushInterval: time.Hour,
+				DropPolicy:    tc.policy,
+				BlockTimeout:  10 * time.Millisecond,
+			}, block)
+
+			// 第一条被协程取走并阻塞在刷新中，第二条占满队列
+			w.Write(1)
+			assert.Eventually(t, func() bool { return len(w.queue) == 0 }, time.Second, time.Millisecond)
+			assert.True(t, w.Write(2))
+			assert.False(t, w.Write(3))
+			assert.Equal(t, int64(1), w.Dropped())
+
+			release <- struct{}{}
+			release <- struct{}{}
+			assert.NoError(t, w.Close(context.Background()))
+		})
+	}
+}
@@ -0,0 +1,32 @@
+/**
+ * Description：
+ * FileName：captcha_test.go
+ * Author：CJiaの用心
+ * Create：2025/10/31 14:32:10
+ * Remark：
+ */
+
+package captcha
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	c, err := Generate(DefaultConfig())
+	assert.NoError(t, err)
+	assert.Len(t, c.Code, 4)
+	for _, ch := range c.Code {
+		assert.True(t, ch >= '0' && ch <= '9')
+	}
+
+	img, err := png.Decode(bytes.NewReader(c.Image))
+	assert.NoError(t, err)
+	assert.Equal(t, 120, img.Bounds().Dx())
+	assert.Equal(t, 40, img.Bounds().Dy())
+	assert.True(t, strings.HasPrefix(c.DataURI(), "data:image/png;base64,"))
+}
@@ -0,0 +1,248 @@
+/**
+ * Description：
+ * FileName：engine.go
+ * Author：CJiaの用心
+ * Create：2025/11/3 09:42:18
+ * Remark：
+ */
+
+package _import
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/excelutil"
+	"sort"
+	"strings"
+)
+
+// Mode 导入模式
+type Mode string
+
+const (
+	ModePartial      Mode = "partial" // 部分导入：跳过失败行，其余数据正常写入
+	ModeAllOrNothing Mode = "all"     // 全量导入：任一行失败则不写入任何数据
+	ModeDryRun       Mode = "dryRun"  // 预览：仅校验不写入
+)
+
+const (
+	defaultBatchSize = 500  // 默认每批写入条数
+	existsChunkSize  = 1000 // 唯一性校验每次 IN 查询的最大值数量
+	firstDataRow     = 2    // 第1行为表头，数据从第2行开始
+)
+
+// ParseMode 解析导入模式，未知值按部分导入处理
+func ParseMode(mode string) Mode {
+	switch Mode(strings.TrimSpace(mode)) {
+	case ModeAllOrNothing:
+		return ModeAllOrNothing
+	case ModeDryRun:
+		return ModeDryRun
+	default:
+		return ModePartial
+	}
+}
+
+// UniqueKey 唯一性约束
+type UniqueKey[T any] struct {
+	Title string              // 字段名称，用于错误提示，例如 字典名称
+	Value func(item T) string // 取唯一值，空值不参与校验
+	// Exists 批量查询库中已存在的值(IN 查询)，为空时仅校验文件内重复，库内冲突交由数据库唯一索引处理
+	Exists func(ctx context.Context, values []string) ([]string, error)
+}
+
+// Config 导入配置
+type Config[T any] struct {
+	// Parse 单行校验与转换，返回的错误信息直接作为该行的失败原因
+	Parse func(ctx context.Context, row map[string]string) (T, error)
+	// Unique 唯一性约束，依次校验文件内重复与库内已存在
+	Unique []UniqueKey[T]
+	// Insert 批量写入，单次调用需保证原子性(同一事务)
+	Insert func(ctx context.Context, items []T) error
+	// BatchSize 部分导入模式下每批写入条数
+	BatchSize int
+}
+
+// Importer 通用导入引擎
+// 先完成全部行的校验(字段、文件内重复、库内唯一性)，再按模式批量写入
+type Importer[T any] struct {
+	config Config[T]
+}
+
+// NewImporter 创建导入引擎
+func NewImporter[T any](config Config[T]) *Importer[T] {
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaultBatchSize
+	}
+	return &Importer[T]{config: config}
+}
+
+// entry 校验通过的数据行
+type entry[T any] struct {
+	row  int
+	item T
+}
+
+// Run 执行导入
+func (im *Importer[T]) Run(ctx context.Context, rows []map[string]string, mode Mode) ImportResult {
+	result := ImportResult{Mode: mode, DryRun: mode == ModeDryRun}
+
+	// 字段校验与转换
+	entries := make([]entry[T], 0, len(rows))
+	for index, row := range rows {
+		rowNumber := index + firstDataRow
+		item, err := im.config.Parse(ctx, row)
+		if err != nil {
+			im.addParseError(&result, rowNumber, err)
+			continue
+		}
+		entries = append(entries, entry[T]{row: rowNumber, item: item})
+	}
+
+	// 唯一性校验
+	for _, key := range im.config.Unique {
+		entries = im.checkDuplicate(key, entries, &result)
+		entries = im.checkExists(ctx, key, entries, &result)
+	}
+	result.ValidCount = len(entries)
+	// 校验未通过的行已得出结果
+	reportProgress(ctx, len(rows)-len(entries), len(rows))
+
+	switch {
+	case mode == ModeDryRun:
+		preview := make([]T, 0, len(entries))
+		for _, e := range entries {
+			preview = append(preview, e.item)
+		}
+		result.Preview = preview
+	case mode == ModeAllOrNothing:
+		if result.FailCount > 0 || len(entries) == 0 {
+			break
+		}
+		if err := im.config.Insert(ctx, im.items(entries)); err != nil {
+			for _, e := range entries {
+				result.AddError(e.row, "写入失败："+err.Error())
+			}
+			break
+		}
+		result.SuccessCount = len(entries)
+	default:
+		im.insertPartial(ctx, entries, &result, func(inserted int) {
+			reportProgress(ctx, len(rows)-len(entries)+inserted, len(rows))
+		})
+	}
+
+	reportProgress(ctx, len(rows), len(rows))
+	result.sortErrors()
+	return result
+}
+
+// addParseError 记录字段校验错误，单元格错误逐个记录以便定位到列
+func (im *Importer[T]) addParseError(result *ImportResult, row int, err error) {
+	var cellErrors excelutil.CellErrors
+	if !errors.As(err, &cellErrors) {
+		result.AddError(row, err.Error())
+		return
+	}
+	for _, cell := range cellErrors {
+		result.AddCellError(row, cell.Column, cell.Error())
+	}
+}
+
+// checkDuplicate 校验文件内重复，保留首次出现的行
+func (im *Importer[T]) checkDuplicate(key UniqueKey[T], entries []entry[T], result *ImportResult) []entry[T] {
+	first := make(map[string]int, len(entries))
+	valid := entries[:0]
+	for _, e := range entries {
+		value := key.Value(e.item)
+		if value == "" {
+			valid = append(valid, e)
+			continue
+		}
+		if row, ok := first[value]; ok {
+			result.AddError(e.row, fmt.Sprintf("【%s：%s】与第%d行重复", key.Title, value, row))
+			continue
+		}
+		first[value] = e.row
+		valid = append(valid, e)
+	}
+	return valid
+}
+
+// checkExists 批量校验库内是否已存在
+func (im *Importer[T]) checkExists(ctx context.Context, key UniqueKey[T], entries []entry[T], result *ImportResult) []entry[T] {
+	if key.Exists == nil || len(entries) == 0 {
+		return entries
+	}
+
+	values := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if value := key.Value(e.item); value != "" {
+			values = append(values, value)
+		}
+	}
+
+	existing := make(map[string]struct{})
+	for start := 0; start < len(values); start += existsChunkSize {
+		end := min(start+existsChunkSize, len(values))
+		found, err := key.Exists(ctx, values[start:end])
+		if err != nil {
+			for _, e := range entries {
+				result.AddError(e.row, fmt.Sprintf("检查【%s】唯一性失败：%s", key.Title, err.Error()))
+			}
+			return nil
+		}
+		for _, v := range found {
+			existing[v] = struct{}{}
+		}
+	}
+
+	valid := entries[:0]
+	for _, e := range entries {
+		value := key.Value(e.item)
+		if _, ok := existing[value]; ok && value != "" {
+			result.AddError(e.row, fmt.Sprintf("%s【%s】已存在", key.Title, value))
+			continue
+		}
+		valid = append(valid, e)
+	}
+	return valid
+}
+
+// insertPartial 分批写入，批次失败时逐行重试以定位失败行，每批完成后回调已处理条数
+func (im *Importer[T]) insertPartial(ctx context.Context, entries []entry[T], result *ImportResult, done func(inserted int)) {
+	for start := 0; start < len(entries); start += im.config.BatchSize {
+		end := min(start+im.config.BatchSize, len(entries))
+		batch := entries[start:end]
+		if err := im.config.Insert(ctx, im.items(batch)); err == nil {
+			result.SuccessCount += len(batch)
+			done(end)
+			continue
+		}
+
+		for _, e := range batch {
+			if err := im.config.Insert(ctx, []T{e.item}); err != nil {
+				result.AddError(e.row, "创建失败："+err.Error())
+				continue
+			}
+			result.SuccessCount++
+		}
+		done(end)
+	}
+}
+
+func (im *Importer[T]) items(entries []entry[T]) []T {
+	items := make([]T, 0, len(entries))
+	for _, e := range entries {
+		items = append(items, e.item)
+	}
+	return items
+}
+
+// sortErrors 错误信息按行号排序
+func (r *ImportResult) sortErrors() {
+	sort.SliceStable(r.Errors, func(i, j int) bool {
+		return r.Errors[i].Row < r.Errors[j].Row
+	})
+}
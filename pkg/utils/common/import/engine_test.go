@@ -0,0 +1,298 @@
+/**
+ * Description：
+ * FileName：engine_test.go
+ * Author：CJiaの用心
+ * Create：2025/11/3 14:08:35
+ * Remark：
+ */
+
+package _import
+
+import (
+	"context"
+	"errors"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/excelutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testItem struct {
+	Name string
+	Code string
+}
+
+func newTestImporter(existing []string, inserted *[][]testItem, insertErr func(items []testItem) error) *Importer[testItem] {
+	return NewImporter(Config[testItem]{
+		Parse: func(ctx context.Context, row map[string]string) (testItem, error) {
+			if row["名称"] == "" {
+				return testItem{}, errors.New("【名称】不能为空")
+			}
+			return testItem{Name: row["名称"], Code: row["编码"]}, nil
+		},
+		Unique: []UniqueKey[testItem]{
+			{
+				Title: "名称",
+				Value: func(item testItem) string { return item.Name },
+				Exists: func(ctx context.Context, values []string) ([]string, error) {
+					var found []string
+					for _, v := range values {
+						for _, e := range existing {
+							if v == e {
+								found = append(found, v)
+							}
+						}
+					}
+					return found, nil
+				},
+			},
+			{
+				Title: "编码",
+				Value: func(item testItem) string { return item.Code },
+			},
+		},
+		Insert: func(ctx context.Context, items []testItem) error {
+			if insertErr != nil {
+				if err := insertErr(items); err != nil {
+					return err
+				}
+			}
+			*inserted = append(*inserted, items)
+			return nil
+		},
+		BatchSize: 2,
+	})
+}
+
+func errorRows(result ImportResult) []int {
+	rows := make([]int, 0, len(result.Errors))
+	for _, e := range result.Errors {
+		rows = append(rows, e.Row)
+	}
+	return rows
+}
+
+func TestParseMode(t *testing.T) {
+	assert.Equal(t, ModeAllOrNothing, ParseMode("all"))
+	assert.Equal(t, ModeDryRun, ParseMode(" dryRun "))
+	assert.Equal(t, ModePartial, ParseMode(""))
+	assert.Equal(t, ModePartial, ParseMode("unknown"))
+}
+
+func TestImporter_Run(t *testing.T) {
+	rows := []map[string]string{
+		{"名称": "a", "编码": "A"},
+		{"名称": "", "编码": "B"},  // 字段校验失败
+		{"名称": "a", "编码": "C"}, // 与第2行名称重复
+		{"名称": "d", "编码": "A"}, // 与第2行编码重复
+		{"名称": "exist", "编码": "E"},
+		{"名称": "f", "编码": "F"},
+		{"名称": "g", "编码": "G"},
+	}
+
+	testCases := []struct {
+		name         string
+		mode         Mode
+		insertErr    func(items []testItem) error
+		wantSuccess  int
+		wantValid    int
+		wantErrRows  []int
+		wantInserted int // Insert 成功调用次数
+	}{
+		{
+			name:         "部分导入",
+			mode:         ModePartial,
+			wantSuccess:  3,
+			wantValid:    3,
+			wantErrRows:  []int{3, 4, 5, 6},
+			wantInserted: 2,
+		},
+		{
+			name: "部分导入批次失败时逐行重试",
+			mode: ModePartial,
+			insertErr: func(items []testItem) error {
+				for _, item := range items {
+					if item.Name == "f" {
+						return errors.New("duplicate")
+					}
+				}
+				return nil
+			},
+			wantSuccess:  2,
+			wantValid:    3,
+			wantErrRows:  []int{3, 4, 5, 6, 7},
+			wantInserted: 2,
+		},
+		{
+			name:        "全量导入存在错误时不写入",
+			mode:        ModeAllOrNothing,
+			wantValid:   3,
+			wantErrRows: []int{3, 4, 5, 6},
+		},
+		{
+			name:        "预览不写入",
+			mode:        ModeDryRun,
+			wantValid:   3,
+			wantErrRows: []int{3, 4, 5, 6},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var inserted [][]testItem
+			result := newTestImporter([]string{"exist"}, &inserted, tc.insertErr).Run(context.Background(), rows, tc.mode)
+
+			assert.Equal(t, tc.mode, result.Mode)
+			assert.Equal(t, tc.wantSuccess, result.SuccessCount)
+			assert.Equal(t, tc.wantValid, result.ValidCount)
+			assert.Equal(t, tc.wantErrRows, errorRows(result))
+			assert.Len(t, inserted, tc.wantInserted)
+			if tc.mode == ModeDryRun {
+				assert.True(t, result.DryRun)
+				assert.Equal(t, []testItem{{"a", "A"}, {"f", "F"}, {"g", "G"}}, result.Preview)
+			}
+		})
+	}
+}
+
+func TestImporter_RunAllOrNothing(t *testing.T) {
+	rows := []map[string]string{
+		{"名称": "a", "编码": "A"},
+		{"名称": "b", "编码": "B"},
+		{"名称": "c", "编码": "C"},
+	}
+
+	var inserted [][]testItem
+	result := newTestImporter(nil, &inserted, nil).Run(context.Background(), rows, ModeAllOrNothing)
+	assert.Equal(t, 3, result.SuccessCount)
+	require.Len(t, inserted, 1) // 单次写入，不按批次拆分
+	assert.Len(t, inserted[0], 3)
+
+	inserted = nil
+	result = newTestImporter(nil, &inserted, func(items []testItem) error {
+		return errors.New("db error")
+	}).Run(context.Background(), rows, ModeAllOrNothing)
+	assert.Equal(t, 0, result.SuccessCount)
+	assert.Equal(t, []int{2, 3, 4}, errorRows(result))
+	assert.Empty(t, inserted)
+}
+
+func TestImporter_RunProgress(t *testing.T) {
+	rows := []map[string]string{
+		{"名称": "a", "编码": "A"},
+		{"名称": ""},
+		{"名称": "b", "编码": "B"},
+		{"名称": "c", "编码": "C"},
+	}
+
+	var reports [][2]int64
+	ctx := WithProgress(context.Background(), func(processed, total int64) {
+		reports = append(reports, [2]int64{processed, total})
+	})
+
+	var inserted [][]testItem
+	newTestImporter(nil, &inserted, nil).Run(ctx, rows, ModePartial)
+	// 校验失败1行，之后每批(2条)写入完成上报一次，最后上报全部完成
+	assert.Equal(t, [][2]int64{{1, 4}, {3, 4}, {4, 4}, {4, 4}}, reports)
+}
+
+func TestImporter_RunCellErrors(t *testing.T) {
+	importer := NewImporter(Config[testItem]{
+		Parse: func(ctx context.Context, row map[string]string) (testItem, error) {
+			if row["名称"] == "" && row["编码"] == "" {
+				return testItem{}, excelutil.CellErrors{
+					{Column: "名称", Message: "不能为空"},
+					{Column: "编码", Message: "不能为空"},
+				}
+			}
+			return testItem{Name: row["名称"], Code: row["编码"]}, nil
+		},
+		Insert: func(ctx context.Context, items []testItem) error { return nil },
+	})
+
+	result := importer.Run(context.Background(), []map[string]string{
+		{"名称": "a", "编码": "A"},
+		{},
+	}, ModePartial)
+	assert.Equal(t, 1, result.SuccessCount)
+	assert.Equal(t, 1, result.FailCount) // 同一行多个单元格错误只计一条失败
+	assert.Equal(t, []ImportError{
+		{Row: 3, Column: "名称", Message: "【名称】不能为空"},
+		{Row: 3, Column: "编码", Message: "【编码】不能为空"},
+	}, result.Errors)
+}
+
+func TestImportResult_ErrorWorkbook(t *testing.T) {
+	headers := []string{"名称", "编码"}
+	rows := []map[string]string{
+		{"名称": "a", "编码": "A"},
+		{"名称": "", "编码": "B"},
+	}
+	result := ImportResult{}
+	result.AddCellError(3, "名称", "【名称】不能为空")
+	result.AddError(3, "【编码】无效")
+
+	f, err := result.ErrorWorkbook(headers, rows)
+	require.NoError(t, err)
+	defer f.Close()
+
+	// 无成功数据时保留全部行
+	got, err := f.GetRows(errorSheetName)
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"名称", "编码", errorColumnTitle},
+		{"a", "A"},
+		{"", "B", "【名称】不能为空；【编码】无效"},
+	}, got)
+
+	okStyle, err := f.GetCellStyle(errorSheetName, "A2")
+	require.NoError(t, err)
+	failStyle, err := f.GetCellStyle(errorSheetName, "C3")
+	require.NoError(t, err)
+	assert.NotEqual(t, okStyle, failStyle)
+
+	// 出错的单元格单独标记
+	cellStyle, err := f.GetCellStyle(errorSheetName, "A3")
+	require.NoError(t, err)
+	assert.NotEqual(t, failStyle, cellStyle)
+
+	// 已有数据写入成功时仅保留失败行
+	result.SuccessCount = 1
+	f2, err := result.ErrorWorkbook(headers, rows)
+	require.NoError(t, err)
+	defer f2.Close()
+	got, err = f2.GetRows(errorSheetName)
+	require.NoError(t, err)
+	assert.Len(t, got, 2)
+	assert.Equal(t, "B", got[1][1])
+}
+
+func TestImportResult_SaveErrorWorkbook(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	result := ImportResult{}
+	require.NoError(t, result.SaveErrorWorkbook([]string{"名称"}, nil, "错误数据"))
+	assert.Empty(t, result.ErrorFile)
+
+	result.AddError(2, "【名称】不能为空")
+	require.NoError(t, result.SaveErrorWorkbook([]string{"名称"}, []map[string]string{{"名称": ""}}, "错误数据"))
+	assert.True(t, strings.HasPrefix(result.ErrorFile, ErrorFileURL))
+
+	path, name, err := OpenErrorFile(strings.TrimPrefix(result.ErrorFile, ErrorFileURL))
+	require.NoError(t, err)
+	assert.FileExists(t, path)
+	assert.Equal(t, "错误数据.xlsx", name)
+
+	// 非法令牌与过期文件均不可下载
+	_, _, err = OpenErrorFile("../../config")
+	assert.ErrorIs(t, err, ErrErrorFileNotFound)
+	expired := time.Now().Add(-errorFileTTL - time.Minute)
+	require.NoError(t, os.Chtimes(path, expired, expired))
+	_, _, err = OpenErrorFile(strings.TrimPrefix(result.ErrorFile, ErrorFileURL))
+	assert.ErrorIs(t, err, ErrErrorFileNotFound)
+	cleanErrorFiles(time.Now().Add(-errorFileTTL))
+	assert.NoFileExists(t, path)
+}
@@ -0,0 +1,28 @@
+/**
+ * Description：
+ * FileName：progress.go
+ * Author：CJiaの用心
+ * Create：2025/11/7 16:48:30
+ * Remark：
+ */
+
+package _import
+
+import "context"
+
+// ProgressFunc 导入进度回调，processed 为已得出结果(失败或写入)的行数
+type ProgressFunc func(processed, total int64)
+
+type progressKey struct{}
+
+// WithProgress 在上下文中设置导入进度回调，供后台任务上报进度
+func WithProgress(ctx context.Context, fn ProgressFunc) context.Context {
+	return context.WithValue(ctx, progressKey{}, fn)
+}
+
+// reportProgress 上报导入进度，未设置回调时忽略
+func reportProgress(ctx context.Context, processed, total int) {
+	if fn, ok := ctx.Value(progressKey{}).(ProgressFunc); ok && fn != nil {
+		fn(int64(processed), int64(total))
+	}
+}
@@ -12,11 +12,17 @@ type ImportResult struct {
 	SuccessCount int           // 成功导入的条数
 	FailCount    int           // 失败导入的条数
 	Errors       []ImportError // 错误信息
+	ValidCount   int           // 校验通过的条数
+	Mode         Mode          // 导入模式
+	DryRun       bool          // 是否为预览(未写入数据)
+	Preview      any           // 预览模式下校验通过的数据
+	ErrorFile    string        // 错误数据工作簿下载地址
 }
 
 type ImportError struct {
-	Row     int    `json:"row"`     // 数据行号
-	Message string `json:"message"` // 错误信息
+	Row     int    `json:"row"`              // 数据行号
+	Column  string `json:"column,omitempty"` // 出错的列(表头标题)，整行错误时为空
+	Message string `json:"message"`          // 错误信息
 }
 
 func (r *ImportResult) AddError(row int, message string) {
@@ -26,3 +32,15 @@ func (r *ImportResult) AddError(row int, message string) {
 		Message: message,
 	})
 }
+
+// AddCellError 记录单元格错误，同一行的多个单元格错误需连续记录，只计一条失败
+func (r *ImportResult) AddCellError(row int, column, message string) {
+	if n := len(r.Errors); n == 0 || r.Errors[n-1].Row != row {
+		r.FailCount++
+	}
+	r.Errors = append(r.Errors, ImportError{
+		Row:     row,
+		Column:  column,
+		Message: message,
+	})
+}
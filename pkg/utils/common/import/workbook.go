@@ -0,0 +1,237 @@
+/**
+ * Description：
+ * FileName：workbook.go
+ * Author：CJiaの用心
+ * Create：2025/11/3 10:26:51
+ * Remark：
+ */
+
+package _import
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/xuri/excelize/v2"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	errorSheetName   = "错误数据"
+	errorColumnTitle = "错误信息"
+	errorBasePath    = "uploads/import/errors" // 错误数据含原始上传内容，不放在静态资源目录下
+	errorFileTTL     = 24 * time.Hour          // 错误数据工作簿保留时长
+)
+
+var (
+	// ErrorFileURL 错误数据工作簿下载地址前缀，由需登录的下载接口按令牌提供文件
+	ErrorFileURL = "/v1/tools/import/errorFile/"
+
+	ErrErrorFileNotFound = errors.New("错误数据文件不存在或已过期")
+
+	errorTokenPattern = regexp.MustCompile(`^[0-9a-f]{32}$`)
+)
+
+// ErrorRows 失败行号及对应的错误信息
+func (r *ImportResult) ErrorRows() map[int]string {
+	rows := make(map[int]string, len(r.Errors))
+	for _, e := range r.Errors {
+		if msg, ok := rows[e.Row]; ok {
+			rows[e.Row] = msg + "；" + e.Message
+			continue
+		}
+		rows[e.Row] = e.Message
+	}
+	return rows
+}
+
+// errorCells 失败行号及出错的列
+func (r *ImportResult) errorCells() map[int]map[string]struct{} {
+	cells := make(map[int]map[string]struct{})
+	for _, e := range r.Errors {
+		if e.Column == "" {
+			continue
+		}
+		if cells[e.Row] == nil {
+			cells[e.Row] = make(map[string]struct{})
+		}
+		cells[e.Row][e.Column] = struct{}{}
+	}
+	return cells
+}
+
+// ErrorWorkbook 生成错误数据工作簿，末列追加错误信息，失败行标红，出错的单元格加粗并加红框
+// 已有数据写入成功时仅保留失败行，修正后可直接重新导入；否则保留全部行便于对照修改
+func (r *ImportResult) ErrorWorkbook(headers []string, rows []map[string]string) (*excelize.File, error) {
+	f := excelize.NewFile()
+	if err := f.SetSheetName("Sheet1", errorSheetName); err != nil {
+		return nil, err
+	}
+
+	headerStyle, err := f.NewStyle(&excelize.Style{
+		Font: &excelize.Font{Bold: true},
+		Fill: excelize.Fill{Type: "pattern", Color: []string{"#D3D3D3"}, Pattern: 1},
+	})
+	if err != nil {
+		return nil, err
+	}
+	errorStyle, err := f.NewStyle(&excelize.Style{
+		Fill: excelize.Fill{Type: "pattern", Color: []string{"#FFC7CE"}, Pattern: 1},
+		Font: &excelize.Font{Color: "#9C0006"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	cellStyle, err := f.NewStyle(&excelize.Style{
+		Fill: excelize.Fill{Type: "pattern", Color: []string{"#FFC7CE"}, Pattern: 1},
+		Font: &excelize.Font{Color: "#9C0006", Bold: true},
+		Border: []excelize.Border{
+			{Type: "top", Color: "#FF0000", Style: 2},
+			{Type: "bottom", Color: "#FF0000", Style: 2},
+			{Type: "left", Color: "#FF0000", Style: 2},
+			{Type: "right", Color: "#FF0000", Style: 2},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 表头
+	titles := append(append(make([]any, 0, len(headers)+1), toAny(headers)...), errorColumnTitle)
+	if err := f.SetSheetRow(errorSheetName, "A1", &titles); err != nil {
+		return nil, err
+	}
+	lastCol, _ := excelize.ColumnNumberToName(len(titles))
+	if err := f.SetCellStyle(errorSheetName, "A1", lastCol+"1", headerStyle); err != nil {
+		return nil, err
+	}
+	_ = f.SetColWidth(errorSheetName, "A", lastCol, 16)
+	_ = f.SetColWidth(errorSheetName, lastCol, lastCol, 60)
+
+	errorRows := r.ErrorRows()
+	errorCells := r.errorCells()
+	onlyFailed := r.SuccessCount > 0 && !r.DryRun
+	current := 2
+	for index, row := range rows {
+		msg, failed := errorRows[index+firstDataRow]
+		if onlyFailed && !failed {
+			continue
+		}
+
+		values := make([]any, 0, len(headers)+1)
+		for _, h := range headers {
+			values = append(values, row[h])
+		}
+		values = append(values, msg)
+
+		cell, _ := excelize.CoordinatesToCellName(1, current)
+		if err := f.SetSheetRow(errorSheetName, cell, &values); err != nil {
+			return nil, err
+		}
+		if failed {
+			end, _ := excelize.CoordinatesToCellName(len(values), current)
+			if err := f.SetCellStyle(errorSheetName, cell, end, errorStyle); err != nil {
+				return nil, err
+			}
+			for col, h := range headers {
+				if _, ok := errorCells[index+firstDataRow][h]; !ok {
+					continue
+				}
+				name, _ := excelize.CoordinatesToCellName(col+1, current)
+				if err := f.SetCellStyle(errorSheetName, name, name, cellStyle); err != nil {
+					return nil, err
+				}
+			}
+		}
+		current++
+	}
+
+	return f, nil
+}
+
+// SaveErrorWorkbook 存在失败行时保存错误数据工作簿，并设置 ErrorFile 下载地址
+// 文件以随机令牌命名，超过保留时长后在下次保存时清理
+func (r *ImportResult) SaveErrorWorkbook(headers []string, rows []map[string]string, fileName string) error {
+	if r.FailCount == 0 {
+		return nil
+	}
+
+	f, err := r.ErrorWorkbook(headers, rows)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := os.MkdirAll(errorBasePath, 0755); err != nil {
+		return fmt.Errorf("创建目录失败: %w", err)
+	}
+	cleanErrorFiles(time.Now().Add(-errorFileTTL))
+
+	token, err := newErrorToken()
+	if err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%s_%s.xlsx", token, strings.TrimSuffix(filepath.Base(fileName), ".xlsx"))
+	if err := f.SaveAs(filepath.Join(errorBasePath, name)); err != nil {
+		return err
+	}
+
+	r.ErrorFile = ErrorFileURL + token
+	return nil
+}
+
+// OpenErrorFile 根据令牌查找错误数据工作簿，返回文件路径及下载文件名
+func OpenErrorFile(token string) (string, string, error) {
+	if !errorTokenPattern.MatchString(token) {
+		return "", "", ErrErrorFileNotFound
+	}
+
+	matches, err := filepath.Glob(filepath.Join(errorBasePath, token+"_*.xlsx"))
+	if err != nil || len(matches) == 0 {
+		return "", "", ErrErrorFileNotFound
+	}
+
+	info, err := os.Stat(matches[0])
+	if err != nil || time.Since(info.ModTime()) > errorFileTTL {
+		return "", "", ErrErrorFileNotFound
+	}
+
+	return matches[0], strings.TrimPrefix(filepath.Base(matches[0]), token+"_"), nil
+}
+
+// cleanErrorFiles 清理过期的错误数据工作簿
+func cleanErrorFiles(before time.Time) {
+	entries, err := os.ReadDir(errorBasePath)
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil || entry.IsDir() || info.ModTime().After(before) {
+			continue
+		}
+		_ = os.Remove(filepath.Join(errorBasePath, entry.Name()))
+	}
+}
+
+// newErrorToken 生成不可猜测的下载令牌
+func newErrorToken() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+func toAny(values []string) []any {
+	result := make([]any, 0, len(values))
+	for _, v := range values {
+		result = append(result, v)
+	}
+	return result
+}
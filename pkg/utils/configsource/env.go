@@ -0,0 +1,141 @@
+/**
+ * Description：
+ * FileName：env.go
+ * Author：CJiaの用心
+ * Create：2025/11/16 11:41:08
+ * Remark：
+ */
+
+package configsource
+
+import (
+	"gopkg.in/yaml.v3"
+	"os"
+	"reflect"
+	"strings"
+)
+
+// Env 环境变量配置来源，变量名为前缀加配置路径，各级路径转为大写并以下划线连接
+// 如前缀 CAREFUL 时 CAREFUL_TOKEN_EXPIRE 对应 token.expire，CAREFUL_DATABASE_CAREFUL_MAXOPENCONN 对应 database.careful.maxOpenConn
+type Env struct {
+	prefix  string
+	target  reflect.Type
+	environ func() []string
+}
+
+// NewEnv 创建环境变量配置来源，target 为配置结构体，按其 yaml 标签确定可覆盖的配置路径
+func NewEnv(prefix string, target any) *Env {
+	return &Env{
+		prefix:  strings.ToUpper(prefix),
+		target:  reflect.TypeOf(target),
+		environ: os.Environ,
+	}
+}
+
+func (e *Env) Name() string {
+	return "env:" + e.prefix
+}
+
+func (e *Env) Load() (map[string]any, error) {
+	vars := make(map[string]string)
+	for _, item := range e.environ() {
+		name, value, ok := strings.Cut(item, "=")
+		if ok && strings.HasPrefix(name, e.prefix+"_") {
+			vars[name] = value
+		}
+	}
+	if len(vars) == 0 {
+		return nil, nil
+	}
+
+	value, ok := lookup(e.target, e.prefix, vars)
+	if !ok {
+		return nil, nil
+	}
+	doc, _ := value.(map[string]any)
+	return doc, nil
+}
+
+// lookup 按类型查找变量名为 name 或以 name 为前缀的环境变量
+func lookup(t reflect.Type, name string, vars map[string]string) (any, bool) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		doc := make(map[string]any)
+		for i := 0; i < t.NumField(); i++ {
+			key := fieldName(t.Field(i))
+			if key == "" {
+				continue
+			}
+			if value, ok := lookup(t.Field(i).Type, name+"_"+strings.ToUpper(key), vars); ok {
+				doc[key] = value
+			}
+		}
+		return doc, len(doc) > 0
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return nil, false
+		}
+		// 键名取变量名中去掉前缀与字段名后的部分，转为小写
+		elem := t.Elem()
+		for elem.Kind() == reflect.Pointer {
+			elem = elem.Elem()
+		}
+		doc := make(map[string]any)
+		for varName := range vars {
+			rest, ok := strings.CutPrefix(varName, name+"_")
+			if !ok {
+				continue
+			}
+			key := strings.ToLower(rest)
+			if elem.Kind() == reflect.Struct {
+				key = ""
+				for i := 0; i < elem.NumField(); i++ {
+					field := fieldName(elem.Field(i))
+					if field != "" && strings.HasSuffix(rest, "_"+strings.ToUpper(field)) {
+						key = strings.ToLower(strings.TrimSuffix(rest, "_"+strings.ToUpper(field)))
+						break
+					}
+				}
+			}
+			if key == "" || doc[key] != nil {
+				continue
+			}
+			if value, ok := lookup(t.Elem(), name+"_"+strings.ToUpper(key), vars); ok {
+				doc[key] = value
+			}
+		}
+		return doc, len(doc) > 0
+	case reflect.String:
+		value, ok := vars[name]
+		return value, ok
+	default:
+		value, ok := vars[name]
+		if !ok {
+			return nil, false
+		}
+		// 数字、布尔值按 YAML 标量解析，时长等无法解析的值保留原文
+		var scalar any
+		if err := yaml.Unmarshal([]byte(value), &scalar); err != nil || scalar == nil {
+			return value, true
+		}
+		return scalar, true
+	}
+}
+
+func fieldName(field reflect.StructField) string {
+	if !field.IsExported() {
+		return ""
+	}
+	name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return strings.ToLower(field.Name)
+	}
+	return name
+}
@@ -0,0 +1,76 @@
+/**
+ * Description：
+ * FileName：file.go
+ * Author：CJiaの用心
+ * Create：2025/11/16 11:24:40
+ * Remark：
+ */
+
+package configsource
+
+import (
+	"errors"
+	"github.com/fsnotify/fsnotify"
+	"go.uber.org/zap"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// File 本地 YAML 文件配置来源，文件不存在时视为没有配置
+type File struct {
+	path string
+}
+
+// NewFile 创建本地文件配置来源
+func NewFile(path string) *File {
+	return &File{path: filepath.Clean(path)}
+}
+
+func (f *File) Name() string {
+	return "file:" + f.path
+}
+
+func (f *File) Load() (map[string]any, error) {
+	content, err := os.ReadFile(f.path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return parse(content)
+}
+
+// Watch 监听文件所在目录，兼容编辑器以重命名方式保存以及启动后才创建文件的情况
+func (f *File) Watch(onChange func()) (func(), error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	if err := watcher.Add(filepath.Dir(f.path)); err != nil {
+		_ = watcher.Close()
+		return nil, err
+	}
+
+	go func() {
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if filepath.Clean(event.Name) == f.path && event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Remove|fsnotify.Rename) != 0 {
+					onChange()
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				zap.L().Error("配置文件监听错误", zap.String("path", f.path), zap.Error(err))
+			}
+		}
+	}()
+
+	return func() { _ = watcher.Close() }, nil
+}
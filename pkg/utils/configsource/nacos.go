@@ -0,0 +1,86 @@
+/**
+ * Description：
+ * FileName：nacos.go
+ * Author：CJiaの用心
+ * Create：2025/11/16 12:05:33
+ * Remark：
+ */
+
+package configsource
+
+import (
+	"errors"
+	"github.com/nacos-group/nacos-sdk-go/clients/config_client"
+	"github.com/nacos-group/nacos-sdk-go/vo"
+	"go.uber.org/zap"
+	"sync"
+)
+
+var (
+	ErrEmptyContent = errors.New("配置内容为空")
+)
+
+// Nacos Nacos 配置中心来源，通过 ListenConfig 接收配置变更推送
+// 服务端不可用时返回最近一次成功读取或推送的配置，避免重新加载时回退到低优先级来源
+type Nacos struct {
+	client  config_client.IConfigClient
+	dataId  string
+	group   string
+	mutex   sync.RWMutex
+	content string
+}
+
+// NewNacos 创建 Nacos 配置来源
+func NewNacos(client config_client.IConfigClient, dataId, group string) *Nacos {
+	return &Nacos{client: client, dataId: dataId, group: group}
+}
+
+func (n *Nacos) Name() string {
+	return "nacos:" + n.group + "/" + n.dataId
+}
+
+func (n *Nacos) Load() (map[string]any, error) {
+	content, err := n.client.GetConfig(vo.ConfigParam{DataId: n.dataId, Group: n.group})
+	if err == nil && content == "" {
+		err = ErrEmptyContent
+	}
+
+	n.mutex.Lock()
+	if err == nil {
+		n.content = content
+	} else if n.content != "" {
+		zap.L().Warn("读取Nacos配置失败，使用最近一次的配置", zap.String("dataId", n.dataId), zap.Error(err))
+		content, err = n.content, nil
+	}
+	n.mutex.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+	return parse([]byte(content))
+}
+
+func (n *Nacos) Watch(onChange func()) (func(), error) {
+	param := vo.ConfigParam{
+		DataId: n.dataId,
+		Group:  n.group,
+		OnChange: func(namespace, group, dataId, data string) {
+			if data == "" {
+				zap.L().Warn("Nacos推送的配置内容为空，已忽略", zap.String("dataId", dataId))
+				return
+			}
+			n.mutex.Lock()
+			n.content = data
+			n.mutex.Unlock()
+			onChange()
+		},
+	}
+	if err := n.client.ListenConfig(param); err != nil {
+		return nil, err
+	}
+	return func() {
+		if err := n.client.CancelListenConfig(param); err != nil {
+			zap.L().Warn("取消Nacos配置监听失败", zap.String("dataId", n.dataId), zap.Error(err))
+		}
+	}, nil
+}
@@ -0,0 +1,120 @@
+/**
+ * Description：
+ * FileName：source.go
+ * Author：CJiaの用心
+ * Create：2025/11/16 11:02:15
+ * Remark：
+ */
+
+package configsource
+
+import (
+	"errors"
+	"fmt"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	ErrNoSource = errors.New("没有可用的配置来源")
+)
+
+// Provider 配置来源，返回 YAML 文档解析后的键值树，没有配置时返回 nil
+type Provider interface {
+	Name() string
+	Load() (map[string]any, error)
+}
+
+// Watcher 支持变更通知的配置来源，返回的 stop 用于停止监听
+type Watcher interface {
+	Watch(onChange func()) (stop func(), err error)
+}
+
+// Layered 按优先级叠加多个配置来源，后面的来源按配置路径覆盖前面的同名配置
+type Layered struct {
+	providers []Provider
+}
+
+// NewLayered 创建叠加配置来源，providers 按优先级从低到高排列
+func NewLayered(providers ...Provider) *Layered {
+	return &Layered{providers: providers}
+}
+
+// Load 依次读取并合并各来源后解析到 out
+// 单个来源读取失败时跳过并记录原因，由其余来源兜底；全部来源均无配置时返回 ErrNoSource
+func (l *Layered) Load(out any) error {
+	merged := make(map[string]any)
+	var (
+		loaded int
+		errs   []error
+	)
+	for _, provider := range l.providers {
+		doc, err := provider.Load()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", provider.Name(), err))
+			continue
+		}
+		if doc == nil {
+			continue
+		}
+		mergeDoc(merged, doc)
+		loaded++
+	}
+	if loaded == 0 {
+		return errors.Join(append([]error{ErrNoSource}, errs...)...)
+	}
+	for _, err := range errs {
+		zap.L().Warn("配置来源读取失败，已使用其余来源", zap.Error(err))
+	}
+
+	data, err := yaml.Marshal(merged)
+	if err != nil {
+		return err
+	}
+	return yaml.Unmarshal(data, out)
+}
+
+// Watch 监听所有支持变更通知的来源，任一来源变更时调用 onChange
+func (l *Layered) Watch(onChange func()) (func(), error) {
+	var stops []func()
+	stop := func() {
+		for _, fn := range stops {
+			fn()
+		}
+	}
+	for _, provider := range l.providers {
+		watcher, ok := provider.(Watcher)
+		if !ok {
+			continue
+		}
+		fn, err := watcher.Watch(onChange)
+		if err != nil {
+			stop()
+			return nil, fmt.Errorf("%s: %w", provider.Name(), err)
+		}
+		stops = append(stops, fn)
+	}
+	return stop, nil
+}
+
+// mergeDoc 将 src 深度合并到 dst，同为键值树时逐级合并，否则 src 覆盖 dst
+func mergeDoc(dst, src map[string]any) {
+	for key, value := range src {
+		srcMap, srcOk := value.(map[string]any)
+		dstMap, dstOk := dst[key].(map[string]any)
+		if srcOk && dstOk {
+			mergeDoc(dstMap, srcMap)
+			continue
+		}
+		dst[key] = value
+	}
+}
+
+// parse 解析 YAML 文档，空文档返回空键值树
+func parse(content []byte) (map[string]any, error) {
+	doc := make(map[string]any)
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, fmt.Errorf("配置解析失败: %w", err)
+	}
+	return doc, nil
+}
@@ -0,0 +1,147 @@
+/**
+ * Description：
+ * FileName：source_test.go
+ * Author：CJiaの用心
+ * Create：2025/11/16 15:26:07
+ * Remark：
+ */
+
+package configsource
+
+import (
+	"errors"
+	"github.com/nacos-group/nacos-sdk-go/clients/config_client"
+	"github.com/nacos-group/nacos-sdk-go/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type testConfig struct {
+	Database map[string]testDatabase `yaml:"database"`
+	Token    testToken               `yaml:"token"`
+}
+
+type testDatabase struct {
+	Host            string         `yaml:"host"`
+	Password        string         `yaml:"password"`
+	MaxOpenConn     int            `yaml:"maxOpenConn"`
+	ConnMaxLifetime *time.Duration `yaml:"connMaxLifetime"`
+}
+
+type testToken struct {
+	Secret        string `yaml:"secret"`
+	Expire        int    `yaml:"expire"`
+	SingleSession bool   `yaml:"singleSession"`
+}
+
+// fakeNacos 可控制读取结果并手动推送变更的 Nacos 客户端
+type fakeNacos struct {
+	config_client.IConfigClient
+	content  string
+	err      error
+	onChange func(namespace, group, dataId, data string)
+}
+
+func (f *fakeNacos) GetConfig(vo.ConfigParam) (string, error) { return f.content, f.err }
+
+func (f *fakeNacos) ListenConfig(param vo.ConfigParam) error {
+	f.onChange = param.OnChange
+	return nil
+}
+
+func (f *fakeNacos) CancelListenConfig(vo.ConfigParam) error { return nil }
+
+func writeFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "remote.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return path
+}
+
+func TestLayered_Load(t *testing.T) {
+	file := writeFile(t, "database:\n  careful:\n    host: file-host\n    password: file\n    maxOpenConn: 10\n"+
+		"token:\n  secret: file-secret\n  expire: 1\n")
+	nacos := &fakeNacos{content: "database:\n  careful:\n    host: nacos-host\ntoken:\n  expire: 2\n"}
+	env := NewEnv("careful", testConfig{})
+	env.environ = func() []string {
+		return []string{
+			"CAREFUL_DATABASE_CAREFUL_PASSWORD=0123",
+			"CAREFUL_DATABASE_CAREFUL_CONNMAXLIFETIME=30m",
+			"CAREFUL_DATABASE_TABLE_MAXOPENCONN=5",
+			"CAREFUL_TOKEN_SINGLESESSION=true",
+			"OTHER_TOKEN_EXPIRE=9",
+		}
+	}
+
+	var cfg testConfig
+	require.NoError(t, NewLayered(NewFile(file), NewNacos(nacos, "careful.yaml", "DEFAULT_GROUP"), env).Load(&cfg))
+
+	// 高优先级来源逐项覆盖，未覆盖的配置保留低优先级来源的值
+	careful := cfg.Database["careful"]
+	assert.Equal(t, "nacos-host", careful.Host)
+	assert.Equal(t, "0123", careful.Password)
+	assert.Equal(t, 10, careful.MaxOpenConn)
+	require.NotNil(t, careful.ConnMaxLifetime)
+	assert.Equal(t, 30*time.Minute, *careful.ConnMaxLifetime)
+	assert.Equal(t, 5, cfg.Database["table"].MaxOpenConn)
+	assert.Equal(t, testToken{Secret: "file-secret", Expire: 2, SingleSession: true}, cfg.Token)
+}
+
+func TestLayered_Fallback(t *testing.T) {
+	file := writeFile(t, "token:\n  expire: 1\n")
+	nacos := &fakeNacos{err: errors.New("connection refused")}
+
+	// Nacos 不可用时使用本地文件
+	var cfg testConfig
+	require.NoError(t, NewLayered(NewFile(file), NewNacos(nacos, "careful.yaml", "DEFAULT_GROUP")).Load(&cfg))
+	assert.Equal(t, 1, cfg.Token.Expire)
+
+	// 所有来源均无配置
+	missing := NewFile(filepath.Join(t.TempDir(), "missing.yaml"))
+	err := NewLayered(missing, NewNacos(nacos, "careful.yaml", "DEFAULT_GROUP")).Load(&cfg)
+	assert.ErrorIs(t, err, ErrNoSource)
+	assert.ErrorContains(t, err, "connection refused")
+}
+
+func TestNacos_Watch(t *testing.T) {
+	nacos := &fakeNacos{content: "token:\n  expire: 1\n"}
+	source := NewNacos(nacos, "careful.yaml", "DEFAULT_GROUP")
+	layered := NewLayered(source)
+
+	var cfg testConfig
+	require.NoError(t, layered.Load(&cfg))
+
+	changes := 0
+	stop, err := layered.Watch(func() { changes++ })
+	require.NoError(t, err)
+	defer stop()
+
+	// 推送变更后服务端不可用，仍使用推送的配置
+	nacos.onChange("public", "DEFAULT_GROUP", "careful.yaml", "token:\n  expire: 3\n")
+	nacos.err = errors.New("connection refused")
+	assert.Equal(t, 1, changes)
+	require.NoError(t, layered.Load(&cfg))
+	assert.Equal(t, 3, cfg.Token.Expire)
+
+	// 空内容推送被忽略
+	nacos.onChange("public", "DEFAULT_GROUP", "careful.yaml", "")
+	assert.Equal(t, 1, changes)
+}
+
+func TestFile_Watch(t *testing.T) {
+	path := writeFile(t, "token:\n  expire: 1\n")
+	changed := make(chan struct{}, 10)
+	stop, err := NewFile(path).Watch(func() { changed <- struct{}{} })
+	require.NoError(t, err)
+	defer stop()
+
+	require.NoError(t, os.WriteFile(path, []byte("token:\n  expire: 2\n"), 0o644))
+	select {
+	case <-changed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("未收到配置文件变更通知")
+	}
+}
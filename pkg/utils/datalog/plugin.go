@@ -0,0 +1,387 @@
+/**
+ * Description：
+ * FileName：plugin.go
+ * Author：CJiaの用心
+ * Create：2025/11/14 10:05:37
+ * Remark：
+ */
+
+package datalog
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	modelLogger "github.com/carefuly/careful-admin-go-gin/internal/model/careful/logger"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/logger/data_log"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+	"reflect"
+	"time"
+)
+
+const (
+	// TagKey 字段标签，datalog:"-" 不记录该字段，datalog:"mask" 记录变更但值脱敏
+	TagKey = "datalog"
+	// MaskedValue 脱敏后的字段值
+	MaskedValue = "******"
+)
+
+// snapshotKey 变更前数据快照在语句实例中的键
+const snapshotKey = "careful:data_log:snapshot"
+
+var coreModelsType = reflect.TypeOf(models.CoreModels{})
+
+// Skipper 不记录数据变更的模型实现该接口，如日志表、频繁更新进度的任务表
+type Skipper interface {
+	SkipDataLog() bool
+}
+
+// Plugin 数据变更日志插件
+// 为嵌入 CoreModels 的数据表记录新增、修改、删除前后的字段差异，日志与业务数据在同一事务内写入
+// 仅作用于 gorm 的 Create/Update/Delete，Exec 执行的原生 SQL 与数据库外键级联不记录
+// 日志写入失败只记录错误，不影响业务数据的写入
+type Plugin struct{}
+
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+// Name 插件名称
+func (p *Plugin) Name() string {
+	return "careful:data_log"
+}
+
+// Initialize 注册回调，变更前快照在 gorm 执行语句前查询，日志在提交事务前写入
+func (p *Plugin) Initialize(db *gorm.DB) error {
+	if err := db.Callback().Create().After("gorm:create").
+		Register("careful:data_log:after_create", p.afterCreate); err != nil {
+		return err
+	}
+	if err := db.Callback().Update().Before("gorm:update").
+		Register("careful:data_log:before_update", p.before); err != nil {
+		return err
+	}
+	if err := db.Callback().Update().After("gorm:update").
+		Register("careful:data_log:after_update", p.afterUpdate); err != nil {
+		return err
+	}
+	if err := db.Callback().Delete().Before("gorm:delete").
+		Register("careful:data_log:before_delete", p.before); err != nil {
+		return err
+	}
+	return db.Callback().Delete().After("gorm:delete").
+		Register("careful:data_log:after_delete", p.afterDelete)
+}
+
+// enabled 语句作用的模型是否需要记录数据变更
+func (p *Plugin) enabled(db *gorm.DB) bool {
+	stmt := db.Statement
+	if db.Error != nil || db.DryRun || stmt.Schema == nil {
+		return false
+	}
+
+	field, ok := stmt.Schema.ModelType.FieldByName("CoreModels")
+	if !ok || !field.Anonymous || field.Type != coreModelsType {
+		return false
+	}
+	if skipper, ok := reflect.New(stmt.Schema.ModelType).Interface().(Skipper); ok && skipper.SkipDataLog() {
+		return false
+	}
+
+	return true
+}
+
+// afterCreate 记录新增数据的非零字段
+func (p *Plugin) afterCreate(db *gorm.DB) {
+	if !p.enabled(db) || db.Statement.RowsAffected == 0 {
+		return
+	}
+
+	var records []reflect.Value
+	value := reflect.Indirect(db.Statement.ReflectValue)
+	switch value.Kind() {
+	case reflect.Struct:
+		records = append(records, value)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			records = append(records, reflect.Indirect(value.Index(i)))
+		}
+	default:
+		// 以 map 创建时没有完整的数据，不记录
+		return
+	}
+
+	logs := make([]*modelLogger.DataLogger, 0, len(records))
+	for _, record := range records {
+		if record.Kind() != reflect.Struct {
+			continue
+		}
+		changes := p.diff(db.Statement.Context, db.Statement.Schema, reflect.Value{}, record)
+		logs = append(logs, p.newLog(db, data_log.ActionConstCreate, record, changes))
+	}
+
+	p.write(db, logs)
+}
+
+// before 查询将被修改或删除的数据作为变更前快照
+func (p *Plugin) before(db *gorm.DB) {
+	if !p.enabled(db) {
+		return
+	}
+
+	exprs := p.conditions(db.Statement)
+	if len(exprs) == 0 && !db.AllowGlobalUpdate {
+		// 没有条件的语句会被 gorm 拒绝执行
+		return
+	}
+
+	records, err := p.find(db, exprs, db.Statement.Unscoped)
+	if err != nil {
+		zap.L().Error("数据变更日志查询变更前数据失败", zap.String("table", db.Statement.Table), zap.Error(err))
+		return
+	}
+	db.InstanceSet(snapshotKey, records)
+}
+
+// afterUpdate 重新查询变更后的数据，逐条比较字段差异
+func (p *Plugin) afterUpdate(db *gorm.DB) {
+	before, ok := p.snapshot(db)
+	if !ok {
+		return
+	}
+
+	ids := make([]any, 0, len(before))
+	for _, record := range before {
+		ids = append(ids, p.stringValue(db.Statement.Context, db.Statement.Schema, record, "id"))
+	}
+	// 恢复等操作会修改软删除标记，变更后的数据需包含已删除的数据
+	after, err := p.find(db, []clause.Expression{clause.IN{Column: clause.PrimaryColumn, Values: ids}}, true)
+	if err != nil {
+		zap.L().Error("数据变更日志查询变更后数据失败", zap.String("table", db.Statement.Table), zap.Error(err))
+		return
+	}
+
+	afterById := make(map[string]reflect.Value, len(after))
+	for _, record := range after {
+		afterById[p.stringValue(db.Statement.Context, db.Statement.Schema, record, "id")] = record
+	}
+
+	logs := make([]*modelLogger.DataLogger, 0, len(before))
+	for _, record := range before {
+		current, ok := afterById[p.stringValue(db.Statement.Context, db.Statement.Schema, record, "id")]
+		if !ok {
+			continue
+		}
+		changes := p.diff(db.Statement.Context, db.Statement.Schema, record, current)
+		if len(changes) == 0 {
+			continue
+		}
+		logs = append(logs, p.newLog(db, data_log.ActionConstUpdate, current, changes))
+	}
+
+	p.write(db, logs)
+}
+
+// afterDelete 记录被删除数据的非零字段，软删除与物理删除一致
+func (p *Plugin) afterDelete(db *gorm.DB) {
+	before, ok := p.snapshot(db)
+	if !ok {
+		return
+	}
+
+	logs := make([]*modelLogger.DataLogger, 0, len(before))
+	for _, record := range before {
+		changes := p.diff(db.Statement.Context, db.Statement.Schema, record, reflect.Value{})
+		logs = append(logs, p.newLog(db, data_log.ActionConstDelete, record, changes))
+	}
+
+	p.write(db, logs)
+}
+
+// snapshot 获取变更前快照，语句执行失败或未影响数据时返回 false
+func (p *Plugin) snapshot(db *gorm.DB) ([]reflect.Value, bool) {
+	if db.Error != nil || db.Statement.RowsAffected == 0 {
+		return nil, false
+	}
+	value, ok := db.InstanceGet(snapshotKey)
+	if !ok {
+		return nil, false
+	}
+	records, ok := value.([]reflect.Value)
+	return records, ok && len(records) > 0
+}
+
+// conditions 语句的查询条件，包括 gorm 执行时按传入模型主键追加的条件
+func (p *Plugin) conditions(stmt *gorm.Statement) []clause.Expression {
+	var exprs []clause.Expression
+	if c, ok := stmt.Clauses["WHERE"]; ok {
+		if where, ok := c.Expression.(clause.Where); ok {
+			exprs = append(exprs, where.Exprs...)
+		}
+	}
+
+	values := []reflect.Value{stmt.ReflectValue}
+	if stmt.Model != nil && stmt.Dest != stmt.Model {
+		values = append(values, reflect.ValueOf(stmt.Model))
+	}
+	for _, value := range values {
+		if !value.IsValid() {
+			continue
+		}
+		_, queryValues := schema.GetIdentityFieldValuesMap(stmt.Context, value, stmt.Schema.PrimaryFields)
+		column, primaryValues := schema.ToQueryValues(stmt.Table, stmt.Schema.PrimaryFieldDBNames, queryValues)
+		if len(primaryValues) > 0 {
+			exprs = append(exprs, clause.IN{Column: column, Values: primaryValues})
+		}
+	}
+
+	return exprs
+}
+
+// find 在语句所在的事务内按条件查询数据
+func (p *Plugin) find(db *gorm.DB, exprs []clause.Expression, unscoped bool) ([]reflect.Value, error) {
+	stmt := db.Statement
+	dest := reflect.New(reflect.SliceOf(stmt.Schema.ModelType))
+
+	tx := db.Session(&gorm.Session{NewDB: true, SkipHooks: true}).Table(stmt.Table)
+	if unscoped {
+		tx = tx.Unscoped()
+	}
+	if len(exprs) > 0 {
+		tx = tx.Clauses(clause.Where{Exprs: exprs})
+	}
+	if err := tx.Find(dest.Interface()).Error; err != nil {
+		return nil, err
+	}
+
+	list := dest.Elem()
+	records := make([]reflect.Value, 0, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		records = append(records, list.Index(i))
+	}
+	return records, nil
+}
+
+// diff 比较字段差异，before 或 after 无效时分别表示新增或删除，此时只记录非零字段
+// 主键已单独记录，不计入差异
+func (p *Plugin) diff(ctx context.Context, sch *schema.Schema, before, after reflect.Value) []modelLogger.FieldChange {
+	changes := make([]modelLogger.FieldChange, 0)
+	for _, field := range sch.Fields {
+		tag := field.Tag.Get(TagKey)
+		if field.DBName == "" || field.PrimaryKey || tag == "-" {
+			continue
+		}
+
+		var oldValue, newValue any
+		if before.IsValid() {
+			value, zero := field.ValueOf(ctx, before)
+			if zero && !after.IsValid() {
+				continue
+			}
+			oldValue = normalize(value)
+		}
+		if after.IsValid() {
+			value, zero := field.ValueOf(ctx, after)
+			if zero && !before.IsValid() {
+				continue
+			}
+			newValue = normalize(value)
+		}
+		if reflect.DeepEqual(oldValue, newValue) {
+			continue
+		}
+
+		if tag == "mask" {
+			oldValue, newValue = mask(oldValue), mask(newValue)
+		}
+		changes = append(changes, modelLogger.FieldChange{Field: field.DBName, Old: oldValue, New: newValue})
+	}
+	return changes
+}
+
+// newLog 生成数据变更日志，归属部门与数据一致以便按数据权限查询
+func (p *Plugin) newLog(db *gorm.DB, action data_log.ActionConst, record reflect.Value, changes []modelLogger.FieldChange) *modelLogger.DataLogger {
+	ctx := db.Statement.Context
+	operator := models.Operator(ctx)
+
+	changesJSON, err := json.Marshal(changes)
+	if err != nil {
+		zap.L().Error("数据变更日志序列化失败", zap.String("table", db.Statement.Table), zap.Error(err))
+	}
+
+	version, _ := p.value(ctx, db.Statement.Schema, record, "timestamp").(int64)
+	return &modelLogger.DataLogger{
+		CoreModels: models.CoreModels{
+			Creator:    operator,
+			Modifier:   operator,
+			BelongDept: p.stringValue(ctx, db.Statement.Schema, record, "belong_dept"),
+		},
+		RecordTable: db.Statement.Table,
+		RecordId:    p.stringValue(ctx, db.Statement.Schema, record, "id"),
+		Action:      action,
+		Version:     version,
+		Operator:    operator,
+		RequestId:   models.RequestId(ctx),
+		Changes:     string(changesJSON),
+	}
+}
+
+// write 在语句所在的事务内写入日志
+func (p *Plugin) write(db *gorm.DB, logs []*modelLogger.DataLogger) {
+	if len(logs) == 0 {
+		return
+	}
+	if err := db.Session(&gorm.Session{NewDB: true}).Create(&logs).Error; err != nil {
+		zap.L().Error("数据变更日志写入失败", zap.String("table", db.Statement.Table), zap.Error(err))
+	}
+}
+
+// value 按列名读取字段值
+func (p *Plugin) value(ctx context.Context, sch *schema.Schema, record reflect.Value, dbName string) any {
+	field := sch.LookUpField(dbName)
+	if field == nil {
+		return nil
+	}
+	value, _ := field.ValueOf(ctx, record)
+	return value
+}
+
+// stringValue 按列名读取字符串字段值
+func (p *Plugin) stringValue(ctx context.Context, sch *schema.Schema, record reflect.Value, dbName string) string {
+	value, _ := p.value(ctx, sch, record, dbName).(string)
+	return value
+}
+
+// normalize 将字段值转换为可比较、可序列化的值
+func normalize(value any) any {
+	if rv := reflect.ValueOf(value); !rv.IsValid() || (rv.Kind() == reflect.Ptr && rv.IsNil()) {
+		return nil
+	}
+	if valuer, ok := value.(driver.Valuer); ok {
+		if v, err := valuer.Value(); err == nil {
+			value = v
+		}
+	}
+
+	switch v := value.(type) {
+	case time.Time:
+		return v.Format(time.DateTime)
+	case *time.Time:
+		return v.Format(time.DateTime)
+	case []byte:
+		return string(v)
+	}
+	return value
+}
+
+// mask 脱敏，空值保持不变以区分是否设置
+func mask(value any) any {
+	if value == nil || value == "" {
+		return value
+	}
+	return MaskedValue
+}
@@ -0,0 +1,230 @@
+/**
+ * Description：
+ * FileName：plugin_test.go
+ * Author：CJiaの用心
+ * Create：2025/11/14 11:20:16
+ * Remark：
+ */
+
+package datalog
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"github.com/carefuly/careful-admin-go-gin/pkg/constants/careful/logger/data_log"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"io"
+	"strings"
+	"testing"
+)
+
+// testAccount 测试模型
+type testAccount struct {
+	models.CoreModels
+
+	Name     string `gorm:"column:name"`
+	Password string `gorm:"column:password" datalog:"mask"`
+}
+
+func (a *testAccount) TableName() string {
+	return "test_account"
+}
+
+// testSkipped 不记录数据变更的测试模型
+type testSkipped struct {
+	models.CoreModels
+
+	Name string `gorm:"column:name"`
+}
+
+func (s *testSkipped) TableName() string {
+	return "test_skipped"
+}
+
+func (s *testSkipped) SkipDataLog() bool {
+	return true
+}
+
+// fakeConn 按顺序返回预设查询结果并记录执行语句的数据库连接
+type fakeConn struct {
+	results [][]map[string]driver.Value
+	execs   []fakeExec
+}
+
+type fakeExec struct {
+	query string
+	args  []driver.Value
+}
+
+func (c *fakeConn) Connect(context.Context) (driver.Conn, error) { return c, nil }
+func (c *fakeConn) Driver() driver.Driver                        { return nil }
+func (c *fakeConn) Prepare(q string) (driver.Stmt, error)        { return &fakeStmt{conn: c, query: q}, nil }
+func (c *fakeConn) Close() error                                 { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)                    { return c, nil }
+func (c *fakeConn) Commit() error                                { return nil }
+func (c *fakeConn) Rollback() error                              { return nil }
+
+// dataLogs 写入的数据变更日志参数
+func (c *fakeConn) dataLogs() [][]driver.Value {
+	var logs [][]driver.Value
+	for _, exec := range c.execs {
+		if strings.Contains(exec.query, "careful_logger_data_log") {
+			logs = append(logs, exec.args)
+		}
+	}
+	return logs
+}
+
+type fakeStmt struct {
+	conn  *fakeConn
+	query string
+}
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.conn.execs = append(s.conn.execs, fakeExec{query: s.query, args: args})
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	var rows []map[string]driver.Value
+	if len(s.conn.results) > 0 {
+		rows, s.conn.results = s.conn.results[0], s.conn.results[1:]
+	}
+	return &fakeRows{rows: rows}, nil
+}
+
+type fakeRows struct {
+	rows  []map[string]driver.Value
+	index int
+}
+
+func (r *fakeRows) Columns() []string {
+	return []string{"id", "timestamp", "belong_dept", "name", "password"}
+}
+
+func (r *fakeRows) Close() error { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.index >= len(r.rows) {
+		return io.EOF
+	}
+	for i, column := range r.Columns() {
+		dest[i] = r.rows[r.index][column]
+	}
+	r.index++
+	return nil
+}
+
+func newTestDB(t *testing.T, conn *fakeConn) *gorm.DB {
+	db, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      sql.OpenDB(conn),
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{DisableAutomaticPing: true})
+	require.NoError(t, err)
+	require.NoError(t, db.Use(NewPlugin()))
+	return db
+}
+
+// changesOf 解析日志参数中的字段变更
+func changesOf(t *testing.T, args []driver.Value) []map[string]any {
+	for _, arg := range args {
+		if value, ok := arg.(string); ok && strings.HasPrefix(value, "[") {
+			var changes []map[string]any
+			require.NoError(t, json.Unmarshal([]byte(value), &changes))
+			return changes
+		}
+	}
+	t.Fatal("未找到字段变更")
+	return nil
+}
+
+func TestPlugin_Update(t *testing.T) {
+	conn := &fakeConn{results: [][]map[string]driver.Value{
+		{{"id": "A1", "timestamp": int64(1), "belong_dept": "D1", "name": "旧名称", "password": "p1"}},
+		{{"id": "A1", "timestamp": int64(2), "belong_dept": "D1", "name": "新名称", "password": "p2"}},
+	}}
+	db := newTestDB(t, conn)
+
+	ctx := context.WithValue(models.WithOperator(context.Background(), "U1"), models.RequestIdKey, "R1")
+	err := db.WithContext(ctx).Model(&testAccount{}).Where("id = ?", "A1").
+		Updates(map[string]any{"name": "新名称", "password": "p2"}).Error
+	require.NoError(t, err)
+
+	logs := conn.dataLogs()
+	require.Len(t, logs, 1)
+	assert.Contains(t, logs[0], "test_account")
+	assert.Contains(t, logs[0], "A1")
+	assert.Contains(t, logs[0], int64(data_log.ActionConstUpdate))
+	assert.Contains(t, logs[0], int64(2))
+	assert.Contains(t, logs[0], "U1")
+	assert.Contains(t, logs[0], "R1")
+	assert.Contains(t, logs[0], "D1")
+	assert.Equal(t, []map[string]any{
+		{"field": "name", "old": "旧名称", "new": "新名称"},
+		{"field": "password", "old": MaskedValue, "new": MaskedValue},
+	}, changesOf(t, logs[0]))
+}
+
+func TestPlugin_UpdateUnchanged(t *testing.T) {
+	row := map[string]driver.Value{"id": "A1", "timestamp": int64(1), "belong_dept": "D1", "name": "名称", "password": "p1"}
+	conn := &fakeConn{results: [][]map[string]driver.Value{{row}, {row}}}
+	db := newTestDB(t, conn)
+
+	err := db.Model(&testAccount{}).Where("id = ?", "A1").Update("name", "名称").Error
+	require.NoError(t, err)
+	assert.Empty(t, conn.dataLogs())
+}
+
+func TestPlugin_Create(t *testing.T) {
+	conn := &fakeConn{}
+	db := newTestDB(t, conn)
+
+	err := db.WithContext(models.WithOperator(context.Background(), "U1")).
+		Create(&testAccount{Name: "名称", Password: "p1"}).Error
+	require.NoError(t, err)
+
+	logs := conn.dataLogs()
+	require.Len(t, logs, 1)
+	assert.Contains(t, logs[0], int64(data_log.ActionConstCreate))
+	assert.Equal(t, []map[string]any{
+		{"field": "sort", "old": nil, "new": float64(1)},
+		{"field": "name", "old": nil, "new": "名称"},
+		{"field": "password", "old": nil, "new": MaskedValue},
+	}, changesOf(t, logs[0]))
+}
+
+func TestPlugin_Delete(t *testing.T) {
+	conn := &fakeConn{results: [][]map[string]driver.Value{
+		{{"id": "A1", "timestamp": int64(1), "belong_dept": "D1", "name": "名称", "password": ""}},
+	}}
+	db := newTestDB(t, conn)
+
+	err := db.Where("id = ?", "A1").Delete(&testAccount{}).Error
+	require.NoError(t, err)
+
+	logs := conn.dataLogs()
+	require.Len(t, logs, 1)
+	assert.Contains(t, logs[0], int64(data_log.ActionConstDelete))
+	assert.Contains(t, logs[0], int64(1))
+	assert.Equal(t, []map[string]any{
+		{"field": "belong_dept", "old": "D1", "new": nil},
+		{"field": "name", "old": "名称", "new": nil},
+	}, changesOf(t, logs[0]))
+}
+
+func TestPlugin_Skipper(t *testing.T) {
+	conn := &fakeConn{}
+	db := newTestDB(t, conn)
+
+	require.NoError(t, db.Create(&testSkipped{Name: "名称"}).Error)
+	assert.Empty(t, conn.dataLogs())
+}
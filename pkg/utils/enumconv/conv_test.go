@@ -10,6 +10,7 @@ package enumconv
 
 import (
 	"fmt"
+	"github.com/stretchr/testify/assert"
 	"testing"
 )
 
@@ -127,3 +128,30 @@ func TestStringToString(t *testing.T) {
 	level, err := converter.ToEnum("错误")
 	fmt.Printf("Level: %s, Error: %v\n", level, err)
 }
+
+func TestRegister(t *testing.T) {
+	Register("test.LogLevel", NewEnumConverter(
+		map[LogLevel]string{LogInfo: "信息"},
+		map[string]LogLevel{"信息": LogInfo},
+		[]string{"信息"},
+		"日志级别",
+	))
+
+	codec, ok := Lookup("test.LogLevel")
+	assert.True(t, ok)
+
+	str, err := codec.Format(LogInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, "信息", str)
+	_, err = codec.Format("INFO")
+	assert.Error(t, err)
+
+	level, err := codec.Parse("信息")
+	assert.NoError(t, err)
+	assert.Equal(t, LogInfo, level)
+	_, err = codec.Parse("警告")
+	assert.Error(t, err)
+
+	_, ok = Lookup("test.Unknown")
+	assert.False(t, ok)
+}
@@ -0,0 +1,56 @@
+/**
+ * Description：
+ * FileName：registry.go
+ * Author：CJiaの用心
+ * Create：2025/11/10 10:12:37
+ * Remark：
+ */
+
+package enumconv
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Codec 按名称注册的枚举转换器，屏蔽泛型参数，供 excel 标签等按名称引用
+type Codec interface {
+	// Format 枚举值转换为展示文本
+	Format(value any) (string, error)
+	// Parse 展示文本转换为枚举值
+	Parse(input string) (any, error)
+}
+
+// registry 已注册的枚举转换器
+var registry sync.Map
+
+// Register 注册枚举转换器，名称重复时覆盖
+func Register[T EnumType](name string, converter *EnumConverter[T, string]) {
+	registry.Store(name, codec[T]{converter: converter})
+}
+
+// Lookup 按名称查找枚举转换器
+func Lookup(name string) (Codec, bool) {
+	value, ok := registry.Load(name)
+	if !ok {
+		return nil, false
+	}
+	return value.(Codec), true
+}
+
+// codec 枚举与字符串互转的 Codec 实现
+type codec[T EnumType] struct {
+	converter *EnumConverter[T, string]
+}
+
+func (c codec[T]) Format(value any) (string, error) {
+	v, ok := value.(T)
+	if !ok {
+		return "", fmt.Errorf("无效的%s枚举值类型: %T", c.converter.enumName, value)
+	}
+	return c.converter.FromEnum(v)
+}
+
+func (c codec[T]) Parse(input string) (any, error) {
+	return c.converter.ToEnum(input)
+}
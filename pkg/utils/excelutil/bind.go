@@ -0,0 +1,157 @@
+/**
+ * Description：
+ * FileName：bind.go
+ * Author：CJiaの用心
+ * Create：2025/11/10 11:26:54
+ * Remark：
+ */
+
+package excelutil
+
+import (
+	"errors"
+	"fmt"
+	_string "github.com/carefuly/careful-admin-go-gin/pkg/utils/common/string"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/enumconv"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// bindTimeLayouts 导入时间字段支持的格式
+var bindTimeLayouts = []string{"2006-01-02 15:04:05", "2006-01-02", "2006/01/02 15:04:05", "2006/01/02"}
+
+// CellError 单元格校验错误
+type CellError struct {
+	Column  string // 表头标题
+	Value   string // 单元格原始值
+	Message string // 错误信息
+}
+
+func (e CellError) Error() string {
+	return fmt.Sprintf("【%s】%s", e.Column, e.Message)
+}
+
+// CellErrors 一行数据的全部单元格校验错误
+type CellErrors []CellError
+
+func (e CellErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, cell := range e {
+		messages = append(messages, cell.Error())
+	}
+	return strings.Join(messages, "；")
+}
+
+// Bind 按 excel 标签将导入行(xlsx.ReadSheetByName 的结果)解码到结构体，dst 须为结构体指针
+// 全部单元格校验完成后一并返回 CellErrors，便于一次性修正
+func Bind(row map[string]string, dst any) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("导入目标必须是结构体指针, 实际是: %T", dst)
+	}
+	v = v.Elem()
+
+	var cellErrors CellErrors
+	for _, meta := range typeFields(v.Type()) {
+		if meta.readonly {
+			continue
+		}
+
+		raw := row[meta.title]
+		if meta.multiline {
+			raw = strings.TrimSpace(raw)
+		} else {
+			raw = _string.CleanInputString(raw)
+		}
+		if raw == "" {
+			raw = meta.def
+		}
+		if raw == "" {
+			if meta.required {
+				cellErrors = append(cellErrors, CellError{Column: meta.title, Message: "不能为空"})
+			}
+			continue
+		}
+
+		field, err := v.FieldByIndexErr(meta.index)
+		if err != nil {
+			return fmt.Errorf("字段 %s 不可写入: %w", meta.path, err)
+		}
+		if err := setField(field, meta, raw); err != nil {
+			cellErrors = append(cellErrors, CellError{Column: meta.title, Value: raw, Message: err.Error()})
+		}
+	}
+
+	if len(cellErrors) > 0 {
+		return cellErrors
+	}
+	return nil
+}
+
+// setField 单元格文本转换为字段值
+func setField(field reflect.Value, meta fieldMeta, raw string) error {
+	if meta.enum != "" {
+		codec, _ := enumconv.Lookup(meta.enum)
+		value, err := codec.Parse(raw)
+		if err != nil {
+			return fmt.Errorf("转换失败：%s", err.Error())
+		}
+		rv := reflect.ValueOf(value)
+		if !rv.Type().ConvertibleTo(field.Type()) {
+			return fmt.Errorf("枚举类型 %s 与字段类型 %s 不匹配", rv.Type(), field.Type())
+		}
+		field.Set(rv.Convert(field.Type()))
+		return nil
+	}
+
+	if field.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			field.Set(reflect.New(field.Type().Elem()))
+		}
+		field = field.Elem()
+	}
+
+	if field.Type() == reflect.TypeFor[time.Time]() {
+		for _, layout := range bindTimeLayouts {
+			if t, err := time.ParseInLocation(layout, raw, time.Local); err == nil {
+				field.Set(reflect.ValueOf(t))
+				return nil
+			}
+		}
+		return fmt.Errorf("时间格式错误，示例：%s", bindTimeLayouts[0])
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(raw)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, field.Type().Bits())
+		if err != nil {
+			return errors.New("必须为整数")
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(raw, 10, field.Type().Bits())
+		if err != nil {
+			return errors.New("必须为非负整数")
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(raw, field.Type().Bits())
+		if err != nil {
+			return errors.New("必须为数字")
+		}
+		field.SetFloat(n)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return errors.New("必须为 true 或 false")
+		}
+		field.SetBool(b)
+	default:
+		return fmt.Errorf("不支持的字段类型: %s", field.Type())
+	}
+	return nil
+}
@@ -26,6 +26,15 @@ type ExcelColumn struct {
 	Width     float64                        // 列宽
 	Formatter func(value interface{}) string // 值格式化函数
 	Style     *excelize.Style                // 列样式
+	Key       string                         // JSON导出的键名，为空时使用 Field
+}
+
+// JsonKey JSON导出的键名
+func (c ExcelColumn) JsonKey() string {
+	if c.Key != "" {
+		return c.Key
+	}
+	return c.Field
 }
 
 // ExcelExportConfig Excel导出配置
@@ -39,6 +48,7 @@ type ExcelExportConfig struct {
 	DefaultStyle *excelize.Style // 默认单元格样式
 	HeaderStyle  *excelize.Style // 表头样式
 	BasePath     string          // 文件保存的基础路径
+	BOM          bool            // CSV导出时是否写入UTF-8 BOM(中文版Excel直接打开不乱码)
 }
 
 // ExcelExporter Excel导出工具
@@ -48,7 +58,12 @@ type ExcelExporter struct {
 
 // NewExcelExporter 创建Excel导出器
 func NewExcelExporter(cfg *ExcelExportConfig) *ExcelExporter {
-	// 设置默认值
+	applyDefaults(cfg)
+	return &ExcelExporter{config: cfg}
+}
+
+// applyDefaults 设置导出配置默认值
+func applyDefaults(cfg *ExcelExportConfig) {
 	if cfg.SheetName == "" {
 		cfg.SheetName = "Sheet1"
 	}
@@ -107,8 +122,6 @@ func NewExcelExporter(cfg *ExcelExportConfig) *ExcelExporter {
 		// 默认为当前目录下的export目录
 		cfg.BasePath = filepath.Join(".", "static/export")
 	}
-
-	return &ExcelExporter{config: cfg}
 }
 
 // Export 执行导出，返回Excel文件内容
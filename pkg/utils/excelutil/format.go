@@ -0,0 +1,238 @@
+/**
+ * Description：
+ * FileName：format.go
+ * Author：CJiaの用心
+ * Create：2025/11/8 14:05:22
+ * Remark：
+ */
+
+package excelutil
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go.uber.org/zap"
+	"io"
+	"reflect"
+	"strings"
+)
+
+var ErrUnsupportedFormat = errors.New("不支持的导出格式，可选值: xlsx、csv、json、ndjson")
+
+// Format 导出格式
+type Format string
+
+const (
+	FormatXlsx   Format = "xlsx"   // Excel工作簿
+	FormatCsv    Format = "csv"    // 逗号分隔值
+	FormatJson   Format = "json"   // JSON数组
+	FormatNdjson Format = "ndjson" // 每行一个JSON对象
+)
+
+// utf8BOM UTF-8字节序标记，中文版Excel据此识别CSV编码
+const utf8BOM = "\xEF\xBB\xBF"
+
+// ParseFormat 解析导出格式，为空时默认导出Excel
+func ParseFormat(format string) (Format, error) {
+	switch f := Format(strings.ToLower(strings.TrimSpace(format))); f {
+	case "":
+		return FormatXlsx, nil
+	case FormatXlsx, FormatCsv, FormatJson, FormatNdjson:
+		return f, nil
+	default:
+		return "", ErrUnsupportedFormat
+	}
+}
+
+// ContentType 响应类型
+func (f Format) ContentType() string {
+	switch f {
+	case FormatCsv:
+		return "text/csv; charset=utf-8"
+	case FormatJson:
+		return "application/json; charset=utf-8"
+	case FormatNdjson:
+		return "application/x-ndjson; charset=utf-8"
+	default:
+		return ContentType
+	}
+}
+
+// FileName 拼接导出文件名后缀
+func (f Format) FileName(name string) string {
+	return name + "." + string(f)
+}
+
+// Write 按导出格式逐批读取数据并写入 w，返回导出的数据条数
+// 所有格式共用同一份列配置：CSV使用列标题作为表头，JSON使用列的键名
+func Write[T any](ctx context.Context, w io.Writer, format Format, cfg *ExcelExportConfig, it RowIterator[T]) (int, error) {
+	switch format {
+	case FormatXlsx, "":
+		return WriteStream(ctx, w, cfg, it)
+	case FormatCsv:
+		return writeCsv(ctx, w, cfg, it)
+	case FormatJson, FormatNdjson:
+		return writeJson(ctx, w, format == FormatNdjson, cfg, it)
+	default:
+		return 0, ErrUnsupportedFormat
+	}
+}
+
+// writeCsv 导出CSV，取值与格式化规则与Excel一致
+func writeCsv[T any](ctx context.Context, w io.Writer, cfg *ExcelExportConfig, it RowIterator[T]) (int, error) {
+	applyDefaults(cfg)
+
+	// 首批数据读取成功后再写入，读取失败时调用方仍可返回错误响应
+	batch, err := nextBatch(ctx, it)
+	if err != nil {
+		return 0, err
+	}
+
+	bw := bufio.NewWriter(w)
+	if cfg.BOM {
+		if _, err := bw.WriteString(utf8BOM); err != nil {
+			return 0, err
+		}
+	}
+
+	cw := csv.NewWriter(bw)
+	header := make([]string, 0, len(cfg.Columns))
+	for _, col := range cfg.Columns {
+		header = append(header, col.Title)
+	}
+	if err := cw.Write(header); err != nil {
+		return 0, err
+	}
+
+	count := 0
+	record := make([]string, len(cfg.Columns))
+	for len(batch) > 0 {
+		for _, item := range batch {
+			for colIdx, value := range rowValues(reflect.ValueOf(item), cfg) {
+				record[colIdx] = stringValue(value)
+			}
+			if err := cw.Write(record); err != nil {
+				return count, err
+			}
+			count++
+		}
+		if batch, err = nextBatch(ctx, it); err != nil {
+			return count, err
+		}
+	}
+
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return count, err
+	}
+	return count, bw.Flush()
+}
+
+// writeJson 导出JSON数组或NDJSON，对象的键按列配置顺序输出
+func writeJson[T any](ctx context.Context, w io.Writer, lines bool, cfg *ExcelExportConfig, it RowIterator[T]) (int, error) {
+	applyDefaults(cfg)
+
+	batch, err := nextBatch(ctx, it)
+	if err != nil {
+		return 0, err
+	}
+
+	// 预先编码键名
+	keys := make([][]byte, 0, len(cfg.Columns))
+	for _, col := range cfg.Columns {
+		key, err := json.Marshal(col.JsonKey())
+		if err != nil {
+			return 0, err
+		}
+		keys = append(keys, key)
+	}
+
+	bw := bufio.NewWriter(w)
+	if !lines {
+		bw.WriteByte('[')
+	}
+
+	count := 0
+	for len(batch) > 0 {
+		for _, item := range batch {
+			switch {
+			case lines && count > 0:
+				bw.WriteByte('\n')
+			case !lines && count > 0:
+				bw.WriteByte(',')
+			}
+
+			bw.WriteByte('{')
+			for colIdx, value := range rowValues(reflect.ValueOf(item), cfg) {
+				data, err := json.Marshal(value)
+				if err != nil {
+					return count, fmt.Errorf("序列化字段[%s]失败: %w", cfg.Columns[colIdx].Field, err)
+				}
+				if colIdx > 0 {
+					bw.WriteByte(',')
+				}
+				bw.Write(keys[colIdx])
+				bw.WriteByte(':')
+				bw.Write(data)
+			}
+			bw.WriteByte('}')
+			count++
+		}
+		if batch, err = nextBatch(ctx, it); err != nil {
+			return count, err
+		}
+	}
+
+	switch {
+	case !lines:
+		bw.WriteByte(']')
+	case count > 0:
+		bw.WriteByte('\n')
+	}
+	return count, bw.Flush()
+}
+
+// nextBatch 读取下一批数据，读取前检查请求是否已取消
+func nextBatch[T any](ctx context.Context, it RowIterator[T]) ([]T, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return it(ctx)
+}
+
+// rowValues 按列配置取值并格式化，字段不存在时为空值
+func rowValues(rowValue reflect.Value, cfg *ExcelExportConfig) []interface{} {
+	if rowValue.Kind() == reflect.Ptr {
+		rowValue = rowValue.Elem()
+	}
+
+	values := make([]interface{}, 0, len(cfg.Columns))
+	for _, col := range cfg.Columns {
+		fieldValue, err := getFieldValue(rowValue, col.Field)
+		if err != nil {
+			zap.S().Warnf("获取字段值失败: %s, %v", col.Field, err)
+			values = append(values, nil)
+			continue
+		}
+		values = append(values, formatValue(fieldValue, col.Formatter, cfg.TimeFormat))
+	}
+	return values
+}
+
+// stringValue 转换为CSV单元格文本，空值输出空字符串
+func stringValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
@@ -0,0 +1,71 @@
+/**
+ * Description：
+ * FileName：format_test.go
+ * Author：CJiaの用心
+ * Create：2025/11/8 15:12:09
+ * Remark：
+ */
+
+package excelutil
+
+import (
+	"bytes"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func formatUsers() []streamUser {
+	return []streamUser{
+		{Id: 1, Name: "张三", Active: true, Dept: &streamDept{Name: "研发部"}},
+		{Id: 2, Name: "李,四", Active: false},
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	format, err := ParseFormat("")
+	assert.NoError(t, err)
+	assert.Equal(t, FormatXlsx, format)
+
+	format, err = ParseFormat(" CSV ")
+	assert.NoError(t, err)
+	assert.Equal(t, FormatCsv, format)
+	assert.Equal(t, "导出.csv", format.FileName("导出"))
+
+	_, err = ParseFormat("xml")
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func TestWrite_Csv(t *testing.T) {
+	var buf bytes.Buffer
+	cfg := &ExcelExportConfig{Columns: streamColumns(), BOM: true}
+	count, err := Write(context.Background(), &buf, FormatCsv, cfg, SliceIterator(formatUsers()))
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, "\xEF\xBB\xBFID,用户名,状态,部门\n1,张三,启用,研发部\n2,\"李,四\",停用,\n", buf.String())
+}
+
+func TestWrite_Json(t *testing.T) {
+	columns := streamColumns()
+	columns[1].Key = "name"
+
+	var buf bytes.Buffer
+	count, err := Write(context.Background(), &buf, FormatJson, &ExcelExportConfig{Columns: columns}, SliceIterator(formatUsers()))
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, `[{"Id":1,"name":"张三","Active":"启用","Dept.Name":"研发部"},{"Id":2,"name":"李,四","Active":"停用","Dept.Name":null}]`, buf.String())
+
+	buf.Reset()
+	_, err = Write(context.Background(), &buf, FormatJson, &ExcelExportConfig{Columns: columns}, SliceIterator([]streamUser{}))
+	require.NoError(t, err)
+	assert.Equal(t, "[]", buf.String())
+}
+
+func TestWrite_Ndjson(t *testing.T) {
+	var buf bytes.Buffer
+	count, err := Write(context.Background(), &buf, FormatNdjson, &ExcelExportConfig{Columns: streamColumns()[:2]}, SliceIterator(formatUsers()))
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, "{\"Id\":1,\"Name\":\"张三\"}\n{\"Id\":2,\"Name\":\"李,四\"}\n", buf.String())
+}
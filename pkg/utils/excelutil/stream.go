@@ -0,0 +1,204 @@
+/**
+ * Description：
+ * FileName：stream.go
+ * Author：CJiaの用心
+ * Create：2025/11/8 09:36:14
+ * Remark：
+ */
+
+package excelutil
+
+import (
+	"context"
+	"fmt"
+	"github.com/xuri/excelize/v2"
+	"go.uber.org/zap"
+	"io"
+	"reflect"
+)
+
+// ContentType Excel文件响应类型
+const ContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+const (
+	defaultBatchSize = 1000               // 默认每批读取条数
+	maxSheetRows     = excelize.TotalRows // 单个工作表最大行数(含表头)
+)
+
+// RowIterator 行迭代器，每次返回下一批数据，返回空批次表示遍历结束
+type RowIterator[T any] func(ctx context.Context) ([]T, error)
+
+// SliceIterator 内存数据迭代器，用于数据量较小且已加载的导出
+func SliceIterator[T any](data []T) RowIterator[T] {
+	done := false
+	return func(ctx context.Context) ([]T, error) {
+		if done {
+			return nil, nil
+		}
+		done = true
+		return data, nil
+	}
+}
+
+// CursorIterator 游标分页迭代器
+// fetch 按游标查询下一批数据(首次游标为nil)，next 取批次最后一条数据作为下一次查询的游标
+func CursorIterator[T any, C any](fetch func(ctx context.Context, cursor *C, limit int) ([]T, error), next func(last T) C, batchSize int) RowIterator[T] {
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	var cursor *C
+	done := false
+	return func(ctx context.Context) ([]T, error) {
+		if done {
+			return nil, nil
+		}
+		batch, err := fetch(ctx, cursor, batchSize)
+		if err != nil {
+			return nil, err
+		}
+		// 不足一批说明已到末尾，避免多查询一次
+		if len(batch) < batchSize {
+			done = true
+		}
+		if len(batch) > 0 {
+			c := next(batch[len(batch)-1])
+			cursor = &c
+		}
+		return batch, nil
+	}
+}
+
+// WriteStream 按迭代器逐批读取数据，通过 StreamWriter 写入工作簿后输出到 w，返回导出的数据条数
+// 数据行由 StreamWriter 写入临时文件，内存占用与数据总量无关；读取数据失败时不会向 w 写入任何内容
+func WriteStream[T any](ctx context.Context, w io.Writer, cfg *ExcelExportConfig, it RowIterator[T]) (int, error) {
+	applyDefaults(cfg)
+
+	f := excelize.NewFile()
+	defer func() {
+		if err := f.Close(); err != nil {
+			zap.S().Errorf("关闭Excel文件失败: %v", err)
+		}
+	}()
+
+	sheet := cfg.SheetName
+	if sheet != "Sheet1" {
+		if err := f.SetSheetName("Sheet1", sheet); err != nil {
+			return 0, fmt.Errorf("创建工作表[%s]失败: %w", sheet, err)
+		}
+	}
+
+	sw, err := f.NewStreamWriter(sheet)
+	if err != nil {
+		return 0, err
+	}
+
+	styles, err := newStreamStyles(f, cfg)
+	if err != nil {
+		return 0, err
+	}
+
+	// 列宽需在写入行之前设置
+	for colIdx, col := range cfg.Columns {
+		if col.Width > 0 {
+			if err := sw.SetColWidth(colIdx+1, colIdx+1, col.Width); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	// 表头
+	header := make([]interface{}, 0, len(cfg.Columns))
+	for _, col := range cfg.Columns {
+		header = append(header, excelize.Cell{StyleID: styles.header, Value: col.Title})
+	}
+	if err := sw.SetRow("A1", header, excelize.RowOpts{Height: 24}); err != nil {
+		return 0, err
+	}
+
+	// 数据行
+	count := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return count, err
+		}
+		batch, err := it(ctx)
+		if err != nil {
+			return count, err
+		}
+		if len(batch) == 0 {
+			break
+		}
+		if count+len(batch)+1 > maxSheetRows {
+			return count, fmt.Errorf("导出数据超过Excel单表最大行数【%d】，请缩小查询范围", maxSheetRows-1)
+		}
+
+		for _, item := range batch {
+			count++
+			cell, _ := excelize.CoordinatesToCellName(1, count+1)
+			if err := sw.SetRow(cell, rowCells(reflect.ValueOf(item), cfg, styles.columns)); err != nil {
+				return count, err
+			}
+		}
+	}
+
+	if err := sw.Flush(); err != nil {
+		return count, err
+	}
+	if _, err := f.WriteTo(w); err != nil {
+		return count, err
+	}
+	return count, nil
+}
+
+// streamStyles 流式导出使用的样式，每种样式只创建一次
+type streamStyles struct {
+	header  int
+	columns []int
+}
+
+func newStreamStyles(f *excelize.File, cfg *ExcelExportConfig) (streamStyles, error) {
+	styles := streamStyles{columns: make([]int, len(cfg.Columns))}
+
+	var err error
+	if cfg.HeaderStyle != nil {
+		if styles.header, err = f.NewStyle(cfg.HeaderStyle); err != nil {
+			return styles, fmt.Errorf("创建表头样式失败: %w", err)
+		}
+	}
+
+	defaultStyle := 0
+	if cfg.DefaultStyle != nil {
+		if defaultStyle, err = f.NewStyle(cfg.DefaultStyle); err != nil {
+			return styles, fmt.Errorf("创建单元格样式失败: %w", err)
+		}
+	}
+
+	for colIdx, col := range cfg.Columns {
+		styles.columns[colIdx] = defaultStyle
+		if col.Style == nil {
+			continue
+		}
+		// 与普通导出保持一致，列样式强制居中
+		style := *col.Style
+		style.Alignment = &excelize.Alignment{
+			Horizontal: "center", // 水平居中
+			Vertical:   "center", // 垂直居中
+		}
+		if styles.columns[colIdx], err = f.NewStyle(&style); err != nil {
+			return styles, fmt.Errorf("创建列[%s]样式失败: %w", col.Title, err)
+		}
+	}
+
+	return styles, nil
+}
+
+// rowCells 按列配置取值并格式化为一行单元格
+func rowCells(rowValue reflect.Value, cfg *ExcelExportConfig, styleIDs []int) []interface{} {
+	values := rowValues(rowValue, cfg)
+	cells := make([]interface{}, 0, len(values))
+	for colIdx, value := range values {
+		cells = append(cells, excelize.Cell{StyleID: styleIDs[colIdx], Value: value})
+	}
+	return cells
+}
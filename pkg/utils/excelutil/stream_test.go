@@ -0,0 +1,118 @@
+/**
+ * Description：
+ * FileName：stream_test.go
+ * Author：CJiaの用心
+ * Create：2025/11/8 10:42:51
+ * Remark：
+ */
+
+package excelutil
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+	"strconv"
+	"testing"
+)
+
+type streamDept struct {
+	Name string
+}
+
+type streamUser struct {
+	Id     int
+	Name   string
+	Active bool
+	Dept   *streamDept
+}
+
+func streamColumns() []ExcelColumn {
+	return []ExcelColumn{
+		{Title: "ID", Field: "Id", Width: 8},
+		{Title: "用户名", Field: "Name", Width: 15},
+		{
+			Title: "状态",
+			Field: "Active",
+			Formatter: func(value interface{}) string {
+				if value.(bool) {
+					return "启用"
+				}
+				return "停用"
+			},
+		},
+		{Title: "部门", Field: "Dept.Name", Width: 15},
+	}
+}
+
+func TestWriteStream(t *testing.T) {
+	users := make([]streamUser, 0, 5)
+	for i := 1; i <= 5; i++ {
+		users = append(users, streamUser{Id: i, Name: "用户" + strconv.Itoa(i), Active: i%2 == 1, Dept: &streamDept{Name: "研发部"}})
+	}
+	users[4].Dept = nil
+
+	// 按游标(最后一条的Id)分批读取
+	var cursors []int
+	it := CursorIterator(func(ctx context.Context, cursor *int, limit int) ([]streamUser, error) {
+		start := 0
+		if cursor != nil {
+			cursors = append(cursors, *cursor)
+			start = *cursor
+		}
+		return users[start:min(start+limit, len(users))], nil
+	}, func(last streamUser) int {
+		return last.Id
+	}, 2)
+
+	var buf bytes.Buffer
+	count, err := WriteStream(context.Background(), &buf, &ExcelExportConfig{SheetName: "用户数据", Columns: streamColumns()}, it)
+	require.NoError(t, err)
+	assert.Equal(t, 5, count)
+	// 第三批不足一批，不再继续查询
+	assert.Equal(t, []int{2, 4}, cursors)
+
+	f, err := excelize.OpenReader(&buf)
+	require.NoError(t, err)
+	defer f.Close()
+
+	rows, err := f.GetRows("用户数据")
+	require.NoError(t, err)
+	require.Len(t, rows, 6)
+	assert.Equal(t, []string{"ID", "用户名", "状态", "部门"}, rows[0])
+	assert.Equal(t, []string{"1", "用户1", "启用", "研发部"}, rows[1])
+	assert.Equal(t, []string{"2", "用户2", "停用", "研发部"}, rows[2])
+	assert.Equal(t, []string{"5", "用户5", "启用"}, rows[5])
+}
+
+func TestWriteStream_FetchError(t *testing.T) {
+	calls := 0
+	it := RowIterator[streamUser](func(ctx context.Context) ([]streamUser, error) {
+		calls++
+		if calls > 1 {
+			return nil, errors.New("db error")
+		}
+		return []streamUser{{Id: 1}}, nil
+	})
+
+	var buf bytes.Buffer
+	_, err := WriteStream(context.Background(), &buf, &ExcelExportConfig{Columns: streamColumns()}, it)
+	assert.EqualError(t, err, "db error")
+	// 读取失败时不输出内容，调用方仍可返回错误响应
+	assert.Zero(t, buf.Len())
+}
+
+func TestSliceIterator(t *testing.T) {
+	it := SliceIterator([]int{1, 2, 3})
+
+	batch, err := it(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, batch)
+
+	batch, err = it(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, batch)
+}
@@ -0,0 +1,187 @@
+/**
+ * Description：
+ * FileName：tag.go
+ * Author：CJiaの用心
+ * Create：2025/11/10 10:40:21
+ * Remark：
+ */
+
+package excelutil
+
+import (
+	"fmt"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/enumconv"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// TagName 列声明的结构体标签名
+// 示例：`excel:"title=字典名称;width=20;enum=dict.TypeMapping;required"`
+//
+//	title     表头标题，未设置的字段不参与导入导出
+//	width     导出列宽
+//	enum      枚举转换器名称(enumconv.Register 注册)，导出转为展示文本，导入转为枚举值
+//	default   导入时单元格为空使用的默认值
+//	key       JSON导出的键名，默认取 json 标签
+//	order     导出列顺序，未设置时为0，相同顺序按字段声明顺序
+//	required  导入时不能为空
+//	readonly  仅导出，导入时忽略
+//	multiline 导入时保留换行
+const TagName = "excel"
+
+// fieldMeta excel 标签声明的字段
+type fieldMeta struct {
+	index     []int  // 字段索引路径(含嵌入结构体)
+	path      string // 点号分隔的字段路径
+	title     string
+	width     float64
+	enum      string
+	def       string
+	key       string
+	order     int
+	required  bool
+	readonly  bool
+	multiline bool
+}
+
+// fieldCache 结构体类型 -> 字段声明
+var fieldCache sync.Map
+
+// Columns 根据结构体 excel 标签生成导出列配置
+func Columns[T any]() []ExcelColumn {
+	metas := typeFields(reflect.TypeFor[T]())
+	columns := make([]ExcelColumn, 0, len(metas))
+	for _, meta := range metas {
+		column := ExcelColumn{
+			Title: meta.title,
+			Field: meta.path,
+			Width: meta.width,
+			Key:   meta.key,
+		}
+		if codec, ok := enumconv.Lookup(meta.enum); ok {
+			column.Formatter = enumFormatter(codec)
+		}
+		columns = append(columns, column)
+	}
+	return columns
+}
+
+// Headers 根据结构体 excel 标签生成导入表头，不含只读列
+func Headers[T any]() []string {
+	metas := typeFields(reflect.TypeFor[T]())
+	headers := make([]string, 0, len(metas))
+	for _, meta := range metas {
+		if !meta.readonly {
+			headers = append(headers, meta.title)
+		}
+	}
+	return headers
+}
+
+// enumFormatter 枚举值转换为展示文本，无法转换时原样输出
+func enumFormatter(codec enumconv.Codec) func(value interface{}) string {
+	return func(value interface{}) string {
+		if str, err := codec.Format(value); err == nil {
+			return str
+		}
+		return fmt.Sprintf("%v", value)
+	}
+}
+
+// typeFields 解析结构体 excel 标签，按导出顺序返回
+func typeFields(t reflect.Type) []fieldMeta {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if cached, ok := fieldCache.Load(t); ok {
+		return cached.([]fieldMeta)
+	}
+	if t.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("excel 标签仅支持结构体类型, 实际是: %s", t.String()))
+	}
+
+	// VisibleFields 已排除被外层同名字段遮蔽的嵌入字段
+	var metas []fieldMeta
+	for _, field := range reflect.VisibleFields(t) {
+		tag, ok := field.Tag.Lookup(TagName)
+		if !ok || tag == "-" || !field.IsExported() {
+			continue
+		}
+		meta := parseTag(tag)
+		if meta.title == "" {
+			panic(fmt.Sprintf("%s.%s 的 excel 标签缺少 title", t.String(), field.Name))
+		}
+		if meta.enum != "" {
+			if _, ok := enumconv.Lookup(meta.enum); !ok {
+				panic(fmt.Sprintf("%s.%s 引用的枚举转换器 %s 未注册", t.String(), field.Name, meta.enum))
+			}
+		}
+		meta.index = field.Index
+		meta.path = fieldPath(t, field.Index)
+		if meta.key == "" {
+			meta.key = jsonKey(field)
+		}
+		metas = append(metas, meta)
+	}
+	slices.SortStableFunc(metas, func(a, b fieldMeta) int {
+		return a.order - b.order
+	})
+
+	fieldCache.Store(t, metas)
+	return metas
+}
+
+// parseTag 解析标签内容
+func parseTag(tag string) fieldMeta {
+	var meta fieldMeta
+	for _, part := range strings.Split(tag, ";") {
+		name, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch name {
+		case "title":
+			meta.title = value
+		case "width":
+			meta.width, _ = strconv.ParseFloat(value, 64)
+		case "enum":
+			meta.enum = value
+		case "default":
+			meta.def = value
+		case "key":
+			meta.key = value
+		case "order":
+			meta.order, _ = strconv.Atoi(value)
+		case "required":
+			meta.required = true
+		case "readonly":
+			meta.readonly = true
+		case "multiline":
+			meta.multiline = true
+		}
+	}
+	return meta
+}
+
+// fieldPath 索引路径转换为点号分隔的字段路径，与 getFieldValue 的取值方式一致
+func fieldPath(t reflect.Type, index []int) string {
+	names := make([]string, 0, len(index))
+	for _, i := range index {
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		field := t.Field(i)
+		names = append(names, field.Name)
+		t = field.Type
+	}
+	return strings.Join(names, ".")
+}
+
+// jsonKey 取字段的 json 键名，未设置时使用字段名
+func jsonKey(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return field.Name
+	}
+	return name
+}
@@ -0,0 +1,106 @@
+/**
+ * Description：
+ * FileName：tag_test.go
+ * Author：CJiaの用心
+ * Create：2025/11/10 14:05:48
+ * Remark：
+ */
+
+package excelutil
+
+import (
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/enumconv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type tagLevel int
+
+const (
+	tagLevelLow tagLevel = iota + 1
+	tagLevelHigh
+)
+
+func init() {
+	enumconv.Register("test.tagLevel", enumconv.NewEnumConverter(
+		map[tagLevel]string{tagLevelLow: "低", tagLevelHigh: "高"},
+		map[string]tagLevel{"低": tagLevelLow, "高": tagLevelHigh},
+		[]string{"低", "高"},
+		"级别",
+	))
+}
+
+type tagBase struct {
+	Sort       int        `excel:"title=排序;default=1;order=100"`
+	Remark     string     `excel:"title=备注;multiline;order=110"`
+	CreateTime *time.Time `excel:"title=创建时间;readonly;order=120"`
+}
+
+type tagItem struct {
+	tagBase
+	Name     string   `excel:"title=名称;width=20;required" json:"name"`
+	Level    tagLevel `excel:"title=级别;enum=test.tagLevel;required" json:"level"`
+	Weight   float64  `excel:"title=权重" json:"weight"`
+	Internal string   `json:"internal"`
+	Ignored  string   `excel:"-"`
+}
+
+type tagShadow struct {
+	tagBase
+	CreateTime string `excel:"title=创建时间;readonly;order=120"` // 遮蔽嵌入结构体的同名字段
+}
+
+func TestColumns(t *testing.T) {
+	columns := Columns[tagItem]()
+	require.Len(t, columns, 6)
+
+	titles := make([]string, 0, len(columns))
+	for _, column := range columns {
+		titles = append(titles, column.Title)
+	}
+	assert.Equal(t, []string{"名称", "级别", "权重", "排序", "备注", "创建时间"}, titles)
+
+	assert.Equal(t, "Name", columns[0].Field)
+	assert.Equal(t, "name", columns[0].JsonKey())
+	assert.Equal(t, float64(20), columns[0].Width)
+	assert.Equal(t, "高", columns[1].Formatter(tagLevelHigh))
+	assert.Equal(t, "tagBase.Sort", columns[3].Field)
+
+	shadow := Columns[tagShadow]()
+	require.Len(t, shadow, 3)
+	assert.Equal(t, "CreateTime", shadow[2].Field)
+
+	assert.Equal(t, []string{"名称", "级别", "权重", "排序", "备注"}, Headers[tagItem]())
+}
+
+func TestBind(t *testing.T) {
+	var item tagItem
+	err := Bind(map[string]string{
+		"名称":   " 张三\n",
+		"级别":   "高",
+		"权重":   "0.5",
+		"备注":   "第一行\n第二行",
+		"创建时间": "2025-11-10",
+	}, &item)
+	require.NoError(t, err)
+	assert.Equal(t, "张三", item.Name)
+	assert.Equal(t, tagLevelHigh, item.Level)
+	assert.Equal(t, 0.5, item.Weight)
+	assert.Equal(t, 1, item.Sort) // 默认值
+	assert.Equal(t, "第一行\n第二行", item.Remark)
+	assert.Nil(t, item.CreateTime) // 只读列不导入
+
+	err = Bind(map[string]string{"级别": "中", "权重": "abc", "排序": "1.5"}, &tagItem{})
+	var cellErrors CellErrors
+	require.ErrorAs(t, err, &cellErrors)
+	assert.Equal(t, []string{"名称", "级别", "权重", "排序"}, []string{
+		cellErrors[0].Column, cellErrors[1].Column, cellErrors[2].Column, cellErrors[3].Column,
+	})
+	assert.Equal(t, "【名称】不能为空", cellErrors[0].Error())
+	assert.Contains(t, cellErrors[1].Error(), "【级别】转换失败")
+	assert.Equal(t, "abc", cellErrors[2].Value)
+
+	assert.Error(t, Bind(map[string]string{}, tagItem{}))
+}
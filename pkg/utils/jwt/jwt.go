@@ -10,9 +10,13 @@ package jwt
 
 import (
 	"errors"
+	"github.com/carefuly/careful-admin-go-gin/config"
 	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/jwt/keymanager"
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/zap"
+	"sync"
 	"time"
 )
 
@@ -29,6 +33,19 @@ type Claims struct {
 	DeptId    string                 `json:"DeptId"`    // 部门ID
 	UserAgent string                 `json:"userAgent"` // 用户代理
 	UserInfo  map[string]interface{} `json:"userInfo"`  // 用户信息(精简版)
+	SessionId string                 `json:"sid"`       // 会话ID(刷新令牌族ID)
+	AuthTime  int64                  `json:"authTime"`  // 登录认证时间(秒级时间戳)
+}
+
+// AuthAt 获取登录认证时间，兼容未携带 authTime 的旧令牌
+func (c *Claims) AuthAt() time.Time {
+	if c.AuthTime > 0 {
+		return time.Unix(c.AuthTime, 0)
+	}
+	if c.IssuedAt != nil {
+		return c.IssuedAt.Time
+	}
+	return time.Time{}
 }
 
 // TokenConfig JWT配置
@@ -38,26 +55,105 @@ type TokenConfig struct {
 	Issuer      string        `json:"issuer"`      // 签发者
 	Audience    []string      `json:"audience"`    // 接收方
 	MaxRefresh  time.Duration `json:"maxRefresh"`  // 最大刷新时间
+	// 刷新令牌闲置有效期
+	RefreshExpire time.Duration `json:"refreshExpire"`
+	// 滑动续期窗口，访问令牌剩余有效期低于该值时自动续期，0表示关闭
+	RenewWindow time.Duration `json:"renewWindow"`
+	// 单会话模式，登录时注销该用户的其他会话
+	SingleSession bool `json:"singleSession"`
+	// 签名密钥，为空时使用 Secret 以 HS512 签名
+	Keys keymanager.KeyManager `json:"-"`
+	// 非对称算法下接受未携带 kid 的 HS512 旧令牌的截止时间，零值表示不接受
+	LegacySecretUntil time.Time `json:"legacySecretUntil"`
+}
+
+// NewTokenConfig 根据应用配置构建JWT配置，未配置项使用默认值
+func NewTokenConfig(token config.Token, keys ...keymanager.KeyManager) TokenConfig {
+	cfg := TokenConfig{
+		Secret:      token.Secret,
+		ExpireHours: token.Expire,
+		Issuer:      "careful@用心",
+		Audience:    []string{"careful-admin"},
+		MaxRefresh:  24 * time.Hour, // 允许在24小时内刷新
+		RenewWindow: time.Duration(token.RenewWindow) * time.Minute,
+
+		SingleSession: token.SingleSession,
+	}
+	if token.MaxRefresh > 0 {
+		cfg.MaxRefresh = time.Duration(token.MaxRefresh) * time.Hour
+	}
+	if token.LegacySecretUntil != "" {
+		until, err := time.ParseInLocation(time.DateTime, token.LegacySecretUntil, time.Local)
+		if err != nil {
+			zap.L().Warn("旧令牌截止时间格式错误，不再接受旧令牌", zap.String("legacySecretUntil", token.LegacySecretUntil))
+		} else {
+			cfg.LegacySecretUntil = until
+		}
+	}
+	cfg.RefreshExpire = cfg.MaxRefresh
+	if token.RefreshExpire > 0 {
+		cfg.RefreshExpire = time.Duration(token.RefreshExpire) * time.Hour
+	}
+	if len(keys) > 0 {
+		cfg.Keys = keys[0]
+	}
+	return cfg
+}
+
+// TokenSource 获取当前JWT配置
+type TokenSource func() TokenConfig
+
+// StaticTokenSource 固定不变的JWT配置
+func StaticTokenSource(config TokenConfig) TokenSource {
+	return func() TokenConfig {
+		return config
+	}
+}
+
+// LiveTokenSource 根据可热更新的应用配置构建JWT配置，应用配置未变化时复用上次构建结果
+func LiveTokenSource(token *config.Live[config.Token], keys ...keymanager.KeyManager) TokenSource {
+	var (
+		mutex   sync.Mutex
+		current config.Token
+		cfg     TokenConfig
+		built   bool
+	)
+	return func() TokenConfig {
+		latest := token.Load()
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		if !built || latest != current {
+			current, cfg, built = latest, NewTokenConfig(latest, keys...), true
+		}
+		return cfg
+	}
 }
 
 // TokenService JWT服务接口
 type TokenService interface {
-	GenerateToken(ctx *gin.Context, userId string, userInfo domainSystem.User) (string, error)
+	GenerateSessionToken(ctx *gin.Context, userInfo domainSystem.User, sessionId string, authTime time.Time) (string, error)
+	RenewToken(ctx *gin.Context, claims *Claims) (string, error)
 	ParseToken(tokenString string) (*Claims, error)
 }
 
 // DefaultJWTService 默认JWT服务实现
 type DefaultJWTService struct {
-	config TokenConfig
+	source TokenSource
 }
 
 // NewJWTService 创建JWT服务实例
 func NewJWTService(config TokenConfig) *DefaultJWTService {
-	return &DefaultJWTService{config: config}
+	return NewJWTServiceFromSource(StaticTokenSource(config))
+}
+
+// NewJWTServiceFromSource 创建JWT服务实例，每次签发与校验时读取最新配置
+func NewJWTServiceFromSource(source TokenSource) *DefaultJWTService {
+	return &DefaultJWTService{source: source}
 }
 
-// GenerateToken 生成新的 JWT 令牌
-func (s *DefaultJWTService) GenerateToken(ctx *gin.Context, userId string, userInfo domainSystem.User) (string, error) {
+// GenerateSessionToken 生成归属于指定会话的 JWT 令牌
+func (s *DefaultJWTService) GenerateSessionToken(ctx *gin.Context, userInfo domainSystem.User, sessionId string, authTime time.Time) (string, error) {
 	// 创建精简版用户信息
 	essentialUserInfo := map[string]interface{}{
 		"id":       userInfo.Id,
@@ -66,30 +162,127 @@ func (s *DefaultJWTService) GenerateToken(ctx *gin.Context, userId string, userI
 		// 只包含必要信息，避免令牌过大
 	}
 
-	// 设置声明
-	now := time.Now()
-	expiresAt := now.Add(time.Hour * time.Duration(s.config.ExpireHours))
-
 	claims := Claims{
-		UserId:    userId,
+		UserId:    userInfo.Id,
 		Username:  userInfo.Username,
 		DeptId:    userInfo.DeptId,
 		UserAgent: ctx.GetHeader("User-Agent"),
 		UserInfo:  essentialUserInfo,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(expiresAt),
-			IssuedAt:  jwt.NewNumericDate(now),
-			NotBefore: jwt.NewNumericDate(now),
-			Issuer:    s.config.Issuer,
-			Audience:  s.config.Audience,
-		},
+		SessionId: sessionId,
+		AuthTime:  authTime.Unix(),
 	}
 
-	// 创建令牌
-	token := jwt.NewWithClaims(jwt.SigningMethodHS512, claims)
+	return s.sign(claims, time.Time{})
+}
+
+// RenewToken 基于当前声明续期访问令牌，续期后的过期时间不超过登录时间 + MaxRefresh
+func (s *DefaultJWTService) RenewToken(ctx *gin.Context, claims *Claims) (string, error) {
+	if !s.CanRenew(claims) {
+		return "", ErrExpiredToken
+	}
+
+	renewed := *claims
+	renewed.UserAgent = ctx.GetHeader("User-Agent")
+	renewed.AuthTime = claims.AuthAt().Unix()
+	return s.sign(renewed, s.refreshDeadline(claims))
+}
+
+// CanRenew 是否仍在最大刷新时间内
+func (s *DefaultJWTService) CanRenew(claims *Claims) bool {
+	if s.config().MaxRefresh <= 0 {
+		return true
+	}
+	return time.Since(claims.AuthAt()) < s.config().MaxRefresh
+}
+
+// refreshDeadline 最大刷新截止时间，未限制时返回零值
+func (s *DefaultJWTService) refreshDeadline(claims *Claims) time.Time {
+	if s.config().MaxRefresh <= 0 {
+		return time.Time{}
+	}
+	return claims.AuthAt().Add(s.config().MaxRefresh)
+}
+
+// sign 设置有效期并签名，deadline 非零时过期时间不超过 deadline
+func (s *DefaultJWTService) sign(claims Claims, deadline time.Time) (string, error) {
+	// 设置声明
+	now := time.Now()
+	expiresAt := now.Add(time.Hour * time.Duration(s.config().ExpireHours))
+	if !deadline.IsZero() && expiresAt.After(deadline) {
+		expiresAt = deadline
+	}
+
+	claims.RegisteredClaims = jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+		IssuedAt:  jwt.NewNumericDate(now),
+		NotBefore: jwt.NewNumericDate(now),
+		Issuer:    s.config().Issuer,
+		Audience:  s.config().Audience,
+	}
+
+	// 未配置密钥管理时沿用 HS512 共享密钥
+	if s.config().Keys == nil {
+		return jwt.NewWithClaims(jwt.SigningMethodHS512, claims).SignedString([]byte(s.config().Secret))
+	}
+
+	key, err := s.config().Keys.SigningKey()
+	if err != nil {
+		return "", err
+	}
+
+	// 创建令牌，头部携带 kid 以便验签时定位密钥
+	token := jwt.NewWithClaims(key.Method, claims)
+	token.Header["kid"] = key.Kid
 
 	// 使用密钥签名
-	return token.SignedString([]byte(s.config.Secret))
+	return token.SignedString(key.SignKey)
+}
+
+// keyFunc 根据令牌头部 kid 选择验签密钥
+// 未携带 kid 的旧令牌按 HS512 共享密钥校验；切换为非对称算法后仅在 LegacySecretUntil 之前接受
+func (s *DefaultJWTService) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" || s.config().Keys == nil {
+		// 验证签名方法
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok || s.config().Secret == "" {
+			return nil, ErrInvalidToken
+		}
+		if !s.acceptLegacy() {
+			return nil, ErrInvalidToken
+		}
+		return []byte(s.config().Secret), nil
+	}
+
+	key, err := s.config().Keys.VerificationKey(kid)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	// 签名方法必须与密钥一致，防止算法混淆
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, ErrInvalidToken
+	}
+	return key.VerifyKey, nil
+}
+
+// acceptLegacy 是否接受共享密钥签名的旧令牌
+// HS512 模式下共享密钥即签名密钥；非对称模式下超过截止时间后共享密钥不再可用于伪造令牌
+func (s *DefaultJWTService) acceptLegacy() bool {
+	if s.config().Keys == nil {
+		return true
+	}
+	if key, err := s.config().Keys.SigningKey(); err == nil && key.Method.Alg() == jwt.SigningMethodHS512.Alg() {
+		return true
+	}
+	return !s.config().LegacySecretUntil.IsZero() && time.Now().Before(s.config().LegacySecretUntil)
+}
+
+// Config 获取JWT配置
+func (s *DefaultJWTService) Config() TokenConfig {
+	return s.source()
+}
+
+func (s *DefaultJWTService) config() TokenConfig {
+	return s.source()
 }
 
 // ParseToken 解析 JWT 令牌并返回声明
@@ -99,13 +292,7 @@ func (s *DefaultJWTService) ParseToken(tokenString string) (*Claims, error) {
 	}
 
 	// 解析令牌
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		// 验证签名方法
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, ErrInvalidToken
-		}
-		return []byte(s.config.Secret), nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, s.keyFunc)
 
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
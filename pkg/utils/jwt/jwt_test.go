@@ -0,0 +1,146 @@
+/**
+ * Description：
+ * FileName：jwt_test.go
+ * Author：CJiaの用心
+ * Create：2025/11/1 11:40:18
+ * Remark：
+ */
+
+package jwt
+
+import (
+	"github.com/carefuly/careful-admin-go-gin/config"
+	domainSystem "github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/system"
+	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/jwt/keymanager"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestDefaultJWTService_RenewToken(t *testing.T) {
+	svc := NewJWTService(TokenConfig{
+		Secret:      "secret",
+		ExpireHours: 2,
+		MaxRefresh:  3 * time.Hour,
+	})
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest("GET", "/", nil)
+
+	user := domainSystem.User{User: system.User{CoreModels: models.CoreModels{Id: "1"}, Username: "admin"}}
+
+	testCases := []struct {
+		name       string
+		authTime   time.Time
+		wantErr    error
+		wantBefore time.Time
+	}{
+		{
+			name:       "续期后不超过最大刷新时间",
+			authTime:   time.Now().Add(-2 * time.Hour),
+			wantBefore: time.Now().Add(time.Hour + time.Minute),
+		},
+		{
+			name:     "超过最大刷新时间",
+			authTime: time.Now().Add(-4 * time.Hour),
+			wantErr:  ErrExpiredToken,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			token, err := svc.GenerateSessionToken(ctx, user, "sid", tc.authTime)
+			assert.NoError(t, err)
+			claims, err := svc.ParseToken(token)
+			assert.NoError(t, err)
+			assert.Equal(t, "sid", claims.SessionId)
+
+			renewed, err := svc.RenewToken(ctx, claims)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			renewedClaims, err := svc.ParseToken(renewed)
+			assert.NoError(t, err)
+			assert.Equal(t, claims.SessionId, renewedClaims.SessionId)
+			assert.Equal(t, claims.AuthTime, renewedClaims.AuthTime)
+			assert.True(t, renewedClaims.ExpiresAt.Time.Before(tc.wantBefore))
+		})
+	}
+}
+
+func TestDefaultJWTService_ParseToken_KeyRotation(t *testing.T) {
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest("GET", "/", nil)
+	user := domainSystem.User{User: system.User{CoreModels: models.CoreModels{Id: "1"}, Username: "admin"}}
+
+	for _, algorithm := range []string{keymanager.AlgorithmRS256, keymanager.AlgorithmEdDSA} {
+		t.Run(algorithm, func(t *testing.T) {
+			manager, err := keymanager.New(keymanager.Options{Algorithm: algorithm, KeyDir: t.TempDir()})
+			assert.NoError(t, err)
+			svc := NewJWTService(TokenConfig{Secret: "secret", ExpireHours: 2, Keys: manager})
+
+			oldToken, err := svc.GenerateSessionToken(ctx, user, "sid", time.Now())
+			assert.NoError(t, err)
+
+			// 轮换后旧令牌仍可验签，新令牌使用新密钥
+			_, err = manager.Rotate()
+			assert.NoError(t, err)
+			newToken, err := svc.GenerateSessionToken(ctx, user, "sid", time.Now())
+			assert.NoError(t, err)
+			for _, token := range []string{oldToken, newToken} {
+				claims, err := svc.ParseToken(token)
+				assert.NoError(t, err)
+				assert.Equal(t, "1", claims.UserId)
+			}
+
+			// 移除旧密钥后旧令牌失效
+			kids := manager.Kids()
+			assert.NoError(t, manager.Remove(kids[0]))
+			_, err = svc.ParseToken(oldToken)
+			assert.Equal(t, ErrInvalidToken, err)
+
+			// 未携带 kid 的 HS512 旧令牌默认拒绝，避免共享密钥继续用于伪造令牌
+			legacy, err := NewJWTService(TokenConfig{Secret: "secret", ExpireHours: 2}).GenerateSessionToken(ctx, user, "sid", time.Now())
+			assert.NoError(t, err)
+			_, err = svc.ParseToken(legacy)
+			assert.Equal(t, ErrInvalidToken, err)
+
+			// 截止时间之前按共享密钥校验
+			legacySvc := NewJWTService(TokenConfig{Secret: "secret", ExpireHours: 2, Keys: manager,
+				LegacySecretUntil: time.Now().Add(time.Hour)})
+			_, err = legacySvc.ParseToken(legacy)
+			assert.NoError(t, err)
+
+			// 超过截止时间后拒绝
+			expiredSvc := NewJWTService(TokenConfig{Secret: "secret", ExpireHours: 2, Keys: manager,
+				LegacySecretUntil: time.Now().Add(-time.Minute)})
+			_, err = expiredSvc.ParseToken(legacy)
+			assert.Equal(t, ErrInvalidToken, err)
+		})
+	}
+}
+
+func TestLiveTokenSource(t *testing.T) {
+	token := config.NewLive(config.Token{Secret: "secret", Expire: 2, MaxRefresh: 3})
+	svc := NewJWTServiceFromSource(LiveTokenSource(token))
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest("GET", "/", nil)
+	user := domainSystem.User{User: system.User{CoreModels: models.CoreModels{Id: "1"}, Username: "admin"}}
+
+	assert.Equal(t, 3*time.Hour, svc.Config().MaxRefresh)
+	assert.False(t, svc.Config().SingleSession)
+
+	// 配置变更后无需重建服务即按新配置签发
+	token.Store(config.Token{Secret: "secret", Expire: 5, MaxRefresh: 3, SingleSession: true})
+	assert.True(t, svc.Config().SingleSession)
+	signed, err := svc.GenerateSessionToken(ctx, user, "sid", time.Now())
+	assert.NoError(t, err)
+	claims, err := svc.ParseToken(signed)
+	assert.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(5*time.Hour), claims.ExpiresAt.Time, time.Minute)
+}
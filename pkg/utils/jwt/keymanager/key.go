@@ -0,0 +1,203 @@
+/**
+ * Description：
+ * FileName：key.go
+ * Author：CJiaの用心
+ * Create：2025/11/2 10:05:13
+ * Remark：
+ */
+
+package keymanager
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"github.com/golang-jwt/jwt/v5"
+	"math/big"
+	"strings"
+)
+
+const (
+	AlgorithmHS512 = "HS512" // HMAC-SHA512，对称密钥，不对外公开
+	AlgorithmRS256 = "RS256" // RSA-SHA256
+	AlgorithmEdDSA = "EdDSA" // Ed25519
+)
+
+var (
+	ErrUnsupportedAlgorithm = errors.New("不支持的签名算法")
+	ErrUnsupportedKey       = errors.New("不支持的密钥类型")
+)
+
+// Key 签名密钥
+type Key struct {
+	Kid        string            // 密钥ID，写入令牌头部 kid
+	Method     jwt.SigningMethod // 签名算法
+	SignKey    interface{}       // 签名用私钥(HMAC为共享密钥)
+	VerifyKey  interface{}       // 验签用公钥(HMAC为共享密钥)
+	Symmetric  bool              // 是否对称密钥，对称密钥不出现在 JWKS 中
+	privatePEM []byte
+}
+
+// NewHMACKey 创建 HMAC 密钥
+func NewHMACKey(kid string, secret []byte) *Key {
+	return &Key{
+		Kid:       kid,
+		Method:    jwt.SigningMethodHS512,
+		SignKey:   secret,
+		VerifyKey: secret,
+		Symmetric: true,
+	}
+}
+
+// NewRSAKey 创建 RSA 密钥
+func NewRSAKey(kid string, private *rsa.PrivateKey) *Key {
+	return &Key{
+		Kid:       kid,
+		Method:    jwt.SigningMethodRS256,
+		SignKey:   private,
+		VerifyKey: &private.PublicKey,
+	}
+}
+
+// NewEd25519Key 创建 Ed25519 密钥
+func NewEd25519Key(kid string, private ed25519.PrivateKey) *Key {
+	return &Key{
+		Kid:       kid,
+		Method:    jwt.SigningMethodEdDSA,
+		SignKey:   private,
+		VerifyKey: private.Public(),
+	}
+}
+
+// GenerateKey 按算法生成新的非对称密钥
+func GenerateKey(algorithm, kid string) (*Key, error) {
+	switch algorithm {
+	case AlgorithmRS256:
+		private, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			return nil, err
+		}
+		return NewRSAKey(kid, private), nil
+	case AlgorithmEdDSA:
+		_, private, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		return NewEd25519Key(kid, private), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, algorithm)
+	}
+}
+
+// ParsePrivateKeyPEM 解析 PEM 格式私钥(PKCS#8 或 PKCS#1)
+func ParsePrivateKeyPEM(kid string, data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("密钥[%s]不是有效的PEM格式", kid)
+	}
+
+	var private interface{}
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		private, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		private, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("解析密钥[%s]失败: %w", kid, err)
+	}
+
+	var key *Key
+	switch k := private.(type) {
+	case *rsa.PrivateKey:
+		key = NewRSAKey(kid, k)
+	case ed25519.PrivateKey:
+		key = NewEd25519Key(kid, k)
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedKey, private)
+	}
+	key.privatePEM = data
+	return key, nil
+}
+
+// MarshalPEM 将私钥编码为 PKCS#8 PEM
+func (k *Key) MarshalPEM() ([]byte, error) {
+	if k.Symmetric {
+		return nil, ErrUnsupportedKey
+	}
+	if k.privatePEM != nil {
+		return k.privatePEM, nil
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(k.SignKey)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+// JWK 公钥的 JWK 表示，对称密钥返回 false
+func (k *Key) JWK() (JWK, bool) {
+	jwk := JWK{
+		Kid: k.Kid,
+		Use: "sig",
+		Alg: k.Method.Alg(),
+	}
+	switch pub := k.VerifyKey.(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+	default:
+		return JWK{}, false
+	}
+	return jwk, true
+}
+
+// JWK JSON Web Key
+type JWK struct {
+	Kty string `json:"kty"`           // 密钥类型
+	Kid string `json:"kid"`           // 密钥ID
+	Use string `json:"use"`           // 用途
+	Alg string `json:"alg"`           // 算法
+	N   string `json:"n,omitempty"`   // RSA 模数
+	E   string `json:"e,omitempty"`   // RSA 指数
+	Crv string `json:"crv,omitempty"` // 曲线
+	X   string `json:"x,omitempty"`   // Ed25519 公钥
+}
+
+// JWKS JSON Web Key Set
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// SecretKid 根据共享密钥生成稳定的 kid，避免暴露密钥内容
+func SecretKid(secret []byte) string {
+	sum := sha256.Sum256(secret)
+	return "hs-" + hex.EncodeToString(sum[:4])
+}
+
+// NormalizeAlgorithm 规范化算法名称，空值默认 HS512
+func NormalizeAlgorithm(algorithm string) (string, error) {
+	switch strings.ToUpper(strings.TrimSpace(algorithm)) {
+	case "", "HS512":
+		return AlgorithmHS512, nil
+	case "RS256":
+		return AlgorithmRS256, nil
+	case "EDDSA", "ED25519":
+		return AlgorithmEdDSA, nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, algorithm)
+	}
+}
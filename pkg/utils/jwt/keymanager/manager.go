@@ -0,0 +1,366 @@
+/**
+ * Description：
+ * FileName：manager.go
+ * Author：CJiaの用心
+ * Create：2025/11/2 10:32:47
+ * Remark：
+ */
+
+package keymanager
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	keyFileExt     = ".pem"
+	activeKidFile  = "active"
+	keyFilePerm    = 0600
+	keyDirPerm     = 0700
+	kidTimeLayout  = "20060102150405"
+	defaultKeysDir = "./keys"
+)
+
+var (
+	ErrKeyNotFound  = errors.New("签名密钥不存在")
+	ErrNoActiveKey  = errors.New("未配置当前签名密钥")
+	ErrRemoveActive = errors.New("不能移除当前签名密钥")
+	ErrKeyDuplicate = errors.New("签名密钥ID已存在")
+)
+
+// KeyManager 签名密钥管理
+// 当前密钥用于签发令牌，所有已加载密钥均可用于验签，轮换后旧令牌在过期前仍然有效
+type KeyManager interface {
+	// SigningKey 当前签名密钥
+	SigningKey() (*Key, error)
+	// VerificationKey 按 kid 查找验签密钥
+	VerificationKey(kid string) (*Key, error)
+	// JWKS 公钥集合(不包含对称密钥)
+	JWKS() JWKS
+}
+
+// Rotator 支持查看与轮换的密钥管理，供管理接口使用
+type Rotator interface {
+	KeyManager
+	// Algorithm 签名算法
+	Algorithm() string
+	// Kids 已加载的密钥ID
+	Kids() []string
+	// Rotate 生成新密钥并设为当前签名密钥
+	Rotate() (*Key, error)
+}
+
+// Options 密钥管理配置
+type Options struct {
+	Algorithm string // 签名算法：HS512、RS256、EdDSA
+	Secret    string // HS512 共享密钥
+	KeyDir    string // 非对称密钥目录，每个 <kid>.pem 为一把私钥
+	ActiveKid string // 指定当前签名密钥，为空时读取目录下 active 文件或最新密钥
+}
+
+// Manager 基于内存与密钥目录的密钥管理实现
+type Manager struct {
+	mu        sync.RWMutex
+	algorithm string
+	keyDir    string
+	keys      map[string]*Key
+	active    string
+	now       func() time.Time
+}
+
+// New 创建密钥管理器
+// HS512 仅使用共享密钥；RS256/EdDSA 从密钥目录加载，目录为空时自动生成首把密钥
+func New(opts Options) (*Manager, error) {
+	algorithm, err := NormalizeAlgorithm(opts.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Manager{
+		algorithm: algorithm,
+		keyDir:    opts.KeyDir,
+		keys:      make(map[string]*Key),
+		now:       time.Now,
+	}
+
+	if algorithm == AlgorithmHS512 {
+		if opts.Secret == "" {
+			return nil, errors.New("HS512 签名密钥不能为空")
+		}
+		key := NewHMACKey(SecretKid([]byte(opts.Secret)), []byte(opts.Secret))
+		m.keys[key.Kid] = key
+		m.active = key.Kid
+		return m, nil
+	}
+
+	if m.keyDir == "" {
+		m.keyDir = defaultKeysDir
+	}
+	if err := m.Reload(); err != nil {
+		return nil, err
+	}
+	if len(m.keys) == 0 {
+		if _, err := m.Rotate(); err != nil {
+			return nil, err
+		}
+	}
+	if opts.ActiveKid != "" {
+		if err := m.Activate(opts.ActiveKid); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// Algorithm 签名算法
+func (m *Manager) Algorithm() string {
+	return m.algorithm
+}
+
+// SigningKey 当前签名密钥
+func (m *Manager) SigningKey() (*Key, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	key, ok := m.keys[m.active]
+	if !ok {
+		return nil, ErrNoActiveKey
+	}
+	return key, nil
+}
+
+// VerificationKey 按 kid 查找验签密钥
+func (m *Manager) VerificationKey(kid string) (*Key, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	key, ok := m.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrKeyNotFound, kid)
+	}
+	return key, nil
+}
+
+// Kids 已加载的密钥ID，按 kid 升序
+func (m *Manager) Kids() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	kids := make([]string, 0, len(m.keys))
+	for kid := range m.keys {
+		kids = append(kids, kid)
+	}
+	sort.Strings(kids)
+	return kids
+}
+
+// JWKS 公钥集合
+func (m *Manager) JWKS() JWKS {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	set := JWKS{Keys: make([]JWK, 0, len(m.keys))}
+	for _, key := range m.keys {
+		if jwk, ok := key.JWK(); ok {
+			set.Keys = append(set.Keys, jwk)
+		}
+	}
+	sort.Slice(set.Keys, func(i, j int) bool {
+		return set.Keys[i].Kid < set.Keys[j].Kid
+	})
+	return set
+}
+
+// Add 加入验签密钥，不改变当前签名密钥
+func (m *Manager) Add(key *Key) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.keys[key.Kid]; ok {
+		return fmt.Errorf("%w: %s", ErrKeyDuplicate, key.Kid)
+	}
+	m.keys[key.Kid] = key
+	if m.active == "" {
+		m.active = key.Kid
+	}
+	return nil
+}
+
+// Activate 切换当前签名密钥
+func (m *Manager) Activate(kid string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.keys[kid]; !ok {
+		return fmt.Errorf("%w: %s", ErrKeyNotFound, kid)
+	}
+	m.active = kid
+	return m.writeActive(kid)
+}
+
+// Remove 移除密钥，使用该密钥签发的令牌将无法通过验签
+func (m *Manager) Remove(kid string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if kid == m.active {
+		return ErrRemoveActive
+	}
+	if _, ok := m.keys[kid]; !ok {
+		return fmt.Errorf("%w: %s", ErrKeyNotFound, kid)
+	}
+	delete(m.keys, kid)
+	if m.keyDir != "" {
+		if err := os.Remove(filepath.Join(m.keyDir, kid+keyFileExt)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// Rotate 生成新密钥并设为当前签名密钥，旧密钥保留用于验签
+func (m *Manager) Rotate() (*Key, error) {
+	if m.algorithm == AlgorithmHS512 {
+		return nil, fmt.Errorf("%w: HS512 不支持自动轮换", ErrUnsupportedAlgorithm)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	kid := m.now().UTC().Format(kidTimeLayout)
+	for i := 1; ; i++ {
+		if _, ok := m.keys[kid]; !ok {
+			break
+		}
+		kid = fmt.Sprintf("%s-%d", m.now().UTC().Format(kidTimeLayout), i)
+	}
+
+	key, err := GenerateKey(m.algorithm, kid)
+	if err != nil {
+		return nil, err
+	}
+	if m.keyDir != "" {
+		data, err := key.MarshalPEM()
+		if err != nil {
+			return nil, err
+		}
+		if err := os.MkdirAll(m.keyDir, keyDirPerm); err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(filepath.Join(m.keyDir, kid+keyFileExt), data, keyFilePerm); err != nil {
+			return nil, err
+		}
+	}
+
+	m.keys[kid] = key
+	m.active = kid
+	if err := m.writeActive(kid); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// Reload 重新扫描密钥目录
+// 新增的密钥文件立即可用于验签，被删除的文件对应密钥不再可用(当前签名密钥除外)
+func (m *Manager) Reload() error {
+	if m.keyDir == "" || m.algorithm == AlgorithmHS512 {
+		return nil
+	}
+
+	entries, err := os.ReadDir(m.keyDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	keys := make(map[string]*Key)
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), keyFileExt) {
+			continue
+		}
+		kid := strings.TrimSuffix(entry.Name(), keyFileExt)
+		data, err := os.ReadFile(filepath.Join(m.keyDir, entry.Name()))
+		if err != nil {
+			return err
+		}
+		key, err := ParsePrivateKeyPEM(kid, data)
+		if err != nil {
+			return err
+		}
+		// 算法切换后仍保留旧算法密钥用于验签
+		keys[kid] = key
+	}
+
+	active := ""
+	if data, err := os.ReadFile(filepath.Join(m.keyDir, activeKidFile)); err == nil {
+		active = strings.TrimSpace(string(data))
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if current, ok := m.keys[m.active]; ok {
+		if _, exists := keys[m.active]; !exists {
+			keys[m.active] = current
+		}
+	}
+	if _, ok := keys[active]; !ok {
+		active = m.active
+	}
+	if _, ok := keys[active]; !ok {
+		active = latestKid(keys, m.algorithm)
+	}
+	m.keys = keys
+	m.active = active
+	return nil
+}
+
+// StartReload 定期重新扫描密钥目录，多实例部署时可通过共享目录同步轮换结果
+func (m *Manager) StartReload(interval time.Duration, onError func(error)) (stop func()) {
+	ticker := time.NewTicker(interval)
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				if err := m.Reload(); err != nil && onError != nil {
+					onError(err)
+				}
+			case <-done:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+	var once sync.Once
+	return func() {
+		once.Do(func() { close(done) })
+	}
+}
+
+// writeActive 持久化当前签名密钥ID，调用方需持有写锁
+func (m *Manager) writeActive(kid string) error {
+	if m.keyDir == "" || m.algorithm == AlgorithmHS512 {
+		return nil
+	}
+	if err := os.MkdirAll(m.keyDir, keyDirPerm); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(m.keyDir, activeKidFile), []byte(kid), keyFilePerm)
+}
+
+// latestKid 按 kid 倒序选出与配置算法一致的最新密钥
+func latestKid(keys map[string]*Key, algorithm string) string {
+	kids := make([]string, 0, len(keys))
+	for kid, key := range keys {
+		if key.Method.Alg() == algorithm {
+			kids = append(kids, kid)
+		}
+	}
+	if len(kids) == 0 {
+		return ""
+	}
+	sort.Strings(kids)
+	return kids[len(kids)-1]
+}
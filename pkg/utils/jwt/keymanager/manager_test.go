@@ -0,0 +1,114 @@
+/**
+ * Description：
+ * FileName：manager_test.go
+ * Author：CJiaの用心
+ * Create：2025/11/2 11:45:02
+ * Remark：
+ */
+
+package keymanager
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		name      string
+		opts      Options
+		wantErr   bool
+		wantAlg   string
+		wantJWKS  int
+		wantJWKTy string
+	}{
+		{
+			name:     "默认HS512不公开密钥",
+			opts:     Options{Secret: "secret"},
+			wantAlg:  AlgorithmHS512,
+			wantJWKS: 0,
+		},
+		{
+			name:    "HS512缺少共享密钥",
+			opts:    Options{Algorithm: "HS512"},
+			wantErr: true,
+		},
+		{
+			name:      "RS256自动生成密钥",
+			opts:      Options{Algorithm: "rs256"},
+			wantAlg:   AlgorithmRS256,
+			wantJWKS:  1,
+			wantJWKTy: "RSA",
+		},
+		{
+			name:      "EdDSA自动生成密钥",
+			opts:      Options{Algorithm: "EdDSA"},
+			wantAlg:   AlgorithmEdDSA,
+			wantJWKS:  1,
+			wantJWKTy: "OKP",
+		},
+		{
+			name:    "不支持的算法",
+			opts:    Options{Algorithm: "none"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.opts.KeyDir = t.TempDir()
+			m, err := New(tc.opts)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			key, err := m.SigningKey()
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantAlg, key.Method.Alg())
+
+			set := m.JWKS()
+			assert.Len(t, set.Keys, tc.wantJWKS)
+			if tc.wantJWKS > 0 {
+				assert.Equal(t, tc.wantJWKTy, set.Keys[0].Kty)
+				assert.Equal(t, key.Kid, set.Keys[0].Kid)
+			}
+		})
+	}
+}
+
+func TestManager_RotateAndReload(t *testing.T) {
+	dir := t.TempDir()
+	m, err := New(Options{Algorithm: AlgorithmEdDSA, KeyDir: dir})
+	assert.NoError(t, err)
+	first, _ := m.SigningKey()
+
+	m.now = func() time.Time { return time.Now().Add(time.Hour) }
+	second, err := m.Rotate()
+	assert.NoError(t, err)
+	assert.NotEqual(t, first.Kid, second.Kid)
+	assert.Len(t, m.JWKS().Keys, 2)
+	assert.Equal(t, ErrRemoveActive, m.Remove(second.Kid))
+
+	info, err := os.Stat(filepath.Join(dir, second.Kid+keyFileExt))
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(keyFilePerm), info.Mode().Perm())
+
+	// 另一实例从同一目录加载，得到相同的当前密钥与验签密钥
+	other, err := New(Options{Algorithm: AlgorithmEdDSA, KeyDir: dir})
+	assert.NoError(t, err)
+	active, _ := other.SigningKey()
+	assert.Equal(t, second.Kid, active.Kid)
+	_, err = other.VerificationKey(first.Kid)
+	assert.NoError(t, err)
+
+	// 删除旧密钥文件后重新扫描，旧密钥不再可用
+	assert.NoError(t, os.Remove(filepath.Join(dir, first.Kid+keyFileExt)))
+	assert.NoError(t, other.Reload())
+	_, err = other.VerificationKey(first.Kid)
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+}
@@ -0,0 +1,285 @@
+/**
+ * Description：
+ * FileName：refresh.go
+ * Author：CJiaの用心
+ * Create：2025/11/1 10:16:32
+ * Remark：
+ */
+
+package jwt
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"time"
+)
+
+const (
+	// RefreshTokenPrefix Redis中存储刷新令牌的前缀(以令牌摘要为键)
+	RefreshTokenPrefix = "token:refresh:"
+	// RefreshUsedPrefix Redis中存储已轮换刷新令牌的前缀，用于重放检测
+	RefreshUsedPrefix = "token:refresh:used:"
+	// TokenFamilyPrefix Redis中存储刷新令牌族的前缀
+	TokenFamilyPrefix = "token:family:"
+)
+
+var (
+	ErrRefreshTokenInvalid = errors.New("无效的刷新令牌")
+	ErrRefreshTokenReused  = errors.New("刷新令牌已被使用，会话已注销")
+	ErrRefreshExpired      = errors.New("已超过最大刷新时间，请重新登录")
+)
+
+// TokenFamily 刷新令牌族，一次登录对应一个令牌族，同时作为登录会话
+type TokenFamily struct {
+	SessionInfo
+	Id       string `json:"id"`       // 令牌族ID(会话ID)
+	AuthTime int64  `json:"authTime"` // 登录认证时间(秒级时间戳)
+	ExpireAt int64  `json:"expireAt"` // 会话过期时间(秒级时间戳)
+}
+
+// refreshRecord 刷新令牌记录
+type refreshRecord struct {
+	FamilyId string `json:"familyId"`
+	UserId   string `json:"userId"`
+}
+
+// RefreshTokenManager 刷新令牌管理：每次使用即轮换，重复使用将注销整个令牌族
+type RefreshTokenManager struct {
+	rdb    redis.Cmdable
+	source TokenSource
+	now    func() time.Time
+}
+
+// NewRefreshTokenManager 创建刷新令牌管理器
+func NewRefreshTokenManager(rdb redis.Cmdable, config TokenConfig) *RefreshTokenManager {
+	return NewRefreshTokenManagerFromSource(rdb, StaticTokenSource(config))
+}
+
+// NewRefreshTokenManagerFromSource 创建刷新令牌管理器，每次签发与轮换时读取最新配置
+func NewRefreshTokenManagerFromSource(rdb redis.Cmdable, source TokenSource) *RefreshTokenManager {
+	return &RefreshTokenManager{
+		rdb:    rdb,
+		source: source,
+		now:    time.Now,
+	}
+}
+
+func (m *RefreshTokenManager) config() TokenConfig {
+	return m.source()
+}
+
+// Issue 登录时创建令牌族(会话)并签发首个刷新令牌
+func (m *RefreshTokenManager) Issue(ctx context.Context, info SessionInfo) (TokenFamily, string, error) {
+	family := TokenFamily{
+		SessionInfo: info,
+		Id:          uuid.NewString(),
+		AuthTime:    m.now().Unix(),
+	}
+
+	if err := m.saveFamily(ctx, &family); err != nil {
+		return TokenFamily{}, "", err
+	}
+
+	token, err := m.issueToken(ctx, family)
+	if err != nil {
+		return TokenFamily{}, "", err
+	}
+	return family, token, nil
+}
+
+// Rotate 使用刷新令牌换取新的刷新令牌，旧令牌立即失效
+func (m *RefreshTokenManager) Rotate(ctx context.Context, token string) (TokenFamily, string, error) {
+	digest := m.digest(token)
+
+	data, err := m.rdb.Get(ctx, RefreshTokenPrefix+digest).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return TokenFamily{}, "", ErrRefreshTokenInvalid
+		}
+		return TokenFamily{}, "", err
+	}
+
+	var record refreshRecord
+	if err := json.Unmarshal([]byte(data), &record); err != nil {
+		return TokenFamily{}, "", ErrRefreshTokenInvalid
+	}
+
+	// 标记旧令牌已使用，标记失败说明令牌被重复使用(可能已泄露)，注销整个令牌族
+	first, err := m.rdb.SetNX(ctx, RefreshUsedPrefix+digest, record.FamilyId, m.refreshExpire()).Result()
+	if err != nil {
+		return TokenFamily{}, "", err
+	}
+	if !first {
+		if err := m.Revoke(ctx, record.FamilyId); err != nil {
+			return TokenFamily{}, "", err
+		}
+		return TokenFamily{}, "", ErrRefreshTokenReused
+	}
+
+	family, err := m.GetFamily(ctx, record.FamilyId)
+	if err != nil {
+		return TokenFamily{}, "", err
+	}
+
+	// 超过最大刷新时间
+	if m.config().MaxRefresh > 0 && m.now().Sub(time.Unix(family.AuthTime, 0)) >= m.config().MaxRefresh {
+		if err := m.Revoke(ctx, family.Id); err != nil {
+			return TokenFamily{}, "", err
+		}
+		return TokenFamily{}, "", ErrRefreshExpired
+	}
+
+	newToken, err := m.issueToken(ctx, family)
+	if err != nil {
+		return TokenFamily{}, "", err
+	}
+
+	// 未限制最大刷新时间时，令牌族随轮换顺延
+	if m.config().MaxRefresh <= 0 {
+		if err := m.saveFamily(ctx, &family); err != nil {
+			return TokenFamily{}, "", err
+		}
+	}
+	return family, newToken, nil
+}
+
+// GetFamily 获取令牌族，不存在表示已注销或已过期
+func (m *RefreshTokenManager) GetFamily(ctx context.Context, familyId string) (TokenFamily, error) {
+	data, err := m.rdb.Get(ctx, m.familyKey(familyId)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return TokenFamily{}, ErrRefreshTokenInvalid
+		}
+		return TokenFamily{}, err
+	}
+
+	var family TokenFamily
+	if err := json.Unmarshal([]byte(data), &family); err != nil {
+		return TokenFamily{}, ErrRefreshTokenInvalid
+	}
+	return family, nil
+}
+
+// IsActive 令牌族是否有效
+func (m *RefreshTokenManager) IsActive(ctx context.Context, familyId string) (bool, error) {
+	if familyId == "" {
+		return false, nil
+	}
+	n, err := m.rdb.Exists(ctx, m.familyKey(familyId)).Result()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// Revoke 注销令牌族，其下所有刷新令牌与访问令牌随之失效
+func (m *RefreshTokenManager) Revoke(ctx context.Context, familyId string) error {
+	if familyId == "" {
+		return nil
+	}
+
+	family, err := m.GetFamily(ctx, familyId)
+	if err != nil {
+		if errors.Is(err, ErrRefreshTokenInvalid) {
+			return nil
+		}
+		return err
+	}
+
+	pipe := m.rdb.TxPipeline()
+	pipe.Del(ctx, m.familyKey(familyId))
+	pipe.ZRem(ctx, m.userSessionsKey(family.UserId), familyId)
+	pipe.ZRem(ctx, m.usernameSessionsKey(family.Username), familyId)
+	pipe.ZRem(ctx, OnlineSessionsKey, familyId)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// saveFamily 保存令牌族并维护会话索引
+func (m *RefreshTokenManager) saveFamily(ctx context.Context, family *TokenFamily) error {
+	ttl := m.familyTTL(*family)
+	family.ExpireAt = m.now().Add(ttl).Unix()
+
+	data, err := json.Marshal(family)
+	if err != nil {
+		return err
+	}
+
+	member := redis.Z{Score: float64(family.ExpireAt), Member: family.Id}
+
+	pipe := m.rdb.TxPipeline()
+	pipe.Set(ctx, m.familyKey(family.Id), data, ttl)
+	// 用户会话索引的有效期取其下会话的最大值
+	for _, key := range []string{m.userSessionsKey(family.UserId), m.usernameSessionsKey(family.Username)} {
+		pipe.ZAdd(ctx, key, member)
+		pipe.ExpireNX(ctx, key, ttl)
+		pipe.ExpireGT(ctx, key, ttl)
+	}
+	pipe.ZAdd(ctx, OnlineSessionsKey, member)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// RefreshExpire 刷新令牌有效期
+func (m *RefreshTokenManager) RefreshExpire() time.Duration {
+	return m.refreshExpire()
+}
+
+// issueToken 签发刷新令牌，有效期不超过令牌族剩余时间
+func (m *RefreshTokenManager) issueToken(ctx context.Context, family TokenFamily) (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(buf)
+
+	data, err := json.Marshal(refreshRecord{FamilyId: family.Id, UserId: family.UserId})
+	if err != nil {
+		return "", err
+	}
+
+	ttl := min(m.refreshExpire(), m.familyTTL(family))
+	if ttl <= 0 {
+		return "", ErrRefreshExpired
+	}
+	if err := m.rdb.Set(ctx, RefreshTokenPrefix+m.digest(token), data, ttl).Err(); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// familyTTL 令牌族剩余有效期
+func (m *RefreshTokenManager) familyTTL(family TokenFamily) time.Duration {
+	if m.config().MaxRefresh <= 0 {
+		return m.refreshExpire()
+	}
+	return time.Unix(family.AuthTime, 0).Add(m.config().MaxRefresh).Sub(m.now())
+}
+
+func (m *RefreshTokenManager) refreshExpire() time.Duration {
+	if m.config().RefreshExpire > 0 {
+		return m.config().RefreshExpire
+	}
+	if m.config().MaxRefresh > 0 {
+		return m.config().MaxRefresh
+	}
+	return 24 * time.Hour
+}
+
+func (m *RefreshTokenManager) familyKey(familyId string) string {
+	return fmt.Sprintf("%s%s", TokenFamilyPrefix, familyId)
+}
+
+// digest Redis中仅保存令牌摘要，避免明文泄露
+func (m *RefreshTokenManager) digest(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
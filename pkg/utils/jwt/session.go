@@ -0,0 +1,169 @@
+/**
+ * Description：
+ * FileName：session.go
+ * Author：CJiaの用心
+ * Create：2025/11/1 14:22:07
+ * Remark：
+ */
+
+package jwt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+const (
+	// UserSessionsPrefix Redis中存储用户会话索引的前缀(按过期时间排序)
+	UserSessionsPrefix = "token:sessions:user:"
+	// UsernameSessionsPrefix Redis中按用户名存储会话索引的前缀(按过期时间排序)，供在线用户按用户名分页查询
+	UsernameSessionsPrefix = "token:sessions:username:"
+	// OnlineSessionsKey Redis中存储全部在线会话的索引(按过期时间排序)
+	OnlineSessionsKey = "token:sessions:online"
+)
+
+// SessionInfo 会话设备信息
+type SessionInfo struct {
+	UserId    string `json:"userId"`    // 用户ID
+	Username  string `json:"username"`  // 用户名
+	Ip        string `json:"ip"`        // 登录IP
+	Os        string `json:"os"`        // 操作系统
+	Browser   string `json:"browser"`   // 浏览器
+	UserAgent string `json:"userAgent"` // 设备信息
+}
+
+// ListSessions 获取用户全部有效会话，按登录时间倒序
+func (m *RefreshTokenManager) ListSessions(ctx context.Context, userId string) ([]TokenFamily, error) {
+	key := m.userSessionsKey(userId)
+	if err := m.pruneExpired(ctx, key); err != nil {
+		return nil, err
+	}
+
+	ids, err := m.rdb.ZRange(ctx, key, 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+	return m.loadFamilies(ctx, key, ids)
+}
+
+// ListOnline 分页获取全部在线会话，按过期时间倒序(即最近登录在前)，username 非空时按用户名过滤
+// 仅读取当前页的会话，不加载整个索引
+func (m *RefreshTokenManager) ListOnline(ctx context.Context, username string, page, pageSize int) ([]TokenFamily, int64, error) {
+	key := OnlineSessionsKey
+	if username != "" {
+		key = m.usernameSessionsKey(username)
+	}
+	if err := m.pruneExpired(ctx, key); err != nil {
+		return nil, 0, err
+	}
+
+	total, err := m.rdb.ZCard(ctx, key).Result()
+	if err != nil {
+		return nil, 0, err
+	}
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+	start := int64(page-1) * int64(pageSize)
+	if start >= total {
+		return []TokenFamily{}, total, nil
+	}
+
+	ids, err := m.rdb.ZRevRange(ctx, key, start, start+int64(pageSize)-1).Result()
+	if err != nil {
+		return nil, 0, err
+	}
+	families, err := m.loadFamilies(ctx, key, ids)
+	if err != nil {
+		return nil, 0, err
+	}
+	// 已失效的会话在读取时从索引中移除，总数随之扣减
+	return families, total - int64(len(ids)-len(families)), nil
+}
+
+// RevokeUser 注销用户的全部会话，exceptIds 中的会话保留
+func (m *RefreshTokenManager) RevokeUser(ctx context.Context, userId string, exceptIds ...string) (int, error) {
+	ids, err := m.rdb.ZRange(ctx, m.userSessionsKey(userId), 0, -1).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	keep := make(map[string]struct{}, len(exceptIds))
+	for _, id := range exceptIds {
+		keep[id] = struct{}{}
+	}
+
+	count := 0
+	for _, id := range ids {
+		if _, ok := keep[id]; ok {
+			continue
+		}
+		if err := m.Revoke(ctx, id); err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
+
+// loadFamilies 批量读取会话，清理索引中已失效的会话ID
+func (m *RefreshTokenManager) loadFamilies(ctx context.Context, indexKey string, ids []string) ([]TokenFamily, error) {
+	if len(ids) == 0 {
+		return []TokenFamily{}, nil
+	}
+
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = m.familyKey(id)
+	}
+	values, err := m.rdb.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	families := make([]TokenFamily, 0, len(ids))
+	var stale []interface{}
+	for i, v := range values {
+		data, ok := v.(string)
+		if !ok {
+			stale = append(stale, ids[i])
+			continue
+		}
+		var family TokenFamily
+		if err := json.Unmarshal([]byte(data), &family); err != nil {
+			stale = append(stale, ids[i])
+			continue
+		}
+		families = append(families, family)
+	}
+
+	if len(stale) > 0 {
+		if err := m.rdb.ZRem(ctx, indexKey, stale...).Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Slice(families, func(i, j int) bool {
+		return families[i].AuthTime > families[j].AuthTime
+	})
+	return families, nil
+}
+
+// pruneExpired 清理索引中已过期的会话
+func (m *RefreshTokenManager) pruneExpired(ctx context.Context, indexKey string) error {
+	return m.rdb.ZRemRangeByScore(ctx, indexKey, "-inf", strconv.FormatInt(m.now().Unix(), 10)).Err()
+}
+
+func (m *RefreshTokenManager) userSessionsKey(userId string) string {
+	return fmt.Sprintf("%s%s", UserSessionsPrefix, userId)
+}
+
+func (m *RefreshTokenManager) usernameSessionsKey(username string) string {
+	return fmt.Sprintf("%s%s", UsernameSessionsPrefix, username)
+}
@@ -0,0 +1,82 @@
+/**
+ * Description：
+ * FileName：sink.go
+ * Author：CJiaの用心
+ * Create：2025/10/31 10:20:44
+ * Remark：
+ */
+
+package logsink
+
+import (
+	"context"
+	"errors"
+	modelLogger "github.com/carefuly/careful-admin-go-gin/internal/model/careful/logger"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/batchwriter"
+)
+
+// LogSink 审计日志异步写入器，统一承接操作日志、登录日志与缓存日志
+type LogSink struct {
+	operate *batchwriter.Writer[*modelLogger.OperateLogger]
+	login   *batchwriter.Writer[*modelLogger.LoginLogger]
+	cache   *batchwriter.Writer[*modelLogger.CacheLogger]
+}
+
+// Flushers 三类日志的批量落库函数，由 ioc 层注入具体存储实现
+type Flushers struct {
+	Operate batchwriter.FlushFunc[*modelLogger.OperateLogger]
+	Login   batchwriter.FlushFunc[*modelLogger.LoginLogger]
+	Cache   batchwriter.FlushFunc[*modelLogger.CacheLogger]
+}
+
+// NewLogSink 创建审计日志写入器，三类日志各自拥有独立的队列与协程
+func NewLogSink(flushers Flushers, cfg batchwriter.Config) *LogSink {
+	operateCfg := cfg
+	operateCfg.Name = "操作日志"
+	loginCfg := cfg
+	loginCfg.Name = "登录日志"
+	cacheCfg := cfg
+	cacheCfg.Name = "缓存日志"
+
+	return &LogSink{
+		operate: batchwriter.New(operateCfg, flushers.Operate),
+		login:   batchwriter.New(loginCfg, flushers.Login),
+		cache:   batchwriter.New(cacheCfg, flushers.Cache),
+	}
+}
+
+// WriteOperate 写入操作日志
+func (s *LogSink) WriteOperate(entity *modelLogger.OperateLogger) bool {
+	if s == nil || entity == nil {
+		return false
+	}
+	return s.operate.Write(entity)
+}
+
+// WriteLogin 写入登录日志
+func (s *LogSink) WriteLogin(entity *modelLogger.LoginLogger) bool {
+	if s == nil || entity == nil {
+		return false
+	}
+	return s.login.Write(entity)
+}
+
+// WriteCache 写入缓存日志
+func (s *LogSink) WriteCache(entity *modelLogger.CacheLogger) bool {
+	if s == nil || entity == nil {
+		return false
+	}
+	return s.cache.Write(entity)
+}
+
+// Close 停止接收日志并刷新剩余数据，用于服务优雅关闭
+func (s *LogSink) Close(ctx context.Context) error {
+	if s == nil {
+		return nil
+	}
+	return errors.Join(
+		s.operate.Close(ctx),
+		s.login.Close(ctx),
+		s.cache.Close(ctx),
+	)
+}
@@ -0,0 +1,292 @@
+/**
+ * Description：
+ * FileName：migrate.go
+ * Author：CJiaの用心
+ * Create：2025/11/14 16:35:18
+ * Remark：
+ */
+
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gorm.io/gorm"
+	"sort"
+	"strings"
+	"time"
+)
+
+var (
+	ErrLockTimeout = errors.New("获取迁移锁超时，可能有其他实例正在执行迁移")
+	ErrNoDown      = errors.New("迁移未提供回滚脚本")
+)
+
+// createTableSQL 迁移版本表，dirty 标记执行中断的版本
+// MySQL 的 DDL 会隐式提交，迁移无法整体回滚，中断后需人工修复
+const createTableSQL = "CREATE TABLE IF NOT EXISTS `%s` (" +
+	"`version` bigint NOT NULL COMMENT '版本号'," +
+	"`name` varchar(255) NOT NULL DEFAULT '' COMMENT '迁移名称'," +
+	"`dirty` boolean NOT NULL DEFAULT false COMMENT '是否执行中断'," +
+	"`applied_at` datetime(3) NULL COMMENT '执行时间'," +
+	"PRIMARY KEY (`version`)" +
+	") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='数据库迁移版本表'"
+
+// Config 迁移配置
+type Config struct {
+	Table       string        // 迁移版本表 (默认: schema_migrations)
+	LockName    string        // 迁移锁名称，实际锁名追加当前库名 (默认: schema_migrations)
+	LockTimeout time.Duration // 等待迁移锁的最长时间 (默认: 5分钟)
+}
+
+// DefaultConfig 默认配置
+func DefaultConfig() Config {
+	return Config{
+		Table:       "schema_migrations",
+		LockName:    "schema_migrations",
+		LockTimeout: 5 * time.Minute,
+	}
+}
+
+// Record 已执行的迁移版本
+type Record struct {
+	Version   int64      `gorm:"column:version;primaryKey;autoIncrement:false"`
+	Name      string     `gorm:"column:name"`
+	Dirty     bool       `gorm:"column:dirty"`
+	AppliedAt *time.Time `gorm:"column:applied_at"`
+}
+
+// Status 迁移版本状态
+type Status struct {
+	Version   int64
+	Name      string
+	Applied   bool       // 已执行
+	Dirty     bool       // 执行中断
+	Missing   bool       // 已执行但当前程序中不存在对应的迁移文件
+	AppliedAt *time.Time // 执行时间
+}
+
+// Migrator 版本化迁移执行器
+// 升级与回滚期间持有 MySQL 命名锁(GET_LOCK)，多个实例同时启动时串行执行，后获取锁的实例只会看到已完成的版本
+type Migrator struct {
+	db         *gorm.DB
+	cfg        Config
+	migrations []Migration
+}
+
+// New 创建迁移执行器，migrations 需按版本号升序排列
+func New(db *gorm.DB, migrations []Migration, cfg Config) *Migrator {
+	def := DefaultConfig()
+	if cfg.Table == "" {
+		cfg.Table = def.Table
+	}
+	if cfg.LockName == "" {
+		cfg.LockName = def.LockName
+	}
+	if cfg.LockTimeout <= 0 {
+		cfg.LockTimeout = def.LockTimeout
+	}
+	return &Migrator{
+		db:         db,
+		cfg:        cfg,
+		migrations: migrations,
+	}
+}
+
+// Up 按版本号升序执行未执行的迁移，steps <= 0 时执行全部，返回本次执行的迁移
+func (m *Migrator) Up(ctx context.Context, steps int) ([]Migration, error) {
+	var executed []Migration
+	err := m.withLock(ctx, func(conn *gorm.DB) error {
+		records, err := m.records(conn)
+		if err != nil {
+			return err
+		}
+
+		applied := make(map[int64]bool, len(records))
+		for _, record := range records {
+			applied[record.Version] = true
+		}
+		for _, migration := range m.migrations {
+			if applied[migration.Version] {
+				continue
+			}
+			if steps > 0 && len(executed) >= steps {
+				break
+			}
+			if err := m.apply(conn, migration); err != nil {
+				return err
+			}
+			executed = append(executed, migration)
+		}
+		return nil
+	})
+	return executed, err
+}
+
+// Down 按版本号降序回滚已执行的迁移，steps <= 0 时回滚一个版本，返回本次回滚的迁移
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	if steps <= 0 {
+		steps = 1
+	}
+
+	var reverted []Migration
+	err := m.withLock(ctx, func(conn *gorm.DB) error {
+		records, err := m.records(conn)
+		if err != nil {
+			return err
+		}
+
+		known := make(map[int64]Migration, len(m.migrations))
+		for _, migration := range m.migrations {
+			known[migration.Version] = migration
+		}
+		for i := len(records) - 1; i >= 0 && len(reverted) < steps; i-- {
+			migration, ok := known[records[i].Version]
+			if !ok {
+				return fmt.Errorf("版本 %d 的迁移文件不存在，无法回滚", records[i].Version)
+			}
+			if err := m.revert(conn, migration); err != nil {
+				return err
+			}
+			reverted = append(reverted, migration)
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+// Status 全部迁移版本的执行状态，按版本号升序排列
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	conn := m.db.WithContext(ctx)
+	if err := m.ensureTable(conn); err != nil {
+		return nil, err
+	}
+	var records []Record
+	if err := conn.Table(m.cfg.Table).Order("version").Find(&records).Error; err != nil {
+		return nil, err
+	}
+
+	index := make(map[int64]Record, len(records))
+	for _, record := range records {
+		index[record.Version] = record
+	}
+
+	list := make([]Status, 0, len(m.migrations)+len(records))
+	for _, migration := range m.migrations {
+		status := Status{Version: migration.Version, Name: migration.Name}
+		if record, ok := index[migration.Version]; ok {
+			status.Applied = true
+			status.Dirty = record.Dirty
+			status.AppliedAt = record.AppliedAt
+			delete(index, migration.Version)
+		}
+		list = append(list, status)
+	}
+	for _, record := range index {
+		list = append(list, Status{
+			Version:   record.Version,
+			Name:      record.Name,
+			Applied:   true,
+			Dirty:     record.Dirty,
+			Missing:   true,
+			AppliedAt: record.AppliedAt,
+		})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Version < list[j].Version
+	})
+	return list, nil
+}
+
+// withLock 在同一连接上持有命名锁执行迁移，命名锁与连接绑定，连接断开时自动释放
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *gorm.DB) error) error {
+	return m.db.WithContext(ctx).Connection(func(conn *gorm.DB) error {
+		// Connection 传入的实例未开启新会话，链式调用会共用语句状态
+		conn = conn.Session(&gorm.Session{NewDB: true})
+
+		var acquired int
+		err := conn.Raw("SELECT COALESCE(GET_LOCK(CONCAT(DATABASE(), '.', ?), ?), 0)",
+			m.cfg.LockName, int(m.cfg.LockTimeout/time.Second)).Scan(&acquired).Error
+		if err != nil {
+			return fmt.Errorf("获取迁移锁失败: %w", err)
+		}
+		if acquired != 1 {
+			return ErrLockTimeout
+		}
+		defer conn.Exec("SELECT RELEASE_LOCK(CONCAT(DATABASE(), '.', ?))", m.cfg.LockName)
+
+		if err := m.ensureTable(conn); err != nil {
+			return err
+		}
+		return fn(conn)
+	})
+}
+
+// ensureTable 创建迁移版本表
+func (m *Migrator) ensureTable(conn *gorm.DB) error {
+	if err := conn.Exec(fmt.Sprintf(createTableSQL, m.cfg.Table)).Error; err != nil {
+		return fmt.Errorf("创建迁移版本表失败: %w", err)
+	}
+	return nil
+}
+
+// records 已执行的迁移版本，存在执行中断的版本时返回错误
+func (m *Migrator) records(conn *gorm.DB) ([]Record, error) {
+	var records []Record
+	if err := conn.Table(m.cfg.Table).Order("version").Find(&records).Error; err != nil {
+		return nil, err
+	}
+	for _, record := range records {
+		if record.Dirty {
+			return nil, fmt.Errorf("版本 %d 迁移执行中断，请人工修复数据库后删除 %s 表中该版本的记录",
+				record.Version, m.cfg.Table)
+		}
+	}
+	return records, nil
+}
+
+// apply 执行升级脚本，执行前写入 dirty 记录，全部语句成功后清除
+func (m *Migrator) apply(conn *gorm.DB, migration Migration) error {
+	record := Record{Version: migration.Version, Name: migration.Name, Dirty: true}
+	if err := conn.Table(m.cfg.Table).Create(&record).Error; err != nil {
+		return fmt.Errorf("写入迁移版本 %d 失败: %w", migration.Version, err)
+	}
+	if err := execScript(conn, migration.Up); err != nil {
+		return fmt.Errorf("执行迁移 %d_%s 失败: %w", migration.Version, migration.Name, err)
+	}
+	err := conn.Table(m.cfg.Table).Where("version = ?", migration.Version).
+		Updates(map[string]any{"dirty": false, "applied_at": time.Now()}).Error
+	if err != nil {
+		return fmt.Errorf("更新迁移版本 %d 失败: %w", migration.Version, err)
+	}
+	return nil
+}
+
+// revert 执行回滚脚本，执行前标记 dirty，全部语句成功后删除版本记录
+func (m *Migrator) revert(conn *gorm.DB, migration Migration) error {
+	if strings.TrimSpace(migration.Down) == "" {
+		return fmt.Errorf("版本 %d: %w", migration.Version, ErrNoDown)
+	}
+	err := conn.Table(m.cfg.Table).Where("version = ?", migration.Version).Update("dirty", true).Error
+	if err != nil {
+		return fmt.Errorf("更新迁移版本 %d 失败: %w", migration.Version, err)
+	}
+	if err := execScript(conn, migration.Down); err != nil {
+		return fmt.Errorf("回滚迁移 %d_%s 失败: %w", migration.Version, migration.Name, err)
+	}
+	if err := conn.Table(m.cfg.Table).Where("version = ?", migration.Version).Delete(&Record{}).Error; err != nil {
+		return fmt.Errorf("删除迁移版本 %d 失败: %w", migration.Version, err)
+	}
+	return nil
+}
+
+// execScript 逐条执行脚本中的语句
+func execScript(conn *gorm.DB, script string) error {
+	for _, statement := range Split(script) {
+		if err := conn.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
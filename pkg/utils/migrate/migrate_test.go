@@ -0,0 +1,206 @@
+/**
+ * Description：
+ * FileName：migrate_test.go
+ * Author：CJiaの用心
+ * Create：2025/11/14 17:46:21
+ * Remark：
+ */
+
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeConn 模拟迁移锁与迁移版本表查询，并记录执行的语句
+type fakeConn struct {
+	locked  int64
+	records []Record
+	execs   []string
+}
+
+func (c *fakeConn) Connect(context.Context) (driver.Conn, error) { return c, nil }
+func (c *fakeConn) Driver() driver.Driver                        { return nil }
+func (c *fakeConn) Prepare(q string) (driver.Stmt, error)        { return &fakeStmt{conn: c, query: q}, nil }
+func (c *fakeConn) Close() error                                 { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)                    { return c, nil }
+func (c *fakeConn) Commit() error                                { return nil }
+func (c *fakeConn) Rollback() error                              { return nil }
+
+// statements 执行的迁移脚本语句，不含迁移版本表与迁移锁的语句
+func (c *fakeConn) statements() []string {
+	var statements []string
+	for _, exec := range c.execs {
+		if !strings.Contains(exec, "schema_migrations") && !strings.Contains(exec, "RELEASE_LOCK") {
+			statements = append(statements, exec)
+		}
+	}
+	return statements
+}
+
+type fakeStmt struct {
+	conn  *fakeConn
+	query string
+}
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec([]driver.Value) (driver.Result, error) {
+	s.conn.execs = append(s.conn.execs, s.query)
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	if strings.Contains(s.query, "GET_LOCK") {
+		return &fakeRows{columns: []string{"locked"}, values: [][]driver.Value{{s.conn.locked}}}, nil
+	}
+
+	rows := &fakeRows{columns: []string{"version", "name", "dirty", "applied_at"}}
+	for _, record := range s.conn.records {
+		var appliedAt driver.Value
+		if record.AppliedAt != nil {
+			appliedAt = *record.AppliedAt
+		}
+		rows.values = append(rows.values, []driver.Value{record.Version, record.Name, record.Dirty, appliedAt})
+	}
+	return rows, nil
+}
+
+type fakeRows struct {
+	columns []string
+	values  [][]driver.Value
+	index   int
+}
+
+func (r *fakeRows) Columns() []string { return r.columns }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.index >= len(r.values) {
+		return io.EOF
+	}
+	copy(dest, r.values[r.index])
+	r.index++
+	return nil
+}
+
+func newTestMigrator(t *testing.T, conn *fakeConn) *Migrator {
+	db, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      sql.OpenDB(conn),
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{DisableAutomaticPing: true})
+	require.NoError(t, err)
+
+	return New(db, []Migration{
+		{Version: 1, Name: "create_a", Up: "CREATE TABLE a (id int)", Down: "DROP TABLE a"},
+		{Version: 2, Name: "create_b", Up: "CREATE TABLE b (id int);\nCREATE INDEX idx_b ON b (id);", Down: "DROP TABLE b"},
+		{Version: 3, Name: "seed_b", Up: "INSERT INTO b VALUES (1)"},
+	}, Config{})
+}
+
+func appliedRecord(version int64, name string) Record {
+	appliedAt := time.Date(2025, 11, 14, 10, 0, 0, 0, time.Local)
+	return Record{Version: version, Name: name, AppliedAt: &appliedAt}
+}
+
+func TestMigrator_Up(t *testing.T) {
+	conn := &fakeConn{locked: 1, records: []Record{appliedRecord(1, "create_a")}}
+	migrator := newTestMigrator(t, conn)
+
+	executed, err := migrator.Up(context.Background(), 0)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2, 3}, versionsOf(executed))
+	assert.Equal(t, []string{
+		"CREATE TABLE b (id int)",
+		"CREATE INDEX idx_b ON b (id)",
+		"INSERT INTO b VALUES (1)",
+	}, conn.statements())
+	assert.Contains(t, conn.execs[len(conn.execs)-1], "RELEASE_LOCK")
+}
+
+func TestMigrator_UpSteps(t *testing.T) {
+	conn := &fakeConn{locked: 1}
+	migrator := newTestMigrator(t, conn)
+
+	executed, err := migrator.Up(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1}, versionsOf(executed))
+	assert.Equal(t, []string{"CREATE TABLE a (id int)"}, conn.statements())
+}
+
+func TestMigrator_UpDirty(t *testing.T) {
+	dirty := appliedRecord(2, "create_b")
+	dirty.Dirty = true
+	conn := &fakeConn{locked: 1, records: []Record{appliedRecord(1, "create_a"), dirty}}
+	migrator := newTestMigrator(t, conn)
+
+	executed, err := migrator.Up(context.Background(), 0)
+	assert.ErrorContains(t, err, "版本 2 迁移执行中断")
+	assert.Empty(t, executed)
+	assert.Empty(t, conn.statements())
+}
+
+func TestMigrator_UpLockTimeout(t *testing.T) {
+	conn := &fakeConn{locked: 0}
+	migrator := newTestMigrator(t, conn)
+
+	_, err := migrator.Up(context.Background(), 0)
+	assert.ErrorIs(t, err, ErrLockTimeout)
+	assert.Empty(t, conn.execs)
+}
+
+func TestMigrator_Down(t *testing.T) {
+	conn := &fakeConn{locked: 1, records: []Record{appliedRecord(1, "create_a"), appliedRecord(2, "create_b")}}
+	migrator := newTestMigrator(t, conn)
+
+	reverted, err := migrator.Down(context.Background(), 0)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2}, versionsOf(reverted))
+	assert.Equal(t, []string{"DROP TABLE b"}, conn.statements())
+}
+
+func TestMigrator_DownWithoutScript(t *testing.T) {
+	conn := &fakeConn{locked: 1, records: []Record{
+		appliedRecord(1, "create_a"), appliedRecord(2, "create_b"), appliedRecord(3, "seed_b"),
+	}}
+	migrator := newTestMigrator(t, conn)
+
+	reverted, err := migrator.Down(context.Background(), 2)
+	assert.ErrorIs(t, err, ErrNoDown)
+	assert.Empty(t, reverted)
+	assert.Empty(t, conn.statements())
+}
+
+func TestMigrator_Status(t *testing.T) {
+	conn := &fakeConn{records: []Record{appliedRecord(1, "create_a"), appliedRecord(9, "removed")}}
+	migrator := newTestMigrator(t, conn)
+
+	list, err := migrator.Status(context.Background())
+	require.NoError(t, err)
+	require.Len(t, list, 4)
+	assert.True(t, list[0].Applied)
+	assert.NotNil(t, list[0].AppliedAt)
+	assert.False(t, list[1].Applied)
+	assert.False(t, list[2].Applied)
+	assert.Equal(t, int64(9), list[3].Version)
+	assert.True(t, list[3].Missing)
+}
+
+func versionsOf(list []Migration) []int64 {
+	versions := make([]int64, 0, len(list))
+	for _, migration := range list {
+		versions = append(versions, migration.Version)
+	}
+	return versions
+}
@@ -0,0 +1,138 @@
+/**
+ * Description：
+ * FileName：source.go
+ * Author：CJiaの用心
+ * Create：2025/11/14 16:12:40
+ * Remark：
+ */
+
+package migrate
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidName = errors.New("迁移名称只能包含字母、数字和下划线")
+)
+
+// VersionLayout 迁移版本号格式，以创建时间作为版本号，避免多人并行开发时版本冲突
+const VersionLayout = "20060102150405"
+
+// fileNamePattern 迁移文件名格式：<版本号>_<名称>.up.sql / <版本号>_<名称>.down.sql
+var fileNamePattern = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// namePattern 迁移名称格式
+var namePattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// Migration 单个版本的迁移，Up 升级脚本必填，Down 回滚脚本可为空(不可回滚)
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Load 读取目录下的迁移文件并按版本号升序排列
+func Load(fsys fs.FS) ([]Migration, error) {
+	files, err := fs.Glob(fsys, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[int64]*Migration)
+	for _, file := range files {
+		matches := fileNamePattern.FindStringSubmatch(file)
+		if matches == nil {
+			return nil, fmt.Errorf("迁移文件名格式错误: %s", file)
+		}
+		version, err := strconv.ParseInt(matches[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("迁移文件版本号错误: %s", file)
+		}
+		content, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := index[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: matches[2]}
+			index[version] = migration
+		} else if migration.Name != matches[2] {
+			return nil, fmt.Errorf("迁移版本号重复: %d", version)
+		}
+		if matches[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(index))
+	for _, migration := range index {
+		if strings.TrimSpace(migration.Up) == "" {
+			return nil, fmt.Errorf("版本 %d 缺少升级脚本", migration.Version)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// Create 在目录下创建一对空的升级、回滚迁移文件，返回文件路径
+func Create(dir, name string, now time.Time) (string, string, error) {
+	name = normalizeName(name)
+	if name == "" {
+		return "", "", ErrInvalidName
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", "", err
+	}
+
+	base := now.Format(VersionLayout) + "_" + name
+	upPath := filepath.Join(dir, base+".up.sql")
+	downPath := filepath.Join(dir, base+".down.sql")
+	if err := writeNewFile(upPath, fmt.Sprintf("-- %s 升级\n", name)); err != nil {
+		return "", "", err
+	}
+	if err := writeNewFile(downPath, fmt.Sprintf("-- %s 回滚\n", name)); err != nil {
+		_ = os.Remove(upPath)
+		return "", "", err
+	}
+	return upPath, downPath, nil
+}
+
+// normalizeName 名称统一为小写下划线格式，包含其他字符时返回空
+func normalizeName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	name = strings.NewReplacer("-", "_", " ", "_").Replace(name)
+	if !namePattern.MatchString(name) {
+		return ""
+	}
+	return strings.Trim(name, "_")
+}
+
+// writeNewFile 写入新文件，文件已存在时返回错误
+func writeNewFile(path, content string) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := file.WriteString(content); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
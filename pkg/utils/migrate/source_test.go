@@ -0,0 +1,108 @@
+/**
+ * Description：
+ * FileName：source_test.go
+ * Author：CJiaの用心
+ * Create：2025/11/14 17:32:50
+ * Remark：
+ */
+
+package migrate
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+func TestLoad(t *testing.T) {
+	testCases := []struct {
+		name    string
+		fsys    fstest.MapFS
+		want    []Migration
+		wantErr string
+	}{
+		{
+			name: "按版本号排序",
+			fsys: fstest.MapFS{
+				"20251114120000_add_b.up.sql":       {Data: []byte("CREATE TABLE b")},
+				"20251114120000_add_b.down.sql":     {Data: []byte("DROP TABLE b")},
+				"20251101090000_init_schema.up.sql": {Data: []byte("CREATE TABLE a")},
+			},
+			want: []Migration{
+				{Version: 20251101090000, Name: "init_schema", Up: "CREATE TABLE a"},
+				{Version: 20251114120000, Name: "add_b", Up: "CREATE TABLE b", Down: "DROP TABLE b"},
+			},
+		},
+		{
+			name:    "文件名格式错误",
+			fsys:    fstest.MapFS{"init.sql": {Data: []byte("CREATE TABLE a")}},
+			wantErr: "迁移文件名格式错误: init.sql",
+		},
+		{
+			name: "版本号重复",
+			fsys: fstest.MapFS{
+				"1_add_a.up.sql": {Data: []byte("CREATE TABLE a")},
+				"1_add_b.up.sql": {Data: []byte("CREATE TABLE b")},
+			},
+			wantErr: "迁移版本号重复: 1",
+		},
+		{
+			name:    "缺少升级脚本",
+			fsys:    fstest.MapFS{"1_add_a.down.sql": {Data: []byte("DROP TABLE a")}},
+			wantErr: "版本 1 缺少升级脚本",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Load(tc.fsys)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Date(2025, 11, 14, 17, 30, 0, 0, time.Local)
+
+	upPath, downPath, err := Create(dir, "Add-User Email", now)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "20251114173000_add_user_email.up.sql"), upPath)
+	assert.Equal(t, filepath.Join(dir, "20251114173000_add_user_email.down.sql"), downPath)
+
+	migrations, err := Load(os.DirFS(dir))
+	require.NoError(t, err)
+	require.Len(t, migrations, 1)
+	assert.Equal(t, "add_user_email", migrations[0].Name)
+
+	// 同一版本号的文件已存在
+	_, _, err = Create(dir, "add_user_email", now)
+	assert.ErrorIs(t, err, os.ErrExist)
+
+	_, _, err = Create(dir, "新增字段", now)
+	assert.ErrorIs(t, err, ErrInvalidName)
+}
+
+func TestSplit(t *testing.T) {
+	script := "-- 创建表\n" +
+		"CREATE TABLE `a` (`id` int COMMENT '主键;ID') COMMENT='表;a';\n" +
+		"/* 多行\n注释; */\n" +
+		"INSERT INTO a VALUES (1); # 行尾注释\n" +
+		"INSERT INTO a VALUES ('it\\'s; ok');\n" +
+		"--\n;;"
+
+	assert.Equal(t, []string{
+		"CREATE TABLE `a` (`id` int COMMENT '主键;ID') COMMENT='表;a'",
+		"INSERT INTO a VALUES (1)",
+		"INSERT INTO a VALUES ('it\\'s; ok')",
+	}, Split(script))
+}
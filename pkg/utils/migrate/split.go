@@ -0,0 +1,81 @@
+/**
+ * Description：
+ * FileName：split.go
+ * Author：CJiaの用心
+ * Create：2025/11/14 16:20:05
+ * Remark：
+ */
+
+package migrate
+
+import (
+	"strings"
+)
+
+// Split 按分号拆分迁移脚本中的语句，忽略引号内的分号并去除注释
+// 数据库连接默认不开启 multiStatements，需逐条执行；不支持 DELIMITER 定义的存储过程
+func Split(script string) []string {
+	var (
+		statements []string
+		current    strings.Builder
+		quote      byte
+	)
+	flush := func() {
+		if statement := strings.TrimSpace(current.String()); statement != "" {
+			statements = append(statements, statement)
+		}
+		current.Reset()
+	}
+
+	for i := 0; i < len(script); i++ {
+		ch := script[i]
+
+		// 引号内原样保留，反斜杠转义下一个字符
+		if quote != 0 {
+			current.WriteByte(ch)
+			if ch == '\\' && quote != '`' && i+1 < len(script) {
+				i++
+				current.WriteByte(script[i])
+			} else if ch == quote {
+				quote = 0
+			}
+			continue
+		}
+
+		switch {
+		case ch == '\'' || ch == '"' || ch == '`':
+			quote = ch
+			current.WriteByte(ch)
+		case ch == '#' || isLineComment(script[i:]):
+			// 单行注释
+			for i < len(script) && script[i] != '\n' {
+				i++
+			}
+			current.WriteByte('\n')
+		case ch == '/' && strings.HasPrefix(script[i:], "/*"):
+			// 多行注释
+			end := strings.Index(script[i+2:], "*/")
+			if end < 0 {
+				i = len(script)
+			} else {
+				i += end + 3
+			}
+			current.WriteByte(' ')
+		case ch == ';':
+			flush()
+		default:
+			current.WriteByte(ch)
+		}
+	}
+	flush()
+
+	return statements
+}
+
+// isLineComment 以 "--" 加空白字符开头的单行注释
+func isLineComment(s string) bool {
+	if !strings.HasPrefix(s, "--") {
+		return false
+	}
+	return len(s) == 2 || strings.ContainsRune(" \t\r\n", rune(s[2]))
+}
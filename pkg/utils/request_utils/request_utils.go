@@ -14,10 +14,10 @@ import (
 	"github.com/carefuly/careful-admin-go-gin/internal/domain/careful/system"
 	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/logger"
 	"github.com/carefuly/careful-admin-go-gin/pkg/models"
+	"github.com/carefuly/careful-admin-go-gin/pkg/utils/logsink"
 	"github.com/gin-gonic/gin"
 	"github.com/mssola/user_agent"
 	"go.uber.org/zap"
-	"gorm.io/gorm"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -33,7 +33,7 @@ func GetRequestUser(c *gin.Context) string {
 	return username.(string)
 }
 
-// NormalizeIP IPv6 本地地址处理
+// NormalizeIP IPv6 本地地址处理，客户端地址仅在请求来自可信代理时取自 X-Forwarded-For
 func NormalizeIP(c *gin.Context) string {
 	ip := c.ClientIP()
 
@@ -141,19 +141,39 @@ func GetOS(ua string) string {
 	return parsed.OS()
 }
 
-// SaveLoginLog 保存登录日志
-func SaveLoginLog(c *gin.Context, user system.User, db *gorm.DB) {
-	ip := NormalizeIP(c)
-	ua := GetUserAgent(c)
+// LoginLogInfo 登录日志信息，需在请求结束前从上下文中采集
+type LoginLogInfo struct {
+	Username  string      // 登录用户名
+	User      system.User // 登录用户，失败时可能为空
+	Ip        string      // 登录ip
+	UserAgent string      // agent信息
+	Status    bool        // 登录结果
+	Reason    string      // 失败原因
+}
 
-	analysisData := GetIPAnalysis(ip)
+// NewLoginLogInfo 采集登录日志信息
+func NewLoginLogInfo(c *gin.Context, username string, user system.User, status bool, reason string) LoginLogInfo {
+	return LoginLogInfo{
+		Username:  username,
+		User:      user,
+		Ip:        NormalizeIP(c),
+		UserAgent: GetUserAgent(c),
+		Status:    status,
+		Reason:    reason,
+	}
+}
 
-	log := logger.LoginLogger{
-		LoginUsername:  user.Username,
-		Ip:             ip,
-		Agent:          ua,
-		Browser:        GetBrowser(ua),
-		Os:             GetOS(ua),
+// SaveLoginLog 保存登录日志（IP解析耗时，建议异步调用）
+func SaveLoginLog(info LoginLogInfo, sink *logsink.LogSink) {
+	analysisData := GetIPAnalysis(info.Ip)
+
+	log := &logger.LoginLogger{
+		Status:         info.Status,
+		LoginUsername:  info.Username,
+		Ip:             info.Ip,
+		Agent:          info.UserAgent,
+		Browser:        GetBrowser(info.UserAgent),
+		Os:             GetOS(info.UserAgent),
 		Continent:      analysisData.Continent,
 		Country:        analysisData.Country,
 		Province:       analysisData.Province,
@@ -166,13 +186,14 @@ func SaveLoginLog(c *gin.Context, user system.User, db *gorm.DB) {
 		Longitude:      analysisData.Longitude,
 		Latitude:       analysisData.Latitude,
 		CoreModels: models.CoreModels{
-			Creator:    user.Id, // 假设用户ID字段为ID
-			Modifier:   user.Id,
-			BelongDept: user.DeptId, // 假设用户有部门ID字段
+			Creator:    info.User.Id,
+			Modifier:   info.User.Id,
+			BelongDept: info.User.DeptId,
+			Remark:     info.Reason,
 		},
 	}
 
-	if err := db.Create(&log).Error; err != nil {
-		zap.L().Error("保存登录日志失败", zap.Error(err))
+	if !sink.WriteLogin(log) {
+		zap.L().Warn("保存登录日志失败", zap.String("username", info.Username))
 	}
 }
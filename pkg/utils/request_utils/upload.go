@@ -0,0 +1,31 @@
+/**
+ * Description：
+ * FileName：upload.go
+ * Author：CJiaの用心
+ * Create：2025/11/6 09:42:18
+ * Remark：
+ */
+
+package request_utils
+
+import (
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"mime/multipart"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// SaveUploadedFile 保存上传文件到 dir/日期 目录下
+// 文件名仅保留原始扩展名并使用随机名称，避免通过文件名进行路径穿越
+func SaveUploadedFile(c *gin.Context, file *multipart.FileHeader, dir string) (string, error) {
+	ext := strings.ToLower(filepath.Ext(filepath.Base(file.Filename)))
+	name := fmt.Sprintf("%s%s", strings.ReplaceAll(uuid.NewString(), "-", ""), ext)
+	filePath := filepath.Join(dir, time.Now().Format("2006-01-02"), name)
+	if err := c.SaveUploadedFile(file, filePath); err != nil {
+		return "", err
+	}
+	return filePath, nil
+}
@@ -0,0 +1,162 @@
+/**
+ * Description：
+ * FileName：local.go
+ * Author：CJiaの用心
+ * Create：2025/11/11 09:58:40
+ * Remark：
+ */
+
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// LocalConfig 本地存储配置
+type LocalConfig struct {
+	Root   string // 存储根目录
+	URL    string // 预签名下载接口地址
+	Secret string // 预签名密钥
+}
+
+// LocalDriver 本地磁盘存储，预签名地址由下载接口校验签名后提供文件
+type LocalDriver struct {
+	root   string
+	url    string
+	secret []byte
+	now    func() time.Time
+}
+
+func NewLocalDriver(cfg LocalConfig) (*LocalDriver, error) {
+	if cfg.Secret == "" {
+		return nil, errors.New("本地存储未配置预签名密钥")
+	}
+	root, err := filepath.Abs(cfg.Root)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, fmt.Errorf("创建存储目录失败: %w", err)
+	}
+	return &LocalDriver{
+		root:   root,
+		url:    cfg.URL,
+		secret: []byte(cfg.Secret),
+		now:    time.Now,
+	}, nil
+}
+
+func (d *LocalDriver) Name() string {
+	return DriverLocal
+}
+
+// Put 先写入临时文件再重命名，避免读取到未写完的文件
+func (d *LocalDriver) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	path, err := d.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (d *LocalDriver) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := d.path(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrObjectNotFound
+	}
+	return file, err
+}
+
+func (d *LocalDriver) Delete(ctx context.Context, key string) error {
+	path, err := d.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (d *LocalDriver) Exists(ctx context.Context, key string) (bool, error) {
+	path, err := d.path(key)
+	if err != nil {
+		return false, err
+	}
+	_, err = os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// PresignGet 生成带签名与过期时间的下载接口地址
+func (d *LocalDriver) PresignGet(ctx context.Context, key, name string, expire time.Duration) (string, error) {
+	if err := CheckKey(key); err != nil {
+		return "", err
+	}
+	expires := d.now().Add(expire).Unix()
+	query := url.Values{}
+	query.Set("key", key)
+	query.Set("name", name)
+	query.Set("expires", strconv.FormatInt(expires, 10))
+	query.Set("signature", d.sign(key, name, expires))
+	return d.url + "?" + query.Encode(), nil
+}
+
+// Verify 校验预签名下载地址的签名与过期时间
+func (d *LocalDriver) Verify(key, name string, expires int64, signature string) error {
+	if d.now().Unix() > expires {
+		return ErrInvalidSignature
+	}
+	if !hmac.Equal([]byte(signature), []byte(d.sign(key, name, expires))) {
+		return ErrInvalidSignature
+	}
+	return CheckKey(key)
+}
+
+func (d *LocalDriver) sign(key, name string, expires int64) string {
+	mac := hmac.New(sha256.New, d.secret)
+	_, _ = fmt.Fprintf(mac, "%s\n%s\n%d", key, name, expires)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// path 对象键转换为存储目录下的文件路径
+func (d *LocalDriver) path(key string) (string, error) {
+	if err := CheckKey(key); err != nil {
+		return "", err
+	}
+	return filepath.Join(d.root, filepath.FromSlash(key)), nil
+}
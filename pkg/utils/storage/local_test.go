@@ -0,0 +1,78 @@
+/**
+ * Description：
+ * FileName：local_test.go
+ * Author：CJiaの用心
+ * Create：2025/11/11 14:02:19
+ * Remark：
+ */
+
+package storage
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLocalDriver(t *testing.T) {
+	driver, err := NewLocalDriver(LocalConfig{Root: t.TempDir(), URL: "/dev-api/v1/storage/object", Secret: "secret"})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, driver.Put(ctx, "avatar/ab/abc.txt", strings.NewReader("hello"), 5, "text/plain"))
+
+	exists, err := driver.Exists(ctx, "avatar/ab/abc.txt")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	reader, err := driver.Get(ctx, "avatar/ab/abc.txt")
+	require.NoError(t, err)
+	body, _ := io.ReadAll(reader)
+	_ = reader.Close()
+	assert.Equal(t, "hello", string(body))
+
+	require.NoError(t, driver.Delete(ctx, "avatar/ab/abc.txt"))
+	assert.NoError(t, driver.Delete(ctx, "avatar/ab/abc.txt"))
+	_, err = driver.Get(ctx, "avatar/ab/abc.txt")
+	assert.ErrorIs(t, err, ErrObjectNotFound)
+
+	for _, key := range []string{"../secret", "/etc/passwd", "a//b", "a/./b", ""} {
+		assert.ErrorIs(t, driver.Put(ctx, key, strings.NewReader(""), 0, ""), ErrInvalidKey, key)
+	}
+
+	_, err = NewLocalDriver(LocalConfig{Root: t.TempDir()})
+	assert.Error(t, err)
+}
+
+func TestLocalDriver_Presign(t *testing.T) {
+	driver, err := NewLocalDriver(LocalConfig{Root: t.TempDir(), URL: "/dev-api/v1/storage/object", Secret: "secret"})
+	require.NoError(t, err)
+	now := time.Date(2025, 11, 11, 10, 0, 0, 0, time.Local)
+	driver.now = func() time.Time { return now }
+
+	link, err := driver.PresignGet(context.Background(), "avatar/ab/abc.txt", "头像.png", 15*time.Minute)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(link, "/dev-api/v1/storage/object?"))
+
+	u, err := url.Parse(link)
+	require.NoError(t, err)
+	query := u.Query()
+	expires, _ := strconv.ParseInt(query.Get("expires"), 10, 64)
+	assert.Equal(t, now.Add(15*time.Minute).Unix(), expires)
+	assert.NoError(t, driver.Verify(query.Get("key"), query.Get("name"), expires, query.Get("signature")))
+
+	// 篡改参数
+	assert.ErrorIs(t, driver.Verify("avatar/ab/other.txt", query.Get("name"), expires, query.Get("signature")), ErrInvalidSignature)
+	assert.ErrorIs(t, driver.Verify(query.Get("key"), "x.png", expires, query.Get("signature")), ErrInvalidSignature)
+	assert.ErrorIs(t, driver.Verify(query.Get("key"), query.Get("name"), expires+1, query.Get("signature")), ErrInvalidSignature)
+
+	// 已过期
+	driver.now = func() time.Time { return now.Add(16 * time.Minute) }
+	assert.ErrorIs(t, driver.Verify(query.Get("key"), query.Get("name"), expires, query.Get("signature")), ErrInvalidSignature)
+}
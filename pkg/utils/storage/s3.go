@@ -0,0 +1,320 @@
+/**
+ * Description：
+ * FileName：s3.go
+ * Author：CJiaの用心
+ * Create：2025/11/11 10:46:03
+ * Remark：
+ */
+
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	s3Algorithm      = "AWS4-HMAC-SHA256"
+	s3UnsignedBody   = "UNSIGNED-PAYLOAD"
+	s3DateFormat     = "20060102T150405Z"
+	s3MaxPresignTime = 7 * 24 * time.Hour // 预签名地址最长有效期
+)
+
+// S3Config S3 兼容对象存储配置
+type S3Config struct {
+	Endpoint  string       // 服务地址，如 http://127.0.0.1:9000
+	Region    string       // 区域，默认 us-east-1
+	Bucket    string       // 存储桶
+	AccessKey string       // 访问密钥ID
+	SecretKey string       // 访问密钥
+	PathStyle bool         // 使用路径风格地址(MinIO 需开启)
+	Client    *http.Client // 自定义HTTP客户端，默认 http.DefaultClient
+}
+
+// S3Driver S3 兼容对象存储，请求使用 AWS Signature V4 签名
+type S3Driver struct {
+	cfg      S3Config
+	endpoint *url.URL
+	client   *http.Client
+	now      func() time.Time
+}
+
+func NewS3Driver(cfg S3Config) (*S3Driver, error) {
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("无效的对象存储地址: %s", cfg.Endpoint)
+	}
+	if cfg.Bucket == "" || cfg.AccessKey == "" || cfg.SecretKey == "" {
+		return nil, errors.New("对象存储未配置存储桶或访问密钥")
+	}
+	if cfg.Region == "" {
+		cfg.Region = "us-east-1"
+	}
+	client := cfg.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &S3Driver{
+		cfg:      cfg,
+		endpoint: endpoint,
+		client:   client,
+		now:      time.Now,
+	}, nil
+}
+
+func (d *S3Driver) Name() string {
+	return DriverS3
+}
+
+func (d *S3Driver) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	req, err := d.newRequest(ctx, http.MethodPut, key, r)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	resp, err := d.do(req)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func (d *S3Driver) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	req, err := d.newRequest(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := d.do(req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+func (d *S3Driver) Delete(ctx context.Context, key string) error {
+	req, err := d.newRequest(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := d.do(req)
+	if errors.Is(err, ErrObjectNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func (d *S3Driver) Exists(ctx context.Context, key string) (bool, error) {
+	req, err := d.newRequest(ctx, http.MethodHead, key, nil)
+	if err != nil {
+		return false, err
+	}
+	resp, err := d.do(req)
+	if errors.Is(err, ErrObjectNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, resp.Body.Close()
+}
+
+// PresignGet 生成查询参数签名的下载地址，下载文件名通过 response-content-disposition 指定
+func (d *S3Driver) PresignGet(ctx context.Context, key, name string, expire time.Duration) (string, error) {
+	if err := CheckKey(key); err != nil {
+		return "", err
+	}
+	if expire <= 0 || expire > s3MaxPresignTime {
+		return "", fmt.Errorf("预签名有效期须在 1 秒到 %s 之间", s3MaxPresignTime)
+	}
+	extra := url.Values{}
+	if name != "" {
+		extra.Set("response-content-disposition", mime.FormatMediaType("attachment", map[string]string{"filename": name}))
+	}
+	return d.presign(d.objectURL(key), expire, d.now(), extra), nil
+}
+
+// newRequest 创建对象请求
+func (d *S3Driver) newRequest(ctx context.Context, method, key string, body io.Reader) (*http.Request, error) {
+	if err := CheckKey(key); err != nil {
+		return nil, err
+	}
+	return http.NewRequestWithContext(ctx, method, d.objectURL(key).String(), body)
+}
+
+// do 签名并发送请求，非 2xx 响应转换为错误
+func (d *S3Driver) do(req *http.Request) (*http.Response, error) {
+	req.Header.Set("x-amz-content-sha256", s3UnsignedBody)
+	d.sign(req, s3UnsignedBody, d.now())
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrObjectNotFound
+	}
+	message, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return nil, fmt.Errorf("对象存储请求失败[%d]: %s", resp.StatusCode, strings.TrimSpace(string(message)))
+}
+
+// objectURL 对象地址，路径风格为 endpoint/bucket/key，否则为 bucket.endpoint/key
+func (d *S3Driver) objectURL(key string) *url.URL {
+	u := *d.endpoint
+	if d.cfg.PathStyle {
+		u.Path = strings.TrimSuffix(u.Path, "/") + "/" + d.cfg.Bucket + "/" + key
+	} else {
+		u.Host = d.cfg.Bucket + "." + u.Host
+		u.Path = strings.TrimSuffix(u.Path, "/") + "/" + key
+	}
+	return &u
+}
+
+// sign 按 Signature V4 为请求添加 Authorization 头，签名 host 及请求中已设置的全部请求头
+func (d *S3Driver) sign(req *http.Request, payloadHash string, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format(s3DateFormat)
+	req.Header.Set("x-amz-date", amzDate)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		s3URIEncode(req.URL.Path, false),
+		s3CanonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := d.scope(now)
+	signature := d.signature(now, scope, amzDate, canonicalRequest)
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, d.cfg.AccessKey, scope, signedHeaders, signature))
+}
+
+// presign 生成查询参数签名的地址，仅签名 host 头
+func (d *S3Driver) presign(u *url.URL, expire time.Duration, now time.Time, extra url.Values) string {
+	now = now.UTC()
+	amzDate := now.Format(s3DateFormat)
+	scope := d.scope(now)
+
+	query := url.Values{}
+	for k, v := range extra {
+		query[k] = v
+	}
+	query.Set("X-Amz-Algorithm", s3Algorithm)
+	query.Set("X-Amz-Credential", d.cfg.AccessKey+"/"+scope)
+	query.Set("X-Amz-Date", amzDate)
+	query.Set("X-Amz-Expires", strconv.FormatInt(int64(expire/time.Second), 10))
+	query.Set("X-Amz-SignedHeaders", "host")
+
+	canonicalQuery := s3CanonicalQuery(query)
+	canonicalRequest := strings.Join([]string{
+		http.MethodGet,
+		s3URIEncode(u.Path, false),
+		canonicalQuery,
+		"host:" + u.Host + "\n",
+		"host",
+		s3UnsignedBody,
+	}, "\n")
+
+	signed := *u
+	signed.RawQuery = canonicalQuery + "&X-Amz-Signature=" + d.signature(now, scope, amzDate, canonicalRequest)
+	return signed.String()
+}
+
+// scope 签名范围：日期/区域/服务/aws4_request
+func (d *S3Driver) scope(now time.Time) string {
+	return now.Format("20060102") + "/" + d.cfg.Region + "/s3/aws4_request"
+}
+
+// signature 计算签名
+func (d *S3Driver) signature(now time.Time, scope, amzDate, canonicalRequest string) string {
+	hash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{s3Algorithm, amzDate, scope, hex.EncodeToString(hash[:])}, "\n")
+
+	key := s3HMAC([]byte("AWS4"+d.cfg.SecretKey), now.Format("20060102"))
+	key = s3HMAC(key, d.cfg.Region)
+	key = s3HMAC(key, "s3")
+	key = s3HMAC(key, "aws4_request")
+	return hex.EncodeToString(s3HMAC(key, stringToSign))
+}
+
+func s3HMAC(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// s3CanonicalQuery 规范化查询参数：按键排序，键值均按 RFC 3986 编码
+func s3CanonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		values := append([]string(nil), query[k]...)
+		sort.Strings(values)
+		for _, v := range values {
+			parts = append(parts, s3URIEncode(k, true)+"="+s3URIEncode(v, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// s3URIEncode 按 Signature V4 规则编码，仅保留 A-Z a-z 0-9 - _ . ~ 不编码
+func s3URIEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,60 @@
+/**
+ * Description：
+ * FileName：storage.go
+ * Author：CJiaの用心
+ * Create：2025/11/11 09:32:15
+ * Remark：
+ */
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"regexp"
+	"time"
+)
+
+const (
+	DriverLocal = "local" // 本地磁盘
+	DriverS3    = "s3"    // S3 兼容对象存储(MinIO、OSS、COS 等)
+)
+
+var (
+	ErrObjectNotFound   = errors.New("文件不存在")
+	ErrInvalidKey       = errors.New("无效的文件存储路径")
+	ErrInvalidSignature = errors.New("下载地址无效或已过期")
+
+	keyPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*(/[A-Za-z0-9][A-Za-z0-9._-]*)*$`)
+)
+
+// Driver 文件存储驱动
+type Driver interface {
+	// Name 驱动名称
+	Name() string
+	// Put 写入对象，同名对象直接覆盖
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	// Get 读取对象，对象不存在时返回 ErrObjectNotFound
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete 删除对象，对象不存在时不报错
+	Delete(ctx context.Context, key string) error
+	// Exists 判断对象是否存在
+	Exists(ctx context.Context, key string) (bool, error)
+	// PresignGet 生成限时下载地址，name 为下载文件名
+	PresignGet(ctx context.Context, key, name string, expire time.Duration) (string, error)
+}
+
+// Verifier 由服务端校验签名后提供下载的驱动(本地存储)
+type Verifier interface {
+	// Verify 校验预签名下载地址的参数
+	Verify(key, name string, expires int64, signature string) error
+}
+
+// CheckKey 校验对象键，仅允许字母、数字及 . _ - 组成的多级路径，每级须以字母或数字开头(排除 .. 及绝对路径)
+func CheckKey(key string) error {
+	if len(key) > 255 || !keyPattern.MatchString(key) {
+		return ErrInvalidKey
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+/**
+ * Description：
+ * FileName：pool.go
+ * Author：CJiaの用心
+ * Create：2025/11/7 09:26:41
+ * Remark：
+ */
+
+package workerpool
+
+import (
+	"context"
+	"errors"
+	"go.uber.org/zap"
+	"sync"
+)
+
+var (
+	ErrPoolClosed = errors.New("任务池已关闭")
+	ErrPoolFull   = errors.New("任务队列已满，请稍后重试")
+)
+
+// Task 后台任务，ctx 在任务池关闭超时后取消
+type Task func(ctx context.Context)
+
+// Config 任务池配置
+type Config struct {
+	Name      string // 名称，用于日志输出
+	Workers   int    // 并发执行的协程数 (默认: 4)
+	QueueSize int    // 等待队列容量 (默认: 128)
+}
+
+// DefaultConfig 默认配置
+func DefaultConfig() Config {
+	return Config{
+		Workers:   4,
+		QueueSize: 128,
+	}
+}
+
+// Pool 固定协程数 + 有界队列的后台任务池
+type Pool struct {
+	cfg    Config
+	queue  chan Task
+	ctx    context.Context
+	cancel context.CancelFunc
+	mu     sync.RWMutex
+	closed bool
+	wg     sync.WaitGroup
+}
+
+// New 创建并启动任务池
+func New(cfg Config) *Pool {
+	def := DefaultConfig()
+	if cfg.Workers <= 0 {
+		cfg.Workers = def.Workers
+	}
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = def.QueueSize
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &Pool{
+		cfg:    cfg,
+		queue:  make(chan Task, cfg.QueueSize),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	for i := 0; i < cfg.Workers; i++ {
+		p.wg.Add(1)
+		go p.run()
+	}
+	return p
+}
+
+// Submit 提交任务，队列已满时立即返回 ErrPoolFull，不阻塞调用方
+func (p *Pool) Submit(task Task) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.closed {
+		return ErrPoolClosed
+	}
+
+	select {
+	case p.queue <- task:
+		return nil
+	default:
+		return ErrPoolFull
+	}
+}
+
+// Pending 等待执行的任务数
+func (p *Pool) Pending() int {
+	return len(p.queue)
+}
+
+// Close 停止接收任务并等待已提交的任务执行完成，ctx 到期后取消仍在执行的任务
+func (p *Pool) Close(ctx context.Context) error {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return ErrPoolClosed
+	}
+	p.closed = true
+	close(p.queue)
+	p.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		p.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		p.cancel()
+		return nil
+	case <-ctx.Done():
+		p.cancel()
+		return ctx.Err()
+	}
+}
+
+// run 后台协程：逐个执行队列中的任务
+func (p *Pool) run() {
+	defer p.wg.Done()
+
+	for task := range p.queue {
+		p.execute(task)
+	}
+}
+
+// execute 执行单个任务，任务异常不影响协程继续工作
+func (p *Pool) execute(task Task) {
+	defer func() {
+		if r := recover(); r != nil {
+			zap.L().Error("后台任务执行异常", zap.String("name", p.cfg.Name), zap.Any("panic", r))
+		}
+	}()
+
+	task(p.ctx)
+}
@@ -0,0 +1,79 @@
+/**
+ * Description：
+ * FileName：pool_test.go
+ * Author：CJiaの用心
+ * Create：2025/11/7 09:58:13
+ * Remark：
+ */
+
+package workerpool
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPool_Submit(t *testing.T) {
+	p := New(Config{Name: "test", Workers: 2, QueueSize: 8})
+
+	var count atomic.Int32
+	for i := 0; i < 5; i++ {
+		assert.NoError(t, p.Submit(func(ctx context.Context) {
+			count.Add(1)
+		}))
+	}
+	// 任务异常不影响后续任务执行
+	assert.NoError(t, p.Submit(func(ctx context.Context) {
+		panic("boom")
+	}))
+	assert.NoError(t, p.Submit(func(ctx context.Context) {
+		count.Add(1)
+	}))
+
+	assert.NoError(t, p.Close(context.Background()))
+	assert.Equal(t, int32(6), count.Load())
+	assert.ErrorIs(t, p.Submit(func(ctx context.Context) {}), ErrPoolClosed)
+	assert.ErrorIs(t, p.Close(context.Background()), ErrPoolClosed)
+}
+
+func TestPool_QueueFull(t *testing.T) {
+	p := New(Config{Workers: 1, QueueSize: 1})
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	assert.NoError(t, p.Submit(func(ctx context.Context) {
+		close(started)
+		<-release
+	}))
+	<-started
+
+	assert.NoError(t, p.Submit(func(ctx context.Context) {}))
+	assert.ErrorIs(t, p.Submit(func(ctx context.Context) {}), ErrPoolFull)
+	assert.Equal(t, 1, p.Pending())
+
+	close(release)
+	assert.NoError(t, p.Close(context.Background()))
+}
+
+func TestPool_CloseTimeout(t *testing.T) {
+	p := New(Config{Workers: 1, QueueSize: 1})
+
+	canceled := make(chan struct{})
+	assert.NoError(t, p.Submit(func(ctx context.Context) {
+		<-ctx.Done()
+		close(canceled)
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, p.Close(ctx), context.DeadlineExceeded)
+
+	select {
+	case <-canceled:
+	case <-time.After(time.Second):
+		t.Fatal("关闭超时后任务未被取消")
+	}
+}
@@ -77,6 +77,23 @@ func (x *Xlsx) ReadSheetByName(sheetName string) ([]map[string]string, error) {
 	return x.processRows(rows), nil
 }
 
+// ReadHeaders 读取指定工作表的表头(已去重并处理空表头)，与 ReadSheetByName 返回的键一致
+func (x *Xlsx) ReadHeaders(sheetName string) ([]string, error) {
+	if err := x.openFile(); err != nil {
+		return nil, err
+	}
+
+	rows, err := x.getSheetRows(sheetName)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return []string{}, nil
+	}
+
+	return x.processHeaders(rows[0]), nil
+}
+
 // ReadAllSheets 读取所有工作表数据
 func (x *Xlsx) ReadAllSheets() (map[string][]map[string]string, error) {
 	if err := x.openFile(); err != nil {
@@ -10,12 +10,13 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"database/sql"
 	"fmt"
 	"github.com/carefuly/careful-admin-go-gin/config"
+	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/seeds"
 	"github.com/carefuly/careful-admin-go-gin/internal/model/careful/system"
 	"github.com/carefuly/careful-admin-go-gin/ioc"
-	"github.com/carefuly/careful-admin-go-gin/pkg/models"
 	uuid7 "github.com/gofrs/uuid"
 	uuid4 "github.com/google/uuid"
 	"go.uber.org/zap"
@@ -40,21 +41,25 @@ func main() {
 	}
 	defer configManager.StopWatching()
 	// 初始化远程配置
-	remoteConfig := ioc.InitLoadNacosConfig(configManager.Config)
+	remoteConfig := ioc.InitRemoteConfig(configManager.Config).Config()
 	// 初始化数据库池
 	dbPool := ioc.NewDbPool(remoteConfig.DatabaseConfig)
 	configManager.RelyConfig.Db = config.Database{
 		Careful: dbPool.CarefulDB,
 	}
 
-	// 自动迁移表
-	system.NewUser().AutoMigrate(configManager.RelyConfig.Db.Careful)
-	system.NewDept().AutoMigrate(configManager.RelyConfig.Db.Careful)
+	// 执行数据库迁移
+	ioc.InitMigrate(configManager.RelyConfig.Db.Careful)
 
-	// 创建部门
-	err := ensureDefaultDept(configManager.RelyConfig.Db.Careful)
+	// 导入种子数据中的部门、菜单与字典，超级用户由下方交互创建
+	fixture, err := seeds.LoadFixture(seeds.FS, configManager.Config.Application.Environment)
 	if err != nil {
-		fmt.Printf("创建部门失败: %v\n", err)
+		fmt.Printf("加载种子数据失败: %v\n", err)
+		os.Exit(1)
+	}
+	fixture.Users = nil
+	if _, err := seeds.Apply(context.Background(), configManager.RelyConfig.Db.Careful, fixture); err != nil {
+		fmt.Printf("导入种子数据失败: %v\n", err)
 		os.Exit(1)
 	}
 
@@ -188,6 +193,8 @@ func createSuperUser(db *gorm.DB) error {
 
 	user.Id = generateId()
 	user.Timestamp = generateTimestamp()
+	// 超级用户不受接口权限限制
+	user.IsSuperuser = true
 
 	// 创建用户
 	if err := db.Create(user).Error; err != nil {
@@ -208,44 +215,6 @@ func createSuperUser(db *gorm.DB) error {
 	return nil
 }
 
-func ensureDefaultDept(db *gorm.DB) error {
-	var count int64
-	if err := db.Model(&system.Dept{}).Count(&count).Error; err != nil {
-		return fmt.Errorf("检查部门表失败: %v", err)
-	}
-	// 如果没有部门，创建默认部门
-	if count == 0 {
-		defaultDept := &system.Dept{
-			CoreModels: models.CoreModels{
-				Id:        generateId(),
-				Timestamp: generateTimestamp(),
-			},
-			Status: true,
-			Name:   "用心集团有限公司",
-			Code:   "CAREFUL-COMPANY",
-			Owner:  "careful",
-			Phone:  "13888888888",
-			Email:  "careful@gmail.com",
-			ParentID: sql.NullString{
-				String: "",
-				Valid:  false,
-			},
-			Level:      0,
-			Path:       "",
-			UserCount:  0,
-			ChildCount: 0,
-		}
-
-		if err := db.Create(defaultDept).Error; err != nil {
-			return fmt.Errorf("创建默认部门失败: %v", err)
-		}
-
-		fmt.Println("已创建默认部门: ", defaultDept.Name)
-	}
-
-	return nil
-}
-
 func generateId() string {
 	var id string
 	u7, err := uuid7.NewV7()